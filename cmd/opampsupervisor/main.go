@@ -5,18 +5,30 @@ package main
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
 	"flag"
 	"fmt"
 	"log"
 	"os"
 	"os/signal"
 
+	"github.com/open-telemetry/opamp-go/protobufs"
+
 	"github.com/open-telemetry/opentelemetry-collector-contrib/cmd/opampsupervisor/supervisor"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/cmd/opampsupervisor/supervisor/config"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/cmd/opampsupervisor/supervisor/telemetry"
 )
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "validate" {
+		if err := runValidate(os.Args[2:]); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
 	if err := run(); err != nil {
 		log.Fatal(err)
 	}
@@ -56,3 +68,65 @@ func runInteractive() error {
 
 	return nil
 }
+
+// runValidate dry-runs a remote config against the Collector configured in the supervisor
+// config, printing the result without applying the config.
+func runValidate(args []string) error {
+	flags := flag.NewFlagSet("validate", flag.ExitOnError)
+	configFlag := flags.String("config", "", "Path to a supervisor configuration file")
+	remoteConfigFlag := flags.String("remote-config", "", "Path to the remote configuration file to validate")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if *remoteConfigFlag == "" {
+		return errors.New("path to the remote config file cannot be empty")
+	}
+
+	cfg, err := config.Load(*configFlag)
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+
+	remoteConfig, err := os.ReadFile(*remoteConfigFlag)
+	if err != nil {
+		return fmt.Errorf("failed to read remote config: %w", err)
+	}
+	hash := sha256.Sum256(remoteConfig)
+
+	logger, err := telemetry.NewLogger(cfg.Telemetry.Logs)
+	if err != nil {
+		return fmt.Errorf("failed to create logger: %w", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	supervisor, err := supervisor.NewSupervisor(ctx, logger.Named("supervisor"), cfg)
+	if err != nil {
+		return fmt.Errorf("failed to create supervisor: %w", err)
+	}
+	defer supervisor.Shutdown()
+
+	result, err := supervisor.ValidateConfig(&protobufs.AgentRemoteConfig{
+		Config: &protobufs.AgentConfigMap{
+			ConfigMap: map[string]*protobufs.AgentConfigFile{
+				"": {Body: remoteConfig},
+			},
+		},
+		ConfigHash: hash[:],
+	})
+	if err != nil {
+		return fmt.Errorf("failed to validate remote config: %w", err)
+	}
+
+	out, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(out))
+
+	if !result.Valid {
+		return errors.New("remote config is invalid")
+	}
+	return nil
+}
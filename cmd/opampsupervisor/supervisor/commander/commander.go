@@ -5,6 +5,7 @@ package commander
 
 import (
 	"bufio"
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
@@ -347,6 +348,23 @@ func (c *Commander) StartOneShot() ([]byte, []byte, error) {
 	return stdout, stderr, nil
 }
 
+// Run runs the Collector until it exits and returns its stdout and stderr. Unlike
+// StartOneShot, it returns an error if the Collector exits with a non-zero exit code,
+// which makes it suitable for commands like `validate`.
+func (c *Commander) Run(ctx context.Context) ([]byte, []byte, error) {
+	var stdout, stderr bytes.Buffer
+
+	cmd := exec.CommandContext(ctx, c.cfg.Executable, c.args...) // #nosec G204
+	cmd.Env = envVarMapToEnvMapSlice(c.cfg.Env)
+	cmd.SysProcAttr = sysProcAttrs()
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	c.logger.Debug("Running agent process", zap.Strings("args", c.args))
+	err := cmd.Run()
+	return stdout.Bytes(), stderr.Bytes(), err
+}
+
 // Exited returns a channel that will send a signal when the Agent process exits.
 func (c *Commander) Exited() <-chan struct{} {
 	return c.exitCh
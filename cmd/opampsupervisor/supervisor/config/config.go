@@ -116,6 +116,9 @@ type Capabilities struct {
 	ReportsRemoteConfig            bool `mapstructure:"reports_remote_config"`
 	ReportsAvailableComponents     bool `mapstructure:"reports_available_components"`
 	ReportsHeartbeat               bool `mapstructure:"reports_heartbeat"`
+	// ValidatesRemoteConfig enables a custom capability to validate remote configs against
+	// the Collector without applying them.
+	ValidatesRemoteConfig bool `mapstructure:"validates_remote_config"`
 }
 
 func (c Capabilities) SupportedCapabilities() protobufs.AgentCapabilities {
@@ -371,6 +374,7 @@ func DefaultSupervisor() Supervisor {
 			ReportsRemoteConfig:            false,
 			ReportsAvailableComponents:     false,
 			ReportsHeartbeat:               true,
+			ValidatesRemoteConfig:          false,
 		},
 		Storage: Storage{
 			Directory: defaultStorageDir,
@@ -165,6 +165,9 @@ type Supervisor struct {
 	customMessageToServer chan *protobufs.CustomMessage
 	customMessageWG       sync.WaitGroup
 
+	// A channel of the remote configs the server asks to validate without applying them.
+	configValidationRequests chan *protobufs.CustomMessage
+
 	// agentReady is true if the agent has started and is fully ready.
 	agentReady atomic.Bool
 	// agentReadyChan is a channel that can be used to wait for the agent to
@@ -203,6 +206,7 @@ func NewSupervisor(ctx context.Context, logger *zap.Logger, cfg config.Superviso
 		availableComponents:            &atomic.Value{},
 		doneChan:                       make(chan struct{}),
 		customMessageToServer:          make(chan *protobufs.CustomMessage, maxBufferedCustomMessages),
+		configValidationRequests:       make(chan *protobufs.CustomMessage, maxBufferedCustomMessages),
 		agentConn:                      &atomic.Value{},
 		featureGates:                   map[string]struct{}{},
 		agentReady:                     atomic.Bool{},
@@ -383,6 +387,12 @@ func (s *Supervisor) Start(ctx context.Context) error {
 		s.forwardCustomMessagesToServerLoop()
 	})
 
+	if s.config.Capabilities.ValidatesRemoteConfig {
+		s.customMessageWG.Go(func() {
+			s.configValidationLoop()
+		})
+	}
+
 	return nil
 }
 
@@ -714,6 +724,12 @@ func (s *Supervisor) startOpAMPClient() error {
 		return err
 	}
 
+	if s.config.Capabilities.ValidatesRemoteConfig {
+		if err := s.setCustomCapabilities(nil); err != nil {
+			return err
+		}
+	}
+
 	// Set heartbeat interval if the agent supports it
 	if s.config.Capabilities.ReportsHeartbeat {
 		d := time.Duration(s.heartbeatIntervalSeconds) * time.Second
@@ -863,7 +879,7 @@ func (s *Supervisor) handleAgentOpAMPMessage(conn serverTypes.Connection, messag
 	// Proxy client capabilities to server
 	if message.CustomCapabilities != nil {
 		span.AddEvent("Received customCapabilities")
-		err := s.opampClient.SetCustomCapabilities(message.CustomCapabilities)
+		err := s.setCustomCapabilities(message.CustomCapabilities)
 		if err != nil {
 			span.SetStatus(codes.Error, fmt.Sprintf("Failed to send custom capabilities to OpAMP server: %s", err.Error()))
 			s.telemetrySettings.Logger.Error("Failed to send custom capabilities to OpAMP server")
@@ -1100,13 +1116,11 @@ func (s *Supervisor) composeNoopPipeline() ([]byte, error) {
 	return cfg.Bytes(), nil
 }
 
-func (s *Supervisor) createRemoteConfigComposers(incomingConfig *protobufs.AgentRemoteConfig) []configComposer {
-	if !s.config.Capabilities.AcceptsRemoteConfig {
-		return []configComposer{}
-	}
-
+// remoteConfigComposers returns the composers of the files of a remote config, in the order
+// they are merged.
+func remoteConfigComposers(incomingConfig *protobufs.AgentRemoteConfig) []configComposer {
 	hasIncomingConfigMap := len(incomingConfig.GetConfig().GetConfigMap()) != 0
-	remoteConfigComposers := []configComposer{}
+	composers := []configComposer{}
 	if hasIncomingConfigMap {
 		c := incomingConfig.GetConfig()
 
@@ -1131,12 +1145,12 @@ func (s *Supervisor) createRemoteConfigComposers(incomingConfig *protobufs.Agent
 			if item == nil {
 				continue
 			}
-			remoteConfigComposers = append(remoteConfigComposers, func() []byte {
+			composers = append(composers, func() []byte {
 				return item.Body
 			})
 		}
 	}
-	return remoteConfigComposers
+	return composers
 }
 
 func (s *Supervisor) composeNoopConfig() ([]byte, error) {
@@ -1218,13 +1232,13 @@ func (s *Supervisor) composeOpAMPExtensionConfig() []byte {
 
 type configComposer func() []byte
 
-func (s *Supervisor) composeAgentConfigFiles(incomingConfig *protobufs.AgentRemoteConfig) ([]byte, error) {
+func (s *Supervisor) composeAgentConfigFiles(remoteConfigComposers []configComposer) ([]byte, error) {
 	conf := koanf.New("::")
 
 	specialConfigComposers := map[config.SpecialConfigFile][]configComposer{
 		config.SpecialConfigFileOwnTelemetry:   {s.composeOwnTelemetryConfig, s.composeExtraTelemetryConfig},
 		config.SpecialConfigFileOpAMPExtension: {s.composeOpAMPExtensionConfig},
-		config.SpecialConfigFileRemoteConfig:   s.createRemoteConfigComposers(incomingConfig),
+		config.SpecialConfigFileRemoteConfig:   remoteConfigComposers,
 	}
 
 	for _, file := range s.config.Agent.ConfigFiles {
@@ -1414,39 +1428,12 @@ func (s *Supervisor) setupOwnTelemetry(_ context.Context, settings *protobufs.Co
 // 2) the own metrics config section
 // 3) the local override config that is hard-coded in the Supervisor.
 func (s *Supervisor) composeMergedConfig(incomingConfig *protobufs.AgentRemoteConfig) (configChanged bool, err error) {
-	k := koanf.New("::")
-
-	s.addSpecialConfigFiles()
-
-	hasIncomingConfigMap := len(incomingConfig.GetConfig().GetConfigMap()) != 0
-	if s.config.Capabilities.AcceptsRemoteConfig && !hasIncomingConfigMap {
-		// Add noop pipeline
-		var noopConfig []byte
-		noopConfig, err = s.composeNoopPipeline()
-		if err != nil {
-			return false, fmt.Errorf("could not compose noop pipeline: %w", err)
-		}
-
-		if err = k.Load(rawbytes.Provider(noopConfig), yaml.Parser(), koanf.WithMergeFunc(configMergeFunc)); err != nil {
-			return false, fmt.Errorf("could not merge noop pipeline: %w", err)
-		}
-	}
-
-	agentConfigBytes, err := s.composeAgentConfigFiles(incomingConfig)
+	newMergedConfigBytes, err := s.composeConfig(incomingConfig, s.config.Capabilities.AcceptsRemoteConfig)
 	if err != nil {
 		return false, err
 	}
 
-	err = k.Load(rawbytes.Provider(agentConfigBytes), yaml.Parser(), koanf.WithMergeFunc(configMergeFunc))
-	if err != nil {
-		return false, err
-	}
-
-	// The merged final result is our new merged config.
-	newMergedConfigBytes, err := k.Marshal(yaml.Parser())
-	if err != nil {
-		return false, err
-	}
+	hasIncomingConfigMap := len(incomingConfig.GetConfig().GetConfigMap()) != 0
 
 	// Check if supervisor's merged config is changed.
 
@@ -1466,6 +1453,44 @@ func (s *Supervisor) composeMergedConfig(incomingConfig *protobufs.AgentRemoteCo
 	return configChanged, nil
 }
 
+// composeConfig merges the agent's config files, including the given remote config if
+// withRemoteConfig is true, into the config the Collector runs with.
+func (s *Supervisor) composeConfig(incomingConfig *protobufs.AgentRemoteConfig, withRemoteConfig bool) ([]byte, error) {
+	k := koanf.New("::")
+
+	s.addSpecialConfigFiles()
+
+	hasIncomingConfigMap := len(incomingConfig.GetConfig().GetConfigMap()) != 0
+	if withRemoteConfig && !hasIncomingConfigMap {
+		// Add noop pipeline
+		noopConfig, err := s.composeNoopPipeline()
+		if err != nil {
+			return nil, fmt.Errorf("could not compose noop pipeline: %w", err)
+		}
+
+		if err = k.Load(rawbytes.Provider(noopConfig), yaml.Parser(), koanf.WithMergeFunc(configMergeFunc)); err != nil {
+			return nil, fmt.Errorf("could not merge noop pipeline: %w", err)
+		}
+	}
+
+	var composers []configComposer
+	if withRemoteConfig {
+		composers = remoteConfigComposers(incomingConfig)
+	}
+	agentConfigBytes, err := s.composeAgentConfigFiles(composers)
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.Load(rawbytes.Provider(agentConfigBytes), yaml.Parser(), koanf.WithMergeFunc(configMergeFunc))
+	if err != nil {
+		return nil, err
+	}
+
+	// The merged final result is the config of the Collector.
+	return k.Marshal(yaml.Parser())
+}
+
 func (s *Supervisor) handleRestartCommand() error {
 	s.agentRestarting.Store(true)
 	defer s.agentRestarting.Store(false)
@@ -1952,8 +1977,14 @@ func (s *Supervisor) onMessage(ctx context.Context, msg *types.MessageData) {
 		haveMessageForAgent = true
 	}
 
-	// Proxy server messages to opamp extension
-	if msg.CustomMessage != nil {
+	// Proxy server messages to opamp extension, except the ones for the Supervisor's own capabilities
+	if msg.CustomMessage.GetCapability() == configValidationCapability && s.config.Capabilities.ValidatesRemoteConfig {
+		select {
+		case s.configValidationRequests <- msg.CustomMessage:
+		default:
+			s.telemetrySettings.Logger.Warn("Buffer full, skipping config validation request from server")
+		}
+	} else if msg.CustomMessage != nil {
 		messageToAgent.CustomMessage = msg.CustomMessage
 		haveMessageForAgent = true
 	}
@@ -0,0 +1,260 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package supervisor
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/knadh/koanf/parsers/yaml"
+	"github.com/knadh/koanf/providers/rawbytes"
+	"github.com/knadh/koanf/v2"
+	"github.com/open-telemetry/opamp-go/protobufs"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/cmd/opampsupervisor/supervisor/commander"
+)
+
+const (
+	// configValidationCapability is the custom capability the Supervisor implements to
+	// validate remote configs against the Collector without applying them.
+	configValidationCapability = "io.opentelemetry.supervisor.config_validation"
+	// configValidationRequestType is the type of the custom messages the server sends to
+	// validate a remote config, with a serialized AgentRemoteConfig protobuf message as data.
+	configValidationRequestType = "validate"
+	// configValidationResultType is the type of the custom messages the Supervisor replies
+	// with, with the JSON encoded ConfigValidationResult as data.
+	configValidationResultType = "result"
+
+	// validateCommandTimeout is the maximum time the Collector's validate command may run.
+	validateCommandTimeout = 30 * time.Second
+)
+
+// componentSections are the sections of the Collector config that define components, which
+// are also the kinds of components reported in the AvailableComponents message.
+var componentSections = []string{"receivers", "processors", "exporters", "connectors", "extensions"}
+
+// ConfigValidationResult is the result of the validation of a remote config.
+type ConfigValidationResult struct {
+	// ConfigHash is the hex encoded hash of the validated remote config.
+	ConfigHash string `json:"config_hash,omitempty"`
+	// Valid is true if the Collector would accept the config.
+	Valid bool `json:"valid"`
+	// Errors holds the reasons the config is invalid.
+	Errors []string `json:"errors,omitempty"`
+	// MissingComponents holds the components referenced by the config that are not
+	// available in the Collector, e.g. "receivers::kafka".
+	MissingComponents []string `json:"missing_components,omitempty"`
+}
+
+// ValidateConfig obtains the Collector's feature gates and available components, then
+// dry-runs the remote config against the Collector without applying it. It is meant to be
+// used instead of Start, e.g. to validate configs before rolling them out. It uses a
+// temporary storage directory so that it does not interfere with a running Supervisor.
+func (s *Supervisor) ValidateConfig(remoteConfig *protobufs.AgentRemoteConfig) (*ConfigValidationResult, error) {
+	storageDir, err := os.MkdirTemp("", "opampsupervisor-validate-")
+	if err != nil {
+		return nil, fmt.Errorf("error creating storage dir: %w", err)
+	}
+	defer os.RemoveAll(storageDir)
+	s.config.Storage.Directory = storageDir
+
+	s.persistentState, err = loadOrCreatePersistentState(s.persistentStateFilePath(), s.telemetrySettings.Logger)
+	if err != nil {
+		return nil, err
+	}
+	if err = s.getFeatureGates(); err != nil {
+		return nil, fmt.Errorf("could not get feature gates from the Collector: %w", err)
+	}
+	if err = s.getBootstrapInfo(); err != nil {
+		return nil, fmt.Errorf("could not get bootstrap info from the Collector: %w", err)
+	}
+
+	return s.validateRemoteConfig(s.runCtx, remoteConfig), nil
+}
+
+// validateRemoteConfig composes the config the Collector would run with if the remote
+// config was applied, checks that all the components it references are available in the
+// Collector and runs the Collector's validate command against it.
+func (s *Supervisor) validateRemoteConfig(ctx context.Context, remoteConfig *protobufs.AgentRemoteConfig) *ConfigValidationResult {
+	result := &ConfigValidationResult{
+		ConfigHash: hex.EncodeToString(remoteConfig.GetConfigHash()),
+	}
+
+	mergedConfig, err := s.composeConfig(remoteConfig, true)
+	if err != nil {
+		result.Errors = append(result.Errors, fmt.Sprintf("could not compose config: %v", err))
+		return result
+	}
+
+	result.MissingComponents, err = s.missingComponents(mergedConfig)
+	if err != nil {
+		result.Errors = append(result.Errors, fmt.Sprintf("could not read config: %v", err))
+		return result
+	}
+	if len(result.MissingComponents) > 0 {
+		result.Errors = append(result.Errors, "components not available in the Collector: "+strings.Join(result.MissingComponents, ", "))
+	}
+
+	if err := s.runValidateCommand(ctx, mergedConfig); err != nil {
+		result.Errors = append(result.Errors, err.Error())
+	}
+
+	result.Valid = len(result.Errors) == 0
+	return result
+}
+
+// missingComponents returns the components defined in the config whose type is not available
+// in the Collector. It returns nil if the Collector did not report its available components.
+func (s *Supervisor) missingComponents(cfg []byte) ([]string, error) {
+	ac, ok := s.availableComponents.Load().(*protobufs.AvailableComponents)
+	if !ok || ac.GetComponents() == nil {
+		s.telemetrySettings.Logger.Debug("Available components are unknown, skipping components check")
+		return nil, nil
+	}
+
+	k := koanf.New("::")
+	if err := k.Load(rawbytes.Provider(cfg), yaml.Parser()); err != nil {
+		return nil, err
+	}
+
+	var missing []string
+	for _, section := range componentSections {
+		available := ac.GetComponents()[section].GetSubComponentMap()
+		for _, id := range k.MapKeys(section) {
+			componentType, _, _ := strings.Cut(id, "/")
+			if _, ok := available[componentType]; !ok {
+				missing = append(missing, section+"::"+componentType)
+			}
+		}
+	}
+	sort.Strings(missing)
+	return slices.Compact(missing), nil
+}
+
+// runValidateCommand runs the Collector's validate command against the config.
+func (s *Supervisor) runValidateCommand(ctx context.Context, cfg []byte) error {
+	f, err := os.CreateTemp(s.config.Storage.Directory, "validate-*.yaml")
+	if err != nil {
+		return fmt.Errorf("could not write config to validate: %w", err)
+	}
+	defer os.Remove(f.Name())
+	_, err = f.Write(cfg)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return fmt.Errorf("could not write config to validate: %w", err)
+	}
+
+	args := []string{"validate", "--config", f.Name()}
+	args = append(args, s.getFeatureGateFlag()...)
+	cmd, err := commander.NewCommander(
+		s.telemetrySettings.Logger,
+		s.config.Storage.Directory,
+		s.config.Agent,
+		args...,
+	)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, validateCommandTimeout)
+	defer cancel()
+	_, stderr, err := cmd.Run(ctx)
+	if err == nil {
+		return nil
+	}
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) && ctx.Err() == nil {
+		if msg := strings.TrimSpace(string(stderr)); msg != "" {
+			return fmt.Errorf("the Collector rejected the config: %s", msg)
+		}
+		return fmt.Errorf("the Collector rejected the config: %w", err)
+	}
+	return fmt.Errorf("could not run the Collector's validate command: %w", err)
+}
+
+// setCustomCapabilities reports the custom capabilities of the agent to the server, along
+// with the ones the Supervisor implements itself.
+func (s *Supervisor) setCustomCapabilities(agentCapabilities *protobufs.CustomCapabilities) error {
+	if !s.config.Capabilities.ValidatesRemoteConfig {
+		return s.opampClient.SetCustomCapabilities(agentCapabilities)
+	}
+
+	capabilities := slices.Clone(agentCapabilities.GetCapabilities())
+	if !slices.Contains(capabilities, configValidationCapability) {
+		capabilities = append(capabilities, configValidationCapability)
+	}
+	return s.opampClient.SetCustomCapabilities(&protobufs.CustomCapabilities{Capabilities: capabilities})
+}
+
+// configValidationLoop validates the remote configs sent by the server, one at a time, and
+// reports the results back to the server.
+func (s *Supervisor) configValidationLoop() {
+	ctx, cancel := context.WithCancel(s.runCtx)
+	defer cancel()
+	// Cancel running validations on shutdown, which only cancels the run context once
+	// the loops are done.
+	go func() {
+		select {
+		case <-s.doneChan:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	for {
+		select {
+		case msg := <-s.configValidationRequests:
+			s.processConfigValidationRequest(ctx, msg)
+		case <-s.doneChan:
+			return
+		}
+	}
+}
+
+func (s *Supervisor) processConfigValidationRequest(ctx context.Context, msg *protobufs.CustomMessage) {
+	if msg.Type != configValidationRequestType {
+		s.telemetrySettings.Logger.Debug("Ignoring config validation message of unknown type", zap.String("type", msg.Type))
+		return
+	}
+
+	var result *ConfigValidationResult
+	remoteConfig := &protobufs.AgentRemoteConfig{}
+	if err := proto.Unmarshal(msg.Data, remoteConfig); err != nil {
+		result = &ConfigValidationResult{Errors: []string{fmt.Sprintf("could not decode remote config: %v", err)}}
+	} else {
+		result = s.validateRemoteConfig(ctx, remoteConfig)
+	}
+	s.telemetrySettings.Logger.Debug("Validated remote config",
+		zap.String("hash", result.ConfigHash),
+		zap.Bool("valid", result.Valid),
+		zap.Strings("errors", result.Errors))
+
+	data, err := json.Marshal(result)
+	if err != nil {
+		s.telemetrySettings.Logger.Error("Could not encode config validation result", zap.Error(err))
+		return
+	}
+	select {
+	case s.customMessageToServer <- &protobufs.CustomMessage{
+		Capability: configValidationCapability,
+		Type:       configValidationResultType,
+		Data:       data,
+	}:
+	default:
+		s.telemetrySettings.Logger.Warn("Buffer full, skipping sending config validation result to server")
+	}
+}
@@ -0,0 +1,245 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package supervisor
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sync/atomic"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/open-telemetry/opamp-go/client/types"
+	"github.com/open-telemetry/opamp-go/protobufs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/cmd/opampsupervisor/supervisor/config"
+)
+
+// fakeCollector is a Collector executable whose validate command rejects configs containing
+// an "invalid" component.
+const fakeCollector = `#!/bin/sh
+if grep -q "invalid" "$3"; then
+  echo "Error: invalid configuration: receivers::invalid: unknown type" >&2
+  exit 1
+fi
+`
+
+func newValidationTestSupervisor(t *testing.T, executable string) *Supervisor {
+	s := &Supervisor{
+		telemetrySettings: newNopTelemetrySettings(),
+		persistentState:   &persistentState{InstanceID: uuid.MustParse("018fee23-4a51-7303-a441-73faed7d9deb")},
+		config: config.Supervisor{
+			Capabilities: config.Capabilities{ValidatesRemoteConfig: true},
+			Agent:        config.Agent{Executable: executable},
+			Storage:      config.Storage{Directory: t.TempDir()},
+		},
+		pidProvider:                    staticPIDProvider(1234),
+		agentConfigOwnTelemetrySection: &atomic.Value{},
+		agentDescription:               &atomic.Value{},
+		availableComponents:            &atomic.Value{},
+		customMessageToServer:          make(chan *protobufs.CustomMessage, 10),
+		configValidationRequests:       make(chan *protobufs.CustomMessage, 10),
+		featureGates:                   map[string]struct{}{},
+	}
+	s.agentDescription.Store(&protobufs.AgentDescription{})
+	s.availableComponents.Store(&protobufs.AvailableComponents{
+		Components: map[string]*protobufs.ComponentDetails{
+			"receivers":  {SubComponentMap: map[string]*protobufs.ComponentDetails{"otlp": {}, "nop": {}, "invalid": {}}},
+			"exporters":  {SubComponentMap: map[string]*protobufs.ComponentDetails{"debug": {}, "nop": {}}},
+			"extensions": {SubComponentMap: map[string]*protobufs.ComponentDetails{"opamp": {}}},
+		},
+	})
+	require.NoError(t, s.createTemplates())
+	return s
+}
+
+func newFakeCollector(t *testing.T) string {
+	if runtime.GOOS == "windows" {
+		t.Skip("Skipping test on Windows because the fake Collector is a shell script.")
+	}
+	executable := filepath.Join(t.TempDir(), "otelcol")
+	require.NoError(t, os.WriteFile(executable, []byte(fakeCollector), 0o700))
+	return executable
+}
+
+func remoteConfigWithBody(body string) *protobufs.AgentRemoteConfig {
+	return &protobufs.AgentRemoteConfig{
+		Config: &protobufs.AgentConfigMap{
+			ConfigMap: map[string]*protobufs.AgentConfigFile{
+				"": {Body: []byte(body)},
+			},
+		},
+		ConfigHash: []byte{0xca, 0xfe},
+	}
+}
+
+func TestSupervisor_validateRemoteConfig(t *testing.T) {
+	tests := []struct {
+		name         string
+		remoteConfig string
+		wantValid    bool
+		wantErr      string
+		wantMissing  []string
+	}{
+		{
+			name: "valid config",
+			remoteConfig: `
+receivers:
+  otlp:
+exporters:
+  debug:
+service:
+  pipelines:
+    traces:
+      receivers: [otlp]
+      exporters: [debug]
+`,
+			wantValid: true,
+		},
+		{
+			name: "missing components",
+			remoteConfig: `
+receivers:
+  kafka/primary:
+  kafka/secondary:
+exporters:
+  debug:
+processors:
+  batch:
+service:
+  pipelines:
+    traces:
+      receivers: [kafka/primary, kafka/secondary]
+      processors: [batch]
+      exporters: [debug]
+`,
+			wantErr:     "components not available in the Collector: processors::batch, receivers::kafka",
+			wantMissing: []string{"processors::batch", "receivers::kafka"},
+		},
+		{
+			name: "rejected by the Collector",
+			remoteConfig: `
+receivers:
+  invalid:
+exporters:
+  debug:
+`,
+			wantErr: "the Collector rejected the config: Error: invalid configuration: receivers::invalid: unknown type",
+		},
+		{
+			name:         "invalid yaml",
+			remoteConfig: "receivers: [",
+			wantErr:      "could not compose config",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newValidationTestSupervisor(t, newFakeCollector(t))
+
+			got := s.validateRemoteConfig(t.Context(), remoteConfigWithBody(tt.remoteConfig))
+			assert.Equal(t, "cafe", got.ConfigHash)
+			assert.Equal(t, tt.wantValid, got.Valid)
+			assert.Equal(t, tt.wantMissing, got.MissingComponents)
+			if tt.wantErr == "" {
+				assert.Empty(t, got.Errors)
+			} else {
+				require.Len(t, got.Errors, 1)
+				assert.Contains(t, got.Errors[0], tt.wantErr)
+			}
+
+			entries, err := os.ReadDir(s.config.Storage.Directory)
+			require.NoError(t, err)
+			assert.Empty(t, entries, "the config to validate must be removed")
+		})
+	}
+}
+
+func TestSupervisor_configValidationRequest(t *testing.T) {
+	s := newValidationTestSupervisor(t, newFakeCollector(t))
+	s.agentConn = &atomic.Value{}
+	s.agentConn.Store(&mockConn{
+		sendFunc: func(_ context.Context, _ *protobufs.ServerToAgent) error {
+			t.Error("Config validation request must not be forwarded to the agent")
+			return nil
+		},
+	})
+
+	data, err := proto.Marshal(remoteConfigWithBody(`
+receivers:
+  otlp:
+exporters:
+  debug:
+`))
+	require.NoError(t, err)
+	s.onMessage(t.Context(), &types.MessageData{
+		CustomMessage: &protobufs.CustomMessage{
+			Capability: configValidationCapability,
+			Type:       configValidationRequestType,
+			Data:       data,
+		},
+	})
+
+	require.Len(t, s.configValidationRequests, 1)
+	s.processConfigValidationRequest(t.Context(), <-s.configValidationRequests)
+
+	require.Len(t, s.customMessageToServer, 1)
+	msg := <-s.customMessageToServer
+	assert.Equal(t, configValidationCapability, msg.Capability)
+	assert.Equal(t, configValidationResultType, msg.Type)
+
+	var result ConfigValidationResult
+	require.NoError(t, json.Unmarshal(msg.Data, &result))
+	assert.Equal(t, ConfigValidationResult{ConfigHash: "cafe", Valid: true}, result)
+}
+
+func TestSupervisor_setCustomCapabilities(t *testing.T) {
+	tests := []struct {
+		name                  string
+		validatesRemoteConfig bool
+		agentCapabilities     *protobufs.CustomCapabilities
+		want                  []string
+	}{
+		{
+			name:              "capability disabled",
+			agentCapabilities: &protobufs.CustomCapabilities{Capabilities: []string{"teapot"}},
+			want:              []string{"teapot"},
+		},
+		{
+			name:                  "capability enabled",
+			validatesRemoteConfig: true,
+			agentCapabilities:     &protobufs.CustomCapabilities{Capabilities: []string{"teapot"}},
+			want:                  []string{"teapot", configValidationCapability},
+		},
+		{
+			name:                  "capability enabled without agent capabilities",
+			validatesRemoteConfig: true,
+			want:                  []string{configValidationCapability},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			s := Supervisor{
+				config: config.Supervisor{
+					Capabilities: config.Capabilities{ValidatesRemoteConfig: tt.validatesRemoteConfig},
+				},
+				opampClient: &mockOpAMPClient{
+					setCustomCapabilitiesFunc: func(caps *protobufs.CustomCapabilities) error {
+						got = caps.GetCapabilities()
+						return nil
+					},
+				},
+			}
+
+			require.NoError(t, s.setCustomCapabilities(tt.agentCapabilities))
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,8 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:generate make mdatagen
+
+// ottldryrun runs the statements or conditions of a transform or filter processor
+// configuration over OTLP JSON data, without starting a collector.
+package main // import "github.com/open-telemetry/opentelemetry-collector-contrib/cmd/ottldryrun"
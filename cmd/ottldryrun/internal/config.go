@@ -0,0 +1,73 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package internal // import "github.com/open-telemetry/opentelemetry-collector-contrib/cmd/ottldryrun/internal"
+
+import (
+	"errors"
+	"fmt"
+)
+
+type Config struct {
+	// ConfigFile is the YAML file holding the processor configuration, either as a collector
+	// configuration with a processors section, or as a map of processor IDs to configurations.
+	ConfigFile string
+	// Processor is the ID of the processor to run. It may be omitted when the configuration
+	// file defines a single processor.
+	Processor string
+	// InputFile is the OTLP JSON file holding the data to process.
+	InputFile string
+	// OutputFile is the file the resulting OTLP JSON is written to, or the standard output if empty.
+	OutputFile string
+	// ExpectedFile is the OTLP JSON file the result is compared to.
+	ExpectedFile  string
+	WriteExpected bool
+	// IgnoreErrors does not fail the run when statements or conditions returned errors.
+	IgnoreErrors bool
+	// Quiet only writes the resulting OTLP JSON and the failures, without the per-rule report.
+	Quiet bool
+}
+
+func ReadConfig(args []string) (*Config, error) {
+	cfg := &Config{}
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		switch arg {
+		case "--config", "--processor", "--input", "--output", "--expected":
+			i++
+			if i == len(args) {
+				return nil, fmt.Errorf("%s requires an argument", arg)
+			}
+			switch arg {
+			case "--config":
+				cfg.ConfigFile = args[i]
+			case "--processor":
+				cfg.Processor = args[i]
+			case "--input":
+				cfg.InputFile = args[i]
+			case "--output":
+				cfg.OutputFile = args[i]
+			case "--expected":
+				cfg.ExpectedFile = args[i]
+			}
+		case "--write-expected":
+			cfg.WriteExpected = true
+		case "--ignore-errors":
+			cfg.IgnoreErrors = true
+		case "--quiet":
+			cfg.Quiet = true
+		default:
+			return nil, fmt.Errorf("unknown argument %q", arg)
+		}
+	}
+	if cfg.ConfigFile == "" {
+		return nil, errors.New("--config is required")
+	}
+	if cfg.InputFile == "" {
+		return nil, errors.New("--input is required")
+	}
+	if cfg.WriteExpected && cfg.ExpectedFile == "" {
+		return nil, errors.New("--write-expected requires --expected")
+	}
+	return cfg, nil
+}
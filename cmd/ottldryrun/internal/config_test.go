@@ -0,0 +1,85 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package internal
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ReadConfig(t *testing.T) {
+	tests := []struct {
+		name string
+		args []string
+		cfg  *Config
+		err  string
+	}{
+		{
+			name: "required",
+			args: []string{"--config", "config.yaml", "--input", "logs.json"},
+			cfg:  &Config{ConfigFile: "config.yaml", InputFile: "logs.json"},
+		},
+		{
+			name: "all",
+			args: []string{
+				"--config", "config.yaml",
+				"--processor", "transform/logs",
+				"--input", "logs.json",
+				"--output", "out.json",
+				"--expected", "expected.json",
+				"--write-expected",
+				"--ignore-errors",
+				"--quiet",
+			},
+			cfg: &Config{
+				ConfigFile:    "config.yaml",
+				Processor:     "transform/logs",
+				InputFile:     "logs.json",
+				OutputFile:    "out.json",
+				ExpectedFile:  "expected.json",
+				WriteExpected: true,
+				IgnoreErrors:  true,
+				Quiet:         true,
+			},
+		},
+		{
+			name: "missing config",
+			args: []string{"--input", "logs.json"},
+			err:  "--config is required",
+		},
+		{
+			name: "missing input",
+			args: []string{"--config", "config.yaml"},
+			err:  "--input is required",
+		},
+		{
+			name: "missing argument",
+			args: []string{"--input", "logs.json", "--config"},
+			err:  "--config requires an argument",
+		},
+		{
+			name: "write expected without expected",
+			args: []string{"--config", "config.yaml", "--input", "logs.json", "--write-expected"},
+			err:  "--write-expected requires --expected",
+		},
+		{
+			name: "unknown argument",
+			args: []string{"--config", "config.yaml", "--input", "logs.json", "--foo"},
+			err:  `unknown argument "--foo"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := ReadConfig(tt.args)
+			if tt.err != "" {
+				require.EqualError(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.cfg, cfg)
+		})
+	}
+}
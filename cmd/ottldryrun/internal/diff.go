@@ -0,0 +1,119 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package internal // import "github.com/open-telemetry/opentelemetry-collector-contrib/cmd/ottldryrun/internal"
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+)
+
+// diff returns the differences between two OTLP JSON documents, one line per changed value.
+// Values are identified by their path in the document, where the items of key/value lists,
+// e.g. attributes, are identified by their key rather than their index.
+func diff(before, after []byte) ([]string, error) {
+	beforeValues, err := flatten(before)
+	if err != nil {
+		return nil, err
+	}
+	afterValues, err := flatten(after)
+	if err != nil {
+		return nil, err
+	}
+
+	paths := make([]string, 0, len(beforeValues)+len(afterValues))
+	for path := range beforeValues {
+		paths = append(paths, path)
+	}
+	for path := range afterValues {
+		if _, ok := beforeValues[path]; !ok {
+			paths = append(paths, path)
+		}
+	}
+	slices.Sort(paths)
+
+	var lines []string
+	for _, path := range paths {
+		b, inBefore := beforeValues[path]
+		a, inAfter := afterValues[path]
+		switch {
+		case !inAfter:
+			lines = append(lines, fmt.Sprintf("- %s: %s", path, b))
+		case !inBefore:
+			lines = append(lines, fmt.Sprintf("+ %s: %s", path, a))
+		case a != b:
+			lines = append(lines, fmt.Sprintf("~ %s: %s -> %s", path, b, a))
+		}
+	}
+	return lines, nil
+}
+
+func flatten(doc []byte) (map[string]string, error) {
+	decoder := json.NewDecoder(bytes.NewReader(doc))
+	decoder.UseNumber()
+	var v any
+	if err := decoder.Decode(&v); err != nil {
+		return nil, err
+	}
+	values := map[string]string{}
+	flattenValue("", v, values)
+	return values, nil
+}
+
+func flattenValue(path string, v any, values map[string]string) {
+	switch val := v.(type) {
+	case map[string]any:
+		if len(val) == 0 {
+			values[path] = "{}"
+			return
+		}
+		for k, item := range val {
+			if path == "" {
+				flattenValue(k, item, values)
+			} else {
+				flattenValue(path+"."+k, item, values)
+			}
+		}
+	case []any:
+		if len(val) == 0 {
+			values[path] = "[]"
+			return
+		}
+		if isKeyValueList(val) {
+			for _, item := range val {
+				kv := item.(map[string]any)
+				flattenValue(path+"["+strconv.Quote(kv["key"].(string))+"]", kv["value"], values)
+			}
+			return
+		}
+		for i, item := range val {
+			flattenValue(path+"["+strconv.Itoa(i)+"]", item, values)
+		}
+	default:
+		encoded, _ := json.Marshal(val)
+		values[path] = string(encoded)
+	}
+}
+
+// isKeyValueList returns whether the list is a list of OTLP key/values with unique keys.
+func isKeyValueList(list []any) bool {
+	keys := make(map[string]struct{}, len(list))
+	for _, item := range list {
+		kv, ok := item.(map[string]any)
+		if !ok || len(kv) > 2 {
+			return false
+		}
+		key, ok := kv["key"].(string)
+		if !ok {
+			return false
+		}
+		if _, ok = keys[key]; ok {
+			return false
+		}
+		keys[key] = struct{}{}
+	}
+	return true
+}
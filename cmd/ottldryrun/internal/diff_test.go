@@ -0,0 +1,43 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package internal
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_diff(t *testing.T) {
+	before := `{"resourceLogs":[{"resource":{"attributes":[{"key":"a","value":{"stringValue":"x"}},{"key":"b","value":{"intValue":"1"}}]},"scopeLogs":[{"scope":{},"logRecords":[{"body":{"stringValue":"hello"}}]}]}]}`
+	after := `{"resourceLogs":[{"resource":{"attributes":[{"key":"b","value":{"intValue":"2"}},{"key":"c","value":{"boolValue":true}}]},"scopeLogs":[{"scope":{},"logRecords":[{"body":{"stringValue":"hello"},"severityText":"INFO"}]}]}]}`
+
+	lines, err := diff([]byte(before), []byte(after))
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		`- resourceLogs[0].resource.attributes["a"].stringValue: "x"`,
+		`~ resourceLogs[0].resource.attributes["b"].intValue: "1" -> "2"`,
+		`+ resourceLogs[0].resource.attributes["c"].boolValue: true`,
+		`+ resourceLogs[0].scopeLogs[0].logRecords[0].severityText: "INFO"`,
+	}, lines)
+
+	lines, err = diff([]byte(before), []byte(before))
+	require.NoError(t, err)
+	assert.Empty(t, lines)
+}
+
+func Test_diff_emptyValues(t *testing.T) {
+	lines, err := diff([]byte(`{"resourceLogs":[{"scopeLogs":[{"scope":{"name":"s"}}]}]}`), []byte(`{"resourceLogs":[{"scopeLogs":[{"scope":{}}]}]}`))
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		`+ resourceLogs[0].scopeLogs[0].scope: {}`,
+		`- resourceLogs[0].scopeLogs[0].scope.name: "s"`,
+	}, lines)
+}
+
+func Test_diff_invalidJSON(t *testing.T) {
+	_, err := diff([]byte(`{`), []byte(`{}`))
+	assert.Error(t, err)
+}
@@ -0,0 +1,209 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package internal // import "github.com/open-telemetry/opentelemetry-collector-contrib/cmd/ottldryrun/internal"
+
+import (
+	"fmt"
+	"maps"
+	"os"
+	"slices"
+	"strings"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/confmap"
+	"go.opentelemetry.io/collector/confmap/xconfmap"
+	"go.opentelemetry.io/collector/processor"
+	"go.yaml.in/yaml/v3"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/filterprocessor"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/transformprocessor"
+)
+
+// processorKind describes where the statements or conditions of an OTTL based processor are
+// configured, so that they can be run one by one.
+type processorKind struct {
+	factory processor.Factory
+	// ruleName is the name of the rules of the processor, e.g. statement.
+	ruleName string
+	// ruleKeys are the configuration keys holding the rules of each signal, in execution order.
+	// Nested keys are separated by confmap.KeyDelimiter.
+	ruleKeys map[signalType][]string
+	// groupKey is the key holding the rules of the context groups, in the lists of rules
+	// which may be grouped by context.
+	groupKey string
+}
+
+var processorKinds = []processorKind{
+	{
+		factory:  transformprocessor.NewFactory(),
+		ruleName: "statement",
+		ruleKeys: map[signalType][]string{
+			signalLogs:     {"log_statements"},
+			signalMetrics:  {"metric_statements"},
+			signalTraces:   {"trace_statements"},
+			signalProfiles: {"profile_statements"},
+		},
+		groupKey: "statements",
+	},
+	{
+		factory:  filterprocessor.NewFactory(),
+		ruleName: "condition",
+		ruleKeys: map[signalType][]string{
+			signalLogs:     {"logs::resource", "logs::log_record", "log_conditions"},
+			signalMetrics:  {"metrics::resource", "metrics::metric", "metrics::datapoint", "metric_conditions"},
+			signalTraces:   {"traces::resource", "traces::span", "traces::spanevent", "trace_conditions"},
+			signalProfiles: {"profiles::resource", "profiles::profile", "profile_conditions"},
+		},
+		groupKey: "conditions",
+	},
+}
+
+// Rule is a statement or condition of the processor configuration.
+type Rule struct {
+	// Key is the configuration key the rule is defined in.
+	Key  string
+	Text string
+}
+
+// processorConfig is the raw configuration of the processor to run.
+type processorConfig struct {
+	id   component.ID
+	kind processorKind
+	raw  map[string]any
+}
+
+func loadProcessorConfig(file, id string) (*processorConfig, error) {
+	content, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	var raw map[string]any
+	if err = yaml.Unmarshal(content, &raw); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", file, err)
+	}
+	if processors, ok := raw["processors"].(map[string]any); ok {
+		raw = processors
+	}
+
+	if id == "" {
+		if len(raw) != 1 {
+			return nil, fmt.Errorf("%s defines %d processors, use --processor to select one", file, len(raw))
+		}
+		for k := range raw {
+			id = k
+		}
+	}
+	cfg, ok := raw[id]
+	if !ok {
+		return nil, fmt.Errorf("processor %q is not defined in %s", id, file)
+	}
+
+	pc := &processorConfig{}
+	if err = pc.id.UnmarshalText([]byte(id)); err != nil {
+		return nil, err
+	}
+	idx := slices.IndexFunc(processorKinds, func(kind processorKind) bool {
+		return kind.factory.Type() == pc.id.Type()
+	})
+	if idx < 0 {
+		return nil, fmt.Errorf("processor %q is not supported, only the transform and filter processors are", id)
+	}
+	pc.kind = processorKinds[idx]
+	pc.raw, _ = cfg.(map[string]any)
+	if pc.raw == nil {
+		pc.raw = map[string]any{}
+	}
+	return pc, nil
+}
+
+// rules returns the rules of the signal, in execution order.
+func (pc *processorConfig) rules(signal signalType) []Rule {
+	var rules []Rule
+	for _, key := range pc.kind.ruleKeys[signal] {
+		list, _ := lookup(pc.raw, key).([]any)
+		for _, item := range list {
+			switch v := item.(type) {
+			case string:
+				rules = append(rules, Rule{Key: key, Text: v})
+			case map[string]any:
+				group, _ := v[pc.kind.groupKey].([]any)
+				for _, text := range group {
+					rules = append(rules, Rule{Key: key, Text: fmt.Sprint(text)})
+				}
+			}
+		}
+	}
+	return rules
+}
+
+// config returns the processor configuration only holding the first n rules of the signal.
+func (pc *processorConfig) config(signal signalType, n int) (component.Config, error) {
+	raw := pc.raw
+	remaining := n
+	for _, key := range pc.kind.ruleKeys[signal] {
+		list, ok := lookup(raw, key).([]any)
+		if !ok {
+			continue
+		}
+		var truncated []any
+		for _, item := range list {
+			if remaining == 0 {
+				break
+			}
+			switch v := item.(type) {
+			case map[string]any:
+				group, _ := v[pc.kind.groupKey].([]any)
+				if len(group) > remaining {
+					copied := maps.Clone(v)
+					copied[pc.kind.groupKey] = group[:remaining]
+					item = copied
+				}
+				remaining -= min(len(group), remaining)
+			default:
+				remaining--
+			}
+			truncated = append(truncated, item)
+		}
+		if len(truncated) != len(list) {
+			raw = replace(raw, key, truncated)
+		}
+	}
+
+	cfg := pc.kind.factory.CreateDefaultConfig()
+	if err := confmap.NewFromStringMap(raw).Unmarshal(cfg); err != nil {
+		return nil, err
+	}
+	if err := xconfmap.Validate(cfg); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+func lookup(raw map[string]any, key string) any {
+	name, rest, nested := strings.Cut(key, confmap.KeyDelimiter)
+	if !nested {
+		return raw[name]
+	}
+	sub, _ := raw[name].(map[string]any)
+	return lookup(sub, rest)
+}
+
+// replace returns a copy of raw with the value of the key replaced, or removed if it is empty.
+func replace(raw map[string]any, key string, value []any) map[string]any {
+	copied := maps.Clone(raw)
+	if copied == nil {
+		copied = map[string]any{}
+	}
+	name, rest, nested := strings.Cut(key, confmap.KeyDelimiter)
+	switch {
+	case nested:
+		sub, _ := raw[name].(map[string]any)
+		copied[name] = replace(sub, rest, value)
+	case len(value) == 0:
+		delete(copied, name)
+	default:
+		copied[name] = value
+	}
+	return copied
+}
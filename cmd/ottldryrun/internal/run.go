@@ -0,0 +1,179 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package internal // import "github.com/open-telemetry/opentelemetry-collector-contrib/cmd/ottldryrun/internal"
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"go.opentelemetry.io/collector/processor/processortest"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+// Step is the outcome of running a rule after the rules preceding it.
+type Step struct {
+	Rule Rule
+	// Diff holds the changes made by the rule to the output of the preceding rules.
+	Diff []string
+	// Errors holds the errors which occurred when running the rule.
+	Errors []string
+}
+
+// Result is the outcome of running the processor over the input.
+type Result struct {
+	// RuleName is the name of the rules of the processor, e.g. statement.
+	RuleName string
+	Signal   string
+	// Output is the OTLP JSON output of the processor.
+	Output []byte
+	Steps  []Step
+	// Errors holds the errors which occurred when running the complete configuration.
+	Errors []string
+}
+
+// Run runs the processor configured in cfg over its input, first running each of its rules
+// after the preceding ones to report the changes and errors of each rule.
+func Run(ctx context.Context, cfg *Config) (*Result, error) {
+	pc, err := loadProcessorConfig(cfg.ConfigFile, cfg.Processor)
+	if err != nil {
+		return nil, err
+	}
+	input, err := os.ReadFile(cfg.InputFile)
+	if err != nil {
+		return nil, err
+	}
+	signal, err := detectSignal(input)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &Result{RuleName: pc.kind.ruleName, Signal: string(signal)}
+	rules := pc.rules(signal)
+	previous, err := normalize(signal, input)
+	if err != nil {
+		return nil, err
+	}
+	var previousErrors []string
+	for i, rule := range rules {
+		output, errs, err := runProcessor(ctx, pc, signal, i+1, input)
+		if err != nil {
+			return nil, fmt.Errorf("failed to run %s %d %q: %w", pc.kind.ruleName, i, rule.Text, err)
+		}
+		step := Step{Rule: rule, Errors: subtract(errs, previousErrors)}
+		if step.Diff, err = diff(previous, output); err != nil {
+			return nil, err
+		}
+		result.Steps = append(result.Steps, step)
+		previous, previousErrors = output, errs
+	}
+
+	if len(rules) > 0 {
+		result.Output, result.Errors = previous, previousErrors
+		return result, nil
+	}
+	// The processor has no rules for the signal, but may have other settings, e.g. the filter
+	// processor include and exclude settings.
+	if result.Output, result.Errors, err = runProcessor(ctx, pc, signal, 0, input); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// runProcessor runs the processor configured with the first n rules of the signal, and returns
+// its output along with the errors which occurred.
+func runProcessor(ctx context.Context, pc *processorConfig, signal signalType, n int, input []byte) ([]byte, []string, error) {
+	cfg, err := pc.config(signal, n)
+	if err != nil {
+		return nil, nil, err
+	}
+	core, logs := observer.New(zapcore.WarnLevel)
+	set := processortest.NewNopSettings(pc.id.Type())
+	set.ID = pc.id
+	set.Logger = zap.New(core)
+
+	output, processErr, err := process(ctx, signal, pc.kind.factory, set, cfg, input)
+	if err != nil {
+		return nil, nil, err
+	}
+	var errs []string
+	for _, entry := range logs.All() {
+		fields := entry.ContextMap()
+		msg := entry.Message
+		if rule, ok := fields[pc.kind.ruleName]; ok {
+			msg = fmt.Sprintf("%s %q", msg, rule)
+		}
+		if e, ok := fields["error"]; ok {
+			msg = fmt.Sprintf("%s: %v", msg, e)
+		}
+		errs = append(errs, msg)
+	}
+	if processErr != nil {
+		errs = append(errs, fmt.Sprintf("the processor returned an error, the data is dropped: %v", processErr))
+	}
+	return output, errs, nil
+}
+
+// subtract returns the errors which are not in previous, taking into account their number
+// of occurrences.
+func subtract(errs, previous []string) []string {
+	counts := make(map[string]int, len(previous))
+	for _, e := range previous {
+		counts[e]++
+	}
+	var result []string
+	for _, e := range errs {
+		if counts[e] > 0 {
+			counts[e]--
+			continue
+		}
+		result = append(result, e)
+	}
+	return result
+}
+
+// WriteReport writes the changes and errors of each rule, followed by the errors of the
+// complete configuration.
+func (r *Result) WriteReport(w io.Writer) error {
+	var err error
+	printf := func(format string, args ...any) {
+		if err == nil {
+			_, err = fmt.Fprintf(w, format, args...)
+		}
+	}
+	for i, step := range r.Steps {
+		printf("%s %d: %s\n", r.RuleName, i, step.Rule.Text)
+		if len(step.Diff) == 0 && len(step.Errors) == 0 {
+			printf("  no changes\n")
+		}
+		for _, line := range step.Diff {
+			printf("  %s\n", line)
+		}
+		for _, e := range step.Errors {
+			printf("  error: %s\n", e)
+		}
+	}
+	if len(r.Errors) == 0 {
+		printf("no errors\n")
+		return err
+	}
+	printf("%d error(s):\n", len(r.Errors))
+	for _, e := range r.Errors {
+		printf("  %s\n", e)
+	}
+	return err
+}
+
+// CompareExpected compares the output to the OTLP JSON of the expected file, and returns an
+// error describing their differences if they don't match.
+func (r *Result) CompareExpected(expectedFile string) error {
+	expected, err := os.ReadFile(expectedFile)
+	if err != nil {
+		return err
+	}
+	return compare(signalType(r.Signal), expected, r.Output)
+}
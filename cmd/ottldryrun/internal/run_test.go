@@ -0,0 +1,123 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package internal
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/transformprocessor"
+)
+
+func Test_loadProcessorConfig(t *testing.T) {
+	_, err := loadProcessorConfig(filepath.Join("testdata", "transform.yaml"), "")
+	require.EqualError(t, err, "testdata/transform.yaml defines 2 processors, use --processor to select one")
+
+	_, err = loadProcessorConfig(filepath.Join("testdata", "transform.yaml"), "transform/missing")
+	require.EqualError(t, err, `processor "transform/missing" is not defined in testdata/transform.yaml`)
+
+	pc, err := loadProcessorConfig(filepath.Join("testdata", "filter.yaml"), "")
+	require.NoError(t, err)
+	assert.Equal(t, "filter", pc.id.String())
+	assert.Equal(t, []Rule{
+		{Key: "logs::log_record", Text: `attributes["user"] == "bob"`},
+		{Key: "logs::log_record", Text: `IsMatch(body, "^debug")`},
+	}, pc.rules(signalLogs))
+	assert.Empty(t, pc.rules(signalTraces))
+}
+
+func Test_processorConfig_config(t *testing.T) {
+	pc, err := loadProcessorConfig(filepath.Join("testdata", "transform.yaml"), "transform")
+	require.NoError(t, err)
+	require.Len(t, pc.rules(signalLogs), 3)
+
+	statements := func(n int) [][]string {
+		cfg, err := pc.config(signalLogs, n)
+		require.NoError(t, err)
+		var result [][]string
+		for _, group := range cfg.(*transformprocessor.Config).LogStatements {
+			result = append(result, group.Statements)
+		}
+		return result
+	}
+	assert.Equal(t, [][]string{
+		{`set(log.attributes["env"], "prod")`},
+	}, statements(1))
+	assert.Equal(t, [][]string{
+		{`set(log.attributes["env"], "prod")`, `set(log.severity_text, "INFO") where log.severity_text == ""`},
+	}, statements(2))
+	assert.Equal(t, [][]string{
+		{`set(log.attributes["env"], "prod")`, `set(log.severity_text, "INFO") where log.severity_text == ""`},
+		{`merge_maps(log.attributes, log.body, "upsert")`},
+	}, statements(3))
+	assert.Len(t, pc.raw["log_statements"], 2, "the configuration must not be modified")
+}
+
+func Test_Run_transform(t *testing.T) {
+	result, err := Run(t.Context(), &Config{
+		ConfigFile: filepath.Join("testdata", "transform.yaml"),
+		Processor:  "transform",
+		InputFile:  filepath.Join("testdata", "logs.json"),
+	})
+	require.NoError(t, err)
+	require.Len(t, result.Steps, 3)
+
+	assert.Equal(t, []string{
+		`+ resourceLogs[0].scopeLogs[0].logRecords[0].attributes["env"].stringValue: "prod"`,
+		`+ resourceLogs[0].scopeLogs[0].logRecords[1].attributes["env"].stringValue: "prod"`,
+		`+ resourceLogs[0].scopeLogs[0].logRecords[2].attributes["env"].stringValue: "prod"`,
+	}, result.Steps[0].Diff)
+	assert.Empty(t, result.Steps[0].Errors)
+	assert.Equal(t, []string{
+		`+ resourceLogs[0].scopeLogs[0].logRecords[0].severityText: "INFO"`,
+		`+ resourceLogs[0].scopeLogs[0].logRecords[1].severityText: "INFO"`,
+		`+ resourceLogs[0].scopeLogs[0].logRecords[2].severityText: "INFO"`,
+	}, result.Steps[1].Diff)
+
+	// merge_maps fails as the bodies are not maps
+	assert.Empty(t, result.Steps[2].Diff)
+	require.Len(t, result.Steps[2].Errors, 3)
+	assert.Contains(t, result.Steps[2].Errors[0], `failed to execute statement "merge_maps(log.attributes, log.body, \"upsert\")"`)
+	assert.Equal(t, result.Steps[2].Errors, result.Errors)
+
+	var report bytes.Buffer
+	require.NoError(t, result.WriteReport(&report))
+	assert.Contains(t, report.String(), "statement 0: set(log.attributes[\"env\"], \"prod\")\n")
+	assert.Contains(t, report.String(), "3 error(s):\n")
+}
+
+func Test_Run_filter(t *testing.T) {
+	result, err := Run(t.Context(), &Config{
+		ConfigFile: filepath.Join("testdata", "filter.yaml"),
+		InputFile:  filepath.Join("testdata", "logs.json"),
+	})
+	require.NoError(t, err)
+	require.Len(t, result.Steps, 2)
+	assert.Equal(t, "condition", result.RuleName)
+	assert.NotEmpty(t, result.Steps[0].Diff)
+	assert.NotEmpty(t, result.Steps[1].Diff)
+	assert.Empty(t, result.Errors)
+
+	require.NoError(t, result.CompareExpected(filepath.Join("testdata", "expected.json")))
+}
+
+func Test_Run_unsupportedProcessor(t *testing.T) {
+	cfg := filepath.Join(t.TempDir(), "config.yaml")
+	require.NoError(t, os.WriteFile(cfg, []byte("batch:\n"), 0o600))
+	_, err := Run(t.Context(), &Config{
+		ConfigFile: cfg,
+		InputFile:  filepath.Join("testdata", "logs.json"),
+	})
+	require.EqualError(t, err, `processor "batch" is not supported, only the transform and filter processors are`)
+}
+
+func Test_subtract(t *testing.T) {
+	assert.Equal(t, []string{"b", "a"}, subtract([]string{"a", "b", "a", "a"}, []string{"a", "a"}))
+	assert.Empty(t, subtract([]string{"a"}, []string{"a"}))
+}
@@ -0,0 +1,230 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package internal // import "github.com/open-telemetry/opentelemetry-collector-contrib/cmd/ottldryrun/internal"
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/pprofile"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.opentelemetry.io/collector/processor"
+	"go.opentelemetry.io/collector/processor/xprocessor"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatatest/plogtest"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatatest/pmetrictest"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatatest/pprofiletest"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatatest/ptracetest"
+)
+
+type signalType string
+
+const (
+	signalLogs     signalType = "logs"
+	signalMetrics  signalType = "metrics"
+	signalTraces   signalType = "traces"
+	signalProfiles signalType = "profiles"
+)
+
+// signalKeys are the top level keys of the OTLP JSON encoding of each signal.
+var signalKeys = map[string]signalType{
+	"resourceLogs":     signalLogs,
+	"resourceMetrics":  signalMetrics,
+	"resourceSpans":    signalTraces,
+	"resourceProfiles": signalProfiles,
+}
+
+// detectSignal returns the signal of the OTLP JSON input.
+func detectSignal(input []byte) (signalType, error) {
+	var top map[string]json.RawMessage
+	if err := json.Unmarshal(input, &top); err != nil {
+		return "", fmt.Errorf("the input is not OTLP JSON: %w", err)
+	}
+	for key, signal := range signalKeys {
+		if _, ok := top[key]; ok {
+			return signal, nil
+		}
+	}
+	return "", errors.New("the input holds no resourceLogs, resourceMetrics, resourceSpans or resourceProfiles")
+}
+
+// normalize returns the OTLP JSON input as encoded by the processor outputs, so that it can be
+// compared with them.
+func normalize(signal signalType, input []byte) ([]byte, error) {
+	switch signal {
+	case signalLogs:
+		ld, err := (&plog.JSONUnmarshaler{}).UnmarshalLogs(input)
+		if err != nil {
+			return nil, err
+		}
+		return (&plog.JSONMarshaler{}).MarshalLogs(ld)
+	case signalMetrics:
+		md, err := (&pmetric.JSONUnmarshaler{}).UnmarshalMetrics(input)
+		if err != nil {
+			return nil, err
+		}
+		return (&pmetric.JSONMarshaler{}).MarshalMetrics(md)
+	case signalTraces:
+		td, err := (&ptrace.JSONUnmarshaler{}).UnmarshalTraces(input)
+		if err != nil {
+			return nil, err
+		}
+		return (&ptrace.JSONMarshaler{}).MarshalTraces(td)
+	case signalProfiles:
+		pd, err := (&pprofile.JSONUnmarshaler{}).UnmarshalProfiles(input)
+		if err != nil {
+			return nil, err
+		}
+		return (&pprofile.JSONMarshaler{}).MarshalProfiles(pd)
+	default:
+		return nil, fmt.Errorf("unsupported signal %q", signal)
+	}
+}
+
+// process runs the processor over the OTLP JSON input, and returns the OTLP JSON of its output.
+// processErr is the error returned by the processor when processing the input, while err is
+// returned when the processor could not be run.
+func process(ctx context.Context, signal signalType, factory processor.Factory, set processor.Settings, cfg component.Config, input []byte) (output []byte, processErr, err error) {
+	switch signal {
+	case signalLogs:
+		ld, err := (&plog.JSONUnmarshaler{}).UnmarshalLogs(input)
+		if err != nil {
+			return nil, nil, err
+		}
+		sink := new(consumertest.LogsSink)
+		p, err := factory.CreateLogs(ctx, set, cfg, sink)
+		if err != nil {
+			return nil, nil, err
+		}
+		consumeErr := run(ctx, p, func() error { return p.ConsumeLogs(ctx, ld) })
+		result := plog.NewLogs()
+		for _, l := range sink.AllLogs() {
+			l.ResourceLogs().MoveAndAppendTo(result.ResourceLogs())
+		}
+		output, err = (&plog.JSONMarshaler{}).MarshalLogs(result)
+		return output, consumeErr, err
+	case signalMetrics:
+		md, err := (&pmetric.JSONUnmarshaler{}).UnmarshalMetrics(input)
+		if err != nil {
+			return nil, nil, err
+		}
+		sink := new(consumertest.MetricsSink)
+		p, err := factory.CreateMetrics(ctx, set, cfg, sink)
+		if err != nil {
+			return nil, nil, err
+		}
+		consumeErr := run(ctx, p, func() error { return p.ConsumeMetrics(ctx, md) })
+		result := pmetric.NewMetrics()
+		for _, m := range sink.AllMetrics() {
+			m.ResourceMetrics().MoveAndAppendTo(result.ResourceMetrics())
+		}
+		output, err = (&pmetric.JSONMarshaler{}).MarshalMetrics(result)
+		return output, consumeErr, err
+	case signalTraces:
+		td, err := (&ptrace.JSONUnmarshaler{}).UnmarshalTraces(input)
+		if err != nil {
+			return nil, nil, err
+		}
+		sink := new(consumertest.TracesSink)
+		p, err := factory.CreateTraces(ctx, set, cfg, sink)
+		if err != nil {
+			return nil, nil, err
+		}
+		consumeErr := run(ctx, p, func() error { return p.ConsumeTraces(ctx, td) })
+		result := ptrace.NewTraces()
+		for _, t := range sink.AllTraces() {
+			t.ResourceSpans().MoveAndAppendTo(result.ResourceSpans())
+		}
+		output, err = (&ptrace.JSONMarshaler{}).MarshalTraces(result)
+		return output, consumeErr, err
+	case signalProfiles:
+		xfactory, ok := factory.(xprocessor.Factory)
+		if !ok {
+			return nil, nil, fmt.Errorf("the %s processor does not support profiles", factory.Type())
+		}
+		pd, err := (&pprofile.JSONUnmarshaler{}).UnmarshalProfiles(input)
+		if err != nil {
+			return nil, nil, err
+		}
+		sink := new(consumertest.ProfilesSink)
+		p, err := xfactory.CreateProfiles(ctx, set, cfg, sink)
+		if err != nil {
+			return nil, nil, err
+		}
+		consumeErr := run(ctx, p, func() error { return p.ConsumeProfiles(ctx, pd) })
+		// The profiles are returned as received by the sink, as merging them would require
+		// merging their dictionaries.
+		result := pprofile.NewProfiles()
+		if all := sink.AllProfiles(); len(all) > 0 {
+			result = all[0]
+		}
+		output, err = (&pprofile.JSONMarshaler{}).MarshalProfiles(result)
+		return output, consumeErr, err
+	default:
+		return nil, nil, fmt.Errorf("unsupported signal %q", signal)
+	}
+}
+
+func run(ctx context.Context, p component.Component, consume func() error) error {
+	if err := p.Start(ctx, componenttest.NewNopHost()); err != nil {
+		return err
+	}
+	return errors.Join(consume(), p.Shutdown(ctx))
+}
+
+// compare compares the expected and actual OTLP JSON, and returns an error describing their
+// differences if they don't match.
+func compare(signal signalType, expected, actual []byte) error {
+	switch signal {
+	case signalLogs:
+		exp, err := (&plog.JSONUnmarshaler{}).UnmarshalLogs(expected)
+		if err != nil {
+			return err
+		}
+		act, err := (&plog.JSONUnmarshaler{}).UnmarshalLogs(actual)
+		if err != nil {
+			return err
+		}
+		return plogtest.CompareLogs(exp, act)
+	case signalMetrics:
+		exp, err := (&pmetric.JSONUnmarshaler{}).UnmarshalMetrics(expected)
+		if err != nil {
+			return err
+		}
+		act, err := (&pmetric.JSONUnmarshaler{}).UnmarshalMetrics(actual)
+		if err != nil {
+			return err
+		}
+		return pmetrictest.CompareMetrics(exp, act)
+	case signalTraces:
+		exp, err := (&ptrace.JSONUnmarshaler{}).UnmarshalTraces(expected)
+		if err != nil {
+			return err
+		}
+		act, err := (&ptrace.JSONUnmarshaler{}).UnmarshalTraces(actual)
+		if err != nil {
+			return err
+		}
+		return ptracetest.CompareTraces(exp, act)
+	case signalProfiles:
+		exp, err := (&pprofile.JSONUnmarshaler{}).UnmarshalProfiles(expected)
+		if err != nil {
+			return err
+		}
+		act, err := (&pprofile.JSONUnmarshaler{}).UnmarshalProfiles(actual)
+		if err != nil {
+			return err
+		}
+		return pprofiletest.CompareProfiles(exp, act)
+	default:
+		return fmt.Errorf("unsupported signal %q", signal)
+	}
+}
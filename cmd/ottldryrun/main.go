@@ -0,0 +1,61 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package main // import "github.com/open-telemetry/opentelemetry-collector-contrib/cmd/ottldryrun"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/cmd/ottldryrun/internal"
+)
+
+func main() {
+	if err := run(os.Args[1:], os.Stdout, os.Stderr); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func run(args []string, stdout, stderr io.Writer) error {
+	cfg, err := internal.ReadConfig(args)
+	if err != nil {
+		return err
+	}
+
+	result, err := internal.Run(context.Background(), cfg)
+	if err != nil {
+		return err
+	}
+
+	if cfg.OutputFile != "" {
+		err = os.WriteFile(cfg.OutputFile, result.Output, 0o600)
+	} else {
+		_, err = fmt.Fprintln(stdout, string(result.Output))
+	}
+	if err != nil {
+		return err
+	}
+	if !cfg.Quiet {
+		if err = result.WriteReport(stderr); err != nil {
+			return err
+		}
+	}
+
+	if cfg.WriteExpected {
+		return os.WriteFile(cfg.ExpectedFile, result.Output, 0o600)
+	}
+	var errs []error
+	if cfg.ExpectedFile != "" {
+		if err = result.CompareExpected(cfg.ExpectedFile); err != nil {
+			errs = append(errs, fmt.Errorf("the output does not match %s: %w", cfg.ExpectedFile, err))
+		}
+	}
+	if len(result.Errors) > 0 && !cfg.IgnoreErrors {
+		errs = append(errs, fmt.Errorf("%d error(s) occurred while running the %ss", len(result.Errors), result.RuleName))
+	}
+	return errors.Join(errs...)
+}
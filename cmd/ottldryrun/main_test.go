@@ -0,0 +1,61 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMissingArgument(t *testing.T) {
+	err := run([]string{"--config", "config.yaml"}, &bytes.Buffer{}, &bytes.Buffer{})
+	require.EqualError(t, err, "--input is required")
+}
+
+func TestExpected(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+	err := run([]string{
+		"--config", filepath.Join("internal", "testdata", "filter.yaml"),
+		"--input", filepath.Join("internal", "testdata", "logs.json"),
+		"--expected", filepath.Join("internal", "testdata", "expected.json"),
+	}, &stdout, &stderr)
+	require.NoError(t, err)
+	assert.Contains(t, stdout.String(), `"resourceLogs"`)
+	assert.Contains(t, stderr.String(), "condition 0: attributes[\"user\"] == \"bob\"\n")
+}
+
+func TestWriteExpected(t *testing.T) {
+	expected := filepath.Join(t.TempDir(), "expected.json")
+	err := run([]string{
+		"--config", filepath.Join("internal", "testdata", "transform.yaml"),
+		"--processor", "transform/other",
+		"--input", filepath.Join("internal", "testdata", "logs.json"),
+		"--expected", expected,
+		"--write-expected",
+		"--quiet",
+	}, &bytes.Buffer{}, &bytes.Buffer{})
+	require.NoError(t, err)
+	content, err := os.ReadFile(expected)
+	require.NoError(t, err)
+	assert.NotContains(t, string(content), `"user"`)
+}
+
+func TestErrors(t *testing.T) {
+	args := []string{
+		"--config", filepath.Join("internal", "testdata", "transform.yaml"),
+		"--processor", "transform",
+		"--input", filepath.Join("internal", "testdata", "logs.json"),
+		"--output", filepath.Join(t.TempDir(), "output.json"),
+	}
+	err := run(args, &bytes.Buffer{}, &bytes.Buffer{})
+	require.EqualError(t, err, "3 error(s) occurred while running the statements")
+
+	err = run(append(args, "--ignore-errors"), &bytes.Buffer{}, &bytes.Buffer{})
+	require.NoError(t, err)
+}
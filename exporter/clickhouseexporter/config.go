@@ -61,6 +61,8 @@ type Config struct {
 	ClusterName string `mapstructure:"cluster_name"`
 	// CreateSchema if set to true will run the DDL for creating the database and tables. default is true.
 	CreateSchema bool `mapstructure:"create_schema"`
+	// SchemaMigrations configures the schema migrations applied when CreateSchema is enabled.
+	SchemaMigrations SchemaMigrationsConfig `mapstructure:"schema_migrations"`
 	// Compress controls the compression algorithm. Valid options: `none` (disabled), `zstd`, `lz4` (default), `gzip`, `deflate`, `br`, `true` (lz4).
 	Compress string `mapstructure:"compress"`
 	// AsyncInsert if true will enable async inserts. Default is `true`.
@@ -89,6 +91,14 @@ type MetricTablesConfig struct {
 	ExponentialHistogram metrics.MetricTypeConfig `mapstructure:"exponential_histogram"`
 }
 
+// SchemaMigrationsConfig defines how the versioned schema migrations are applied.
+type SchemaMigrationsConfig struct {
+	// TableName is the table name recording the applied schema versions. default is `otel_schema_migrations`.
+	TableName string `mapstructure:"table_name"`
+	// DryRun if set to true will log the pending DDL instead of running it. default is false.
+	DryRun bool `mapstructure:"dry_run"`
+}
+
 // TableEngine defines the ENGINE string value when creating the table.
 type TableEngine struct {
 	Name   string `mapstructure:"name"`
@@ -96,19 +106,21 @@ type TableEngine struct {
 }
 
 const (
-	defaultDatabase           = "default"
-	defaultTableEngineName    = "MergeTree"
-	defaultMetricTableName    = "otel_metrics"
-	defaultGaugeSuffix        = "_gauge"
-	defaultSumSuffix          = "_sum"
-	defaultSummarySuffix      = "_summary"
-	defaultHistogramSuffix    = "_histogram"
-	defaultExpHistogramSuffix = "_exponential_histogram"
+	defaultDatabase            = "default"
+	defaultTableEngineName     = "MergeTree"
+	defaultMetricTableName     = "otel_metrics"
+	defaultGaugeSuffix         = "_gauge"
+	defaultSumSuffix           = "_sum"
+	defaultSummarySuffix       = "_summary"
+	defaultHistogramSuffix     = "_histogram"
+	defaultExpHistogramSuffix  = "_exponential_histogram"
+	defaultMigrationsTableName = "otel_schema_migrations"
 )
 
 var (
-	errConfigNoEndpoint      = errors.New("endpoint must be specified")
-	errConfigInvalidEndpoint = errors.New("endpoint must be url format")
+	errConfigNoEndpoint        = errors.New("endpoint must be specified")
+	errConfigInvalidEndpoint   = errors.New("endpoint must be url format")
+	errConfigNoMigrationsTable = errors.New("schema_migrations::table_name must be specified")
 )
 
 func createDefaultConfig() component.Config {
@@ -124,7 +136,10 @@ func createDefaultConfig() component.Config {
 		TracesTableName:  "otel_traces",
 		TTL:              0,
 		CreateSchema:     true,
-		AsyncInsert:      true,
+		SchemaMigrations: SchemaMigrationsConfig{
+			TableName: defaultMigrationsTableName,
+		},
+		AsyncInsert: true,
 		MetricsTables: MetricTablesConfig{
 			Gauge:                metrics.MetricTypeConfig{Name: defaultMetricTableName + defaultGaugeSuffix},
 			Sum:                  metrics.MetricTypeConfig{Name: defaultMetricTableName + defaultSumSuffix},
@@ -141,6 +156,10 @@ func (cfg *Config) Validate() (err error) {
 		err = errors.Join(err, errConfigNoEndpoint)
 	}
 
+	if cfg.CreateSchema && cfg.SchemaMigrations.TableName == "" {
+		err = errors.Join(err, errConfigNoMigrationsTable)
+	}
+
 	dsn, e := cfg.buildDSN()
 	if e != nil {
 		err = errors.Join(err, e)
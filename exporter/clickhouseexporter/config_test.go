@@ -60,6 +60,10 @@ func TestLoadConfig(t *testing.T) {
 				LogsTableName:    "otel_logs",
 				TracesTableName:  "otel_traces",
 				CreateSchema:     true,
+				SchemaMigrations: SchemaMigrationsConfig{
+					TableName: "otel_migrations",
+					DryRun:    true,
+				},
 				TimeoutSettings: exporterhelper.TimeoutConfig{
 					Timeout: 5 * time.Second,
 				},
@@ -598,6 +602,19 @@ func TestShouldCreateSchema(t *testing.T) {
 	}
 }
 
+func TestValidateSchemaMigrationsTable(t *testing.T) {
+	t.Parallel()
+
+	cfg := withDefaultConfig(func(cfg *Config) {
+		cfg.Endpoint = defaultEndpoint
+		cfg.SchemaMigrations.TableName = ""
+	})
+	assert.ErrorContains(t, xconfmap.Validate(cfg), errConfigNoMigrationsTable.Error())
+
+	cfg.CreateSchema = false
+	assert.NoError(t, xconfmap.Validate(cfg))
+}
+
 func TestTableEngineConfigParsing(t *testing.T) {
 	t.Parallel()
 	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
@@ -49,12 +49,8 @@ func (e *logsExporter) start(ctx context.Context, _ component.Host) error {
 	}
 
 	if e.cfg.shouldCreateSchema() {
-		if createDBErr := internal.CreateDatabase(ctx, e.db, e.cfg.database(), e.cfg.clusterString()); createDBErr != nil {
-			return createDBErr
-		}
-
-		if createTableErr := createLogsTable(ctx, e.cfg, e.db); createTableErr != nil {
-			return createTableErr
+		if migrateErr := migrateSchema(ctx, e.cfg, e.db, e.logger, logsMigrations(e.cfg)); migrateErr != nil {
+			return migrateErr
 		}
 	}
 
@@ -211,11 +207,3 @@ func renderCreateLogsTableSQL(cfg *Config) string {
 		ttlExpr,
 	)
 }
-
-func createLogsTable(ctx context.Context, cfg *Config, db driver.Conn) error {
-	if err := db.Exec(ctx, renderCreateLogsTableSQL(cfg)); err != nil {
-		return fmt.Errorf("exec create logs table sql: %w", err)
-	}
-
-	return nil
-}
@@ -56,12 +56,8 @@ func (e *logsJSONExporter) start(ctx context.Context, _ component.Host) error {
 	}
 
 	if e.cfg.shouldCreateSchema() {
-		if createDBErr := internal.CreateDatabase(ctx, e.db, e.cfg.database(), e.cfg.clusterString()); createDBErr != nil {
-			return createDBErr
-		}
-
-		if createTableErr := createLogsJSONTable(ctx, e.cfg, e.db); createTableErr != nil {
-			return createTableErr
+		if migrateErr := migrateSchema(ctx, e.cfg, e.db, e.logger, logsJSONMigrations(e.cfg)); migrateErr != nil {
+			return migrateErr
 		}
 	}
 
@@ -269,11 +265,3 @@ func renderCreateLogsJSONTableSQL(cfg *Config) string {
 		ttlExpr,
 	)
 }
-
-func createLogsJSONTable(ctx context.Context, cfg *Config, db driver.Conn) error {
-	if err := db.Exec(ctx, renderCreateLogsJSONTableSQL(cfg)); err != nil {
-		return fmt.Errorf("exec create logs json table sql: %w", err)
-	}
-
-	return nil
-}
@@ -48,15 +48,7 @@ func (e *metricsExporter) start(ctx context.Context, _ component.Host) error {
 	}
 
 	if e.cfg.shouldCreateSchema() {
-		database := e.cfg.database()
-		clusterStr := e.cfg.clusterString()
-		if err := internal.CreateDatabase(ctx, e.db, database, clusterStr); err != nil {
-			return err
-		}
-
-		ttlExpr := internal.GenerateTTLExpr(e.cfg.TTL, "toDateTime(TimeUnix)")
-		err := metrics.NewMetricsTable(ctx, e.tablesConfig, database, clusterStr, e.cfg.tableEngineString(), ttlExpr, e.db)
-		if err != nil {
+		if err := migrateSchema(ctx, e.cfg, e.db, e.logger, metricsMigrations(e.cfg, e.tablesConfig)...); err != nil {
 			return err
 		}
 	}
@@ -47,11 +47,7 @@ func (e *tracesExporter) start(ctx context.Context, _ component.Host) error {
 	}
 
 	if e.cfg.shouldCreateSchema() {
-		if err := internal.CreateDatabase(ctx, e.db, e.cfg.database(), e.cfg.clusterString()); err != nil {
-			return err
-		}
-
-		if err := createTraceTables(ctx, e.cfg, e.db); err != nil {
+		if err := migrateSchema(ctx, e.cfg, e.db, e.logger, tracesMigrations(e.cfg)); err != nil {
 			return err
 		}
 	}
@@ -211,17 +207,3 @@ func renderTraceIDTsMaterializedViewSQL(cfg *Config) string {
 		database, cfg.TracesTableName,
 	)
 }
-
-func createTraceTables(ctx context.Context, cfg *Config, db driver.Conn) error {
-	if err := db.Exec(ctx, renderCreateTracesTableSQL(cfg)); err != nil {
-		return fmt.Errorf("exec create traces table sql: %w", err)
-	}
-	if err := db.Exec(ctx, renderCreateTraceIDTsTableSQL(cfg)); err != nil {
-		return fmt.Errorf("exec create traceID timestamp table sql: %w", err)
-	}
-	if err := db.Exec(ctx, renderTraceIDTsMaterializedViewSQL(cfg)); err != nil {
-		return fmt.Errorf("exec create traceID timestamp view sql: %w", err)
-	}
-
-	return nil
-}
@@ -55,12 +55,8 @@ func (e *tracesJSONExporter) start(ctx context.Context, _ component.Host) error
 	}
 
 	if e.cfg.shouldCreateSchema() {
-		if createDBErr := internal.CreateDatabase(ctx, e.db, e.cfg.database(), e.cfg.clusterString()); createDBErr != nil {
-			return createDBErr
-		}
-
-		if createTableErr := createTraceJSONTables(ctx, e.cfg, e.db); createTableErr != nil {
-			return createTableErr
+		if migrateErr := migrateSchema(ctx, e.cfg, e.db, e.logger, tracesJSONMigrations(e.cfg)); migrateErr != nil {
+			return migrateErr
 		}
 	}
 
@@ -282,17 +278,3 @@ func renderCreateTracesJSONTableSQL(cfg *Config) string {
 		ttlExpr,
 	)
 }
-
-func createTraceJSONTables(ctx context.Context, cfg *Config, db driver.Conn) error {
-	if err := db.Exec(ctx, renderCreateTracesJSONTableSQL(cfg)); err != nil {
-		return fmt.Errorf("exec create json traces table sql: %w", err)
-	}
-	if err := db.Exec(ctx, renderCreateTraceIDTsTableSQL(cfg)); err != nil {
-		return fmt.Errorf("exec create traceID timestamp table sql: %w", err)
-	}
-	if err := db.Exec(ctx, renderTraceIDTsMaterializedViewSQL(cfg)); err != nil {
-		return fmt.Errorf("exec create traceID timestamp view sql: %w", err)
-	}
-
-	return nil
-}
@@ -226,6 +226,8 @@ func testIntegrationWithImage(t *testing.T, clickhouseImage string) {
 	t.Run("TestTracesJSONExporterSchemaFeatures", testProtocols(testTracesJSONExporterSchemaFeatures, false))
 	t.Run("TestLogsCombinedExporter", testProtocolsMapBody(testLogsCombinedExporter))
 	t.Run("TestTracesCombinedExporter", testProtocols(testTracesCombinedExporter, false))
+	t.Run("TestSchemaMigrations", testProtocols(testSchemaMigrations, false))
+	t.Run("TestSchemaMigrationsDryRun", testProtocols(testSchemaMigrationsDryRun, false))
 
 	t.Run("TestCertAuth", testProtocols(func(t *testing.T, dsn string) {
 		applyTLS := func(config *Config) {
@@ -62,9 +62,7 @@ func CreateDatabase(ctx context.Context, db driver.Conn, database, clusterStr st
 		return nil
 	}
 
-	ddl := fmt.Sprintf("CREATE DATABASE IF NOT EXISTS %q %s", database, clusterStr)
-
-	err := db.Exec(ctx, ddl)
+	err := db.Exec(ctx, renderCreateDatabaseSQL(database, clusterStr))
 	if err != nil {
 		return fmt.Errorf("create database: %w", err)
 	}
@@ -72,6 +70,10 @@ func CreateDatabase(ctx context.Context, db driver.Conn, database, clusterStr st
 	return nil
 }
 
+func renderCreateDatabaseSQL(database, clusterStr string) string {
+	return fmt.Sprintf("CREATE DATABASE IF NOT EXISTS %q %s", database, clusterStr)
+}
+
 // GetTableColumns returns the column names on a table for schema detection
 func GetTableColumns(ctx context.Context, db driver.Conn, database, table string) ([]string, error) {
 	descTable := fmt.Sprintf("DESC TABLE %q.%q", database, table)
@@ -61,15 +61,9 @@ func SetLogger(l *zap.Logger) {
 	logger = l
 }
 
-// NewMetricsTable create metric tables with an expiry time to storage metric telemetry data
-func NewMetricsTable(ctx context.Context, tablesConfig MetricTablesConfigMapper, database, cluster, engine, ttlExpr string, db driver.Conn) error {
-	for key, ddlTemplate := range supportedMetricTypes {
-		query := fmt.Sprintf(ddlTemplate, database, tablesConfig[key].Name, cluster, engine, ttlExpr)
-		if err := db.Exec(ctx, query); err != nil {
-			return fmt.Errorf("exec create metrics table sql: %w", err)
-		}
-	}
-	return nil
+// RenderCreateTableSQL renders the DDL creating the table of a metric type
+func RenderCreateTableSQL(metricType pmetric.MetricType, database, tableName, cluster, engine, ttlExpr string) string {
+	return fmt.Sprintf(supportedMetricTypes[metricType], database, tableName, cluster, engine, ttlExpr)
 }
 
 // NewMetricsModel create a model for contain different metric data
@@ -0,0 +1,175 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package internal // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/clickhouseexporter/internal"
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ClickHouse/clickhouse-go/v2/lib/driver"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/clickhouseexporter/internal/sqltemplates"
+)
+
+// Migration is a forward migration of a table to a schema version.
+//
+// Statements must be idempotent, e.g. by using IF NOT EXISTS, since they are also
+// applied to tables that were created before their schema versions were recorded.
+type Migration struct {
+	Version     uint32
+	Description string
+	Statements  []string
+}
+
+// TableMigrations are the migrations of a table, ordered by version.
+type TableMigrations struct {
+	Table      string
+	Migrations []Migration
+}
+
+// LatestVersion returns the schema version of the table after all migrations are applied.
+func (t TableMigrations) LatestVersion() uint32 {
+	if len(t.Migrations) == 0 {
+		return 0
+	}
+
+	return t.Migrations[len(t.Migrations)-1].Version
+}
+
+// Migrator applies the pending migrations of tables, recording the applied schema
+// versions in the migrations table.
+type Migrator struct {
+	db              driver.Conn
+	logger          *zap.Logger
+	database        string
+	migrationsTable string
+	clusterStr      string
+	tableEngine     string
+	dryRun          bool
+}
+
+// NewMigrator creates a Migrator. In dry run mode, the pending DDL is logged instead of executed.
+// The migrations table is created with the given cluster clause and table engine, like the other tables.
+func NewMigrator(db driver.Conn, logger *zap.Logger, database, migrationsTable, clusterStr, tableEngine string, dryRun bool) *Migrator {
+	return &Migrator{
+		db:              db,
+		logger:          logger,
+		database:        database,
+		migrationsTable: migrationsTable,
+		clusterStr:      clusterStr,
+		tableEngine:     tableEngine,
+		dryRun:          dryRun,
+	}
+}
+
+// Migrate creates the database and the migrations table if needed, then applies the
+// pending migrations of each table in order.
+func (m *Migrator) Migrate(ctx context.Context, tables ...TableMigrations) error {
+	hasMigrationsTable := true
+	if m.dryRun {
+		if m.database != DefaultDatabase {
+			m.logger.Info("Pending schema migration",
+				zap.String("description", "create database"),
+				zap.String("ddl", renderCreateDatabaseSQL(m.database, m.clusterStr)))
+		}
+
+		var err error
+		hasMigrationsTable, err = m.migrationsTableExists(ctx)
+		if err != nil {
+			return err
+		}
+	} else {
+		if err := CreateDatabase(ctx, m.db, m.database, m.clusterStr); err != nil {
+			return err
+		}
+
+		ddl := fmt.Sprintf(sqltemplates.SchemaMigrationsCreateTable, m.database, m.migrationsTable, m.clusterStr, m.tableEngine)
+		if err := m.db.Exec(ctx, ddl); err != nil {
+			return fmt.Errorf("exec create schema migrations table sql: %w", err)
+		}
+	}
+
+	for _, t := range tables {
+		var current uint32
+		if hasMigrationsTable {
+			var err error
+			current, err = m.appliedVersion(ctx, t.Table)
+			if err != nil {
+				return err
+			}
+		}
+
+		if err := m.migrateTable(ctx, t, current); err != nil {
+			return fmt.Errorf("migrate table %q: %w", t.Table, err)
+		}
+	}
+
+	return nil
+}
+
+func (m *Migrator) migrateTable(ctx context.Context, t TableMigrations, current uint32) error {
+	latest := t.LatestVersion()
+	if current > latest {
+		return fmt.Errorf("schema version %d is newer than the version %d supported by this collector", current, latest)
+	}
+
+	for _, migration := range t.Migrations {
+		if migration.Version <= current {
+			continue
+		}
+
+		if m.dryRun {
+			m.logger.Info("Pending schema migration",
+				zap.String("table", t.Table),
+				zap.Uint32("version", migration.Version),
+				zap.String("description", migration.Description),
+				zap.String("ddl", strings.Join(migration.Statements, ";\n")+";"))
+			continue
+		}
+
+		for _, statement := range migration.Statements {
+			if err := m.db.Exec(ctx, statement); err != nil {
+				return fmt.Errorf("exec schema version %d sql: %w", migration.Version, err)
+			}
+		}
+
+		insertSQL := fmt.Sprintf(sqltemplates.SchemaMigrationsInsert, m.database, m.migrationsTable)
+		if err := m.db.Exec(ctx, insertSQL, t.Table, migration.Version, migration.Description, time.Now().UTC()); err != nil {
+			return fmt.Errorf("record schema version %d: %w", migration.Version, err)
+		}
+
+		m.logger.Info("Applied schema migration",
+			zap.String("table", t.Table),
+			zap.Uint32("version", migration.Version),
+			zap.String("description", migration.Description))
+	}
+
+	return nil
+}
+
+// appliedVersion returns the highest schema version recorded for the table, 0 if none.
+func (m *Migrator) appliedVersion(ctx context.Context, table string) (uint32, error) {
+	query := fmt.Sprintf("SELECT max(Version) FROM %q.%q WHERE TableName = ?", m.database, m.migrationsTable)
+
+	var version uint32
+	if err := m.db.QueryRow(ctx, query, table).Scan(&version); err != nil {
+		return 0, fmt.Errorf("get schema version of table %q: %w", table, err)
+	}
+
+	return version, nil
+}
+
+func (m *Migrator) migrationsTableExists(ctx context.Context) (bool, error) {
+	query := fmt.Sprintf("EXISTS TABLE %q.%q", m.database, m.migrationsTable)
+
+	var exists uint8
+	if err := m.db.QueryRow(ctx, query).Scan(&exists); err != nil {
+		return false, fmt.Errorf("check schema migrations table: %w", err)
+	}
+
+	return exists == 1, nil
+}
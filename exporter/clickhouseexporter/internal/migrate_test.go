@@ -0,0 +1,169 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package internal
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/ClickHouse/clickhouse-go/v2/lib/driver"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+// fakeConn records the executed statements and keeps the recorded schema versions in memory.
+type fakeConn struct {
+	driver.Conn
+	migrationsTableExists bool
+	versions              map[string]uint32
+	statements            []string
+}
+
+func newFakeConn() *fakeConn {
+	return &fakeConn{versions: map[string]uint32{}}
+}
+
+func (c *fakeConn) Exec(_ context.Context, query string, args ...any) error {
+	if strings.HasPrefix(query, "INSERT INTO") {
+		c.versions[args[0].(string)] = args[1].(uint32)
+		return nil
+	}
+
+	if strings.Contains(query, `"otel_schema_migrations"`) {
+		c.migrationsTableExists = true
+	}
+	c.statements = append(c.statements, query)
+	return nil
+}
+
+func (c *fakeConn) QueryRow(_ context.Context, query string, args ...any) driver.Row {
+	if strings.HasPrefix(query, "EXISTS TABLE") {
+		var exists uint8
+		if c.migrationsTableExists {
+			exists = 1
+		}
+		return &fakeRow{value: exists}
+	}
+
+	return &fakeRow{value: c.versions[args[0].(string)]}
+}
+
+type fakeRow struct {
+	driver.Row
+	value any
+}
+
+func (r *fakeRow) Scan(dest ...any) error {
+	switch d := dest[0].(type) {
+	case *uint8:
+		*d = r.value.(uint8)
+	case *uint32:
+		*d = r.value.(uint32)
+	}
+	return nil
+}
+
+func testTableMigrations() TableMigrations {
+	return TableMigrations{
+		Table: "otel_logs",
+		Migrations: []Migration{
+			{Version: 1, Description: "create table", Statements: []string{"CREATE TABLE otel_logs"}},
+			{Version: 2, Description: "add columns", Statements: []string{"ALTER TABLE otel_logs ADD COLUMN a", "ALTER TABLE otel_logs ADD COLUMN b"}},
+		},
+	}
+}
+
+func TestMigrator(t *testing.T) {
+	db := newFakeConn()
+	migrator := NewMigrator(db, zap.NewNop(), "otel", "otel_schema_migrations", "", "MergeTree()", false)
+
+	require.NoError(t, migrator.Migrate(t.Context(), testTableMigrations()))
+	require.Len(t, db.statements, 5)
+	assert.Equal(t, `CREATE DATABASE IF NOT EXISTS "otel" `, db.statements[0])
+	assert.Contains(t, db.statements[1], `CREATE TABLE IF NOT EXISTS "otel"."otel_schema_migrations"`)
+	assert.Contains(t, db.statements[1], ") ENGINE = MergeTree()\n")
+	assert.Equal(t, []string{
+		"CREATE TABLE otel_logs",
+		"ALTER TABLE otel_logs ADD COLUMN a",
+		"ALTER TABLE otel_logs ADD COLUMN b",
+	}, db.statements[2:])
+	assert.Equal(t, uint32(2), db.versions["otel_logs"])
+
+	// Applied migrations are skipped.
+	db.statements = nil
+	require.NoError(t, migrator.Migrate(t.Context(), testTableMigrations()))
+	assert.Len(t, db.statements, 2)
+}
+
+func TestMigratorPendingVersions(t *testing.T) {
+	db := newFakeConn()
+	db.versions["otel_logs"] = 1
+	migrator := NewMigrator(db, zap.NewNop(), DefaultDatabase, "otel_schema_migrations", "", "MergeTree()", false)
+
+	require.NoError(t, migrator.Migrate(t.Context(), testTableMigrations()))
+	assert.Equal(t, []string{
+		"ALTER TABLE otel_logs ADD COLUMN a",
+		"ALTER TABLE otel_logs ADD COLUMN b",
+	}, db.statements[1:])
+	assert.Equal(t, uint32(2), db.versions["otel_logs"])
+}
+
+func TestMigratorNewerVersion(t *testing.T) {
+	db := newFakeConn()
+	db.versions["otel_logs"] = 3
+	migrator := NewMigrator(db, zap.NewNop(), DefaultDatabase, "otel_schema_migrations", "", "MergeTree()", false)
+
+	err := migrator.Migrate(t.Context(), testTableMigrations())
+	assert.ErrorContains(t, err, `migrate table "otel_logs": schema version 3 is newer than the version 2 supported by this collector`)
+}
+
+func TestMigratorDryRun(t *testing.T) {
+	tests := []struct {
+		name           string
+		setup          func(db *fakeConn)
+		wantMigrations []uint32
+	}{
+		{
+			name:           "no migrations table",
+			wantMigrations: []uint32{1, 2},
+		},
+		{
+			name: "partially migrated",
+			setup: func(db *fakeConn) {
+				db.migrationsTableExists = true
+				db.versions["otel_logs"] = 1
+			},
+			wantMigrations: []uint32{2},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := newFakeConn()
+			if tt.setup != nil {
+				tt.setup(db)
+			}
+			core, logs := observer.New(zapcore.InfoLevel)
+			migrator := NewMigrator(db, zap.New(core), "otel", "otel_schema_migrations", "ON CLUSTER c", "ReplicatedMergeTree()", true)
+
+			require.NoError(t, migrator.Migrate(t.Context(), testTableMigrations()))
+			assert.Empty(t, db.statements)
+
+			entries := logs.FilterMessage("Pending schema migration").AllUntimed()
+			require.Len(t, entries, len(tt.wantMigrations)+1)
+			assert.Equal(t, `CREATE DATABASE IF NOT EXISTS "otel" ON CLUSTER c`, entries[0].ContextMap()["ddl"])
+			for i, version := range tt.wantMigrations {
+				fields := entries[i+1].ContextMap()
+				assert.Equal(t, "otel_logs", fields["table"])
+				assert.Equal(t, version, fields["version"])
+			}
+			assert.Equal(t, "ALTER TABLE otel_logs ADD COLUMN a;\nALTER TABLE otel_logs ADD COLUMN b;",
+				entries[len(entries)-1].ContextMap()["ddl"])
+		})
+	}
+}
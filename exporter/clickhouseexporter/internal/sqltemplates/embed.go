@@ -69,3 +69,11 @@ var MetricsSummaryCreateTable string
 
 //go:embed metrics_summary_insert.sql
 var MetricsSummaryInsert string
+
+// SCHEMA MIGRATIONS
+
+//go:embed schema_migrations_table.sql
+var SchemaMigrationsCreateTable string
+
+//go:embed schema_migrations_insert.sql
+var SchemaMigrationsInsert string
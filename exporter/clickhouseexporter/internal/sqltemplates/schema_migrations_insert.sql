@@ -0,0 +1,11 @@
+INSERT INTO %q.%q (
+    TableName,
+    Version,
+    Description,
+    AppliedAt
+) VALUES (
+    ?,
+    ?,
+    ?,
+    ?
+)
@@ -0,0 +1,7 @@
+CREATE TABLE IF NOT EXISTS %q.%q %s (
+    TableName String,
+    Version UInt32,
+    Description String,
+    AppliedAt DateTime64(9)
+) ENGINE = %s
+ORDER BY (TableName, Version)
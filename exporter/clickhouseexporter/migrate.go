@@ -0,0 +1,173 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package clickhouseexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/clickhouseexporter"
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ClickHouse/clickhouse-go/v2/lib/driver"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/clickhouseexporter/internal"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/clickhouseexporter/internal/metrics"
+)
+
+// The migrations of each table start with version 1 creating the table from its current template.
+// Versions are only ever appended: a change to a template must come with a migration applying
+// the change to existing tables.
+
+// migrateSchema creates the database and applies the pending migrations of the tables.
+func migrateSchema(ctx context.Context, cfg *Config, db driver.Conn, logger *zap.Logger, tables ...internal.TableMigrations) error {
+	migrator := internal.NewMigrator(db, logger, cfg.database(), cfg.SchemaMigrations.TableName, cfg.clusterString(), cfg.tableEngineString(), cfg.SchemaMigrations.DryRun)
+	return migrator.Migrate(ctx, tables...)
+}
+
+func logsMigrations(cfg *Config) internal.TableMigrations {
+	return internal.TableMigrations{
+		Table: cfg.LogsTableName,
+		Migrations: []internal.Migration{
+			{
+				Version:     1,
+				Description: "create logs table",
+				Statements:  []string{renderCreateLogsTableSQL(cfg)},
+			},
+			{
+				Version:     2,
+				Description: "add EventName column",
+				Statements: []string{
+					renderAddColumnSQL(cfg, cfg.LogsTableName, "EventName String CODEC(ZSTD(1))"),
+				},
+			},
+		},
+	}
+}
+
+func logsJSONMigrations(cfg *Config) internal.TableMigrations {
+	return internal.TableMigrations{
+		Table: cfg.LogsTableName,
+		Migrations: []internal.Migration{
+			{
+				Version:     1,
+				Description: "create logs table",
+				Statements:  []string{renderCreateLogsJSONTableSQL(cfg)},
+			},
+			{
+				Version:     2,
+				Description: "add attribute keys columns",
+				Statements: []string{
+					renderAddColumnSQL(cfg, cfg.LogsTableName, "ResourceAttributesKeys Array(LowCardinality(String)) CODEC(ZSTD(1))"),
+					renderAddColumnSQL(cfg, cfg.LogsTableName, "ScopeAttributesKeys Array(LowCardinality(String)) CODEC(ZSTD(1))"),
+					renderAddColumnSQL(cfg, cfg.LogsTableName, "LogAttributesKeys Array(LowCardinality(String)) CODEC(ZSTD(1))"),
+					renderAddIndexSQL(cfg, cfg.LogsTableName, "idx_res_attr_keys ResourceAttributesKeys TYPE bloom_filter(0.01) GRANULARITY 1"),
+					renderAddIndexSQL(cfg, cfg.LogsTableName, "idx_scope_attr_keys ScopeAttributesKeys TYPE bloom_filter(0.01) GRANULARITY 1"),
+					renderAddIndexSQL(cfg, cfg.LogsTableName, "idx_log_attr_keys LogAttributesKeys TYPE bloom_filter(0.01) GRANULARITY 1"),
+				},
+			},
+			{
+				Version:     3,
+				Description: "add EventName column",
+				Statements: []string{
+					renderAddColumnSQL(cfg, cfg.LogsTableName, "EventName String CODEC(ZSTD(1))"),
+				},
+			},
+		},
+	}
+}
+
+func tracesMigrations(cfg *Config) internal.TableMigrations {
+	return internal.TableMigrations{
+		Table: cfg.TracesTableName,
+		Migrations: []internal.Migration{
+			{
+				Version:     1,
+				Description: "create traces table",
+				Statements:  []string{renderCreateTracesTableSQL(cfg)},
+			},
+			{
+				Version:     2,
+				Description: "create trace ID timestamp lookup table and materialized view",
+				Statements: []string{
+					renderCreateTraceIDTsTableSQL(cfg),
+					renderTraceIDTsMaterializedViewSQL(cfg),
+				},
+			},
+		},
+	}
+}
+
+func tracesJSONMigrations(cfg *Config) internal.TableMigrations {
+	return internal.TableMigrations{
+		Table: cfg.TracesTableName,
+		Migrations: []internal.Migration{
+			{
+				Version:     1,
+				Description: "create traces table",
+				Statements:  []string{renderCreateTracesJSONTableSQL(cfg)},
+			},
+			{
+				Version:     2,
+				Description: "add attribute keys columns",
+				Statements: []string{
+					renderAddColumnSQL(cfg, cfg.TracesTableName, "ResourceAttributesKeys Array(LowCardinality(String)) CODEC(ZSTD(1))"),
+					renderAddColumnSQL(cfg, cfg.TracesTableName, "SpanAttributesKeys Array(LowCardinality(String)) CODEC(ZSTD(1))"),
+					renderAddIndexSQL(cfg, cfg.TracesTableName, "idx_res_attr_keys ResourceAttributesKeys TYPE bloom_filter(0.01) GRANULARITY 1"),
+					renderAddIndexSQL(cfg, cfg.TracesTableName, "idx_span_attr_keys SpanAttributesKeys TYPE bloom_filter(0.01) GRANULARITY 1"),
+				},
+			},
+			{
+				Version:     3,
+				Description: "create trace ID timestamp lookup table and materialized view",
+				Statements: []string{
+					renderCreateTraceIDTsTableSQL(cfg),
+					renderTraceIDTsMaterializedViewSQL(cfg),
+				},
+			},
+		},
+	}
+}
+
+// metricTypesInMigrationOrder fixes the order the metric tables are migrated in.
+var metricTypesInMigrationOrder = []pmetric.MetricType{
+	pmetric.MetricTypeGauge,
+	pmetric.MetricTypeSum,
+	pmetric.MetricTypeSummary,
+	pmetric.MetricTypeHistogram,
+	pmetric.MetricTypeExponentialHistogram,
+}
+
+func metricsMigrations(cfg *Config, tablesConfig metrics.MetricTablesConfigMapper) []internal.TableMigrations {
+	database := cfg.database()
+	clusterStr := cfg.clusterString()
+	engine := cfg.tableEngineString()
+	ttlExpr := internal.GenerateTTLExpr(cfg.TTL, "toDateTime(TimeUnix)")
+
+	tables := make([]internal.TableMigrations, 0, len(metricTypesInMigrationOrder))
+	for _, metricType := range metricTypesInMigrationOrder {
+		tableName := tablesConfig[metricType].Name
+		tables = append(tables, internal.TableMigrations{
+			Table: tableName,
+			Migrations: []internal.Migration{
+				{
+					Version:     1,
+					Description: fmt.Sprintf("create %s metrics table", metricType),
+					Statements: []string{
+						metrics.RenderCreateTableSQL(metricType, database, tableName, clusterStr, engine, ttlExpr),
+					},
+				},
+			},
+		})
+	}
+
+	return tables
+}
+
+func renderAddColumnSQL(cfg *Config, table, columnDefinition string) string {
+	return fmt.Sprintf("ALTER TABLE %q.%q %s ADD COLUMN IF NOT EXISTS %s", cfg.database(), table, cfg.clusterString(), columnDefinition)
+}
+
+func renderAddIndexSQL(cfg *Config, table, indexDefinition string) string {
+	return fmt.Sprintf("ALTER TABLE %q.%q %s ADD INDEX IF NOT EXISTS %s", cfg.database(), table, cfg.clusterString(), indexDefinition)
+}
@@ -0,0 +1,51 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build integration
+
+package clickhouseexporter
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func testSchemaMigrations(t *testing.T, endpoint string) {
+	withTable := func(cfg *Config) {
+		cfg.LogsTableName = "otel_logs_migrations"
+	}
+	exporter := newTestLogsExporter(t, endpoint, false, withTable)
+	database := exporter.cfg.database()
+	migrationsTable := exporter.cfg.SchemaMigrations.TableName
+
+	schemaVersion := func() uint32 {
+		var version uint32
+		query := fmt.Sprintf("SELECT max(Version) FROM %q.%q WHERE TableName = ?", database, migrationsTable)
+		require.NoError(t, exporter.db.QueryRow(t.Context(), query, exporter.cfg.LogsTableName).Scan(&version))
+		return version
+	}
+	require.Equal(t, logsMigrations(exporter.cfg).LatestVersion(), schemaVersion())
+
+	// Simulate a table created before the EventName column was introduced and before versions were recorded.
+	require.NoError(t, exporter.db.Exec(t.Context(), fmt.Sprintf("ALTER TABLE %q.%q DROP COLUMN EventName", database, exporter.cfg.LogsTableName)))
+	require.NoError(t, exporter.db.Exec(t.Context(), fmt.Sprintf("DELETE FROM %q.%q WHERE TableName = ?", database, migrationsTable), exporter.cfg.LogsTableName))
+	require.Zero(t, schemaVersion())
+
+	exporter = newTestLogsExporter(t, endpoint, false, withTable)
+	require.True(t, exporter.schemaFeatures.EventName)
+	require.Equal(t, logsMigrations(exporter.cfg).LatestVersion(), schemaVersion())
+}
+
+func testSchemaMigrationsDryRun(t *testing.T, endpoint string) {
+	exporter := newTestLogsExporter(t, endpoint, false, func(cfg *Config) {
+		cfg.LogsTableName = "otel_logs_dry_run"
+		cfg.SchemaMigrations.DryRun = true
+	})
+
+	var exists uint8
+	query := fmt.Sprintf("EXISTS TABLE %q.%q", exporter.cfg.database(), exporter.cfg.LogsTableName)
+	require.NoError(t, exporter.db.QueryRow(t.Context(), query).Scan(&exists))
+	require.Zero(t, exists)
+}
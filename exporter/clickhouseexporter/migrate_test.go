@@ -0,0 +1,82 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package clickhouseexporter
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/clickhouseexporter/internal"
+)
+
+func TestMigrations(t *testing.T) {
+	cfg := withDefaultConfig(func(cfg *Config) {
+		cfg.Endpoint = defaultEndpoint
+		cfg.ClusterName = "my_cluster"
+	})
+	cfg.buildMetricTableNames()
+
+	tables := map[string][]internal.TableMigrations{
+		"logs":        {logsMigrations(cfg)},
+		"logs json":   {logsJSONMigrations(cfg)},
+		"traces":      {tracesMigrations(cfg)},
+		"traces json": {tracesJSONMigrations(cfg)},
+		"metrics":     metricsMigrations(cfg, generateMetricTablesConfigMapper(cfg)),
+	}
+
+	for name, migrations := range tables {
+		t.Run(name, func(t *testing.T) {
+			for _, table := range migrations {
+				require.NotEmpty(t, table.Table)
+				require.NotEmpty(t, table.Migrations)
+
+				createTableSQL := table.Migrations[0].Statements[0]
+				assert.Contains(t, createTableSQL, "CREATE TABLE IF NOT EXISTS")
+
+				for i, migration := range table.Migrations {
+					assert.Equal(t, uint32(i+1), migration.Version, "versions must be consecutive")
+					assert.NotEmpty(t, migration.Description)
+					require.NotEmpty(t, migration.Statements)
+
+					for _, statement := range migration.Statements {
+						assert.Contains(t, statement, "ON CLUSTER my_cluster")
+						assert.Contains(t, statement, "IF NOT EXISTS", "statements must be idempotent")
+
+						// Tables created from the current template must already match the latest version.
+						_, definition, found := strings.Cut(statement, "ADD COLUMN IF NOT EXISTS ")
+						if !found {
+							_, definition, found = strings.Cut(statement, "ADD INDEX IF NOT EXISTS ")
+						}
+						if found {
+							assert.Contains(t, createTableSQL, definition)
+						}
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestMetricsMigrationsTables(t *testing.T) {
+	cfg := withDefaultConfig(func(cfg *Config) {
+		cfg.Endpoint = defaultEndpoint
+	})
+	cfg.buildMetricTableNames()
+
+	var names []string
+	for _, table := range metricsMigrations(cfg, generateMetricTablesConfigMapper(cfg)) {
+		names = append(names, table.Table)
+		assert.Contains(t, table.Migrations[0].Statements[0], `"default"."`+table.Table+`"`)
+	}
+	assert.Equal(t, []string{
+		"otel_metrics_gauge",
+		"otel_metrics_sum",
+		"otel_metrics_summary",
+		"otel_metrics_histogram",
+		"otel_metrics_exponential_histogram",
+	}, names)
+}
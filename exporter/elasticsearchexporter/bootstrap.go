@@ -0,0 +1,84 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package elasticsearchexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/elasticsearchexporter"
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/elastic/elastic-transport-go/v8/elastictransport"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/elasticsearchexporter/internal/bootstrap"
+)
+
+// maxBootstrapErrorBodySize limits the size of the response body
+// included in the error of a failed bootstrap request.
+const maxBootstrapErrorBodySize = 4096
+
+// bootstrapSettings converts the bootstrap configuration to the settings of
+// the installed resources.
+func bootstrapSettings(cfg BootstrapSettings) bootstrap.Settings {
+	settings := bootstrap.Settings{TemplatePriority: cfg.TemplatePriority}
+	if cfg.Lifecycle.Enabled {
+		settings.Lifecycle = &bootstrap.Lifecycle{
+			RolloverMaxAge:              cfg.Lifecycle.RolloverMaxAge,
+			RolloverMaxPrimaryShardSize: cfg.Lifecycle.RolloverMaxPrimaryShardSize,
+			DeleteAfter:                 cfg.Lifecycle.DeleteAfter,
+		}
+	}
+	return settings
+}
+
+// bootstrapDataStreams installs, or updates, the lifecycle policies, component
+// templates and index templates of the otel mapping mode data streams of the
+// given signals.
+func bootstrapDataStreams(
+	ctx context.Context,
+	client elastictransport.Interface,
+	cfg BootstrapSettings,
+	signals []bootstrap.Signal,
+	logger *zap.Logger,
+) error {
+	settings := bootstrapSettings(cfg)
+	for _, signal := range signals {
+		resources, err := bootstrap.Resources(signal, settings)
+		if err != nil {
+			return err
+		}
+		for _, resource := range resources {
+			if err := putBootstrapResource(ctx, client, resource); err != nil {
+				return err
+			}
+		}
+		logger.Info("Installed Elasticsearch resources of the otel mapping mode data streams",
+			zap.String("index_pattern", signal.IndexPattern()),
+			zap.String("index_template", signal.Name()),
+			zap.Int("version", bootstrap.Version),
+		)
+	}
+	return nil
+}
+
+func putBootstrapResource(ctx context.Context, client elastictransport.Interface, resource bootstrap.Resource) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, resource.Path, bytes.NewReader(resource.Body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := client.Perform(req)
+	if err != nil {
+		return fmt.Errorf("failed to install %s: %w", resource.Path, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, maxBootstrapErrorBodySize))
+		return fmt.Errorf("failed to install %s: %s: %s", resource.Path, resp.Status, body)
+	}
+	_, _ = io.Copy(io.Discard, resp.Body)
+	return nil
+}
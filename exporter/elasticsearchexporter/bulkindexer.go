@@ -10,7 +10,8 @@ import (
 	"io"
 	"net/http"
 	"net/url"
-	"runtime"
+	"regexp"
+	"slices"
 	"strings"
 	"sync"
 	"time"
@@ -18,7 +19,6 @@ import (
 	"github.com/elastic/elastic-transport-go/v8/elastictransport"
 	"github.com/elastic/go-docappender/v2"
 	"go.opentelemetry.io/collector/client"
-	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config/configcompression"
 	"go.opentelemetry.io/collector/exporter"
 	"go.opentelemetry.io/collector/exporter/exporterhelper"
@@ -379,6 +379,18 @@ func flushBulkIndexer(
 			)...)),
 		)
 
+		mappingConflict := isMappingConflict(resp.Error.Type, resp.Error.Reason)
+		if mappingConflict {
+			tb.ElasticsearchDocsMappingConflicts.Add(
+				ctx,
+				int64(1),
+				metric.WithAttributeSet(attribute.NewSet(append(defaultMetaAttrs,
+					attribute.String("data_stream", dataStreamFromIndex(resp.Index)),
+					attribute.String("error.type", resp.Error.Type),
+				)...)),
+			)
+		}
+
 		if resp.Error.Type == "version_conflict_engine_exception" &&
 			(strings.HasPrefix(resp.Index, ".profiling-stackframes-") ||
 				strings.HasPrefix(resp.Index, ".profiling-stacktraces-")) {
@@ -388,25 +400,33 @@ func flushBulkIndexer(
 			continue
 		}
 
-		// Log failed docs
-		fields = append(fields,
+		// Log failed docs. The fields of each document are appended to a
+		// clipped copy so that they don't leak into the logs of the next one.
+		docFields := append(slices.Clip(fields),
 			zap.String("index", resp.Index),
 			zap.String("error.type", resp.Error.Type),
 			zap.String("error.reason", resp.Error.Reason),
 			zap.Int("http.response.status_code", resp.Status),
 		)
 
+		if mappingConflict {
+			docFields = append(docFields, zap.Bool("mapping_conflict", true))
+			if field := mappingConflictField(resp.Error.Reason); field != "" {
+				docFields = append(docFields, zap.String("mapping_conflict.field", field))
+			}
+		}
+
 		if getErrorHintFunc != nil {
 			if hint := getErrorHintFunc(resp.Index, resp.Error.Type); hint != "" {
-				fields = append(fields, zap.String("hint", hint))
+				docFields = append(docFields, zap.String("hint", hint))
 			}
 		}
-		logger.Error("failed to index document", fields...)
+		logger.Error("failed to index document", docFields...)
 
 		if resp.Input != "" {
-			fields = append(fields, zap.String("input", resp.Input))
+			docFields = append(docFields, zap.String("input", resp.Input))
 		}
-		failedDocsInputLogger.Debug("failed to index document; input may contain sensitive data", fields...)
+		failedDocsInputLogger.Debug("failed to index document; input may contain sensitive data", docFields...)
 	}
 	if stat.Indexed > 0 {
 		tb.ElasticsearchDocsProcessed.Add(
@@ -490,6 +510,47 @@ func getErrorHint(mode MappingMode, index, errorType string) string {
 	return ""
 }
 
+var (
+	// mappingConflictFieldRegexp extracts the conflicting field from the
+	// reason of a mapping conflict, e.g. "failed to parse field [a] of type [long]".
+	mappingConflictFieldRegexp = regexp.MustCompile(`(?:field|mapper|introduction of) \[([^\]]+)\]`)
+
+	// backingIndexRegexp extracts the data stream from the name of its backing
+	// and failure store indices, e.g. ".ds-logs-generic.otel-default-2025.01.01-000001".
+	backingIndexRegexp = regexp.MustCompile(`^\.(?:ds|fs)-(.+)-\d{4}\.\d{2}\.\d{2}-\d{6}$`)
+)
+
+// isMappingConflict reports whether a document was rejected because it
+// conflicts with the mappings of its index.
+func isMappingConflict(errorType, reason string) bool {
+	switch errorType {
+	case "document_parsing_exception", "mapper_parsing_exception", "strict_dynamic_mapping_exception":
+		return true
+	case "illegal_argument_exception":
+		return strings.Contains(reason, "mapper [") || strings.Contains(reason, "Limit of total fields")
+	}
+	return false
+}
+
+// mappingConflictField returns the conflicting field of a mapping conflict,
+// or an empty string if the reason doesn't mention it. The reason is only
+// available when include_source_on_error is configured.
+func mappingConflictField(reason string) string {
+	if m := mappingConflictFieldRegexp.FindStringSubmatch(reason); m != nil {
+		return m[1]
+	}
+	return ""
+}
+
+// dataStreamFromIndex returns the data stream of a backing index, or the
+// index itself if it isn't a backing index.
+func dataStreamFromIndex(index string) string {
+	if m := backingIndexRegexp.FindStringSubmatch(index); m != nil {
+		return m[1]
+	}
+	return index
+}
+
 func newFailedDocsInputLogger(logger *zap.Logger, config *Config) *zap.Logger {
 	if !config.LogFailedDocsInput {
 		return zap.NewNop()
@@ -517,25 +578,11 @@ type bulkIndexers struct {
 }
 
 func (b *bulkIndexers) start(
-	ctx context.Context,
+	esClient elastictransport.Interface,
 	cfg *Config,
 	set exporter.Settings,
-	host component.Host,
 	allowedMappingModes map[string]MappingMode,
-) error {
-	userAgent := fmt.Sprintf(
-		"%s/%s (%s/%s)",
-		set.BuildInfo.Description,
-		set.BuildInfo.Version,
-		runtime.GOOS,
-		runtime.GOARCH,
-	)
-
-	esClient, err := newElasticsearchClient(ctx, cfg, host, set.TelemetrySettings, userAgent)
-	if err != nil {
-		return err
-	}
-
+) {
 	for _, mode := range allowedMappingModes {
 		requireDataStream := mode == MappingOTel || mode == MappingECS
 		modeSpecificErrorHintFunc := func(index, errorType string) string {
@@ -560,7 +607,6 @@ func (b *bulkIndexers) start(
 
 	profilingExecutables := newBulkIndexer(esClient, cfg, false, b.telemetryBuilder, set.Logger, mappingModeNoneErrorHintFunc)
 	b.profilingExecutables = &wgTrackingBulkIndexer{bulkIndexer: profilingExecutables, wg: &b.wg}
-	return nil
 }
 
 func (b *bulkIndexers) shutdown(ctx context.Context) error {
@@ -259,6 +259,79 @@ func TestBulkIndexerLogsStatusCode(t *testing.T) {
 	}
 }
 
+func TestBulkIndexerMappingConflicts(t *testing.T) {
+	responseBody := `{"errors": true, "items":[
+		{"create":{"_index":".ds-logs-generic.otel-default-2025.01.01-000001","status":400,"error":{"type":"document_parsing_exception","reason":"[1:15] failed to parse field [attributes.foo] of type [long] in document with id 'abc'"}}},
+		{"create":{"_index":"logs-generic.otel-default","status":400,"error":{"type":"illegal_argument_exception","reason":"Limit of total fields [1000] has been exceeded"}}},
+		{"create":{"_index":"logs-generic.otel-default","status":400,"error":{"type":"illegal_argument_exception","reason":"some other error"}}}
+	]}`
+
+	cfg := Config{
+		QueueBatchConfig: configoptional.Default(exporterhelper.QueueBatchConfig{
+			NumConsumers: 1,
+		}),
+	}
+	esClient, err := elastictransport.New(elastictransport.Config{
+		URLs: []*url.URL{{Scheme: "http", Host: "localhost:9200"}},
+		Transport: &mockTransport{
+			RoundTripFunc: func(*http.Request) (*http.Response, error) {
+				return &http.Response{
+					Header:     http.Header{"X-Elastic-Product": []string{"Elasticsearch"}},
+					Body:       io.NopCloser(strings.NewReader(responseBody)),
+					StatusCode: http.StatusOK,
+				}, nil
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	ct := componenttest.NewTelemetry()
+	tb, err := metadata.NewTelemetryBuilder(
+		metadatatest.NewSettings(ct).TelemetrySettings,
+	)
+	require.NoError(t, err)
+
+	core, observed := observer.New(zap.NewAtomicLevelAt(zapcore.DebugLevel))
+	bi := newSyncBulkIndexer(esClient, &cfg, false, tb, zap.New(core), nil)
+
+	ctx := t.Context()
+	session := bi.StartSession(ctx)
+	for range 3 {
+		require.NoError(t, session.Add(ctx, "logs-generic.otel-default", "", "", strings.NewReader(`{"foo": "bar"}`), nil, docappender.ActionCreate))
+	}
+	require.NoError(t, session.Flush(ctx))
+	session.End()
+	assert.NoError(t, bi.Close(ctx))
+
+	metadatatest.AssertEqualElasticsearchDocsMappingConflicts(t, ct, []metricdata.DataPoint[int64]{
+		{
+			Value: 1,
+			Attributes: attribute.NewSet(
+				attribute.String("data_stream", "logs-generic.otel-default"),
+				attribute.String("error.type", "document_parsing_exception"),
+			),
+		},
+		{
+			Value: 1,
+			Attributes: attribute.NewSet(
+				attribute.String("data_stream", "logs-generic.otel-default"),
+				attribute.String("error.type", "illegal_argument_exception"),
+			),
+		},
+	}, metricdatatest.IgnoreTimestamp())
+
+	messages := observed.FilterMessage("failed to index document").FilterFieldKey("mapping_conflict")
+	require.Equal(t, 2, messages.Len(), "observed.All()=%v", observed.All())
+	assert.Equal(t, "attributes.foo", messages.All()[0].ContextMap()["mapping_conflict.field"])
+}
+
+func TestDataStreamFromIndex(t *testing.T) {
+	assert.Equal(t, "logs-generic.otel-default", dataStreamFromIndex(".ds-logs-generic.otel-default-2025.01.01-000001"))
+	assert.Equal(t, "logs-generic.otel-default", dataStreamFromIndex(".fs-logs-generic.otel-default-2025.01.01-000002"))
+	assert.Equal(t, "logs-generic.otel-default", dataStreamFromIndex("logs-generic.otel-default"))
+	assert.Equal(t, "my-index", dataStreamFromIndex("my-index"))
+}
+
 func TestQueryParamsParsedFromEndpoints(t *testing.T) {
 	cfg := createDefaultConfig().(*Config)
 	cfg.Endpoints = []string{"http://localhost:9200?pipeline=test-pipeline"}
@@ -89,6 +89,11 @@ type Config struct {
 	Mapping        MappingsSettings       `mapstructure:"mapping"`
 	LogstashFormat LogstashFormatSettings `mapstructure:"logstash_format"`
 
+	// Bootstrap configures the installation of the index templates, component
+	// templates and lifecycle policies of the otel mapping mode data streams
+	// when the exporter starts.
+	Bootstrap BootstrapSettings `mapstructure:"bootstrap"`
+
 	// TelemetrySettings contains settings useful for testing/debugging purposes.
 	// This is experimental and may change at any time.
 	TelemetrySettings `mapstructure:"telemetry"`
@@ -141,6 +146,53 @@ type LogstashFormatSettings struct {
 	_ struct{}
 }
 
+// BootstrapSettings defines the installation of the Elasticsearch resources
+// required by the otel mapping mode data streams, i.e. data streams matching
+// `logs-*.otel-*`, `metrics-*.otel-*` and `traces-*.otel-*`.
+//
+// The resources are installed, or updated if they already exist, when the
+// exporter starts.
+type BootstrapSettings struct {
+	// Enabled enables the installation of the resources at start.
+	Enabled bool `mapstructure:"enabled"`
+
+	// TemplatePriority is the priority of the installed index templates.
+	// It should be greater than the priority of the built-in index templates
+	// of Elasticsearch matching the same data streams.
+	TemplatePriority int `mapstructure:"template_priority"`
+
+	// Lifecycle configures the ILM policy of the data streams.
+	Lifecycle BootstrapLifecycleSettings `mapstructure:"lifecycle"`
+
+	// prevent unkeyed literal initialization
+	_ struct{}
+}
+
+// BootstrapLifecycleSettings defines the ILM policy installed by the bootstrap.
+//
+// https://www.elastic.co/guide/en/elasticsearch/reference/current/index-lifecycle-management.html
+type BootstrapLifecycleSettings struct {
+	// Enabled enables the installation of the ILM policy. ILM is not available
+	// in Elasticsearch Serverless, where this should be disabled.
+	Enabled bool `mapstructure:"enabled"`
+
+	// RolloverMaxAge triggers the rollover of the data streams once their write
+	// index reaches the given age. Rollover on age is disabled if <= 0.
+	RolloverMaxAge time.Duration `mapstructure:"rollover_max_age"`
+
+	// RolloverMaxPrimaryShardSize triggers the rollover of the data streams once
+	// the largest primary shard of their write index reaches the given size,
+	// e.g. `50gb`. Rollover on size is disabled if empty.
+	RolloverMaxPrimaryShardSize string `mapstructure:"rollover_max_primary_shard_size"`
+
+	// DeleteAfter deletes the backing indices of the data streams the given
+	// duration after their rollover. Indices are never deleted if <= 0.
+	DeleteAfter time.Duration `mapstructure:"delete_after"`
+
+	// prevent unkeyed literal initialization
+	_ struct{}
+}
+
 type DynamicIndexSetting struct {
 	// Enabled enables dynamic index routing.
 	//
@@ -377,6 +429,15 @@ func (cfg *Config) Validate() error {
 		return errors.New("must not specify both traces_index and traces_dynamic_index; traces_index should be empty unless all documents should be sent to the same index")
 	}
 
+	if cfg.Bootstrap.Enabled {
+		if cfg.Bootstrap.TemplatePriority < 0 {
+			return errors.New("bootstrap::template_priority should be non-negative")
+		}
+		if lc := cfg.Bootstrap.Lifecycle; lc.Enabled && lc.RolloverMaxAge <= 0 && lc.RolloverMaxPrimaryShardSize == "" {
+			return errors.New("bootstrap::lifecycle requires one of rollover_max_age or rollover_max_primary_shard_size")
+		}
+	}
+
 	uniq := map[string]struct{}{}
 	for i, k := range cfg.MetadataKeys {
 		kl := strings.ToLower(k)
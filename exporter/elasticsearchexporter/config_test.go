@@ -136,6 +136,14 @@ func TestConfig(t *testing.T) {
 					PrefixSeparator: "-",
 					DateFormat:      "%Y.%m.%d",
 				},
+				Bootstrap: BootstrapSettings{
+					TemplatePriority: 250,
+					Lifecycle: BootstrapLifecycleSettings{
+						Enabled:                     true,
+						RolloverMaxAge:              30 * 24 * time.Hour,
+						RolloverMaxPrimaryShardSize: "50gb",
+					},
+				},
 				TelemetrySettings: TelemetrySettings{
 					LogFailedDocsInputRateLimit: time.Second,
 				},
@@ -212,6 +220,14 @@ func TestConfig(t *testing.T) {
 					PrefixSeparator: "-",
 					DateFormat:      "%Y.%m.%d",
 				},
+				Bootstrap: BootstrapSettings{
+					TemplatePriority: 250,
+					Lifecycle: BootstrapLifecycleSettings{
+						Enabled:                     true,
+						RolloverMaxAge:              30 * 24 * time.Hour,
+						RolloverMaxPrimaryShardSize: "50gb",
+					},
+				},
 				TelemetrySettings: TelemetrySettings{
 					LogFailedDocsInputRateLimit: time.Second,
 				},
@@ -288,6 +304,14 @@ func TestConfig(t *testing.T) {
 					PrefixSeparator: "-",
 					DateFormat:      "%Y.%m.%d",
 				},
+				Bootstrap: BootstrapSettings{
+					TemplatePriority: 250,
+					Lifecycle: BootstrapLifecycleSettings{
+						Enabled:                     true,
+						RolloverMaxAge:              30 * 24 * time.Hour,
+						RolloverMaxPrimaryShardSize: "50gb",
+					},
+				},
 				TelemetrySettings: TelemetrySettings{
 					LogFailedDocsInputRateLimit: time.Second,
 				},
@@ -344,6 +368,19 @@ func TestConfig(t *testing.T) {
 				cfg.IncludeSourceOnError = &includeSource
 			}),
 		},
+		{
+			id:         component.NewIDWithName(metadata.Type, "bootstrap"),
+			configFile: "config.yaml",
+			expected: withDefaultConfig(func(cfg *Config) {
+				cfg.Endpoint = "https://elastic.example.com:9200"
+
+				cfg.Bootstrap.Enabled = true
+				cfg.Bootstrap.TemplatePriority = 300
+				cfg.Bootstrap.Lifecycle.RolloverMaxAge = 24 * time.Hour
+				cfg.Bootstrap.Lifecycle.RolloverMaxPrimaryShardSize = ""
+				cfg.Bootstrap.Lifecycle.DeleteAfter = 7 * 24 * time.Hour
+			}),
+		},
 		{
 			id:         component.NewIDWithName(metadata.Type, "metadata_keys"),
 			configFile: "config.yaml",
@@ -516,6 +553,23 @@ func TestConfig_Validate(t *testing.T) {
 			}),
 			err: `must not specify both retry::max_requests and retry::max_retries`,
 		},
+		"bootstrap lifecycle without rollover": {
+			config: withDefaultConfig(func(cfg *Config) {
+				cfg.Endpoints = []string{"http://test:9200"}
+				cfg.Bootstrap.Enabled = true
+				cfg.Bootstrap.Lifecycle.RolloverMaxAge = 0
+				cfg.Bootstrap.Lifecycle.RolloverMaxPrimaryShardSize = ""
+			}),
+			err: `bootstrap::lifecycle requires one of rollover_max_age or rollover_max_primary_shard_size`,
+		},
+		"bootstrap negative template priority": {
+			config: withDefaultConfig(func(cfg *Config) {
+				cfg.Endpoints = []string{"http://test:9200"}
+				cfg.Bootstrap.Enabled = true
+				cfg.Bootstrap.TemplatePriority = -1
+			}),
+			err: `bootstrap::template_priority should be non-negative`,
+		},
 		"duplicate metadata_keys specified": {
 			config: withDefaultConfig(func(cfg *Config) {
 				cfg.Endpoints = []string{"http://test:9200"}
@@ -8,6 +8,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"runtime"
 
 	"github.com/elastic/go-docappender/v2"
 	"go.opentelemetry.io/collector/client"
@@ -22,6 +23,7 @@ import (
 	"go.uber.org/zap"
 	"golang.org/x/sync/errgroup"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/elasticsearchexporter/internal/bootstrap"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/elasticsearchexporter/internal/datapoints"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/elasticsearchexporter/internal/elasticsearch"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/elasticsearchexporter/internal/metadata"
@@ -40,6 +42,10 @@ type elasticsearchExporter struct {
 	bulkIndexers        bulkIndexers
 	bufferPool          *pool.BufferPool
 
+	// bootstrapSignals are the signals whose otel mapping mode data
+	// streams are bootstrapped at start, if enabled.
+	bootstrapSignals []bootstrap.Signal
+
 	documentEncoders         [NumMappingModes]documentEncoder
 	documentRouters          [NumMappingModes]documentRouter
 	spanEventDocumentRouters [NumMappingModes]documentRouter
@@ -47,7 +53,7 @@ type elasticsearchExporter struct {
 	telemetryBuilder *metadata.TelemetryBuilder
 }
 
-func newExporter(cfg *Config, set exporter.Settings, index string) (*elasticsearchExporter, error) {
+func newExporter(cfg *Config, set exporter.Settings, index string, bootstrapSignals []bootstrap.Signal) (*elasticsearchExporter, error) {
 	telemetryBuilder, err := metadata.NewTelemetryBuilder(set.TelemetrySettings)
 	if err != nil {
 		return nil, fmt.Errorf("failed to initialize internal telemetry: %w", err)
@@ -63,6 +69,7 @@ func newExporter(cfg *Config, set exporter.Settings, index string) (*elasticsear
 		defaultMappingMode:  MappingOTel,
 		bufferPool:          pool.NewBufferPool(),
 		bulkIndexers:        bulkIndexers{telemetryBuilder: telemetryBuilder},
+		bootstrapSignals:    bootstrapSignals,
 		telemetryBuilder:    telemetryBuilder,
 	}
 	for mappingMode := range NumMappingModes {
@@ -78,9 +85,23 @@ func newExporter(cfg *Config, set exporter.Settings, index string) (*elasticsear
 }
 
 func (e *elasticsearchExporter) Start(ctx context.Context, host component.Host) error {
-	if err := e.bulkIndexers.start(ctx, e.config, e.set, host, e.allowedMappingModes); err != nil {
+	userAgent := fmt.Sprintf(
+		"%s/%s (%s/%s)",
+		e.set.BuildInfo.Description,
+		e.set.BuildInfo.Version,
+		runtime.GOOS,
+		runtime.GOARCH,
+	)
+	esClient, err := newElasticsearchClient(ctx, e.config, host, e.set.TelemetrySettings, userAgent)
+	if err != nil {
 		return fmt.Errorf("error starting bulk indexers: %w", err)
 	}
+	if e.config.Bootstrap.Enabled && len(e.bootstrapSignals) > 0 {
+		if err := bootstrapDataStreams(ctx, esClient, e.config.Bootstrap, e.bootstrapSignals, e.set.Logger); err != nil {
+			return fmt.Errorf("error bootstrapping data streams: %w", err)
+		}
+	}
+	e.bulkIndexers.start(esClient, e.config, e.set, e.allowedMappingModes)
 	return nil
 }
 
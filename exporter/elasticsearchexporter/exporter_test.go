@@ -10,6 +10,7 @@ import (
 	"fmt"
 	"math"
 	"net/http"
+	"net/http/httptest"
 	"net/url"
 	"runtime"
 	"slices"
@@ -2958,6 +2959,84 @@ func TestExporter_DynamicMappingMode(t *testing.T) {
 
 // TestExporterAuth verifies that the Elasticsearch exporter supports
 // confighttp.ClientConfig.Auth.
+func TestExporterBootstrap(t *testing.T) {
+	newBootstrapServer := func(t *testing.T, status int) (*httptest.Server, func() []string) {
+		var mu sync.Mutex
+		var paths []string
+		mux := http.NewServeMux()
+		mux.HandleFunc("GET /{$}", func(w http.ResponseWriter, _ *http.Request) {
+			w.Header().Add("X-Elastic-Product", "Elasticsearch")
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"version": map[string]any{"number": currentESVersion},
+			})
+		})
+		mux.HandleFunc("PUT /", func(w http.ResponseWriter, r *http.Request) {
+			mu.Lock()
+			paths = append(paths, r.URL.Path)
+			mu.Unlock()
+			w.Header().Add("X-Elastic-Product", "Elasticsearch")
+			w.WriteHeader(status)
+			_, _ = w.Write([]byte(`{"acknowledged":true}`))
+		})
+		server := httptest.NewServer(mux)
+		t.Cleanup(server.Close)
+		return server, func() []string {
+			mu.Lock()
+			defer mu.Unlock()
+			return slices.Clone(paths)
+		}
+	}
+
+	t.Run("logs", func(t *testing.T) {
+		server, paths := newBootstrapServer(t, http.StatusOK)
+		newTestLogsExporter(t, server.URL, func(cfg *Config) {
+			cfg.Bootstrap.Enabled = true
+		})
+		assert.Equal(t, []string{
+			"/_ilm/policy/logs-otel-bootstrap",
+			"/_component_template/otel-bootstrap@mappings",
+			"/_component_template/logs-otel-bootstrap@mappings",
+			"/_component_template/logs-otel-bootstrap@settings",
+			"/_index_template/logs-otel-bootstrap",
+		}, paths())
+	})
+
+	t.Run("traces", func(t *testing.T) {
+		server, paths := newBootstrapServer(t, http.StatusOK)
+		newTestTracesExporter(t, server.URL, func(cfg *Config) {
+			cfg.Bootstrap.Enabled = true
+			cfg.Bootstrap.Lifecycle.Enabled = false
+		})
+		assert.Equal(t, []string{
+			"/_component_template/otel-bootstrap@mappings",
+			"/_component_template/traces-otel-bootstrap@mappings",
+			"/_component_template/traces-otel-bootstrap@settings",
+			"/_index_template/traces-otel-bootstrap",
+			"/_component_template/otel-bootstrap@mappings",
+			"/_component_template/logs-otel-bootstrap@mappings",
+			"/_component_template/logs-otel-bootstrap@settings",
+			"/_index_template/logs-otel-bootstrap",
+		}, paths())
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		server, paths := newBootstrapServer(t, http.StatusOK)
+		newTestMetricsExporter(t, server.URL)
+		assert.Empty(t, paths())
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		server, _ := newBootstrapServer(t, http.StatusForbidden)
+		exporter := newUnstartedTestLogsExporter(t, server.URL, func(cfg *Config) {
+			cfg.Bootstrap.Enabled = true
+		})
+		err := exporter.Start(t.Context(), componenttest.NewNopHost())
+		require.ErrorContains(t, err, "error bootstrapping data streams")
+		assert.ErrorContains(t, err, "/_ilm/policy/logs-otel-bootstrap")
+		require.NoError(t, exporter.Shutdown(t.Context()))
+	})
+}
+
 func TestExporterAuth(t *testing.T) {
 	done := make(chan struct{}, 1)
 	testauthID := component.NewID(component.MustNewType("authtest"))
@@ -22,6 +22,7 @@ import (
 	"go.opentelemetry.io/collector/exporter/exporterhelper/xexporterhelper"
 	"go.opentelemetry.io/collector/exporter/xexporter"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/elasticsearchexporter/internal/bootstrap"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/elasticsearchexporter/internal/metadata"
 )
 
@@ -80,6 +81,15 @@ func createDefaultConfig() component.Config {
 			PrefixSeparator: "-",
 			DateFormat:      "%Y.%m.%d",
 		},
+		Bootstrap: BootstrapSettings{
+			Enabled:          false,
+			TemplatePriority: 250,
+			Lifecycle: BootstrapLifecycleSettings{
+				Enabled:                     true,
+				RolloverMaxAge:              30 * 24 * time.Hour,
+				RolloverMaxPrimaryShardSize: "50gb",
+			},
+		},
 		TelemetrySettings: TelemetrySettings{
 			LogRequestBody:              false,
 			LogResponseBody:             false,
@@ -103,7 +113,7 @@ func createLogsExporter(
 	handleDeprecatedConfig(cf, set.Logger)
 	handleTelemetryConfig(cf, set.Logger)
 
-	exporter, err := newExporter(cf, set, cf.LogsIndex)
+	exporter, err := newExporter(cf, set, cf.LogsIndex, []bootstrap.Signal{bootstrap.Logs})
 	if err != nil {
 		return nil, err
 	}
@@ -133,7 +143,7 @@ func createMetricsExporter(
 	handleDeprecatedConfig(cf, set.Logger)
 	handleTelemetryConfig(cf, set.Logger)
 
-	exporter, err := newExporter(cf, set, cf.MetricsIndex)
+	exporter, err := newExporter(cf, set, cf.MetricsIndex, []bootstrap.Signal{bootstrap.Metrics})
 	if err != nil {
 		return nil, err
 	}
@@ -162,7 +172,7 @@ func createTracesExporter(ctx context.Context,
 	handleDeprecatedConfig(cf, set.Logger)
 	handleTelemetryConfig(cf, set.Logger)
 
-	exporter, err := newExporter(cf, set, cf.TracesIndex)
+	exporter, err := newExporter(cf, set, cf.TracesIndex, []bootstrap.Signal{bootstrap.Traces, bootstrap.Logs})
 	if err != nil {
 		return nil, err
 	}
@@ -196,7 +206,7 @@ func createProfilesExporter(
 	handleDeprecatedConfig(cf, set.Logger)
 	handleTelemetryConfig(cf, set.Logger)
 
-	exporter, err := newExporter(cf, set, "")
+	exporter, err := newExporter(cf, set, "", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,187 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package bootstrap defines the Elasticsearch resources required by the
+// data streams of the otel mapping mode: lifecycle policies, component
+// templates and index templates.
+package bootstrap // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/elasticsearchexporter/internal/bootstrap"
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+//go:embed mappings/*.json
+var mappings embed.FS
+
+const (
+	// ManagedBy is recorded in the metadata of the installed resources.
+	ManagedBy = "opentelemetry-collector"
+
+	// Version is the version of the installed resources. It must be
+	// incremented whenever the definition of the resources changes.
+	Version = 1
+
+	commonMappingsName = "otel-bootstrap@mappings"
+)
+
+// Signal is the type of the otel mapping mode data streams.
+type Signal string
+
+const (
+	Logs    Signal = "logs"
+	Metrics Signal = "metrics"
+	Traces  Signal = "traces"
+)
+
+// IndexPattern returns the pattern matching the otel mapping mode data
+// streams of the signal, i.e. `<type>-<dataset>.otel-<namespace>`.
+func (s Signal) IndexPattern() string {
+	return string(s) + "-*.otel-*"
+}
+
+// Name returns the name of the index template and lifecycle policy of the signal.
+func (s Signal) Name() string {
+	return string(s) + "-otel-bootstrap"
+}
+
+// CustomComponentTemplate returns the name of the optional component template
+// that users can create to customize the data streams of the signal.
+func (s Signal) CustomComponentTemplate() string {
+	return s.Name() + "@custom"
+}
+
+// Lifecycle defines the ILM policy of the data streams.
+type Lifecycle struct {
+	RolloverMaxAge              time.Duration
+	RolloverMaxPrimaryShardSize string
+	DeleteAfter                 time.Duration
+}
+
+// Settings defines the installed resources.
+type Settings struct {
+	TemplatePriority int
+
+	// Lifecycle is the ILM policy of the data streams. No ILM policy is
+	// installed if nil.
+	Lifecycle *Lifecycle
+}
+
+// Resource is an Elasticsearch resource, installed or updated
+// with a PUT request of Body to Path.
+type Resource struct {
+	Path string
+	Body []byte
+}
+
+// Resources returns the resources of the signal, in the order in which they
+// must be installed.
+func Resources(signal Signal, settings Settings) ([]Resource, error) {
+	var resources []Resource
+	add := func(path string, body map[string]any) error {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("failed to encode %s: %w", path, err)
+		}
+		resources = append(resources, Resource{Path: path, Body: b})
+		return nil
+	}
+	meta := map[string]any{"managed_by": ManagedBy, "managed": true}
+
+	indexSettings := map[string]any{}
+	if settings.Lifecycle != nil {
+		policy := map[string]any{
+			"phases": lifecyclePhases(*settings.Lifecycle),
+			"_meta":  meta,
+		}
+		if err := add("/_ilm/policy/"+signal.Name(), map[string]any{"policy": policy}); err != nil {
+			return nil, err
+		}
+		indexSettings["lifecycle"] = map[string]any{"name": signal.Name()}
+	}
+	if signal == Metrics {
+		indexSettings["mode"] = "time_series"
+	}
+
+	for _, name := range []string{"otel", string(signal)} {
+		template, err := mappingsTemplate(name)
+		if err != nil {
+			return nil, err
+		}
+		componentName := commonMappingsName
+		if name != "otel" {
+			componentName = signal.Name() + "@mappings"
+		}
+		template["_meta"] = meta
+		template["version"] = Version
+		if err := add("/_component_template/"+componentName, template); err != nil {
+			return nil, err
+		}
+	}
+	if err := add("/_component_template/"+signal.Name()+"@settings", map[string]any{
+		"template": map[string]any{"settings": map[string]any{"index": indexSettings}},
+		"_meta":    meta,
+		"version":  Version,
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := add("/_index_template/"+signal.Name(), map[string]any{
+		"index_patterns": []string{signal.IndexPattern()},
+		"priority":       settings.TemplatePriority,
+		"data_stream":    map[string]any{},
+		"composed_of": []string{
+			commonMappingsName,
+			signal.Name() + "@mappings",
+			signal.Name() + "@settings",
+			signal.CustomComponentTemplate(),
+		},
+		"ignore_missing_component_templates": []string{signal.CustomComponentTemplate()},
+		"_meta":                              meta,
+		"version":                            Version,
+	}); err != nil {
+		return nil, err
+	}
+	return resources, nil
+}
+
+func lifecyclePhases(lc Lifecycle) map[string]any {
+	rollover := map[string]any{}
+	if lc.RolloverMaxAge > 0 {
+		rollover["max_age"] = formatDuration(lc.RolloverMaxAge)
+	}
+	if lc.RolloverMaxPrimaryShardSize != "" {
+		rollover["max_primary_shard_size"] = lc.RolloverMaxPrimaryShardSize
+	}
+	phases := map[string]any{
+		"hot": map[string]any{
+			"actions": map[string]any{"rollover": rollover},
+		},
+	}
+	if lc.DeleteAfter > 0 {
+		phases["delete"] = map[string]any{
+			"min_age": formatDuration(lc.DeleteAfter),
+			"actions": map[string]any{"delete": map[string]any{}},
+		}
+	}
+	return phases
+}
+
+// formatDuration formats d using the time units of Elasticsearch.
+func formatDuration(d time.Duration) string {
+	return fmt.Sprintf("%ds", int64(d/time.Second))
+}
+
+func mappingsTemplate(name string) (map[string]any, error) {
+	b, err := mappings.ReadFile("mappings/" + name + ".json")
+	if err != nil {
+		return nil, err
+	}
+	var template map[string]any
+	if err := json.Unmarshal(b, &template); err != nil {
+		return nil, fmt.Errorf("invalid %s mappings: %w", name, err)
+	}
+	return template, nil
+}
@@ -0,0 +1,65 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package bootstrap
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tidwall/gjson"
+)
+
+func TestResources(t *testing.T) {
+	for _, signal := range []Signal{Logs, Metrics, Traces} {
+		t.Run(string(signal), func(t *testing.T) {
+			resources, err := Resources(signal, Settings{
+				TemplatePriority: 250,
+				Lifecycle: &Lifecycle{
+					RolloverMaxAge: 24 * time.Hour,
+					DeleteAfter:    7 * 24 * time.Hour,
+				},
+			})
+			require.NoError(t, err)
+
+			paths := make([]string, len(resources))
+			for i, resource := range resources {
+				paths[i] = resource.Path
+			}
+			name := string(signal) + "-otel-bootstrap"
+			assert.Equal(t, []string{
+				"/_ilm/policy/" + name,
+				"/_component_template/otel-bootstrap@mappings",
+				"/_component_template/" + name + "@mappings",
+				"/_component_template/" + name + "@settings",
+				"/_index_template/" + name,
+			}, paths)
+
+			policy := string(resources[0].Body)
+			assert.Equal(t, "86400s", gjson.Get(policy, "policy.phases.hot.actions.rollover.max_age").String())
+			assert.False(t, gjson.Get(policy, "policy.phases.hot.actions.rollover.max_primary_shard_size").Exists())
+			assert.Equal(t, "604800s", gjson.Get(policy, "policy.phases.delete.min_age").String())
+
+			settings := string(resources[3].Body)
+			assert.Equal(t, name, gjson.Get(settings, "template.settings.index.lifecycle.name").String())
+			assert.Equal(t, signal == Metrics, gjson.Get(settings, "template.settings.index.mode").String() == "time_series")
+
+			template := string(resources[4].Body)
+			assert.Equal(t, string(signal)+"-*.otel-*", gjson.Get(template, "index_patterns.0").String())
+			assert.Equal(t, int64(250), gjson.Get(template, "priority").Int())
+			assert.Equal(t, name+"@custom", gjson.Get(template, "ignore_missing_component_templates.0").String())
+			assert.Equal(t, ManagedBy, gjson.Get(template, "_meta.managed_by").String())
+		})
+	}
+}
+
+func TestResourcesWithoutLifecycle(t *testing.T) {
+	resources, err := Resources(Logs, Settings{TemplatePriority: 250})
+	require.NoError(t, err)
+
+	require.Len(t, resources, 4)
+	assert.Equal(t, "/_component_template/otel-bootstrap@mappings", resources[0].Path)
+	assert.False(t, gjson.GetBytes(resources[2].Body, "template.settings.index.lifecycle").Exists())
+}
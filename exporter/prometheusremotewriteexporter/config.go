@@ -6,6 +6,7 @@ package prometheusremotewriteexporter // import "github.com/open-telemetry/opent
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	remoteapi "github.com/prometheus/client_golang/exp/api/remote"
 	"go.opentelemetry.io/collector/component"
@@ -65,6 +66,55 @@ type Config struct {
 
 	// RemoteWriteProtoMsg controls whether prometheus remote write v1 or v2 is sent.
 	RemoteWriteProtoMsg remoteapi.WriteMessageType `mapstructure:"protobuf_message,omitempty"`
+
+	// ConvertHistogramsToNHCB controls whether explicit bucket histograms are sent as native histograms with custom buckets, this option is only supported when using PRW 2.0.
+	ConvertHistogramsToNHCB bool `mapstructure:"convert_histograms_to_nhcb"`
+
+	// Tenant configures how the tenant of the metrics is determined. When enabled, the metrics of each
+	// tenant are sent in their own requests, with the tenant set in the configured header.
+	Tenant configoptional.Optional[TenantConfig] `mapstructure:"tenant"`
+
+	// AdditionalEndpoints are remote write endpoints the metrics are sent to in addition to the main endpoint.
+	AdditionalEndpoints []EndpointConfig `mapstructure:"additional_endpoints"`
+}
+
+// TenantConfig defines how the tenant of the metrics is determined.
+type TenantConfig struct {
+	// FromResourceAttribute is the resource attribute holding the tenant of the resource metrics.
+	FromResourceAttribute string `mapstructure:"from_resource_attribute"`
+
+	// FromClientMetadata is the client metadata key holding the tenant, it is used for
+	// resources which don't have the FromResourceAttribute attribute.
+	FromClientMetadata string `mapstructure:"from_client_metadata"`
+
+	// Default is the tenant of the metrics for which no tenant is found. If empty, these
+	// metrics are sent without the tenant header.
+	Default string `mapstructure:"default"`
+
+	// Header is the HTTP header the tenant is sent in.
+	Header string `mapstructure:"header"`
+
+	// MaxTenants is the maximum number of tenants with senders. The metrics of other tenants are
+	// rejected until idle tenants are evicted. 0 means no limit.
+	MaxTenants int `mapstructure:"max_tenants"`
+
+	// IdleTimeout is the duration after which the senders of a tenant without metrics are shut down.
+	// 0 disables the eviction of idle tenants.
+	IdleTimeout time.Duration `mapstructure:"idle_timeout"`
+
+	// prevent unkeyed literal initialization
+	_ struct{}
+}
+
+// EndpointConfig defines an additional remote write endpoint.
+type EndpointConfig struct {
+	ClientConfig confighttp.ClientConfig `mapstructure:",squash"` // squash ensures fields are correctly decoded in embedded struct.
+
+	// BackOffConfig overrides the retry settings of the exporter for this endpoint.
+	BackOffConfig *configretry.BackOffConfig `mapstructure:"retry_on_failure"`
+
+	// prevent unkeyed literal initialization
+	_ struct{}
 }
 
 type TargetInfo struct {
@@ -136,5 +186,38 @@ func (cfg *Config) Validate() error {
 		return fmt.Errorf("remote write v2 is only supported with the feature gate %s", enableSendingRW2FeatureGate.ID())
 	}
 
+	if cfg.ConvertHistogramsToNHCB && cfg.RemoteWriteProtoMsg != remoteapi.WriteV2MessageType {
+		return fmt.Errorf("convert_histograms_to_nhcb is only supported with the %s protobuf message", remoteapi.WriteV2MessageType)
+	}
+
+	if tenant := cfg.Tenant.Get(); tenant != nil {
+		if tenant.FromResourceAttribute == "" && tenant.FromClientMetadata == "" {
+			return errors.New("tenant requires from_resource_attribute or from_client_metadata to be set")
+		}
+		if tenant.Header == "" {
+			return errors.New("tenant header can't be empty")
+		}
+		if tenant.MaxTenants < 0 {
+			return errors.New("tenant max_tenants can't be negative")
+		}
+		if tenant.IdleTimeout < 0 {
+			return errors.New("tenant idle_timeout can't be negative")
+		}
+	}
+
+	for i, endpoint := range cfg.AdditionalEndpoints {
+		if endpoint.ClientConfig.Endpoint == "" {
+			return fmt.Errorf("additional_endpoints[%d]: endpoint can't be empty", i)
+		}
+		if len(endpoint.ClientConfig.Compression) > 0 && endpoint.ClientConfig.Compression != "snappy" {
+			return fmt.Errorf("additional_endpoints[%d]: compression type must be snappy", i)
+		}
+	}
+
 	return nil
 }
+
+// routingEnabled returns whether the metrics are routed by tenant or sent to multiple endpoints.
+func (cfg *Config) routingEnabled() bool {
+	return cfg.Tenant.HasValue() || len(cfg.AdditionalEndpoints) > 0
+}
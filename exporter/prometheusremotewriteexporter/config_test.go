@@ -15,6 +15,7 @@ import (
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config/confighttp"
 	"go.opentelemetry.io/collector/config/configopaque"
+	"go.opentelemetry.io/collector/config/configoptional"
 	"go.opentelemetry.io/collector/config/configretry"
 	"go.opentelemetry.io/collector/config/configtls"
 	"go.opentelemetry.io/collector/confmap/confmaptest"
@@ -83,8 +84,50 @@ func TestLoadConfig(t *testing.T) {
 					Enabled: true,
 				},
 				RemoteWriteProtoMsg: remoteapi.WriteV1MessageType,
+				Tenant: configoptional.Default(TenantConfig{
+					Header:      defaultTenantHeader,
+					MaxTenants:  defaultMaxTenants,
+					IdleTimeout: defaultTenantIdleTimeout,
+				}),
 			},
 		},
+		{
+			id: component.NewIDWithName(metadata.Type, "tenant"),
+			expected: func() component.Config {
+				cfg := createDefaultConfig().(*Config)
+				cfg.ClientConfig.Endpoint = "localhost:8888"
+				cfg.Tenant = configoptional.Some(TenantConfig{
+					FromResourceAttribute: "tenant.id",
+					FromClientMetadata:    "x-tenant",
+					Default:               "anonymous",
+					Header:                defaultTenantHeader,
+					MaxTenants:            100,
+					IdleTimeout:           time.Hour,
+				})
+				cfg.AdditionalEndpoints = []EndpointConfig{{
+					ClientConfig: confighttp.ClientConfig{
+						Endpoint: "localhost:9999",
+						Headers: configopaque.MapList{
+							{Name: "Authorization", Value: "Bearer token"},
+						},
+					},
+					BackOffConfig: &configretry.BackOffConfig{Enabled: false},
+				}}
+				return cfg
+			}(),
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "tenant_without_source"),
+			errorMessage: "tenant requires from_resource_attribute or from_client_metadata to be set",
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "tenant_negative_max_tenants"),
+			errorMessage: "tenant max_tenants can't be negative",
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "additional_endpoint_without_endpoint"),
+			errorMessage: "additional_endpoints[0]: endpoint can't be empty",
+		},
 		{
 			id:           component.NewIDWithName(metadata.Type, "negative_queue_size"),
 			errorMessage: "remote write queue size can't be negative",
@@ -105,6 +148,10 @@ func TestLoadConfig(t *testing.T) {
 			id:           component.NewIDWithName(metadata.Type, "unknown_protobuf_message"),
 			errorMessage: "unknown type for remote write protobuf message io.prometheus.write.v4.Request, supported: prometheus.WriteRequest, io.prometheus.write.v2.Request",
 		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "nhcb_without_rw2"),
+			errorMessage: "convert_histograms_to_nhcb is only supported with the io.prometheus.write.v2.Request protobuf message",
+		},
 	}
 
 	for _, tt := range tests {
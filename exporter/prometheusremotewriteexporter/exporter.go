@@ -21,6 +21,7 @@ import (
 	remoteapi "github.com/prometheus/client_golang/exp/api/remote"
 	"github.com/prometheus/otlptranslator"
 	"github.com/prometheus/prometheus/prompb"
+	writev2 "github.com/prometheus/prometheus/prompb/io/prometheus/write/v2"
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config/confighttp"
 	"go.opentelemetry.io/collector/config/configretry"
@@ -135,11 +136,16 @@ type prwExporter struct {
 	settings            component.TelemetrySettings
 	retrySettings       configretry.BackOffConfig
 	retryOnHTTP429      bool
-	wal                 *prweWAL
+	wal                 *prweWAL[prompb.WriteRequest, *prompb.WriteRequest]
+	walV2               *prweWAL[writev2.Request, *writev2.Request]
 	exporterSettings    prometheusremotewrite.Settings
 	telemetry           prwTelemetry
 	RemoteWriteProtoMsg remoteapi.WriteMessageType
 
+	// tenant is sent in the tenantHeader of each request, when not empty.
+	tenant       string
+	tenantHeader string
+
 	// When concurrency is enabled, concurrent goroutines would potentially
 	// fight over the same batchState object. To avoid this, we use a pool
 	// to provide each goroutine with its own state.
@@ -218,6 +224,8 @@ func newPRWExporter(cfg *Config, set exporter.Settings) (*prwExporter, error) {
 			DisableScopeInfo:  cfg.DisableScopeInfo,
 			AddMetricSuffixes: cfg.AddMetricSuffixes,
 			SendMetadata:      cfg.SendMetadata,
+
+			ConvertHistogramsToNHCB: cfg.ConvertHistogramsToNHCB,
 		},
 		telemetry:      telemetry,
 		batchStatePool: sync.Pool{New: func() any { return newBatchTimeServicesState() }},
@@ -225,7 +233,11 @@ func newPRWExporter(cfg *Config, set exporter.Settings) (*prwExporter, error) {
 
 	prwe.settings.Logger.Info("starting prometheus remote write exporter", zap.Any("ProtoMsg", cfg.RemoteWriteProtoMsg))
 
-	prwe.wal, err = newWAL(cfg.WAL.Get(), set, prwe.export)
+	if enableSendingRW2FeatureGate.IsEnabled() && cfg.RemoteWriteProtoMsg == remoteapi.WriteV2MessageType {
+		prwe.walV2, err = newWAL(cfg.WAL.Get(), set, prwe.exportV2)
+	} else {
+		prwe.wal, err = newWAL(cfg.WAL.Get(), set, prwe.export)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -245,6 +257,9 @@ func (prwe *prwExporter) shutdownWALIfEnabled() error {
 	if !prwe.walEnabled() {
 		return nil
 	}
+	if prwe.walV2 != nil {
+		return prwe.walV2.stop()
+	}
 	return prwe.wal.stop()
 }
 
@@ -341,19 +356,24 @@ func (prwe *prwExporter) handleExport(ctx context.Context, tsMap map[string]*pro
 	if err != nil {
 		return err
 	}
-	if !prwe.walEnabled() {
+	if prwe.wal == nil {
 		// Perform a direct export otherwise.
 		return prwe.export(ctx, requests)
 	}
 
 	// Otherwise the WAL is enabled, and just persist the requests to the WAL
-	prwe.wal.telemetry.recordWALWrites(ctx)
+	return persistRequestsToWAL(ctx, prwe.wal, requests)
+}
+
+// persistRequestsToWAL persists the requests to the WAL, and records the WAL write telemetry.
+func persistRequestsToWAL[T any, PT walRequest[T]](ctx context.Context, w *prweWAL[T, PT], requests []PT) error {
+	w.telemetry.recordWALWrites(ctx)
 	start := time.Now()
-	err = prwe.wal.persistToWAL(ctx, requests)
+	err := w.persistToWAL(ctx, requests)
 	duration := time.Since(start)
-	prwe.wal.telemetry.recordWALWriteLatency(ctx, duration.Milliseconds())
+	w.telemetry.recordWALWriteLatency(ctx, duration.Milliseconds())
 	if err != nil {
-		prwe.wal.telemetry.recordWALWritesFailures(ctx)
+		w.telemetry.recordWALWritesFailures(ctx)
 		return err
 	}
 	return nil
@@ -442,6 +462,9 @@ func (prwe *prwExporter) execute(ctx context.Context, buf []byte) error {
 		// https://cortexmetrics.io/docs/apis/#remote-api
 		req.Header.Add("Content-Encoding", "snappy")
 		req.Header.Set("User-Agent", prwe.userAgentHeader)
+		if prwe.tenant != "" {
+			req.Header.Set(prwe.tenantHeader, prwe.tenant)
+		}
 
 		switch {
 		// If feature flag not enabled support only RW1
@@ -529,7 +552,7 @@ func (prwe *prwExporter) execute(ctx context.Context, buf []byte) error {
 	return nil
 }
 
-func (prwe *prwExporter) walEnabled() bool { return prwe.wal != nil }
+func (prwe *prwExporter) walEnabled() bool { return prwe.wal != nil || prwe.walV2 != nil }
 
 func (prwe *prwExporter) turnOnWALIfEnabled(ctx context.Context) error {
 	if !prwe.walEnabled() {
@@ -540,5 +563,8 @@ func (prwe *prwExporter) turnOnWALIfEnabled(ctx context.Context) error {
 		<-prwe.closeChan
 		cancel()
 	}()
+	if prwe.walV2 != nil {
+		return prwe.walV2.run(cancelCtx)
+	}
 	return prwe.wal.run(cancelCtx)
 }
@@ -1031,7 +1031,7 @@ func TestWALOnExporterRoundTrip(t *testing.T) {
 
 	// 3. Let's now read back all of the WAL records and ensure
 	// that all the prompb.WriteRequest values exist as we sent them.
-	wal, _, werr := cfg.WAL.Get().createWAL()
+	wal, _, werr := cfg.WAL.Get().createWAL(walDirectory)
 	assert.NoError(t, werr)
 	assert.NotNil(t, wal)
 	t.Cleanup(func() {
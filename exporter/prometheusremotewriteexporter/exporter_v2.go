@@ -103,9 +103,13 @@ func (prwe *prwExporter) handleExportV2(ctx context.Context, symbolsTable writev
 		return err
 	}
 
-	// TODO implement WAl support, can be done after #15277 is fixed
+	if prwe.walV2 == nil {
+		// Perform a direct export otherwise.
+		return prwe.exportV2(ctx, requests)
+	}
 
-	return prwe.exportV2(ctx, requests)
+	// Otherwise the WAL is enabled, and just persist the requests to the WAL
+	return persistRequestsToWAL(ctx, prwe.walV2, requests)
 }
 
 func (prwe *prwExporter) handleHeader(ctx context.Context, resp *http.Response, headerName, metricType string, recordFunc func(context.Context, int64)) {
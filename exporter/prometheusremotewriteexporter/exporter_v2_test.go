@@ -0,0 +1,251 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package prometheusremotewriteexporter
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/golang/snappy"
+	remoteapi "github.com/prometheus/client_golang/exp/api/remote"
+	writev2 "github.com/prometheus/prometheus/prompb/io/prometheus/write/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/config/configoptional"
+	"go.opentelemetry.io/collector/exporter/exportertest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/prometheusremotewriteexporter/internal/metadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/common/testutil"
+)
+
+var (
+	roundTripStartTime = time.UnixMilli(1_700_000_000_000)
+	roundTripTime      = roundTripStartTime.Add(time.Minute)
+	roundTripTraceID   = pcommon.TraceID([16]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16})
+	roundTripSpanID    = pcommon.SpanID([8]byte{1, 2, 3, 4, 5, 6, 7, 8})
+)
+
+func roundTripMetrics() pmetric.Metrics {
+	md := pmetric.NewMetrics()
+	rm := md.ResourceMetrics().AppendEmpty()
+	rm.Resource().Attributes().PutStr("service.name", "test-service")
+	rm.Resource().Attributes().PutStr("service.instance.id", "test-instance")
+	sm := rm.ScopeMetrics().AppendEmpty()
+	sm.Scope().SetName("test-scope")
+	sm.Scope().SetVersion("1.0.0")
+
+	counter := sm.Metrics().AppendEmpty()
+	counter.SetName("requests")
+	counter.SetDescription("Number of requests")
+	sum := counter.SetEmptySum()
+	sum.SetIsMonotonic(true)
+	sum.SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	dp := sum.DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(pcommon.NewTimestampFromTime(roundTripStartTime))
+	dp.SetTimestamp(pcommon.NewTimestampFromTime(roundTripTime))
+	dp.SetDoubleValue(42)
+	dp.Attributes().PutStr("method", "GET")
+	exemplar := dp.Exemplars().AppendEmpty()
+	exemplar.SetTimestamp(pcommon.NewTimestampFromTime(roundTripTime))
+	exemplar.SetDoubleValue(1.5)
+	exemplar.SetTraceID(roundTripTraceID)
+	exemplar.SetSpanID(roundTripSpanID)
+
+	histogram := sm.Metrics().AppendEmpty()
+	histogram.SetName("request.duration")
+	histogram.SetDescription("Duration of requests")
+	histogram.SetUnit("s")
+	hist := histogram.SetEmptyHistogram()
+	hist.SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	hdp := hist.DataPoints().AppendEmpty()
+	hdp.SetStartTimestamp(pcommon.NewTimestampFromTime(roundTripStartTime))
+	hdp.SetTimestamp(pcommon.NewTimestampFromTime(roundTripTime))
+	hdp.SetCount(6)
+	hdp.SetSum(30)
+	hdp.ExplicitBounds().FromRaw([]float64{1, 10})
+	hdp.BucketCounts().FromRaw([]uint64{1, 0, 5})
+
+	expHistogram := sm.Metrics().AppendEmpty()
+	expHistogram.SetName("payload.size")
+	expHistogram.SetDescription("Size of payloads")
+	expHist := expHistogram.SetEmptyExponentialHistogram()
+	expHist.SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	edp := expHist.DataPoints().AppendEmpty()
+	edp.SetStartTimestamp(pcommon.NewTimestampFromTime(roundTripStartTime))
+	edp.SetTimestamp(pcommon.NewTimestampFromTime(roundTripTime))
+	edp.SetScale(0)
+	edp.SetCount(3)
+	edp.SetSum(5)
+	edp.Positive().BucketCounts().FromRaw([]uint64{1, 2})
+
+	return md
+}
+
+// remoteWriteV2Sink is a remote write endpoint decoding the remote write 2.0 requests it receives.
+type remoteWriteV2Sink struct {
+	mu       sync.Mutex
+	requests []*writev2.Request
+}
+
+func (s *remoteWriteV2Sink) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	data, err := snappy.Decode(nil, body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	req := &writev2.Request{}
+	if err := proto.Unmarshal(data, req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	s.mu.Lock()
+	s.requests = append(s.requests, req)
+	s.mu.Unlock()
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// receivedSeries is a time series of a remote write 2.0 request with its symbols resolved.
+type receivedSeries struct {
+	labels    map[string]string
+	help      string
+	unit      string
+	series    writev2.TimeSeries
+	exemplars []map[string]string
+}
+
+func (s *remoteWriteV2Sink) series() map[string]receivedSeries {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	series := map[string]receivedSeries{}
+	for _, req := range s.requests {
+		for _, ts := range req.Timeseries {
+			received := receivedSeries{
+				labels: resolveLabels(req.Symbols, ts.LabelsRefs),
+				help:   req.Symbols[ts.Metadata.HelpRef],
+				unit:   req.Symbols[ts.Metadata.UnitRef],
+				series: ts,
+			}
+			for _, exemplar := range ts.Exemplars {
+				received.exemplars = append(received.exemplars, resolveLabels(req.Symbols, exemplar.LabelsRefs))
+			}
+			series[received.labels["__name__"]] = received
+		}
+	}
+	return series
+}
+
+func resolveLabels(symbols []string, refs []uint32) map[string]string {
+	labels := make(map[string]string, len(refs)/2)
+	for i := 0; i+1 < len(refs); i += 2 {
+		labels[symbols[refs[i]]] = symbols[refs[i+1]]
+	}
+	return labels
+}
+
+// TestRemoteWriteV2RoundTrip sends metrics through the remote write 2.0 path and checks
+// that the decoded requests hold them without losing data.
+func TestRemoteWriteV2RoundTrip(t *testing.T) {
+	testutil.SetFeatureGateForTest(t, enableSendingRW2FeatureGate, true)
+
+	for _, tt := range []struct {
+		name       string
+		enabledWAL bool
+	}{
+		{name: "without_wal"},
+		{name: "with_wal", enabledWAL: true},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			sink := &remoteWriteV2Sink{}
+			server := httptest.NewServer(sink)
+			t.Cleanup(server.Close)
+
+			cfg := createDefaultConfig().(*Config)
+			cfg.ClientConfig.Endpoint = server.URL + "/api/v1/write"
+			cfg.RemoteWriteProtoMsg = remoteapi.WriteV2MessageType
+			cfg.ConvertHistogramsToNHCB = true
+			if tt.enabledWAL {
+				cfg.WAL = configoptional.Some(WALConfig{Directory: t.TempDir()})
+			}
+			require.NoError(t, cfg.Validate())
+
+			prwe, err := newPRWExporter(cfg, exportertest.NewNopSettings(metadata.Type))
+			require.NoError(t, err)
+			require.NoError(t, prwe.Start(t.Context(), componenttest.NewNopHost()))
+			t.Cleanup(func() {
+				assert.NoError(t, prwe.Shutdown(context.Background())) //nolint:usetesting
+			})
+
+			require.NoError(t, prwe.PushMetrics(t.Context(), roundTripMetrics()))
+			var got map[string]receivedSeries
+			require.EventuallyWithT(t, func(t *assert.CollectT) {
+				got = sink.series()
+				assert.Contains(t, got, "requests_total")
+				assert.Contains(t, got, "request_duration_seconds")
+				assert.Contains(t, got, "payload_size")
+			}, 5*time.Second, 10*time.Millisecond)
+
+			startMs := roundTripStartTime.UnixMilli()
+			timeMs := roundTripTime.UnixMilli()
+
+			counter := got["requests_total"]
+			assert.Subset(t, counter.labels, map[string]string{
+				"__name__":           "requests_total",
+				"job":                "test-service",
+				"instance":           "test-instance",
+				"otel_scope_name":    "test-scope",
+				"otel_scope_version": "1.0.0",
+				"method":             "GET",
+			})
+			assert.Equal(t, "Number of requests", counter.help)
+			assert.Equal(t, writev2.Metadata_METRIC_TYPE_COUNTER, counter.series.Metadata.Type)
+			require.Len(t, counter.series.Samples, 1)
+			assert.Equal(t, 42.0, counter.series.Samples[0].Value)
+			assert.Equal(t, timeMs, counter.series.Samples[0].Timestamp)
+			assert.Equal(t, startMs, counter.series.Samples[0].StartTimestamp)
+			require.Len(t, counter.series.Exemplars, 1)
+			assert.Equal(t, 1.5, counter.series.Exemplars[0].Value)
+			assert.Equal(t, timeMs, counter.series.Exemplars[0].Timestamp)
+			assert.Subset(t, counter.exemplars[0], map[string]string{
+				"trace_id": roundTripTraceID.String(),
+				"span_id":  roundTripSpanID.String(),
+			})
+
+			histogram := got["request_duration_seconds"]
+			assert.Equal(t, "Duration of requests", histogram.help)
+			assert.Equal(t, "seconds", histogram.unit)
+			assert.Equal(t, writev2.Metadata_METRIC_TYPE_HISTOGRAM, histogram.series.Metadata.Type)
+			require.Len(t, histogram.series.Histograms, 1)
+			hist := histogram.series.Histograms[0]
+			assert.Equal(t, int32(-53), hist.Schema, "native histogram with custom buckets")
+			assert.Equal(t, []float64{1, 10}, hist.CustomValues)
+			assert.Equal(t, uint64(6), hist.GetCountInt())
+			assert.Equal(t, 30.0, hist.Sum)
+			assert.Equal(t, startMs, hist.StartTimestamp)
+
+			expHistogram := got["payload_size"]
+			assert.Equal(t, "Size of payloads", expHistogram.help)
+			require.Len(t, expHistogram.series.Histograms, 1)
+			expHist := expHistogram.series.Histograms[0]
+			assert.Equal(t, int32(0), expHist.Schema)
+			assert.Equal(t, uint64(3), expHist.GetCountInt())
+			assert.Equal(t, []writev2.BucketSpan{{Offset: 1, Length: 2}}, expHist.PositiveSpans)
+			assert.Equal(t, []int64{1, 1}, expHist.PositiveDeltas)
+			assert.Equal(t, startMs, expHist.StartTimestamp)
+		})
+	}
+}
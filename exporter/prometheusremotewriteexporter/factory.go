@@ -62,6 +62,10 @@ func createMetricsExporter(ctx context.Context, set exporter.Settings,
 		set.Logger.Warn("`remote_write_queue.num_consumers` will be used to configure processing parallelism, rather than request parallelism in a future release. This may cause out-of-order issues unless you take action. Please migrate to using `max_batch_request_parallelism` to keep the your existing behavior.")
 	}
 
+	if prwCfg.routingEnabled() {
+		return createRoutingMetricsExporter(ctx, set, prwCfg)
+	}
+
 	prwe, err := newPRWExporter(prwCfg, set)
 	if err != nil {
 		return nil, err
@@ -97,6 +101,29 @@ func createMetricsExporter(ctx context.Context, set exporter.Settings,
 	return resourcetotelemetry.WrapMetricsExporter(prwCfg.ResourceToTelemetrySettings, exporter), nil
 }
 
+// createRoutingMetricsExporter creates an exporter routing the metrics to a sender per tenant and endpoint.
+// The senders have their own queue, so the remote write queue isn't used in front of them.
+func createRoutingMetricsExporter(ctx context.Context, set exporter.Settings, cfg *Config) (exporter.Metrics, error) {
+	router, err := newPRWRouter(cfg, set)
+	if err != nil {
+		return nil, err
+	}
+
+	exporter, err := exporterhelper.NewMetrics(
+		ctx,
+		set,
+		cfg,
+		router.PushMetrics,
+		exporterhelper.WithTimeout(cfg.TimeoutSettings),
+		exporterhelper.WithStart(router.Start),
+		exporterhelper.WithShutdown(router.Shutdown),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return resourcetotelemetry.WrapMetricsExporter(cfg.ResourceToTelemetrySettings, exporter), nil
+}
+
 func createDefaultConfig() component.Config {
 	retrySettings := configretry.NewDefaultBackOffConfig()
 	retrySettings.InitialInterval = 50 * time.Millisecond
@@ -132,5 +159,10 @@ func createDefaultConfig() component.Config {
 		TargetInfo: TargetInfo{
 			Enabled: true,
 		},
+		Tenant: configoptional.Default(TenantConfig{
+			Header:      defaultTenantHeader,
+			MaxTenants:  defaultMaxTenants,
+			IdleTimeout: defaultTenantIdleTimeout,
+		}),
 	}
 }
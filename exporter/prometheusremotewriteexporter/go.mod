@@ -16,6 +16,7 @@ require (
 	github.com/prometheus/prometheus v0.309.2-0.20260113170727-c7bc56cf6c8f
 	github.com/stretchr/testify v1.11.1
 	github.com/tidwall/wal v1.2.1
+	go.opentelemetry.io/collector/client v1.53.1-0.20260309153054-85fc1918516c
 	go.opentelemetry.io/collector/component v1.53.1-0.20260309153054-85fc1918516c
 	go.opentelemetry.io/collector/component/componenttest v0.147.1-0.20260309153054-85fc1918516c
 	go.opentelemetry.io/collector/config/confighttp v0.147.1-0.20260309153054-85fc1918516c
@@ -112,7 +113,6 @@ require (
 	github.com/tidwall/pretty v1.2.0 // indirect
 	github.com/tidwall/tinylru v1.1.0 // indirect
 	go.opentelemetry.io/auto/sdk v1.2.1 // indirect
-	go.opentelemetry.io/collector/client v1.53.1-0.20260309153054-85fc1918516c // indirect
 	go.opentelemetry.io/collector/config/configauth v1.53.1-0.20260309153054-85fc1918516c // indirect
 	go.opentelemetry.io/collector/config/configcompression v1.53.1-0.20260309153054-85fc1918516c // indirect
 	go.opentelemetry.io/collector/config/configmiddleware v1.53.1-0.20260309153054-85fc1918516c // indirect
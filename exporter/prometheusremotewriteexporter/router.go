@@ -0,0 +1,559 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package prometheusremotewriteexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/prometheusremotewriteexporter"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/collector/client"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/configoptional"
+	"go.opentelemetry.io/collector/consumer/consumererror"
+	"go.opentelemetry.io/collector/exporter"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.uber.org/multierr"
+	"go.uber.org/zap"
+)
+
+const (
+	defaultTenantHeader      = "X-Scope-OrgID"
+	defaultMaxTenants        = 1000
+	defaultTenantIdleTimeout = 15 * time.Minute
+
+	// tenantDirPrefix prefixes the WAL directory of each tenant, which also prevents
+	// tenants such as ".." from escaping the WAL directory.
+	tenantDirPrefix = "tenant_"
+	// endpointsDir is the directory, relative to the WAL directory, of the WALs of the additional endpoints.
+	endpointsDir = "endpoints"
+)
+
+// prwSender sends the metrics of a tenant to an endpoint.
+type prwSender struct {
+	exporter *prwExporter
+	// queue holds the metrics waiting to be sent, it is nil when the remote write queue is disabled.
+	queue   chan pmetric.Metrics
+	timeout time.Duration
+	logger  *zap.Logger
+}
+
+// run sends the queued metrics until the queue is closed.
+func (s *prwSender) run() {
+	for md := range s.queue {
+		s.send(md)
+	}
+}
+
+func (s *prwSender) send(md pmetric.Metrics) {
+	ctx := context.Background()
+	if s.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, s.timeout)
+		defer cancel()
+	}
+	if err := s.exporter.PushMetrics(ctx, md); err != nil {
+		s.logger.Error("failed to send metrics", zap.Error(err), zap.Int("data_points", md.DataPointCount()))
+	}
+}
+
+// tenantSenders holds the senders of a tenant. They are started by the first metrics of the tenant
+// outside of the router lock, so that replaying the WAL of a tenant doesn't block the others.
+type tenantSenders struct {
+	once    sync.Once
+	senders []*prwSender
+	err     error
+	wg      sync.WaitGroup // wg waits for the senders to send their queued metrics.
+
+	// previous, when the tenant is being evicted, is closed once its former senders are shut down.
+	previous <-chan struct{}
+
+	// The fields below are protected by the router lock.
+	inflight int // inflight is the number of pushes using the senders, which can't be evicted meanwhile.
+	lastUsed time.Time
+}
+
+// prwRouter routes the metrics to a sender per tenant and endpoint. Each sender has its own queue,
+// WAL and retry state, so that a slow tenant or endpoint doesn't block the others.
+type prwRouter struct {
+	set          exporter.Settings
+	tenantCfg    *TenantConfig
+	tenantHeader string
+	// endpoints holds the configuration of the senders of each endpoint, starting with the main endpoint.
+	endpoints   []*Config
+	queueSize   int
+	timeout     time.Duration
+	maxTenants  int
+	idleTimeout time.Duration
+
+	host         component.Host
+	stopEviction chan struct{}
+	evictionWg   sync.WaitGroup
+	mu           sync.Mutex // mu protects the fields below.
+	closed       bool
+	tenants      map[string]*tenantSenders
+	// evicting holds a channel per tenant being evicted, closed once the senders of the tenant are shut down.
+	evicting map[string]chan struct{}
+}
+
+// newPRWRouter initializes a new prwRouter, the senders are created when their tenant is first seen.
+func newPRWRouter(cfg *Config, set exporter.Settings) (*prwRouter, error) {
+	if _, err := validateAndSanitizeExternalLabels(cfg); err != nil {
+		return nil, err
+	}
+
+	endpoints := []*Config{cfg}
+	for _, endpoint := range cfg.AdditionalEndpoints {
+		endpointCfg := *cfg
+		endpointCfg.ClientConfig = endpoint.ClientConfig
+		if endpoint.BackOffConfig != nil {
+			endpointCfg.BackOffConfig = *endpoint.BackOffConfig
+		}
+		if wal := cfg.WAL.Get(); wal != nil {
+			walCfg := *wal
+			walCfg.Directory = filepath.Join(wal.Directory, endpointsDir, endpointDirName(endpoint.ClientConfig.Endpoint))
+			endpointCfg.WAL = configoptional.Some(walCfg)
+		}
+		endpoints = append(endpoints, &endpointCfg)
+	}
+	for _, endpointCfg := range endpoints {
+		if _, err := url.ParseRequestURI(endpointCfg.ClientConfig.Endpoint); err != nil {
+			return nil, fmt.Errorf("invalid endpoint %q", endpointCfg.ClientConfig.Endpoint)
+		}
+	}
+
+	r := &prwRouter{
+		set:          set,
+		tenantCfg:    cfg.Tenant.Get(),
+		endpoints:    endpoints,
+		timeout:      cfg.TimeoutSettings.Timeout,
+		stopEviction: make(chan struct{}),
+		tenants:      make(map[string]*tenantSenders),
+		evicting:     make(map[string]chan struct{}),
+	}
+	if r.tenantCfg != nil {
+		r.tenantHeader = r.tenantCfg.Header
+		r.maxTenants = r.tenantCfg.MaxTenants
+		r.idleTimeout = r.tenantCfg.IdleTimeout
+	}
+	if cfg.RemoteWriteQueue.Enabled {
+		r.queueSize = cfg.RemoteWriteQueue.QueueSize
+	}
+	return r, nil
+}
+
+// endpointDirName returns the name of the WAL directory of an additional endpoint.
+func endpointDirName(endpoint string) string {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(endpoint))
+	return fmt.Sprintf("%x", h.Sum64())
+}
+
+// Start creates the senders of the default tenant, and of the tenants which have metrics left in the WAL.
+func (r *prwRouter) Start(_ context.Context, host component.Host) error {
+	r.host = host
+
+	tenants, err := r.walTenants()
+	if err != nil {
+		return err
+	}
+	tenants = append(tenants, r.defaultTenant())
+
+	for _, tenant := range tenants {
+		// The metrics left in the WAL are sent even if there are more tenants than max_tenants.
+		entry, err := r.acquire(tenant, false)
+		if err != nil {
+			return err
+		}
+		_, err = r.start(tenant, entry)
+		r.release(entry)
+		if err != nil {
+			return err
+		}
+	}
+
+	if r.idleTimeout > 0 {
+		r.evictionWg.Go(r.evictIdleTenants)
+	}
+	return nil
+}
+
+func (r *prwRouter) defaultTenant() string {
+	if r.tenantCfg == nil {
+		return ""
+	}
+	return r.tenantCfg.Default
+}
+
+// walTenants returns the tenants for which a WAL directory exists.
+func (r *prwRouter) walTenants() ([]string, error) {
+	if r.tenantCfg == nil {
+		return nil, nil
+	}
+	var tenants []string
+	for _, endpointCfg := range r.endpoints {
+		wal := endpointCfg.WAL.Get()
+		if wal == nil {
+			continue
+		}
+		entries, err := os.ReadDir(wal.Directory)
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		for _, entry := range entries {
+			if !entry.IsDir() || !strings.HasPrefix(entry.Name(), tenantDirPrefix) {
+				continue
+			}
+			tenant, err := url.PathUnescape(strings.TrimPrefix(entry.Name(), tenantDirPrefix))
+			if err != nil {
+				r.set.Logger.Warn("ignoring invalid tenant WAL directory", zap.String("directory", entry.Name()), zap.Error(err))
+				continue
+			}
+			tenants = append(tenants, tenant)
+		}
+	}
+	return tenants, nil
+}
+
+// acquire returns the senders of the tenant, registering them if needed, and prevents their eviction
+// until they are released. When limited, no new tenant is registered beyond max_tenants.
+func (r *prwRouter) acquire(tenant string, limited bool) (*tenantSenders, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.closed {
+		return nil, errors.New("shutdown has been called")
+	}
+	entry, ok := r.tenants[tenant]
+	if !ok {
+		if limited && r.maxTenants > 0 && len(r.tenants) >= r.maxTenants {
+			return nil, fmt.Errorf("tenant %q exceeds the maximum of %d tenants", tenant, r.maxTenants)
+		}
+		entry = &tenantSenders{previous: r.evicting[tenant]}
+		r.tenants[tenant] = entry
+	}
+	entry.inflight++
+	return entry, nil
+}
+
+// release marks the senders as used, they are evicted once they haven't been used for the idle timeout.
+func (r *prwRouter) release(entry *tenantSenders) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	entry.inflight--
+	entry.lastUsed = time.Now()
+}
+
+// start starts the senders of the tenant, once. Senders which fail to start are unregistered,
+// so that they are started again for the next metrics of the tenant.
+func (r *prwRouter) start(tenant string, entry *tenantSenders) ([]*prwSender, error) {
+	entry.once.Do(func() {
+		if entry.previous != nil {
+			// The senders of an evicted tenant must be shut down before their WAL is reopened.
+			<-entry.previous
+		}
+		entry.senders, entry.err = r.newSenders(tenant, entry)
+		if entry.err != nil {
+			r.mu.Lock()
+			if r.tenants[tenant] == entry {
+				delete(r.tenants, tenant)
+			}
+			r.mu.Unlock()
+		}
+	})
+	return entry.senders, entry.err
+}
+
+// newSenders creates and starts a sender per endpoint for the tenant.
+func (r *prwRouter) newSenders(tenant string, entry *tenantSenders) ([]*prwSender, error) {
+	exporters := make([]*prwExporter, 0, len(r.endpoints))
+	for _, endpointCfg := range r.endpoints {
+		senderCfg := *endpointCfg
+		if wal := endpointCfg.WAL.Get(); wal != nil && tenant != "" {
+			walCfg := *wal
+			walCfg.Directory = filepath.Join(wal.Directory, tenantDirPrefix+url.PathEscape(tenant))
+			senderCfg.WAL = configoptional.Some(walCfg)
+		}
+
+		prwe, err := newPRWExporter(&senderCfg, r.set)
+		if err == nil {
+			prwe.tenant = tenant
+			prwe.tenantHeader = r.tenantHeader
+			// The sender outlives the request which created it, so it isn't started with its context.
+			err = prwe.Start(context.Background(), r.host)
+		}
+		if err != nil {
+			for _, started := range exporters {
+				err = multierr.Append(err, started.Shutdown(context.Background()))
+			}
+			return nil, fmt.Errorf("failed to create the senders of tenant %q: %w", tenant, err)
+		}
+		exporters = append(exporters, prwe)
+	}
+
+	senders := make([]*prwSender, 0, len(exporters))
+	for _, prwe := range exporters {
+		sender := &prwSender{
+			exporter: prwe,
+			timeout:  r.timeout,
+			logger:   r.set.Logger.With(zap.String("tenant", tenant), zap.String("endpoint", prwe.endpointURL.String())),
+		}
+		if r.queueSize > 0 {
+			sender.queue = make(chan pmetric.Metrics, r.queueSize)
+			entry.wg.Go(sender.run)
+		}
+		senders = append(senders, sender)
+	}
+	return senders, nil
+}
+
+// PushMetrics splits the metrics by tenant, and hands them to the senders of each tenant.
+// When the remote write queue is enabled, the metrics are queued and sent in the background,
+// otherwise they are sent to all the endpoints before returning. The errors are retryable,
+// as the senders of a tenant can be started, or their queue drained, later on.
+func (r *prwRouter) PushMetrics(ctx context.Context, md pmetric.Metrics) error {
+	type push struct {
+		sender *prwSender
+		md     pmetric.Metrics
+	}
+	var (
+		pushes []push
+		errs   error
+	)
+
+	for tenant, tenantMetrics := range r.splitByTenant(ctx, md) {
+		entry, err := r.acquire(tenant, true)
+		if err != nil {
+			errs = multierr.Append(errs, err)
+			continue
+		}
+		defer r.release(entry)
+
+		senders, err := r.start(tenant, entry)
+		if err != nil {
+			errs = multierr.Append(errs, err)
+			continue
+		}
+
+		r.mu.Lock()
+		for _, sender := range senders {
+			if sender.queue == nil {
+				pushes = append(pushes, push{sender: sender, md: tenantMetrics})
+				continue
+			}
+			if r.closed {
+				// The queues are closed by Shutdown.
+				errs = multierr.Append(errs, errors.New("shutdown has been called"))
+				break
+			}
+			select {
+			case sender.queue <- tenantMetrics:
+			default:
+				errs = multierr.Append(errs, fmt.Errorf("queue of tenant %q for endpoint %s is full", tenant, sender.exporter.endpointURL))
+			}
+		}
+		r.mu.Unlock()
+	}
+
+	var (
+		wg sync.WaitGroup
+		mu sync.Mutex
+	)
+	for _, p := range pushes {
+		wg.Go(func() {
+			if err := p.sender.exporter.PushMetrics(ctx, p.md); err != nil {
+				mu.Lock()
+				errs = multierr.Append(errs, retryable(err))
+				mu.Unlock()
+			}
+		})
+	}
+	wg.Wait()
+	return errs
+}
+
+// splitByTenant splits the metrics by tenant. When all the resource metrics belong to the same
+// tenant, the metrics are returned as is.
+func (r *prwRouter) splitByTenant(ctx context.Context, md pmetric.Metrics) map[string]pmetric.Metrics {
+	if r.tenantCfg == nil {
+		return map[string]pmetric.Metrics{"": md}
+	}
+
+	fallback := r.tenantCfg.Default
+	if key := r.tenantCfg.FromClientMetadata; key != "" {
+		if values := client.FromContext(ctx).Metadata.Get(key); len(values) > 0 && values[0] != "" {
+			fallback = values[0]
+		}
+	}
+
+	rms := md.ResourceMetrics()
+	tenants := make([]string, rms.Len())
+	sameTenant := true
+	for i := 0; i < rms.Len(); i++ {
+		tenants[i] = r.resourceTenant(rms.At(i).Resource(), fallback)
+		sameTenant = sameTenant && tenants[i] == tenants[0]
+	}
+	if len(tenants) == 0 {
+		return nil
+	}
+	if sameTenant {
+		return map[string]pmetric.Metrics{tenants[0]: md}
+	}
+
+	byTenant := make(map[string]pmetric.Metrics)
+	for i, tenant := range tenants {
+		tenantMetrics, ok := byTenant[tenant]
+		if !ok {
+			tenantMetrics = pmetric.NewMetrics()
+			byTenant[tenant] = tenantMetrics
+		}
+		rms.At(i).CopyTo(tenantMetrics.ResourceMetrics().AppendEmpty())
+	}
+	return byTenant
+}
+
+// resourceTenant returns the tenant of the resource, or fallback if the resource doesn't have one.
+func (r *prwRouter) resourceTenant(resource pcommon.Resource, fallback string) string {
+	if r.tenantCfg.FromResourceAttribute == "" {
+		return fallback
+	}
+	if v, ok := resource.Attributes().Get(r.tenantCfg.FromResourceAttribute); ok && v.AsString() != "" {
+		return v.AsString()
+	}
+	return fallback
+}
+
+// retryable strips the permanent errors returned by the senders after their own retries, so that
+// the failure of an endpoint or tenant doesn't drop the metrics of the whole request.
+func retryable(err error) error {
+	var errs error
+	for _, e := range multierr.Errors(err) {
+		for consumererror.IsPermanent(e) && errors.Unwrap(e) != nil {
+			e = errors.Unwrap(e)
+		}
+		errs = multierr.Append(errs, e)
+	}
+	return errs
+}
+
+// evictIdleTenants periodically shuts down the senders of the tenants without metrics for the idle timeout.
+func (r *prwRouter) evictIdleTenants() {
+	ticker := time.NewTicker(r.idleTimeout / 2)
+	defer ticker.Stop()
+	for {
+		select {
+		case now := <-ticker.C:
+			r.evictIdle(now)
+		case <-r.stopEviction:
+			return
+		}
+	}
+}
+
+// evictIdle shuts down the senders of the tenants which haven't been used since the idle timeout,
+// except the senders of the default tenant. Their queued metrics are sent first.
+func (r *prwRouter) evictIdle(now time.Time) {
+	type eviction struct {
+		tenant string
+		entry  *tenantSenders
+		done   chan struct{}
+	}
+	var evictions []eviction
+
+	r.mu.Lock()
+	if r.closed {
+		r.mu.Unlock()
+		return
+	}
+	defaultTenant := r.defaultTenant()
+	for tenant, entry := range r.tenants {
+		if tenant == defaultTenant || entry.inflight > 0 || now.Sub(entry.lastUsed) < r.idleTimeout {
+			continue
+		}
+		delete(r.tenants, tenant)
+		done := make(chan struct{})
+		r.evicting[tenant] = done
+		for _, sender := range entry.senders {
+			if sender.queue != nil {
+				close(sender.queue)
+			}
+		}
+		evictions = append(evictions, eviction{tenant: tenant, entry: entry, done: done})
+	}
+	r.mu.Unlock()
+
+	for _, e := range evictions {
+		e.entry.wg.Wait()
+		var errs error
+		for _, sender := range e.entry.senders {
+			errs = multierr.Append(errs, sender.exporter.Shutdown(context.Background()))
+		}
+		if errs != nil {
+			r.set.Logger.Warn("failed to shut down the senders of an idle tenant", zap.String("tenant", e.tenant), zap.Error(errs))
+		} else {
+			r.set.Logger.Debug("shut down the senders of an idle tenant", zap.String("tenant", e.tenant))
+		}
+
+		r.mu.Lock()
+		if r.evicting[e.tenant] == e.done {
+			delete(r.evicting, e.tenant)
+		}
+		r.mu.Unlock()
+		close(e.done)
+	}
+}
+
+// Shutdown waits for the senders to send their queued metrics, and shuts them down.
+func (r *prwRouter) Shutdown(ctx context.Context) error {
+	r.mu.Lock()
+	if r.closed {
+		r.mu.Unlock()
+		return nil
+	}
+	r.closed = true
+	entries := make([]*tenantSenders, 0, len(r.tenants))
+	for _, entry := range r.tenants {
+		entries = append(entries, entry)
+	}
+	r.mu.Unlock()
+
+	close(r.stopEviction)
+	r.evictionWg.Wait()
+
+	for _, entry := range entries {
+		// Waits for the senders being started, and prevents the others from being started.
+		entry.once.Do(func() {
+			entry.err = errors.New("shutdown has been called")
+		})
+	}
+
+	r.mu.Lock()
+	for _, entry := range entries {
+		for _, sender := range entry.senders {
+			if sender.queue != nil {
+				close(sender.queue)
+			}
+		}
+	}
+	r.mu.Unlock()
+
+	var errs error
+	for _, entry := range entries {
+		entry.wg.Wait()
+		for _, sender := range entry.senders {
+			errs = multierr.Append(errs, sender.exporter.Shutdown(ctx))
+		}
+	}
+	return errs
+}
@@ -0,0 +1,260 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package prometheusremotewriteexporter
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/client"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/config/configoptional"
+	"go.opentelemetry.io/collector/consumer/consumererror"
+	"go.opentelemetry.io/collector/exporter/exportertest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/prometheusremotewriteexporter/internal/metadata"
+)
+
+// tenantRecorder is a remote write endpoint recording the number of requests received per tenant.
+type tenantRecorder struct {
+	mu       sync.Mutex
+	requests map[string]int
+}
+
+func newTenantRecorder(t *testing.T, handle func(tenant string) int) (*tenantRecorder, *httptest.Server) {
+	recorder := &tenantRecorder{requests: map[string]int{}}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		tenant := r.Header.Get(defaultTenantHeader)
+		status := http.StatusNoContent
+		if handle != nil {
+			status = handle(tenant)
+		}
+		if status < 300 {
+			recorder.mu.Lock()
+			recorder.requests[tenant]++
+			recorder.mu.Unlock()
+		}
+		w.WriteHeader(status)
+	}))
+	t.Cleanup(server.Close)
+	return recorder, server
+}
+
+func (r *tenantRecorder) received() map[string]int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	received := make(map[string]int, len(r.requests))
+	for tenant, n := range r.requests {
+		received[tenant] = n
+	}
+	return received
+}
+
+func tenantMetrics(tenants ...string) pmetric.Metrics {
+	md := pmetric.NewMetrics()
+	for _, tenant := range tenants {
+		rm := md.ResourceMetrics().AppendEmpty()
+		if tenant != "" {
+			rm.Resource().Attributes().PutStr("tenant.id", tenant)
+		}
+		m := rm.ScopeMetrics().AppendEmpty().Metrics().AppendEmpty()
+		m.SetName("test_gauge")
+		dp := m.SetEmptyGauge().DataPoints().AppendEmpty()
+		dp.SetTimestamp(pcommon.NewTimestampFromTime(time.Now()))
+		dp.SetDoubleValue(1)
+	}
+	return md
+}
+
+func newTestRouter(t *testing.T, endpoint string, configure func(cfg *Config)) *prwRouter {
+	cfg := createDefaultConfig().(*Config)
+	cfg.ClientConfig.Endpoint = endpoint
+	cfg.BackOffConfig.Enabled = false
+	cfg.RemoteWriteQueue.Enabled = false
+	cfg.Tenant = configoptional.Some(TenantConfig{
+		FromResourceAttribute: "tenant.id",
+		FromClientMetadata:    "x-tenant",
+		Header:                defaultTenantHeader,
+	})
+	if configure != nil {
+		configure(cfg)
+	}
+	require.NoError(t, cfg.Validate())
+
+	router, err := newPRWRouter(cfg, exportertest.NewNopSettings(metadata.Type))
+	require.NoError(t, err)
+	require.NoError(t, router.Start(t.Context(), componenttest.NewNopHost()))
+	t.Cleanup(func() {
+		assert.NoError(t, router.Shutdown(context.Background())) //nolint:usetesting
+	})
+	return router
+}
+
+func TestRouterTenants(t *testing.T) {
+	recorder, server := newTenantRecorder(t, nil)
+	router := newTestRouter(t, server.URL, func(cfg *Config) {
+		cfg.Tenant.Get().Default = "anonymous"
+	})
+
+	// Resources without the tenant attribute use the tenant of the client metadata.
+	ctx := client.NewContext(t.Context(), client.Info{
+		Metadata: client.NewMetadata(map[string][]string{"x-tenant": {"team-c"}}),
+	})
+	require.NoError(t, router.PushMetrics(ctx, tenantMetrics("team-a", "team-b", "")))
+	// Without client metadata, the default tenant is used.
+	require.NoError(t, router.PushMetrics(t.Context(), tenantMetrics("")))
+
+	assert.Equal(t, map[string]int{"team-a": 1, "team-b": 1, "team-c": 1, "anonymous": 1}, recorder.received())
+}
+
+func TestRouterWithoutDefaultTenant(t *testing.T) {
+	recorder, server := newTenantRecorder(t, nil)
+	router := newTestRouter(t, server.URL, nil)
+
+	require.NoError(t, router.PushMetrics(t.Context(), tenantMetrics("")))
+
+	assert.Equal(t, map[string]int{"": 1}, recorder.received())
+}
+
+func TestRouterAdditionalEndpoints(t *testing.T) {
+	recorder, server := newTenantRecorder(t, nil)
+	_, failingServer := newTenantRecorder(t, func(string) int { return http.StatusInternalServerError })
+
+	router := newTestRouter(t, server.URL, func(cfg *Config) {
+		failing := confighttp.NewDefaultClientConfig()
+		failing.Endpoint = failingServer.URL
+		cfg.AdditionalEndpoints = []EndpointConfig{{ClientConfig: failing}}
+	})
+
+	// The failure of an endpoint doesn't prevent the metrics from being sent to the others,
+	// and the metrics can be retried.
+	err := router.PushMetrics(t.Context(), tenantMetrics("team-a"))
+	require.Error(t, err)
+	assert.False(t, consumererror.IsPermanent(err))
+	assert.Equal(t, map[string]int{"team-a": 1}, recorder.received())
+}
+
+func TestRouterSlowTenantDoesNotBlockOthers(t *testing.T) {
+	release := make(chan struct{})
+	recorder, server := newTenantRecorder(t, func(tenant string) int {
+		if tenant == "slow" {
+			<-release
+		}
+		return http.StatusNoContent
+	})
+	router := newTestRouter(t, server.URL, func(cfg *Config) {
+		cfg.RemoteWriteQueue.Enabled = true
+		cfg.RemoteWriteQueue.QueueSize = 10
+	})
+
+	require.NoError(t, router.PushMetrics(t.Context(), tenantMetrics("slow")))
+	require.NoError(t, router.PushMetrics(t.Context(), tenantMetrics("fast")))
+
+	assert.EventuallyWithT(t, func(t *assert.CollectT) {
+		assert.Equal(t, map[string]int{"fast": 1}, recorder.received())
+	}, 5*time.Second, 10*time.Millisecond)
+
+	close(release)
+	assert.EventuallyWithT(t, func(t *assert.CollectT) {
+		assert.Equal(t, map[string]int{"fast": 1, "slow": 1}, recorder.received())
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestRouterFullQueue(t *testing.T) {
+	release := make(chan struct{})
+	defer close(release)
+	_, server := newTenantRecorder(t, func(string) int {
+		<-release
+		return http.StatusNoContent
+	})
+	router := newTestRouter(t, server.URL, func(cfg *Config) {
+		cfg.RemoteWriteQueue.Enabled = true
+		cfg.RemoteWriteQueue.QueueSize = 1
+	})
+
+	var err error
+	for range 3 {
+		if err = router.PushMetrics(t.Context(), tenantMetrics("team-a")); err != nil {
+			break
+		}
+	}
+	require.ErrorContains(t, err, `queue of tenant "team-a"`)
+	assert.False(t, consumererror.IsPermanent(err))
+}
+
+func TestRouterMaxTenants(t *testing.T) {
+	recorder, server := newTenantRecorder(t, nil)
+	router := newTestRouter(t, server.URL, func(cfg *Config) {
+		// The default tenant counts as one of the tenants.
+		cfg.Tenant.Get().MaxTenants = 2
+	})
+
+	require.NoError(t, router.PushMetrics(t.Context(), tenantMetrics("team-a")))
+	err := router.PushMetrics(t.Context(), tenantMetrics("team-b"))
+	require.ErrorContains(t, err, `tenant "team-b" exceeds the maximum of 2 tenants`)
+	assert.False(t, consumererror.IsPermanent(err))
+	// The known tenants are still accepted.
+	require.NoError(t, router.PushMetrics(t.Context(), tenantMetrics("team-a")))
+
+	assert.Equal(t, map[string]int{"team-a": 2}, recorder.received())
+}
+
+func TestRouterEvictIdleTenants(t *testing.T) {
+	recorder, server := newTenantRecorder(t, nil)
+	router := newTestRouter(t, server.URL, func(cfg *Config) {
+		cfg.Tenant.Get().MaxTenants = 2
+		cfg.Tenant.Get().IdleTimeout = time.Hour
+	})
+
+	require.NoError(t, router.PushMetrics(t.Context(), tenantMetrics("team-a")))
+	require.Error(t, router.PushMetrics(t.Context(), tenantMetrics("team-b")))
+
+	// The tenants used recently are kept.
+	router.evictIdle(time.Now())
+	require.Error(t, router.PushMetrics(t.Context(), tenantMetrics("team-b")))
+
+	router.evictIdle(time.Now().Add(2 * time.Hour))
+	router.mu.Lock()
+	assert.Len(t, router.tenants, 1, "only the default tenant is kept")
+	router.mu.Unlock()
+
+	require.NoError(t, router.PushMetrics(t.Context(), tenantMetrics("team-b")))
+	// The senders of an evicted tenant are created again.
+	router.evictIdle(time.Now().Add(2 * time.Hour))
+	require.NoError(t, router.PushMetrics(t.Context(), tenantMetrics("team-a")))
+
+	assert.Equal(t, map[string]int{"team-a": 2, "team-b": 1}, recorder.received())
+}
+
+func TestRouterTenantWAL(t *testing.T) {
+	recorder, server := newTenantRecorder(t, nil)
+	walDir := t.TempDir()
+	router := newTestRouter(t, server.URL, func(cfg *Config) {
+		cfg.WAL = configoptional.Some(WALConfig{Directory: walDir})
+	})
+
+	require.NoError(t, router.PushMetrics(t.Context(), tenantMetrics("team/a")))
+	assert.EventuallyWithT(t, func(t *assert.CollectT) {
+		assert.Equal(t, map[string]int{"team/a": 1}, recorder.received())
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// Each tenant has its own WAL, whose directory name can't escape the WAL directory.
+	_, err := os.Stat(filepath.Join(walDir, "tenant_team%2Fa", walDirectory))
+	require.NoError(t, err)
+
+	tenants, err := router.walTenants()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"team/a"}, tenants)
+}
@@ -13,7 +13,7 @@ import (
 	"time"
 
 	"github.com/gogo/protobuf/proto"
-	"github.com/prometheus/prometheus/prompb"
+	writev2 "github.com/prometheus/prometheus/prompb/io/prometheus/write/v2"
 	"github.com/tidwall/wal"
 	"go.opentelemetry.io/collector/exporter"
 	"go.opentelemetry.io/otel/attribute"
@@ -88,14 +88,22 @@ func newPRWWalTelemetry(set exporter.Settings) (prwWalTelemetry, error) {
 	}, nil
 }
 
-type prweWAL struct {
+// walRequest is a remote write request that is persisted to the WAL,
+// either a prompb.WriteRequest or a writev2.Request.
+type walRequest[T any] interface {
+	*T
+	proto.Message
+}
+
+type prweWAL[T any, PT walRequest[T]] struct {
 	wg        sync.WaitGroup // wg waits for the go routines to finish.
 	mu        sync.Mutex     // mu protects the fields below.
 	wal       *wal.Log
 	walConfig *WALConfig
+	walDir    string
 	walPath   string
 
-	exportSink func(ctx context.Context, reqL []*prompb.WriteRequest) error
+	exportSink func(ctx context.Context, reqL []PT) error
 
 	stopOnce  sync.Once
 	stopChan  chan struct{}
@@ -107,6 +115,12 @@ type prweWAL struct {
 }
 
 const (
+	// walDirectory is the directory, relative to the configured one, of the WAL of remote write 1.0 requests.
+	walDirectory = "prom_remotewrite"
+	// walDirectoryV2 is the directory of the WAL of remote write 2.0 requests. Requests of both versions are
+	// kept apart, so that changing the protobuf message doesn't leave requests in the WAL that can't be decoded.
+	walDirectoryV2 = "prom_remotewrite_v2"
+
 	defaultWALBufferSize         = 300
 	defaultWALTruncateFrequency  = 1 * time.Minute
 	defaultWALLagRecordFrequency = 15 * time.Second
@@ -140,7 +154,7 @@ func (wc *WALConfig) lagRecordInterval() time.Duration {
 	return defaultWALLagRecordFrequency
 }
 
-func newWAL(walConfig *WALConfig, set exporter.Settings, exportSink func(context.Context, []*prompb.WriteRequest) error) (*prweWAL, error) {
+func newWAL[T any, PT walRequest[T]](walConfig *WALConfig, set exporter.Settings, exportSink func(context.Context, []PT) error) (*prweWAL[T, PT], error) {
 	if walConfig == nil {
 		// There are cases for which the WAL can be disabled.
 		// TODO: Perhaps log that the WAL wasn't enabled.
@@ -152,9 +166,15 @@ func newWAL(walConfig *WALConfig, set exporter.Settings, exportSink func(context
 		return nil, err
 	}
 
-	return &prweWAL{
+	walDir := walDirectory
+	if _, ok := any(PT(nil)).(*writev2.Request); ok {
+		walDir = walDirectoryV2
+	}
+
+	return &prweWAL[T, PT]{
 		exportSink: exportSink,
 		walConfig:  walConfig,
+		walDir:     walDir,
 		stopChan:   make(chan struct{}),
 		// Buffered to avoid lost wake-ups when the writer signals before the
 		// reader starts waiting on notifications.
@@ -165,8 +185,8 @@ func newWAL(walConfig *WALConfig, set exporter.Settings, exportSink func(context
 	}, nil
 }
 
-func (wc *WALConfig) createWAL() (*wal.Log, string, error) {
-	walPath := filepath.Join(wc.Directory, "prom_remotewrite")
+func (wc *WALConfig) createWAL(dir string) (*wal.Log, string, error) {
+	walPath := filepath.Join(wc.Directory, dir)
 	log, err := wal.Open(walPath, &wal.Options{
 		SegmentCacheSize: wc.bufferSize(),
 		NoCopy:           true,
@@ -184,7 +204,7 @@ var (
 )
 
 // retrieveWALIndices queries the WriteAheadLog for its current first and last indices.
-func (prweWAL *prweWAL) retrieveWALIndices() (err error) {
+func (prweWAL *prweWAL[T, PT]) retrieveWALIndices() (err error) {
 	prweWAL.mu.Lock()
 	defer prweWAL.mu.Unlock()
 
@@ -193,7 +213,7 @@ func (prweWAL *prweWAL) retrieveWALIndices() (err error) {
 		return err
 	}
 
-	log, walPath, err := prweWAL.walConfig.createWAL()
+	log, walPath, err := prweWAL.walConfig.createWAL(prweWAL.walDir)
 	if err != nil {
 		return err
 	}
@@ -215,7 +235,7 @@ func (prweWAL *prweWAL) retrieveWALIndices() (err error) {
 	return nil
 }
 
-func (prweWAL *prweWAL) stop() error {
+func (prweWAL *prweWAL[T, PT]) stop() error {
 	err := errAlreadyClosed
 	prweWAL.stopOnce.Do(func() {
 		close(prweWAL.stopChan)
@@ -226,7 +246,7 @@ func (prweWAL *prweWAL) stop() error {
 }
 
 // run begins reading from the WAL until prwe.stopChan is closed.
-func (prweWAL *prweWAL) run(ctx context.Context) (err error) {
+func (prweWAL *prweWAL[T, PT]) run(ctx context.Context) (err error) {
 	var logger *zap.Logger
 	logger, err = loggerFromContext(ctx)
 	if err != nil {
@@ -280,7 +300,7 @@ func (prweWAL *prweWAL) run(ctx context.Context) (err error) {
 	return nil
 }
 
-func (prweWAL *prweWAL) recordLagLoop(ctx context.Context) {
+func (prweWAL *prweWAL[T, PT]) recordLagLoop(ctx context.Context) {
 	ticker := time.NewTicker(prweWAL.walConfig.lagRecordInterval())
 	defer ticker.Stop()
 
@@ -298,13 +318,13 @@ func (prweWAL *prweWAL) recordLagLoop(ctx context.Context) {
 	}
 }
 
-// continuallyPopWALThenExport reads a prompb.WriteRequest proto encoded blob from the WAL, and moves
+// continuallyPopWALThenExport reads a remote write request proto encoded blob from the WAL, and moves
 // the WAL's front index forward until either the read buffer period expires or the maximum
 // buffer size is exceeded. When either of the two conditions are matched, it then exports
 // the requests to the Remote-Write endpoint, and then truncates the head of the WAL to where
 // it last read from.
-func (prweWAL *prweWAL) continuallyPopWALThenExport(ctx context.Context, signalStart func()) (err error) {
-	var reqL []*prompb.WriteRequest
+func (prweWAL *prweWAL[T, PT]) continuallyPopWALThenExport(ctx context.Context, signalStart func()) (err error) {
+	var reqL []PT
 	defer func() {
 		// Keeping it within a closure to ensure that the later
 		// updated value of reqL is always flushed to disk.
@@ -336,7 +356,7 @@ func (prweWAL *prweWAL) continuallyPopWALThenExport(ctx context.Context, signalS
 		default:
 		}
 
-		var req *prompb.WriteRequest
+		var req PT
 		req, err = prweWAL.readPrompbFromWAL(ctx, prweWAL.rWALIndex.Load())
 		if err != nil {
 			return err
@@ -368,7 +388,7 @@ func (prweWAL *prweWAL) continuallyPopWALThenExport(ctx context.Context, signalS
 	}
 }
 
-func (prweWAL *prweWAL) closeWAL() error {
+func (prweWAL *prweWAL[T, PT]) closeWAL() error {
 	if prweWAL.wal != nil {
 		err := prweWAL.wal.Close()
 		prweWAL.wal = nil
@@ -377,7 +397,7 @@ func (prweWAL *prweWAL) closeWAL() error {
 	return nil
 }
 
-func (prweWAL *prweWAL) syncAndTruncateFront() error {
+func (prweWAL *prweWAL[T, PT]) syncAndTruncateFront() error {
 	prweWAL.mu.Lock()
 	defer prweWAL.mu.Unlock()
 
@@ -397,7 +417,7 @@ func (prweWAL *prweWAL) syncAndTruncateFront() error {
 	return nil
 }
 
-func (prweWAL *prweWAL) exportThenFrontTruncateWAL(ctx context.Context, reqL []*prompb.WriteRequest) error {
+func (prweWAL *prweWAL[T, PT]) exportThenFrontTruncateWAL(ctx context.Context, reqL []PT) error {
 	if len(reqL) == 0 {
 		return nil
 	}
@@ -418,7 +438,7 @@ func (prweWAL *prweWAL) exportThenFrontTruncateWAL(ctx context.Context, reqL []*
 // persistToWAL is the routine that'll be hooked into the exporter's receiving side and it'll
 // write them to the Write-Ahead-Log so that shutdowns won't lose data, and that the routine that
 // reads from the WAL can then process the previously serialized requests.
-func (prweWAL *prweWAL) persistToWAL(ctx context.Context, requests []*prompb.WriteRequest) error {
+func (prweWAL *prweWAL[T, PT]) persistToWAL(ctx context.Context, requests []PT) error {
 	prweWAL.mu.Lock()
 	defer prweWAL.mu.Unlock()
 
@@ -447,7 +467,7 @@ func (prweWAL *prweWAL) persistToWAL(ctx context.Context, requests []*prompb.Wri
 	return nil
 }
 
-func (prweWAL *prweWAL) readPrompbFromWAL(ctx context.Context, index uint64) (wreq *prompb.WriteRequest, err error) {
+func (prweWAL *prweWAL[T, PT]) readPrompbFromWAL(ctx context.Context, index uint64) (wreq PT, err error) {
 	var protoBlob []byte
 	for range 12 {
 		// Firstly check if we've been terminated, then exit if so.
@@ -473,7 +493,7 @@ func (prweWAL *prweWAL) readPrompbFromWAL(ctx context.Context, index uint64) (wr
 		prweWAL.telemetry.recordWALReadLatency(ctx, duration.Milliseconds())
 		prweWAL.telemetry.recordWALBytesRead(ctx, len(protoBlob))
 		if err == nil { // The read succeeded.
-			req := new(prompb.WriteRequest)
+			req := PT(new(T))
 			err = proto.Unmarshal(protoBlob, req)
 			if err != nil {
 				return nil, err
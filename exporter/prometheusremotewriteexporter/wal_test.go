@@ -19,6 +19,7 @@ import (
 	"github.com/golang/snappy"
 	remoteapi "github.com/prometheus/client_golang/exp/api/remote"
 	"github.com/prometheus/prometheus/prompb"
+	writev2 "github.com/prometheus/prometheus/prompb/io/prometheus/write/v2"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component"
@@ -178,6 +179,48 @@ func TestWAL_persist(t *testing.T) {
 	require.Equal(t, reqLFromWAL[1], reqL[1])
 }
 
+func TestWAL_persistV2(t *testing.T) {
+	// Unit tests that remote write 2.0 requests written to the WAL persist in their own directory.
+	config := &WALConfig{Directory: t.TempDir()}
+	set := exportertest.NewNopSettings(metadata.Type)
+	pwal, err := newWAL(config, set, func(context.Context, []*writev2.Request) error { return nil })
+	require.NotNil(t, pwal)
+	require.NoError(t, err)
+
+	reqL := []*writev2.Request{
+		{
+			Symbols: []string{"", "__name__", "test_metric", "Test help", "seconds"},
+			Timeseries: []writev2.TimeSeries{
+				{
+					LabelsRefs: []uint32{1, 2},
+					Samples:    []writev2.Sample{{Value: 1, Timestamp: 100, StartTimestamp: 50}},
+					Exemplars:  []writev2.Exemplar{{Value: 1, Timestamp: 90}},
+					Metadata: writev2.Metadata{
+						Type:    writev2.Metadata_METRIC_TYPE_COUNTER,
+						HelpRef: 3,
+						UnitRef: 4,
+					},
+				},
+			},
+		},
+	}
+
+	ctx := t.Context()
+	require.NoError(t, pwal.retrieveWALIndices())
+	t.Cleanup(func() {
+		assert.NoError(t, pwal.stop())
+	})
+	assert.Equal(t, filepath.Join(config.Directory, walDirectoryV2), pwal.walPath)
+
+	require.NoError(t, pwal.persistToWAL(ctx, reqL))
+
+	start, err := pwal.wal.FirstIndex()
+	require.NoError(t, err)
+	req, err := pwal.readPrompbFromWAL(ctx, start)
+	require.NoError(t, err)
+	assert.Equal(t, reqL[0], req)
+}
+
 func TestExportWithWALEnabled(t *testing.T) {
 	cfg := &Config{
 		WAL: configoptional.Some(WALConfig{
@@ -0,0 +1,95 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sqlexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/sqlexporter"
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"time"
+
+	"go.opentelemetry.io/collector/config/configopaque"
+	"go.opentelemetry.io/collector/config/configoptional"
+	"go.opentelemetry.io/collector/config/configretry"
+	"go.opentelemetry.io/collector/exporter/exporterhelper"
+)
+
+const (
+	driverPostgreSQL = "pgx"
+	driverSQLite     = "sqlite"
+)
+
+// Config defines configuration for the SQL exporter.
+type Config struct {
+	TimeoutSettings           exporterhelper.TimeoutConfig `mapstructure:",squash"`
+	configretry.BackOffConfig `mapstructure:"retry_on_failure"`
+	QueueSettings             configoptional.Optional[exporterhelper.QueueBatchConfig] `mapstructure:"sending_queue"`
+
+	// Driver is the database/sql driver name, either pgx (PostgreSQL) or sqlite.
+	Driver string `mapstructure:"driver"`
+	// DataSource is the driver specific data source name.
+	DataSource configopaque.String `mapstructure:"datasource"`
+	// Tables are the names of the tables the telemetry is written to.
+	Tables TablesConfig `mapstructure:"tables"`
+	// CreateSchema is whether tables are created and migrated at startup.
+	CreateSchema bool `mapstructure:"create_schema"`
+	// PartitionByDay is whether tables are partitioned by day. Only supported by PostgreSQL,
+	// and only applied when the tables are created.
+	PartitionByDay bool `mapstructure:"partition_by_day"`
+	// MaxOpenConns is the maximum number of open connections to the database, 0 means unlimited.
+	MaxOpenConns int `mapstructure:"max_open_conns"`
+	// ConnMaxLifetime is the maximum amount of time a connection may be reused, 0 means forever.
+	ConnMaxLifetime time.Duration `mapstructure:"conn_max_lifetime"`
+}
+
+// TablesConfig defines the table names.
+type TablesConfig struct {
+	// Logs is the table name for logs.
+	Logs string `mapstructure:"logs"`
+	// Traces is the table name for traces.
+	Traces string `mapstructure:"traces"`
+	// Metrics is the table name prefix for metrics, one table is used per metric type,
+	// e.g. otel_metrics_gauge.
+	Metrics string `mapstructure:"metrics"`
+	// Migrations is the table name recording the applied schema versions.
+	Migrations string `mapstructure:"migrations"`
+}
+
+// Table names are interpolated into SQL statements, so only plain identifiers are allowed.
+var identifierRegexp = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+
+func (cfg *Config) Validate() (err error) {
+	switch cfg.Driver {
+	case "":
+		err = errors.Join(err, errors.New("driver must be specified"))
+	case driverPostgreSQL, driverSQLite:
+	default:
+		err = errors.Join(err, fmt.Errorf("unsupported driver %q, must be one of %q or %q", cfg.Driver, driverPostgreSQL, driverSQLite))
+	}
+	if cfg.DataSource == "" {
+		err = errors.Join(err, errors.New("datasource must be specified"))
+	}
+	if cfg.PartitionByDay && cfg.Driver != driverPostgreSQL {
+		err = errors.Join(err, fmt.Errorf("partition_by_day is only supported by the %q driver", driverPostgreSQL))
+	}
+	if cfg.MaxOpenConns < 0 {
+		err = errors.Join(err, errors.New("max_open_conns must be greater than or equal to 0"))
+	}
+	if cfg.ConnMaxLifetime < 0 {
+		err = errors.Join(err, errors.New("conn_max_lifetime must be greater than or equal to 0"))
+	}
+
+	for name, table := range map[string]string{
+		"logs":       cfg.Tables.Logs,
+		"traces":     cfg.Tables.Traces,
+		"metrics":    cfg.Tables.Metrics,
+		"migrations": cfg.Tables.Migrations,
+	} {
+		if !identifierRegexp.MatchString(table) {
+			err = errors.Join(err, fmt.Errorf("%s table name %q must start with a letter or underscore and contain only alphanumeric characters and underscores", name, table))
+		}
+	}
+
+	return err
+}
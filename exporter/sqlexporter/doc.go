@@ -0,0 +1,7 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:generate make mdatagen
+
+// Package sqlexporter exports trace, metric and log data to a PostgreSQL or SQLite database.
+package sqlexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/sqlexporter"
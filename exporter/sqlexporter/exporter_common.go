@@ -0,0 +1,192 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sqlexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/sqlexporter"
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	_ "github.com/jackc/pgx/v5/stdlib" // PostgreSQL driver
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer/consumererror"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	conventions "go.opentelemetry.io/otel/semconv/v1.38.0"
+	"go.uber.org/zap"
+	_ "modernc.org/sqlite" // SQLite driver
+)
+
+// sqlExporter holds the database connection and the managed tables shared by
+// the exporters of each signal.
+type sqlExporter struct {
+	cfg     *Config
+	logger  *zap.Logger
+	dialect *dialect
+	tables  []*table
+
+	db         *sql.DB
+	partitions *partitionManager
+}
+
+func newSQLExporter(logger *zap.Logger, cfg *Config, tables ...*table) *sqlExporter {
+	return &sqlExporter{
+		cfg:        cfg,
+		logger:     logger,
+		dialect:    dialectForDriver(cfg.Driver),
+		tables:     tables,
+		partitions: newPartitionManager(),
+	}
+}
+
+func (e *sqlExporter) start(ctx context.Context, _ component.Host) error {
+	db, err := sql.Open(e.cfg.Driver, string(e.cfg.DataSource))
+	if err != nil {
+		return err
+	}
+	db.SetMaxOpenConns(e.cfg.MaxOpenConns)
+	db.SetConnMaxLifetime(e.cfg.ConnMaxLifetime)
+	if err = db.PingContext(ctx); err != nil {
+		return errors.Join(fmt.Errorf("connect to database: %w", err), db.Close())
+	}
+	e.db = db
+
+	if e.cfg.CreateSchema {
+		m := &migrator{
+			db:              db,
+			dialect:         e.dialect,
+			migrationsTable: e.cfg.Tables.Migrations,
+			partitioned:     e.cfg.PartitionByDay,
+			logger:          e.logger,
+		}
+		if err := m.migrate(ctx, e.tables...); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e *sqlExporter) shutdown(context.Context) error {
+	if e.db != nil {
+		return e.db.Close()
+	}
+	return nil
+}
+
+// tableRows are the rows to insert into a table, in the order of its columns.
+type tableRows struct {
+	table *table
+	rows  [][]any
+}
+
+// insert writes the rows of all tables within a single transaction.
+func (e *sqlExporter) insert(ctx context.Context, batches ...tableRows) error {
+	start := time.Now()
+	var count int
+	for _, b := range batches {
+		if len(b.rows) == 0 || !e.cfg.PartitionByDay {
+			continue
+		}
+		if err := e.partitions.ensurePartitions(ctx, e.db, b.table, b.rows); err != nil {
+			return err
+		}
+	}
+
+	err := doWithTx(ctx, e.db, func(tx *sql.Tx) error {
+		for _, b := range batches {
+			if len(b.rows) == 0 {
+				continue
+			}
+			if err := e.insertRows(ctx, tx, b); err != nil {
+				return err
+			}
+			count += len(b.rows)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	e.logger.Debug("insert rows",
+		zap.Int("records", count),
+		zap.String("cost", time.Since(start).String()))
+	return nil
+}
+
+func (e *sqlExporter) insertRows(ctx context.Context, tx *sql.Tx, b tableRows) error {
+	statement, err := tx.PrepareContext(ctx, e.dialect.insertSQL(b.table))
+	if err != nil {
+		return fmt.Errorf("prepare insert into %q: %w", b.table.name, err)
+	}
+	defer func() {
+		_ = statement.Close()
+	}()
+
+	for _, row := range b.rows {
+		if _, err := statement.ExecContext(ctx, row...); err != nil {
+			return fmt.Errorf("insert into %q: %w", b.table.name, err)
+		}
+	}
+	return nil
+}
+
+func doWithTx(ctx context.Context, db *sql.DB, fn func(tx *sql.Tx) error) error {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin transaction: %w", err)
+	}
+	if err := fn(tx); err != nil {
+		return errors.Join(err, tx.Rollback())
+	}
+	return tx.Commit()
+}
+
+func serviceName(resourceAttributes pcommon.Map) string {
+	if v, ok := resourceAttributes.Get(string(conventions.ServiceNameKey)); ok {
+		return v.AsString()
+	}
+	return ""
+}
+
+// timestampValue returns the time of a timestamp in UTC, or nil if it is unset.
+func timestampValue(ts pcommon.Timestamp) any {
+	if ts == 0 {
+		return nil
+	}
+	return ts.AsTime().UTC()
+}
+
+// timeColumnValue returns the time of the first set timestamp in UTC, or the current time if none
+// is set, so that rows always belong to a partition of the tables partitioned by day.
+func timeColumnValue(timestamps ...pcommon.Timestamp) time.Time {
+	for _, ts := range timestamps {
+		if ts != 0 {
+			return ts.AsTime().UTC()
+		}
+	}
+	return time.Now().UTC()
+}
+
+func toJSON(v any) (string, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return "", consumererror.NewPermanent(fmt.Errorf("encode JSON: %w", err))
+	}
+	return string(b), nil
+}
+
+// toJSONArray encodes a slice as a JSON array, also if it is nil.
+func toJSONArray[T any](s []T) (string, error) {
+	if s == nil {
+		s = []T{}
+	}
+	return toJSON(s)
+}
+
+func attributesToJSON(m pcommon.Map) (string, error) {
+	return toJSON(m.AsRaw())
+}
@@ -0,0 +1,71 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sqlexporter
+
+import (
+	"database/sql"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/config/configopaque"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+// newTestConfig returns a configuration writing to a SQLite database in a temporary directory.
+func newTestConfig(t *testing.T) *Config {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Driver = driverSQLite
+	cfg.DataSource = configopaque.String("file:" + filepath.Join(t.TempDir(), "otel.db") + "?_pragma=busy_timeout(5000)")
+	require.NoError(t, cfg.Validate())
+	return cfg
+}
+
+func startExporter(t *testing.T, e *sqlExporter) {
+	require.NoError(t, e.start(t.Context(), componenttest.NewNopHost()))
+	t.Cleanup(func() {
+		require.NoError(t, e.shutdown(t.Context()))
+	})
+}
+
+// queryRows returns the rows of a query as maps from column name to value.
+func queryRows(t *testing.T, db *sql.DB, query string, args ...any) []map[string]any {
+	rows, err := db.QueryContext(t.Context(), query, args...)
+	require.NoError(t, err)
+	defer rows.Close()
+
+	columns, err := rows.Columns()
+	require.NoError(t, err)
+
+	var result []map[string]any
+	for rows.Next() {
+		values := make([]any, len(columns))
+		pointers := make([]any, len(columns))
+		for i := range values {
+			pointers[i] = &values[i]
+		}
+		require.NoError(t, rows.Scan(pointers...))
+
+		row := make(map[string]any, len(columns))
+		for i, c := range columns {
+			row[c] = values[i]
+		}
+		result = append(result, row)
+	}
+	require.NoError(t, rows.Err())
+	return result
+}
+
+func TestTimeColumnValue(t *testing.T) {
+	ts := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	assert.Equal(t, ts, timeColumnValue(pcommon.NewTimestampFromTime(ts), pcommon.NewTimestampFromTime(ts.Add(time.Second))))
+	assert.Equal(t, ts, timeColumnValue(0, pcommon.NewTimestampFromTime(ts)))
+
+	// Without any timestamp, the current time is used rather than the Unix epoch.
+	before := time.Now().UTC()
+	assert.False(t, timeColumnValue(0, 0).Before(before))
+}
@@ -0,0 +1,111 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sqlexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/sqlexporter"
+
+import (
+	"context"
+
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/traceutil"
+)
+
+func newLogsTable(name string) *table {
+	return &table{
+		name: name,
+		columns: []column{
+			{name: "timestamp", typ: columnTimestamp},
+			{name: "observed_timestamp", typ: columnTimestamp},
+			{name: "trace_id", typ: columnText},
+			{name: "span_id", typ: columnText},
+			{name: "trace_flags", typ: columnInt},
+			{name: "severity_text", typ: columnText},
+			{name: "severity_number", typ: columnInt},
+			{name: "service_name", typ: columnText},
+			{name: "body", typ: columnText},
+			{name: "resource_schema_url", typ: columnText},
+			{name: "resource_attributes", typ: columnJSON},
+			{name: "scope_schema_url", typ: columnText},
+			{name: "scope_name", typ: columnText},
+			{name: "scope_version", typ: columnText},
+			{name: "scope_attributes", typ: columnJSON},
+			{name: "log_attributes", typ: columnJSON},
+			{name: "event_name", typ: columnText},
+		},
+		timeColumn: "timestamp",
+		indexes: [][]string{
+			{"service_name", "timestamp"},
+			{"trace_id"},
+		},
+	}
+}
+
+type logsExporter struct {
+	*sqlExporter
+	table *table
+}
+
+func newLogsExporter(logger *zap.Logger, cfg *Config) *logsExporter {
+	t := newLogsTable(cfg.Tables.Logs)
+	return &logsExporter{
+		sqlExporter: newSQLExporter(logger, cfg, t),
+		table:       t,
+	}
+}
+
+func (e *logsExporter) pushLogsData(ctx context.Context, ld plog.Logs) error {
+	rows := make([][]any, 0, ld.LogRecordCount())
+
+	rls := ld.ResourceLogs()
+	for i := 0; i < rls.Len(); i++ {
+		rl := rls.At(i)
+		resourceAttributes, err := attributesToJSON(rl.Resource().Attributes())
+		if err != nil {
+			return err
+		}
+		service := serviceName(rl.Resource().Attributes())
+
+		sls := rl.ScopeLogs()
+		for j := 0; j < sls.Len(); j++ {
+			sl := sls.At(j)
+			scope := sl.Scope()
+			scopeAttributes, err := attributesToJSON(scope.Attributes())
+			if err != nil {
+				return err
+			}
+
+			lrs := sl.LogRecords()
+			for k := 0; k < lrs.Len(); k++ {
+				lr := lrs.At(k)
+				logAttributes, err := attributesToJSON(lr.Attributes())
+				if err != nil {
+					return err
+				}
+
+				rows = append(rows, []any{
+					timeColumnValue(lr.Timestamp(), lr.ObservedTimestamp()),
+					timestampValue(lr.ObservedTimestamp()),
+					traceutil.TraceIDToHexOrEmptyString(lr.TraceID()),
+					traceutil.SpanIDToHexOrEmptyString(lr.SpanID()),
+					int64(lr.Flags()),
+					lr.SeverityText(),
+					int64(lr.SeverityNumber()),
+					service,
+					lr.Body().AsString(),
+					rl.SchemaUrl(),
+					resourceAttributes,
+					sl.SchemaUrl(),
+					scope.Name(),
+					scope.Version(),
+					scopeAttributes,
+					logAttributes,
+					lr.EventName(),
+				})
+			}
+		}
+	}
+
+	return e.insert(ctx, tableRows{table: e.table, rows: rows})
+}
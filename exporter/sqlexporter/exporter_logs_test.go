@@ -0,0 +1,97 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sqlexporter
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.uber.org/zap"
+)
+
+func TestPushLogsData(t *testing.T) {
+	exporter := newLogsExporter(zap.NewNop(), newTestConfig(t))
+	startExporter(t, exporter.sqlExporter)
+
+	ts := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	logs := plog.NewLogs()
+	rl := logs.ResourceLogs().AppendEmpty()
+	rl.Resource().Attributes().PutStr("service.name", "checkout")
+	sl := rl.ScopeLogs().AppendEmpty()
+	sl.Scope().SetName("io.opentelemetry.test")
+	sl.Scope().SetVersion("1.0.0")
+	lr := sl.LogRecords().AppendEmpty()
+	lr.SetTimestamp(pcommon.NewTimestampFromTime(ts))
+	lr.SetObservedTimestamp(pcommon.NewTimestampFromTime(ts.Add(time.Second)))
+	lr.SetTraceID([16]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16})
+	lr.SetSpanID([8]byte{1, 2, 3, 4, 5, 6, 7, 8})
+	lr.SetSeverityNumber(plog.SeverityNumberError)
+	lr.SetSeverityText("ERROR")
+	lr.Body().SetStr("payment failed")
+	lr.Attributes().PutInt("attempt", 3)
+	lr.SetEventName("payment.failure")
+	// Records without a timestamp use the observed timestamp.
+	lr2 := sl.LogRecords().AppendEmpty()
+	lr2.SetObservedTimestamp(pcommon.NewTimestampFromTime(ts))
+	lr2.Body().SetStr("retrying")
+
+	require.NoError(t, exporter.pushLogsData(t.Context(), logs))
+
+	rows := queryRows(t, exporter.db, `SELECT * FROM "otel_logs" ORDER BY "body"`)
+	require.Len(t, rows, 2)
+
+	row := rows[0]
+	assert.Equal(t, "payment failed", row["body"])
+	assert.Equal(t, "checkout", row["service_name"])
+	assert.Equal(t, "0102030405060708090a0b0c0d0e0f10", row["trace_id"])
+	assert.Equal(t, "0102030405060708", row["span_id"])
+	assert.Equal(t, "ERROR", row["severity_text"])
+	assert.Equal(t, int64(plog.SeverityNumberError), row["severity_number"])
+	assert.Equal(t, "io.opentelemetry.test", row["scope_name"])
+	assert.Equal(t, "1.0.0", row["scope_version"])
+	assert.JSONEq(t, `{"service.name":"checkout"}`, row["resource_attributes"].(string))
+	assert.JSONEq(t, `{"attempt":3}`, row["log_attributes"].(string))
+	assert.Equal(t, "payment.failure", row["event_name"])
+	assert.True(t, ts.Equal(row["timestamp"].(time.Time)))
+	assert.True(t, ts.Add(time.Second).Equal(row["observed_timestamp"].(time.Time)))
+
+	row = rows[1]
+	assert.Equal(t, "retrying", row["body"])
+	assert.True(t, ts.Equal(row["timestamp"].(time.Time)))
+	assert.Empty(t, row["trace_id"])
+}
+
+func TestPushLogsDataWithoutTimestamps(t *testing.T) {
+	exporter := newLogsExporter(zap.NewNop(), newTestConfig(t))
+	startExporter(t, exporter.sqlExporter)
+
+	logs := plog.NewLogs()
+	lr := logs.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords().AppendEmpty()
+	lr.Body().SetStr("no timestamp")
+
+	before := time.Now().UTC()
+	require.NoError(t, exporter.pushLogsData(t.Context(), logs))
+
+	rows := queryRows(t, exporter.db, `SELECT * FROM "otel_logs"`)
+	require.Len(t, rows, 1)
+	// Records without any timestamp use the ingestion time.
+	assert.False(t, rows[0]["timestamp"].(time.Time).Before(before.Truncate(time.Second)))
+	assert.Nil(t, rows[0]["observed_timestamp"])
+}
+
+func TestPushLogsDataWithoutSchema(t *testing.T) {
+	cfg := newTestConfig(t)
+	cfg.CreateSchema = false
+	exporter := newLogsExporter(zap.NewNop(), cfg)
+	startExporter(t, exporter.sqlExporter)
+
+	logs := plog.NewLogs()
+	logs.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords().AppendEmpty().Body().SetStr("hello")
+
+	assert.ErrorContains(t, exporter.pushLogsData(t.Context(), logs), `prepare insert into "otel_logs"`)
+}
@@ -0,0 +1,369 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sqlexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/sqlexporter"
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/traceutil"
+)
+
+func newMetricsTable(name string, columns ...column) *table {
+	return &table{
+		name: name,
+		columns: append([]column{
+			{name: "resource_schema_url", typ: columnText},
+			{name: "resource_attributes", typ: columnJSON},
+			{name: "scope_schema_url", typ: columnText},
+			{name: "scope_name", typ: columnText},
+			{name: "scope_version", typ: columnText},
+			{name: "scope_attributes", typ: columnJSON},
+			{name: "service_name", typ: columnText},
+			{name: "metric_name", typ: columnText},
+			{name: "metric_description", typ: columnText},
+			{name: "metric_unit", typ: columnText},
+			{name: "attributes", typ: columnJSON},
+			{name: "start_timestamp", typ: columnTimestamp},
+			{name: "timestamp", typ: columnTimestamp},
+			{name: "flags", typ: columnInt},
+			{name: "exemplars", typ: columnJSON},
+		}, columns...),
+		timeColumn: "timestamp",
+		indexes: [][]string{
+			{"metric_name", "timestamp"},
+			{"service_name", "timestamp"},
+		},
+	}
+}
+
+// metricsTables are the tables metrics are written to, one per metric type.
+type metricsTables struct {
+	gauge                *table
+	sum                  *table
+	histogram            *table
+	exponentialHistogram *table
+	summary              *table
+}
+
+func newMetricsTables(prefix string) metricsTables {
+	return metricsTables{
+		gauge: newMetricsTable(prefix+"_gauge",
+			column{name: "value", typ: columnDouble},
+		),
+		sum: newMetricsTable(prefix+"_sum",
+			column{name: "value", typ: columnDouble},
+			column{name: "aggregation_temporality", typ: columnInt},
+			column{name: "is_monotonic", typ: columnBool},
+		),
+		histogram: newMetricsTable(prefix+"_histogram",
+			column{name: "count", typ: columnInt},
+			column{name: "sum", typ: columnDouble},
+			column{name: "bucket_counts", typ: columnJSON},
+			column{name: "explicit_bounds", typ: columnJSON},
+			column{name: "min", typ: columnDouble},
+			column{name: "max", typ: columnDouble},
+			column{name: "aggregation_temporality", typ: columnInt},
+		),
+		exponentialHistogram: newMetricsTable(prefix+"_exponential_histogram",
+			column{name: "count", typ: columnInt},
+			column{name: "sum", typ: columnDouble},
+			column{name: "scale", typ: columnInt},
+			column{name: "zero_count", typ: columnInt},
+			column{name: "zero_threshold", typ: columnDouble},
+			column{name: "positive_offset", typ: columnInt},
+			column{name: "positive_bucket_counts", typ: columnJSON},
+			column{name: "negative_offset", typ: columnInt},
+			column{name: "negative_bucket_counts", typ: columnJSON},
+			column{name: "min", typ: columnDouble},
+			column{name: "max", typ: columnDouble},
+			column{name: "aggregation_temporality", typ: columnInt},
+		),
+		summary: newMetricsTable(prefix+"_summary",
+			column{name: "count", typ: columnInt},
+			column{name: "sum", typ: columnDouble},
+			column{name: "quantile_values", typ: columnJSON},
+		),
+	}
+}
+
+func (t metricsTables) all() []*table {
+	return []*table{t.gauge, t.sum, t.histogram, t.exponentialHistogram, t.summary}
+}
+
+type exemplar struct {
+	Timestamp          time.Time      `json:"timestamp"`
+	Value              float64        `json:"value"`
+	TraceID            string         `json:"trace_id"`
+	SpanID             string         `json:"span_id"`
+	FilteredAttributes map[string]any `json:"filtered_attributes"`
+}
+
+type quantileValue struct {
+	Quantile float64 `json:"quantile"`
+	Value    float64 `json:"value"`
+}
+
+type metricsExporter struct {
+	*sqlExporter
+	tables metricsTables
+}
+
+func newMetricsExporter(logger *zap.Logger, cfg *Config) *metricsExporter {
+	tables := newMetricsTables(cfg.Tables.Metrics)
+	return &metricsExporter{
+		sqlExporter: newSQLExporter(logger, cfg, tables.all()...),
+		tables:      tables,
+	}
+}
+
+// metricRows accumulates the rows of a push per metric type.
+type metricRows struct {
+	gauge                [][]any
+	sum                  [][]any
+	histogram            [][]any
+	exponentialHistogram [][]any
+	summary              [][]any
+}
+
+// metricContext holds the values of the columns shared by all data points of a metric.
+type metricContext struct {
+	resourceSchemaURL  string
+	resourceAttributes string
+	scopeSchemaURL     string
+	scopeName          string
+	scopeVersion       string
+	scopeAttributes    string
+	serviceName        string
+	metric             pmetric.Metric
+}
+
+// row returns the row of a data point, the common metric columns followed by the given values.
+func (mc *metricContext) row(attributes pcommon.Map, start, ts pcommon.Timestamp, flags pmetric.DataPointFlags, exemplars pmetric.ExemplarSlice, values ...any) ([]any, error) {
+	encodedAttributes, err := attributesToJSON(attributes)
+	if err != nil {
+		return nil, err
+	}
+	encodedExemplars, err := exemplarsToJSON(exemplars)
+	if err != nil {
+		return nil, err
+	}
+	row := []any{
+		mc.resourceSchemaURL,
+		mc.resourceAttributes,
+		mc.scopeSchemaURL,
+		mc.scopeName,
+		mc.scopeVersion,
+		mc.scopeAttributes,
+		mc.serviceName,
+		mc.metric.Name(),
+		mc.metric.Description(),
+		mc.metric.Unit(),
+		encodedAttributes,
+		timestampValue(start),
+		timeColumnValue(ts, start),
+		int64(flags),
+		encodedExemplars,
+	}
+	return append(row, values...), nil
+}
+
+func (e *metricsExporter) pushMetricsData(ctx context.Context, md pmetric.Metrics) error {
+	var rows metricRows
+
+	rms := md.ResourceMetrics()
+	for i := 0; i < rms.Len(); i++ {
+		rm := rms.At(i)
+		resourceAttributes, err := attributesToJSON(rm.Resource().Attributes())
+		if err != nil {
+			return err
+		}
+
+		sms := rm.ScopeMetrics()
+		for j := 0; j < sms.Len(); j++ {
+			sm := sms.At(j)
+			scope := sm.Scope()
+			scopeAttributes, err := attributesToJSON(scope.Attributes())
+			if err != nil {
+				return err
+			}
+
+			ms := sm.Metrics()
+			for k := 0; k < ms.Len(); k++ {
+				mc := &metricContext{
+					resourceSchemaURL:  rm.SchemaUrl(),
+					resourceAttributes: resourceAttributes,
+					scopeSchemaURL:     sm.SchemaUrl(),
+					scopeName:          scope.Name(),
+					scopeVersion:       scope.Version(),
+					scopeAttributes:    scopeAttributes,
+					serviceName:        serviceName(rm.Resource().Attributes()),
+					metric:             ms.At(k),
+				}
+				if err := rows.append(mc); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return e.insert(ctx,
+		tableRows{table: e.tables.gauge, rows: rows.gauge},
+		tableRows{table: e.tables.sum, rows: rows.sum},
+		tableRows{table: e.tables.histogram, rows: rows.histogram},
+		tableRows{table: e.tables.exponentialHistogram, rows: rows.exponentialHistogram},
+		tableRows{table: e.tables.summary, rows: rows.summary},
+	)
+}
+
+func (r *metricRows) append(mc *metricContext) error {
+	m := mc.metric
+	switch m.Type() {
+	case pmetric.MetricTypeGauge:
+		dps := m.Gauge().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			dp := dps.At(i)
+			row, err := mc.row(dp.Attributes(), dp.StartTimestamp(), dp.Timestamp(), dp.Flags(), dp.Exemplars(),
+				numberValue(dp))
+			if err != nil {
+				return err
+			}
+			r.gauge = append(r.gauge, row)
+		}
+	case pmetric.MetricTypeSum:
+		dps := m.Sum().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			dp := dps.At(i)
+			row, err := mc.row(dp.Attributes(), dp.StartTimestamp(), dp.Timestamp(), dp.Flags(), dp.Exemplars(),
+				numberValue(dp),
+				int64(m.Sum().AggregationTemporality()),
+				m.Sum().IsMonotonic())
+			if err != nil {
+				return err
+			}
+			r.sum = append(r.sum, row)
+		}
+	case pmetric.MetricTypeHistogram:
+		dps := m.Histogram().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			dp := dps.At(i)
+			bucketCounts, err := toJSONArray(dp.BucketCounts().AsRaw())
+			if err != nil {
+				return err
+			}
+			explicitBounds, err := toJSONArray(dp.ExplicitBounds().AsRaw())
+			if err != nil {
+				return err
+			}
+			row, err := mc.row(dp.Attributes(), dp.StartTimestamp(), dp.Timestamp(), dp.Flags(), dp.Exemplars(),
+				int64(dp.Count()),
+				dp.Sum(),
+				bucketCounts,
+				explicitBounds,
+				optionalDouble(dp.HasMin(), dp.Min()),
+				optionalDouble(dp.HasMax(), dp.Max()),
+				int64(m.Histogram().AggregationTemporality()))
+			if err != nil {
+				return err
+			}
+			r.histogram = append(r.histogram, row)
+		}
+	case pmetric.MetricTypeExponentialHistogram:
+		dps := m.ExponentialHistogram().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			dp := dps.At(i)
+			positiveBucketCounts, err := toJSONArray(dp.Positive().BucketCounts().AsRaw())
+			if err != nil {
+				return err
+			}
+			negativeBucketCounts, err := toJSONArray(dp.Negative().BucketCounts().AsRaw())
+			if err != nil {
+				return err
+			}
+			row, err := mc.row(dp.Attributes(), dp.StartTimestamp(), dp.Timestamp(), dp.Flags(), dp.Exemplars(),
+				int64(dp.Count()),
+				dp.Sum(),
+				int64(dp.Scale()),
+				int64(dp.ZeroCount()),
+				dp.ZeroThreshold(),
+				int64(dp.Positive().Offset()),
+				positiveBucketCounts,
+				int64(dp.Negative().Offset()),
+				negativeBucketCounts,
+				optionalDouble(dp.HasMin(), dp.Min()),
+				optionalDouble(dp.HasMax(), dp.Max()),
+				int64(m.ExponentialHistogram().AggregationTemporality()))
+			if err != nil {
+				return err
+			}
+			r.exponentialHistogram = append(r.exponentialHistogram, row)
+		}
+	case pmetric.MetricTypeSummary:
+		dps := m.Summary().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			dp := dps.At(i)
+			quantiles := make([]quantileValue, 0, dp.QuantileValues().Len())
+			for j := 0; j < dp.QuantileValues().Len(); j++ {
+				q := dp.QuantileValues().At(j)
+				quantiles = append(quantiles, quantileValue{Quantile: q.Quantile(), Value: q.Value()})
+			}
+			quantileValues, err := toJSON(quantiles)
+			if err != nil {
+				return err
+			}
+			// Summaries have no exemplars.
+			row, err := mc.row(dp.Attributes(), dp.StartTimestamp(), dp.Timestamp(), dp.Flags(), pmetric.NewExemplarSlice(),
+				int64(dp.Count()),
+				dp.Sum(),
+				quantileValues)
+			if err != nil {
+				return err
+			}
+			r.summary = append(r.summary, row)
+		}
+	case pmetric.MetricTypeEmpty:
+		return nil
+	default:
+		return fmt.Errorf("unsupported metric type %v", m.Type())
+	}
+	return nil
+}
+
+func numberValue(dp pmetric.NumberDataPoint) float64 {
+	if dp.ValueType() == pmetric.NumberDataPointValueTypeInt {
+		return float64(dp.IntValue())
+	}
+	return dp.DoubleValue()
+}
+
+func optionalDouble(ok bool, v float64) any {
+	if !ok {
+		return nil
+	}
+	return v
+}
+
+func exemplarsToJSON(exemplars pmetric.ExemplarSlice) (string, error) {
+	encoded := make([]exemplar, 0, exemplars.Len())
+	for i := 0; i < exemplars.Len(); i++ {
+		e := exemplars.At(i)
+		value := e.DoubleValue()
+		if e.ValueType() == pmetric.ExemplarValueTypeInt {
+			value = float64(e.IntValue())
+		}
+		encoded = append(encoded, exemplar{
+			Timestamp:          e.Timestamp().AsTime().UTC(),
+			Value:              value,
+			TraceID:            traceutil.TraceIDToHexOrEmptyString(e.TraceID()),
+			SpanID:             traceutil.SpanIDToHexOrEmptyString(e.SpanID()),
+			FilteredAttributes: e.FilteredAttributes().AsRaw(),
+		})
+	}
+	return toJSON(encoded)
+}
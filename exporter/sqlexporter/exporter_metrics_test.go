@@ -0,0 +1,128 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sqlexporter
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.uber.org/zap"
+)
+
+func TestPushMetricsData(t *testing.T) {
+	exporter := newMetricsExporter(zap.NewNop(), newTestConfig(t))
+	startExporter(t, exporter.sqlExporter)
+
+	start := pcommon.NewTimestampFromTime(time.Date(2026, 1, 2, 3, 0, 0, 0, time.UTC))
+	ts := pcommon.NewTimestampFromTime(time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC))
+
+	metrics := pmetric.NewMetrics()
+	rm := metrics.ResourceMetrics().AppendEmpty()
+	rm.Resource().Attributes().PutStr("service.name", "checkout")
+	sm := rm.ScopeMetrics().AppendEmpty()
+	sm.Scope().SetName("io.opentelemetry.test")
+
+	gauge := sm.Metrics().AppendEmpty()
+	gauge.SetName("queue.size")
+	gauge.SetUnit("{item}")
+	gdp := gauge.SetEmptyGauge().DataPoints().AppendEmpty()
+	gdp.SetTimestamp(ts)
+	gdp.SetIntValue(42)
+	gdp.Attributes().PutStr("queue", "orders")
+	ex := gdp.Exemplars().AppendEmpty()
+	ex.SetTimestamp(ts)
+	ex.SetDoubleValue(41.5)
+	ex.SetTraceID([16]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16})
+
+	sum := sm.Metrics().AppendEmpty()
+	sum.SetName("requests")
+	sum.SetEmptySum().SetIsMonotonic(true)
+	sum.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	sdp := sum.Sum().DataPoints().AppendEmpty()
+	sdp.SetStartTimestamp(start)
+	sdp.SetTimestamp(ts)
+	sdp.SetDoubleValue(12.5)
+
+	histogram := sm.Metrics().AppendEmpty()
+	histogram.SetName("latency")
+	histogram.SetEmptyHistogram().SetAggregationTemporality(pmetric.AggregationTemporalityDelta)
+	hdp := histogram.Histogram().DataPoints().AppendEmpty()
+	hdp.SetStartTimestamp(start)
+	hdp.SetTimestamp(ts)
+	hdp.SetCount(6)
+	hdp.SetSum(21)
+	hdp.SetMin(0.5)
+	hdp.BucketCounts().FromRaw([]uint64{1, 2, 3})
+	hdp.ExplicitBounds().FromRaw([]float64{1, 5})
+
+	expHistogram := sm.Metrics().AppendEmpty()
+	expHistogram.SetName("latency.exp")
+	expHistogram.SetEmptyExponentialHistogram().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	edp := expHistogram.ExponentialHistogram().DataPoints().AppendEmpty()
+	edp.SetTimestamp(ts)
+	edp.SetCount(5)
+	edp.SetSum(10)
+	edp.SetScale(2)
+	edp.SetZeroCount(1)
+	edp.SetZeroThreshold(0.001)
+	edp.Positive().SetOffset(-1)
+	edp.Positive().BucketCounts().FromRaw([]uint64{1, 3})
+
+	summary := sm.Metrics().AppendEmpty()
+	summary.SetName("response.size")
+	sudp := summary.SetEmptySummary().DataPoints().AppendEmpty()
+	sudp.SetTimestamp(ts)
+	sudp.SetCount(3)
+	sudp.SetSum(300)
+	q := sudp.QuantileValues().AppendEmpty()
+	q.SetQuantile(0.5)
+	q.SetValue(100)
+
+	require.NoError(t, exporter.pushMetricsData(t.Context(), metrics))
+
+	rows := queryRows(t, exporter.db, `SELECT * FROM "otel_metrics_gauge"`)
+	require.Len(t, rows, 1)
+	assert.Equal(t, "queue.size", rows[0]["metric_name"])
+	assert.Equal(t, "{item}", rows[0]["metric_unit"])
+	assert.Equal(t, "checkout", rows[0]["service_name"])
+	assert.InDelta(t, 42.0, rows[0]["value"], 0)
+	assert.Nil(t, rows[0]["start_timestamp"])
+	assert.True(t, ts.AsTime().Equal(rows[0]["timestamp"].(time.Time)))
+	assert.JSONEq(t, `{"queue":"orders"}`, rows[0]["attributes"].(string))
+	assert.JSONEq(t, `[{"timestamp":"2026-01-02T03:04:05Z","value":41.5,"trace_id":"0102030405060708090a0b0c0d0e0f10","span_id":"","filtered_attributes":{}}]`, rows[0]["exemplars"].(string))
+
+	rows = queryRows(t, exporter.db, `SELECT * FROM "otel_metrics_sum"`)
+	require.Len(t, rows, 1)
+	assert.InDelta(t, 12.5, rows[0]["value"], 0)
+	assert.Equal(t, int64(pmetric.AggregationTemporalityCumulative), rows[0]["aggregation_temporality"])
+	assert.Equal(t, int64(1), rows[0]["is_monotonic"])
+	assert.True(t, start.AsTime().Equal(rows[0]["start_timestamp"].(time.Time)))
+
+	rows = queryRows(t, exporter.db, `SELECT * FROM "otel_metrics_histogram"`)
+	require.Len(t, rows, 1)
+	assert.Equal(t, int64(6), rows[0]["count"])
+	assert.InDelta(t, 21.0, rows[0]["sum"], 0)
+	assert.JSONEq(t, `[1,2,3]`, rows[0]["bucket_counts"].(string))
+	assert.JSONEq(t, `[1,5]`, rows[0]["explicit_bounds"].(string))
+	assert.InDelta(t, 0.5, rows[0]["min"], 0)
+	assert.Nil(t, rows[0]["max"])
+	assert.Equal(t, int64(pmetric.AggregationTemporalityDelta), rows[0]["aggregation_temporality"])
+
+	rows = queryRows(t, exporter.db, `SELECT * FROM "otel_metrics_exponential_histogram"`)
+	require.Len(t, rows, 1)
+	assert.Equal(t, int64(2), rows[0]["scale"])
+	assert.Equal(t, int64(1), rows[0]["zero_count"])
+	assert.Equal(t, int64(-1), rows[0]["positive_offset"])
+	assert.JSONEq(t, `[1,3]`, rows[0]["positive_bucket_counts"].(string))
+	assert.JSONEq(t, `[]`, rows[0]["negative_bucket_counts"].(string))
+
+	rows = queryRows(t, exporter.db, `SELECT * FROM "otel_metrics_summary"`)
+	require.Len(t, rows, 1)
+	assert.Equal(t, int64(3), rows[0]["count"])
+	assert.JSONEq(t, `[{"quantile":0.5,"value":100}]`, rows[0]["quantile_values"].(string))
+}
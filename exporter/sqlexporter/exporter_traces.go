@@ -0,0 +1,172 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sqlexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/sqlexporter"
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/traceutil"
+)
+
+func newTracesTable(name string) *table {
+	return &table{
+		name: name,
+		columns: []column{
+			{name: "timestamp", typ: columnTimestamp},
+			{name: "trace_id", typ: columnText},
+			{name: "span_id", typ: columnText},
+			{name: "parent_span_id", typ: columnText},
+			{name: "trace_state", typ: columnText},
+			{name: "span_name", typ: columnText},
+			{name: "span_kind", typ: columnText},
+			{name: "service_name", typ: columnText},
+			{name: "resource_schema_url", typ: columnText},
+			{name: "resource_attributes", typ: columnJSON},
+			{name: "scope_schema_url", typ: columnText},
+			{name: "scope_name", typ: columnText},
+			{name: "scope_version", typ: columnText},
+			{name: "scope_attributes", typ: columnJSON},
+			{name: "span_attributes", typ: columnJSON},
+			// duration is in nanoseconds.
+			{name: "duration", typ: columnInt},
+			{name: "status_code", typ: columnText},
+			{name: "status_message", typ: columnText},
+			{name: "events", typ: columnJSON},
+			{name: "links", typ: columnJSON},
+		},
+		timeColumn: "timestamp",
+		indexes: [][]string{
+			{"service_name", "timestamp"},
+			{"trace_id"},
+		},
+	}
+}
+
+type spanEvent struct {
+	Timestamp  time.Time      `json:"timestamp"`
+	Name       string         `json:"name"`
+	Attributes map[string]any `json:"attributes"`
+}
+
+type spanLink struct {
+	TraceID    string         `json:"trace_id"`
+	SpanID     string         `json:"span_id"`
+	TraceState string         `json:"trace_state"`
+	Attributes map[string]any `json:"attributes"`
+}
+
+type tracesExporter struct {
+	*sqlExporter
+	table *table
+}
+
+func newTracesExporter(logger *zap.Logger, cfg *Config) *tracesExporter {
+	t := newTracesTable(cfg.Tables.Traces)
+	return &tracesExporter{
+		sqlExporter: newSQLExporter(logger, cfg, t),
+		table:       t,
+	}
+}
+
+func (e *tracesExporter) pushTracesData(ctx context.Context, td ptrace.Traces) error {
+	rows := make([][]any, 0, td.SpanCount())
+
+	rss := td.ResourceSpans()
+	for i := 0; i < rss.Len(); i++ {
+		rs := rss.At(i)
+		resourceAttributes, err := attributesToJSON(rs.Resource().Attributes())
+		if err != nil {
+			return err
+		}
+		service := serviceName(rs.Resource().Attributes())
+
+		sss := rs.ScopeSpans()
+		for j := 0; j < sss.Len(); j++ {
+			ss := sss.At(j)
+			scope := ss.Scope()
+			scopeAttributes, err := attributesToJSON(scope.Attributes())
+			if err != nil {
+				return err
+			}
+
+			spans := ss.Spans()
+			for k := 0; k < spans.Len(); k++ {
+				span := spans.At(k)
+				encoded, err := encodeSpan(span)
+				if err != nil {
+					return err
+				}
+				rows = append(rows, []any{
+					timeColumnValue(span.StartTimestamp(), span.EndTimestamp()),
+					traceutil.TraceIDToHexOrEmptyString(span.TraceID()),
+					traceutil.SpanIDToHexOrEmptyString(span.SpanID()),
+					traceutil.SpanIDToHexOrEmptyString(span.ParentSpanID()),
+					span.TraceState().AsRaw(),
+					span.Name(),
+					span.Kind().String(),
+					service,
+					rs.SchemaUrl(),
+					resourceAttributes,
+					ss.SchemaUrl(),
+					scope.Name(),
+					scope.Version(),
+					scopeAttributes,
+					encoded.attributes,
+					span.EndTimestamp().AsTime().Sub(span.StartTimestamp().AsTime()).Nanoseconds(),
+					span.Status().Code().String(),
+					span.Status().Message(),
+					encoded.events,
+					encoded.links,
+				})
+			}
+		}
+	}
+
+	return e.insert(ctx, tableRows{table: e.table, rows: rows})
+}
+
+// encodedSpan holds the JSON encoded columns of a span.
+type encodedSpan struct {
+	attributes string
+	events     string
+	links      string
+}
+
+func encodeSpan(span ptrace.Span) (encodedSpan, error) {
+	var encoded encodedSpan
+	var err error
+	if encoded.attributes, err = attributesToJSON(span.Attributes()); err != nil {
+		return encoded, err
+	}
+
+	events := make([]spanEvent, 0, span.Events().Len())
+	for i := 0; i < span.Events().Len(); i++ {
+		event := span.Events().At(i)
+		events = append(events, spanEvent{
+			Timestamp:  event.Timestamp().AsTime().UTC(),
+			Name:       event.Name(),
+			Attributes: event.Attributes().AsRaw(),
+		})
+	}
+	if encoded.events, err = toJSON(events); err != nil {
+		return encoded, err
+	}
+
+	links := make([]spanLink, 0, span.Links().Len())
+	for i := 0; i < span.Links().Len(); i++ {
+		link := span.Links().At(i)
+		links = append(links, spanLink{
+			TraceID:    traceutil.TraceIDToHexOrEmptyString(link.TraceID()),
+			SpanID:     traceutil.SpanIDToHexOrEmptyString(link.SpanID()),
+			TraceState: link.TraceState().AsRaw(),
+			Attributes: link.Attributes().AsRaw(),
+		})
+	}
+	encoded.links, err = toJSON(links)
+	return encoded, err
+}
@@ -0,0 +1,65 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sqlexporter
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.uber.org/zap"
+)
+
+func TestPushTracesData(t *testing.T) {
+	exporter := newTracesExporter(zap.NewNop(), newTestConfig(t))
+	startExporter(t, exporter.sqlExporter)
+
+	start := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	traces := ptrace.NewTraces()
+	rs := traces.ResourceSpans().AppendEmpty()
+	rs.Resource().Attributes().PutStr("service.name", "checkout")
+	ss := rs.ScopeSpans().AppendEmpty()
+	ss.Scope().SetName("io.opentelemetry.test")
+	span := ss.Spans().AppendEmpty()
+	span.SetTraceID([16]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16})
+	span.SetSpanID([8]byte{1, 2, 3, 4, 5, 6, 7, 8})
+	span.SetParentSpanID([8]byte{8, 7, 6, 5, 4, 3, 2, 1})
+	span.SetName("POST /checkout")
+	span.SetKind(ptrace.SpanKindServer)
+	span.SetStartTimestamp(pcommon.NewTimestampFromTime(start))
+	span.SetEndTimestamp(pcommon.NewTimestampFromTime(start.Add(250 * time.Millisecond)))
+	span.Status().SetCode(ptrace.StatusCodeError)
+	span.Status().SetMessage("timeout")
+	span.Attributes().PutStr("http.request.method", "POST")
+	event := span.Events().AppendEmpty()
+	event.SetName("exception")
+	event.SetTimestamp(pcommon.NewTimestampFromTime(start.Add(100 * time.Millisecond)))
+	event.Attributes().PutStr("exception.type", "TimeoutError")
+	link := span.Links().AppendEmpty()
+	link.SetTraceID([16]byte{16, 15, 14, 13, 12, 11, 10, 9, 8, 7, 6, 5, 4, 3, 2, 1})
+	link.SetSpanID([8]byte{1, 1, 1, 1, 1, 1, 1, 1})
+
+	require.NoError(t, exporter.pushTracesData(t.Context(), traces))
+
+	rows := queryRows(t, exporter.db, `SELECT * FROM "otel_traces"`)
+	require.Len(t, rows, 1)
+
+	row := rows[0]
+	assert.True(t, start.Equal(row["timestamp"].(time.Time)))
+	assert.Equal(t, "0102030405060708090a0b0c0d0e0f10", row["trace_id"])
+	assert.Equal(t, "0102030405060708", row["span_id"])
+	assert.Equal(t, "0807060504030201", row["parent_span_id"])
+	assert.Equal(t, "POST /checkout", row["span_name"])
+	assert.Equal(t, "Server", row["span_kind"])
+	assert.Equal(t, "checkout", row["service_name"])
+	assert.Equal(t, int64(250*time.Millisecond), row["duration"])
+	assert.Equal(t, "Error", row["status_code"])
+	assert.Equal(t, "timeout", row["status_message"])
+	assert.JSONEq(t, `{"http.request.method":"POST"}`, row["span_attributes"].(string))
+	assert.JSONEq(t, `[{"timestamp":"2026-01-02T03:04:05.1Z","name":"exception","attributes":{"exception.type":"TimeoutError"}}]`, row["events"].(string))
+	assert.JSONEq(t, `[{"trace_id":"100f0e0d0c0b0a090807060504030201","span_id":"0101010101010101","trace_state":"","attributes":{}}]`, row["links"].(string))
+}
@@ -0,0 +1,47 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sqlexporter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/exporter/exportertest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/sqlexporter/internal/metadata"
+)
+
+func TestCreateDefaultConfig(t *testing.T) {
+	cfg := createDefaultConfig()
+	assert.NoError(t, componenttest.CheckConfigStruct(cfg))
+}
+
+func TestFactoryLifecycle(t *testing.T) {
+	factory := NewFactory()
+	cfg := newTestConfig(t)
+	set := exportertest.NewNopSettings(metadata.Type)
+
+	logs, err := factory.CreateLogs(t.Context(), set, cfg)
+	require.NoError(t, err)
+	traces, err := factory.CreateTraces(t.Context(), set, cfg)
+	require.NoError(t, err)
+	metrics, err := factory.CreateMetrics(t.Context(), set, cfg)
+	require.NoError(t, err)
+
+	// All signals share the database and record their schema in the same migrations table.
+	host := componenttest.NewNopHost()
+	require.NoError(t, logs.Start(t.Context(), host))
+	require.NoError(t, traces.Start(t.Context(), host))
+	require.NoError(t, metrics.Start(t.Context(), host))
+
+	exporter := newLogsExporter(set.Logger, cfg)
+	startExporter(t, exporter.sqlExporter)
+	assert.Len(t, queryRows(t, exporter.db, `SELECT * FROM "otel_schema_migrations"`), 7)
+
+	require.NoError(t, logs.Shutdown(t.Context()))
+	require.NoError(t, traces.Shutdown(t.Context()))
+	require.NoError(t, metrics.Shutdown(t.Context()))
+}
@@ -0,0 +1,126 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sqlexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/sqlexporter"
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// migrator creates the managed tables and upgrades them to the schema version
+// of their definition. The applied versions of each table are recorded in the
+// migrations table.
+type migrator struct {
+	db              *sql.DB
+	dialect         *dialect
+	migrationsTable string
+	partitioned     bool
+	logger          *zap.Logger
+}
+
+func (m *migrator) migrate(ctx context.Context, tables ...*table) error {
+	if _, err := m.db.ExecContext(ctx, m.createMigrationsTableSQL()); err != nil {
+		return fmt.Errorf("create migrations table %q: %w", m.migrationsTable, err)
+	}
+	for _, t := range tables {
+		if err := m.migrateTable(ctx, t); err != nil {
+			return fmt.Errorf("migrate table %q: %w", t.name, err)
+		}
+	}
+	return nil
+}
+
+func (m *migrator) migrateTable(ctx context.Context, t *table) error {
+	return doWithTx(ctx, m.db, func(tx *sql.Tx) error {
+		if m.dialect.lockTableSQL != "" {
+			if _, err := tx.ExecContext(ctx, m.dialect.lockTableSQL, t.name); err != nil {
+				return fmt.Errorf("lock: %w", err)
+			}
+		}
+
+		current, err := m.currentVersion(ctx, tx, t.name)
+		if err != nil {
+			return err
+		}
+		latest := t.version()
+		switch {
+		case current == latest:
+			return nil
+		case current > latest:
+			return fmt.Errorf("schema version %d is newer than the version %d supported by this collector", current, latest)
+		}
+
+		for _, statement := range m.pendingStatements(t, current) {
+			if _, err := tx.ExecContext(ctx, statement); err != nil {
+				return fmt.Errorf("execute %q: %w", statement, err)
+			}
+		}
+
+		from := current + 1
+		if current == 0 {
+			// A new table is created at the latest version right away.
+			from = latest
+		}
+		for version := from; version <= latest; version++ {
+			if _, err := tx.ExecContext(ctx, m.recordVersionSQL(), t.name, version, time.Now().UTC()); err != nil {
+				return fmt.Errorf("record version %d: %w", version, err)
+			}
+		}
+
+		m.logger.Info("Migrated table schema",
+			zap.String("table", t.name),
+			zap.Int("from_version", current),
+			zap.Int("to_version", latest))
+		return nil
+	})
+}
+
+// pendingStatements returns the statements migrating the table from the current version to its latest version.
+func (m *migrator) pendingStatements(t *table, current int) []string {
+	if current == 0 {
+		statements := []string{m.dialect.createTableSQL(t, m.partitioned)}
+		for _, index := range t.indexes {
+			statements = append(statements, m.dialect.createIndexSQL(t, index))
+		}
+		return statements
+	}
+
+	var statements []string
+	for _, c := range t.columns {
+		if c.since > current {
+			statements = append(statements, m.dialect.addColumnSQL(t, c))
+		}
+	}
+	return statements
+}
+
+func (m *migrator) currentVersion(ctx context.Context, tx *sql.Tx, tableName string) (int, error) {
+	query := fmt.Sprintf("SELECT COALESCE(MAX(%s), 0) FROM %s WHERE %s = %s",
+		quoteIdentifier("version"), quoteIdentifier(m.migrationsTable), quoteIdentifier("table_name"), m.dialect.placeholder(1))
+	var version int
+	if err := tx.QueryRowContext(ctx, query, tableName).Scan(&version); err != nil {
+		return 0, fmt.Errorf("read schema version: %w", err)
+	}
+	return version, nil
+}
+
+func (m *migrator) createMigrationsTableSQL() string {
+	return fmt.Sprintf("CREATE TABLE IF NOT EXISTS %s (%s %s NOT NULL, %s %s NOT NULL, %s %s NOT NULL, PRIMARY KEY (%s, %s))",
+		quoteIdentifier(m.migrationsTable),
+		quoteIdentifier("table_name"), m.dialect.columnTypes[columnText],
+		quoteIdentifier("version"), m.dialect.columnTypes[columnInt],
+		quoteIdentifier("applied_at"), m.dialect.columnTypes[columnTimestamp],
+		quoteIdentifier("table_name"), quoteIdentifier("version"))
+}
+
+func (m *migrator) recordVersionSQL() string {
+	return fmt.Sprintf("INSERT INTO %s (%s, %s, %s) VALUES (%s, %s, %s)",
+		quoteIdentifier(m.migrationsTable),
+		quoteIdentifier("table_name"), quoteIdentifier("version"), quoteIdentifier("applied_at"),
+		m.dialect.placeholder(1), m.dialect.placeholder(2), m.dialect.placeholder(3))
+}
@@ -0,0 +1,103 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sqlexporter
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func newTestMigrator(t *testing.T) *migrator {
+	cfg := newTestConfig(t)
+	db, err := sql.Open(cfg.Driver, string(cfg.DataSource))
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, db.Close())
+	})
+	return &migrator{
+		db:              db,
+		dialect:         sqliteDialect,
+		migrationsTable: cfg.Tables.Migrations,
+		logger:          zap.NewNop(),
+	}
+}
+
+func appliedVersions(t *testing.T, m *migrator, tableName string) []int64 {
+	var versions []int64
+	for _, row := range queryRows(t, m.db, `SELECT "version" FROM "otel_schema_migrations" WHERE "table_name" = ? ORDER BY "version"`, tableName) {
+		versions = append(versions, row["version"].(int64))
+	}
+	return versions
+}
+
+func tableColumns(t *testing.T, db *sql.DB, tableName string) []string {
+	var columns []string
+	for _, row := range queryRows(t, db, `SELECT "name" FROM pragma_table_info(?)`, tableName) {
+		columns = append(columns, row["name"].(string))
+	}
+	return columns
+}
+
+func TestMigrateCreatesTables(t *testing.T) {
+	m := newTestMigrator(t)
+	tbl := testTable()
+	tbl.columns = append(tbl.columns, column{name: "event_name", typ: columnText, since: 2})
+
+	require.NoError(t, m.migrate(t.Context(), tbl))
+
+	assert.Equal(t, []string{"timestamp", "body", "attributes", "event_name"}, tableColumns(t, m.db, tbl.name))
+	// A new table is created at its latest version.
+	assert.Equal(t, []int64{2}, appliedVersions(t, m, tbl.name))
+	indexes := queryRows(t, m.db, `SELECT "name" FROM pragma_index_list(?)`, tbl.name)
+	require.Len(t, indexes, 1)
+	assert.Equal(t, "otel_test_body_timestamp_idx", indexes[0]["name"])
+
+	// Migrating again is a no-op.
+	require.NoError(t, m.migrate(t.Context(), tbl))
+	assert.Equal(t, []int64{2}, appliedVersions(t, m, tbl.name))
+}
+
+func TestMigrateAddsColumns(t *testing.T) {
+	m := newTestMigrator(t)
+	tbl := testTable()
+	require.NoError(t, m.migrate(t.Context(), tbl))
+	assert.Equal(t, []int64{1}, appliedVersions(t, m, tbl.name))
+
+	tbl.columns = append(tbl.columns,
+		column{name: "event_name", typ: columnText, since: 2},
+		column{name: "flags", typ: columnInt, since: 3},
+		column{name: "severity", typ: columnInt, since: 3},
+	)
+	require.NoError(t, m.migrate(t.Context(), tbl))
+
+	assert.Equal(t, []string{"timestamp", "body", "attributes", "event_name", "flags", "severity"}, tableColumns(t, m.db, tbl.name))
+	assert.Equal(t, []int64{1, 2, 3}, appliedVersions(t, m, tbl.name))
+}
+
+func TestMigrateNewerSchemaVersion(t *testing.T) {
+	m := newTestMigrator(t)
+	tbl := testTable()
+	tbl.columns = append(tbl.columns, column{name: "event_name", typ: columnText, since: 2})
+	require.NoError(t, m.migrate(t.Context(), tbl))
+
+	err := m.migrate(t.Context(), testTable())
+	assert.ErrorContains(t, err, "schema version 2 is newer than the version 1 supported by this collector")
+}
+
+func TestPendingStatements(t *testing.T) {
+	m := &migrator{dialect: postgreSQLDialect, partitioned: true}
+	tbl := testTable()
+	tbl.columns = append(tbl.columns, column{name: "event_name", typ: columnText, since: 2})
+
+	statements := m.pendingStatements(tbl, 0)
+	require.Len(t, statements, 2)
+	assert.Contains(t, statements[0], `PARTITION BY RANGE ("timestamp")`)
+	assert.Equal(t, `CREATE INDEX IF NOT EXISTS "otel_test_body_timestamp_idx" ON "otel_test" ("body", "timestamp")`, statements[1])
+
+	assert.Equal(t, []string{`ALTER TABLE "otel_test" ADD COLUMN "event_name" TEXT`}, m.pendingStatements(tbl, 1))
+}
@@ -0,0 +1,68 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sqlexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/sqlexporter"
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/collector/consumer/consumererror"
+)
+
+const day = 24 * time.Hour
+
+// partitionManager creates the daily partitions of tables partitioned by
+// range on their time column, before rows belonging to them are inserted.
+type partitionManager struct {
+	mu sync.Mutex
+	// created holds the names of the partitions known to exist.
+	created map[string]struct{}
+}
+
+func newPartitionManager() *partitionManager {
+	return &partitionManager{created: make(map[string]struct{})}
+}
+
+func partitionName(tableName string, start time.Time) string {
+	return tableName + "_p" + start.Format("20060102")
+}
+
+func createPartitionSQL(t *table, start time.Time) string {
+	return fmt.Sprintf("CREATE TABLE IF NOT EXISTS %s PARTITION OF %s FOR VALUES FROM ('%s') TO ('%s')",
+		quoteIdentifier(partitionName(t.name, start)),
+		quoteIdentifier(t.name),
+		start.Format(time.RFC3339),
+		start.Add(day).Format(time.RFC3339))
+}
+
+// ensurePartitions creates the missing partitions for the days of the given rows. Rows without
+// a time cannot be inserted into any partition, so they are rejected.
+func (p *partitionManager) ensurePartitions(ctx context.Context, db *sql.DB, t *table, rows [][]any) error {
+	idx := t.columnIndex(t.timeColumn)
+	days := make(map[time.Time]struct{})
+	for _, row := range rows {
+		ts, ok := row[idx].(time.Time)
+		if !ok {
+			return consumererror.NewPermanent(fmt.Errorf("row of %q without %q cannot be partitioned", t.name, t.timeColumn))
+		}
+		days[ts.UTC().Truncate(day)] = struct{}{}
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for start := range days {
+		name := partitionName(t.name, start)
+		if _, ok := p.created[name]; ok {
+			continue
+		}
+		if _, err := db.ExecContext(ctx, createPartitionSQL(t, start)); err != nil {
+			return fmt.Errorf("create partition %q: %w", name, err)
+		}
+		p.created[name] = struct{}{}
+	}
+	return nil
+}
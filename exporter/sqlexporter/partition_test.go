@@ -0,0 +1,28 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sqlexporter
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/consumer/consumererror"
+)
+
+func TestCreatePartitionSQL(t *testing.T) {
+	start := time.Date(2026, 2, 28, 0, 0, 0, 0, time.UTC)
+	assert.Equal(t,
+		`CREATE TABLE IF NOT EXISTS "otel_test_p20260228" PARTITION OF "otel_test" FOR VALUES FROM ('2026-02-28T00:00:00Z') TO ('2026-03-01T00:00:00Z')`,
+		createPartitionSQL(testTable(), start))
+}
+
+func TestEnsurePartitionsWithoutTime(t *testing.T) {
+	table := testTable()
+	row := make([]any, len(table.columns))
+	err := newPartitionManager().ensurePartitions(t.Context(), nil, table, [][]any{row})
+	require.Error(t, err)
+	assert.True(t, consumererror.IsPermanent(err))
+}
@@ -0,0 +1,152 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sqlexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/sqlexporter"
+
+import (
+	"fmt"
+	"strings"
+)
+
+type columnType int
+
+const (
+	columnText columnType = iota
+	columnTimestamp
+	columnInt
+	columnDouble
+	columnBool
+	// columnJSON holds attributes, arrays and other nested values encoded as JSON.
+	columnJSON
+)
+
+// column is a column of a managed table.
+//
+// Columns are only ever appended to a table. A column added after the first
+// release of its table sets since to the schema version introducing it, the
+// migration to that version then adds the column to existing tables.
+type column struct {
+	name  string
+	typ   columnType
+	since int
+}
+
+// table is the definition of a managed table. The schema version of a table
+// is the highest since of its columns.
+type table struct {
+	name    string
+	columns []column
+	// timeColumn is the column tables are partitioned by.
+	timeColumn string
+	indexes    [][]string
+}
+
+func (t *table) version() int {
+	version := 1
+	for _, c := range t.columns {
+		version = max(version, c.since)
+	}
+	return version
+}
+
+func (t *table) columnIndex(name string) int {
+	for i, c := range t.columns {
+		if c.name == name {
+			return i
+		}
+	}
+	return -1
+}
+
+// dialect holds the SQL differences between the supported databases.
+type dialect struct {
+	columnTypes map[columnType]string
+	// placeholder returns the bind parameter for the n-th (1-based) value.
+	placeholder func(n int) string
+	// lockTableSQL takes a transaction scoped lock on a table name to serialize
+	// migrations of several collectors. Empty if the database locks on write anyway.
+	lockTableSQL string
+}
+
+var (
+	postgreSQLDialect = &dialect{
+		columnTypes: map[columnType]string{
+			columnText:      "TEXT",
+			columnTimestamp: "TIMESTAMPTZ",
+			columnInt:       "BIGINT",
+			columnDouble:    "DOUBLE PRECISION",
+			columnBool:      "BOOLEAN",
+			columnJSON:      "JSONB",
+		},
+		placeholder:  func(n int) string { return fmt.Sprintf("$%d", n) },
+		lockTableSQL: "SELECT pg_advisory_xact_lock(hashtext($1))",
+	}
+	sqliteDialect = &dialect{
+		columnTypes: map[columnType]string{
+			columnText:      "TEXT",
+			columnTimestamp: "TIMESTAMP",
+			columnInt:       "INTEGER",
+			columnDouble:    "REAL",
+			columnBool:      "BOOLEAN",
+			columnJSON:      "TEXT",
+		},
+		placeholder: func(int) string { return "?" },
+	}
+)
+
+func dialectForDriver(driver string) *dialect {
+	if driver == driverPostgreSQL {
+		return postgreSQLDialect
+	}
+	return sqliteDialect
+}
+
+func quoteIdentifier(name string) string {
+	return `"` + name + `"`
+}
+
+func (d *dialect) createTableSQL(t *table, partitioned bool) string {
+	var sb strings.Builder
+	sb.WriteString("CREATE TABLE IF NOT EXISTS ")
+	sb.WriteString(quoteIdentifier(t.name))
+	sb.WriteString(" (\n")
+	for i, c := range t.columns {
+		if i > 0 {
+			sb.WriteString(",\n")
+		}
+		sb.WriteString("\t")
+		sb.WriteString(quoteIdentifier(c.name))
+		sb.WriteString(" ")
+		sb.WriteString(d.columnTypes[c.typ])
+	}
+	sb.WriteString("\n)")
+	if partitioned {
+		sb.WriteString(" PARTITION BY RANGE (")
+		sb.WriteString(quoteIdentifier(t.timeColumn))
+		sb.WriteString(")")
+	}
+	return sb.String()
+}
+
+func (*dialect) createIndexSQL(t *table, columns []string) string {
+	quoted := make([]string, len(columns))
+	for i, c := range columns {
+		quoted[i] = quoteIdentifier(c)
+	}
+	name := t.name + "_" + strings.Join(columns, "_") + "_idx"
+	return fmt.Sprintf("CREATE INDEX IF NOT EXISTS %s ON %s (%s)", quoteIdentifier(name), quoteIdentifier(t.name), strings.Join(quoted, ", "))
+}
+
+func (d *dialect) addColumnSQL(t *table, c column) string {
+	return fmt.Sprintf("ALTER TABLE %s ADD COLUMN %s %s", quoteIdentifier(t.name), quoteIdentifier(c.name), d.columnTypes[c.typ])
+}
+
+func (d *dialect) insertSQL(t *table) string {
+	names := make([]string, len(t.columns))
+	placeholders := make([]string, len(t.columns))
+	for i, c := range t.columns {
+		names[i] = quoteIdentifier(c.name)
+		placeholders[i] = d.placeholder(i + 1)
+	}
+	return fmt.Sprintf("INSERT INTO %s (%s) VALUES (%s)", quoteIdentifier(t.name), strings.Join(names, ", "), strings.Join(placeholders, ", "))
+}
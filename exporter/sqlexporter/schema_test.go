@@ -0,0 +1,83 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sqlexporter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func testTable() *table {
+	return &table{
+		name: "otel_test",
+		columns: []column{
+			{name: "timestamp", typ: columnTimestamp},
+			{name: "body", typ: columnText},
+			{name: "attributes", typ: columnJSON},
+		},
+		timeColumn: "timestamp",
+		indexes:    [][]string{{"body", "timestamp"}},
+	}
+}
+
+func TestCreateTableSQL(t *testing.T) {
+	tests := []struct {
+		name        string
+		dialect     *dialect
+		partitioned bool
+		expected    string
+	}{
+		{
+			name:    "sqlite",
+			dialect: sqliteDialect,
+			expected: "CREATE TABLE IF NOT EXISTS \"otel_test\" (\n" +
+				"\t\"timestamp\" TIMESTAMP,\n" +
+				"\t\"body\" TEXT,\n" +
+				"\t\"attributes\" TEXT\n" +
+				")",
+		},
+		{
+			name:    "postgresql",
+			dialect: postgreSQLDialect,
+			expected: "CREATE TABLE IF NOT EXISTS \"otel_test\" (\n" +
+				"\t\"timestamp\" TIMESTAMPTZ,\n" +
+				"\t\"body\" TEXT,\n" +
+				"\t\"attributes\" JSONB\n" +
+				")",
+		},
+		{
+			name:        "postgresql partitioned",
+			dialect:     postgreSQLDialect,
+			partitioned: true,
+			expected: "CREATE TABLE IF NOT EXISTS \"otel_test\" (\n" +
+				"\t\"timestamp\" TIMESTAMPTZ,\n" +
+				"\t\"body\" TEXT,\n" +
+				"\t\"attributes\" JSONB\n" +
+				") PARTITION BY RANGE (\"timestamp\")",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.dialect.createTableSQL(testTable(), tt.partitioned))
+		})
+	}
+}
+
+func TestInsertSQL(t *testing.T) {
+	assert.Equal(t, `INSERT INTO "otel_test" ("timestamp", "body", "attributes") VALUES (?, ?, ?)`, sqliteDialect.insertSQL(testTable()))
+	assert.Equal(t, `INSERT INTO "otel_test" ("timestamp", "body", "attributes") VALUES ($1, $2, $3)`, postgreSQLDialect.insertSQL(testTable()))
+}
+
+func TestCreateIndexSQL(t *testing.T) {
+	tbl := testTable()
+	assert.Equal(t, `CREATE INDEX IF NOT EXISTS "otel_test_body_timestamp_idx" ON "otel_test" ("body", "timestamp")`, sqliteDialect.createIndexSQL(tbl, tbl.indexes[0]))
+}
+
+func TestTableVersion(t *testing.T) {
+	tbl := testTable()
+	assert.Equal(t, 1, tbl.version())
+	tbl.columns = append(tbl.columns, column{name: "event_name", typ: columnText, since: 3})
+	assert.Equal(t, 3, tbl.version())
+}
@@ -168,6 +168,10 @@ func TestLoadConfigV2WithGate(t *testing.T) {
 					Enabled: true,
 					Path:    "/config",
 				},
+				Liveness: healthcheck.PathConfig{
+					Enabled: false,
+					Path:    "/liveness",
+				},
 			},
 			GRPCConfig: &healthcheck.GRPCConfig{
 				ServerConfig: configgrpc.ServerConfig{
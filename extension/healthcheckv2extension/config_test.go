@@ -18,6 +18,7 @@ import (
 	"go.opentelemetry.io/collector/config/configtls"
 	"go.opentelemetry.io/collector/confmap/confmaptest"
 	"go.opentelemetry.io/collector/confmap/xconfmap"
+	"go.opentelemetry.io/collector/pipeline"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/healthcheckv2extension/internal/metadata"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/common/testutil"
@@ -109,6 +110,10 @@ func TestLoadConfig(t *testing.T) {
 						Enabled: false,
 						Path:    "/config",
 					},
+					Liveness: healthcheck.PathConfig{
+						Enabled: false,
+						Path:    "/liveness",
+					},
 				},
 				GRPCConfig: &healthcheck.GRPCConfig{
 					ServerConfig: configgrpc.ServerConfig{
@@ -153,6 +158,10 @@ func TestLoadConfig(t *testing.T) {
 						Enabled: true,
 						Path:    "/conf",
 					},
+					Liveness: healthcheck.PathConfig{
+						Enabled: false,
+						Path:    "/liveness",
+					},
 				},
 			},
 		},
@@ -191,6 +200,75 @@ func TestLoadConfig(t *testing.T) {
 			id:          component.NewIDWithName(metadata.Type, "v2noprotocols"),
 			expectedErr: healthcheck.ErrMissingProtocol,
 		},
+		{
+			id: component.NewIDWithName(metadata.Type, "v2dataflow"),
+			expected: &Config{
+				LegacyConfig: healthcheck.HTTPLegacyConfig{
+					UseV2: true,
+					ServerConfig: confighttp.ServerConfig{
+						NetAddr: confignet.AddrConfig{
+							Transport: "tcp",
+							Endpoint:  testutil.EndpointForPort(healthcheck.DefaultHTTPPort),
+						},
+					},
+					Path: "/",
+				},
+				HTTPConfig: &healthcheck.HTTPConfig{
+					ServerConfig: confighttp.ServerConfig{
+						NetAddr: confignet.AddrConfig{
+							Transport: "tcp",
+							Endpoint:  testutil.EndpointForPort(healthcheck.DefaultHTTPPort),
+						},
+					},
+					Status: healthcheck.PathConfig{
+						Enabled: true,
+						Path:    "/status",
+					},
+					Config: healthcheck.PathConfig{
+						Enabled: false,
+						Path:    "/config",
+					},
+					Liveness: healthcheck.PathConfig{
+						Enabled: true,
+						Path:    "/liveness",
+					},
+				},
+				GRPCConfig: &healthcheck.GRPCConfig{
+					ServerConfig: configgrpc.ServerConfig{
+						NetAddr: confignet.AddrConfig{
+							Endpoint:  testutil.EndpointForPort(healthcheck.DefaultGRPCPort),
+							Transport: "tcp",
+						},
+					},
+				},
+				DataFlowConfig: &healthcheck.DataFlowConfig{
+					MetricsEndpoint: healthcheck.DefaultMetricsEndpoint,
+					CheckInterval:   healthcheck.DefaultCheckInterval,
+					Pipelines: map[pipeline.ID]healthcheck.PipelineDataFlowConfig{
+						pipeline.NewID(pipeline.SignalTraces): {
+							Window:   10 * time.Minute,
+							MinItems: 1,
+						},
+						pipeline.NewIDWithName(pipeline.SignalLogs, "audit"): {
+							Window:                  time.Hour,
+							MaxExporterFailureRatio: 0.1,
+						},
+					},
+				},
+			},
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "v2livenessmissingdataflow"),
+			expectedErr: healthcheck.ErrLivenessNeedsDataFlow,
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "v2dataflowmissingpipelines"),
+			expectedErr: healthcheck.ErrDataFlowMissingPipelines,
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "v2dataflowinvalidratio"),
+			expectedErr: healthcheck.ErrDataFlowInvalidPipeline,
+		},
 	}
 
 	for _, tt := range tests {
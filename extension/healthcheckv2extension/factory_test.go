@@ -46,6 +46,10 @@ func TestCreateDefaultConfig(t *testing.T) {
 				Enabled: false,
 				Path:    "/config",
 			},
+			Liveness: healthcheck.PathConfig{
+				Enabled: false,
+				Path:    "/liveness",
+			},
 		},
 		GRPCConfig: &healthcheck.GRPCConfig{
 			ServerConfig: configgrpc.ServerConfig{
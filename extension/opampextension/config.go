@@ -47,6 +47,16 @@ type Config struct {
 
 	// PPIDPollInterval is the time between polling for whether PPID is running.
 	PPIDPollInterval time.Duration `mapstructure:"ppid_poll_interval"`
+
+	// RuntimeState contains options for the runtime state custom capability.
+	RuntimeState RuntimeState `mapstructure:"runtime_state"`
+}
+
+// RuntimeState contains options for the runtime state custom capability.
+type RuntimeState struct {
+	// MetricsEndpoint is the URL of the collector's internal telemetry in the Prometheus
+	// format, read to report the state of the components. (default: http://localhost:8888/metrics)
+	MetricsEndpoint string `mapstructure:"metrics_endpoint"`
 }
 
 type AgentDescription struct {
@@ -67,6 +77,10 @@ type Capabilities struct {
 	ReportsAvailableComponents bool `mapstructure:"reports_available_components"`
 	// AcceptsRestartCommand enables the OpAMP AcceptsRestartCommand Capability (default: false)
 	AcceptsRestartCommand bool `mapstructure:"accepts_restart_command"`
+	// ReportsRuntimeState enables the runtime state custom capability, answering server queries
+	// for the state of the components and the differences between the local and a remote
+	// configuration. (default: false)
+	ReportsRuntimeState bool `mapstructure:"reports_runtime_state"`
 }
 
 func (caps Capabilities) toAgentCapabilities() protobufs.AgentCapabilities {
@@ -233,5 +247,9 @@ func (cfg *Config) Validate() error {
 		}
 	}
 
+	if cfg.Capabilities.ReportsRuntimeState && cfg.RuntimeState.MetricsEndpoint == "" {
+		return errors.New("runtime_state::metrics_endpoint must be provided to use the reports_runtime_state capability")
+	}
+
 	return nil
 }
@@ -44,6 +44,9 @@ func TestUnmarshalConfig(t *testing.T) {
 				ReportsAvailableComponents: true,
 			},
 			PPIDPollInterval: 5 * time.Second,
+			RuntimeState: RuntimeState{
+				MetricsEndpoint: "http://localhost:8888/metrics",
+			},
 		}, cfg)
 }
 
@@ -70,6 +73,9 @@ func TestUnmarshalHttpConfig(t *testing.T) {
 				ReportsAvailableComponents: true,
 			},
 			PPIDPollInterval: 5 * time.Second,
+			RuntimeState: RuntimeState{
+				MetricsEndpoint: "http://localhost:8888/metrics",
+			},
 		}, cfg)
 }
 
@@ -217,6 +223,7 @@ func TestConfig_Validate(t *testing.T) {
 		Server       *OpAMPServer
 		InstanceUID  string
 		Capabilities Capabilities
+		RuntimeState RuntimeState
 	}
 	tests := []struct {
 		name    string
@@ -358,6 +365,43 @@ func TestConfig_Validate(t *testing.T) {
 				return assert.Equal(t, "extension.opampextension.RemoteRestarts feature gate must be enabled to use the accepts_restart_command capability", err.Error())
 			},
 		},
+		{
+			name: "reports_runtime_state capability without metrics endpoint",
+			fields: fields{
+				Capabilities: Capabilities{
+					ReportsRuntimeState: true,
+				},
+				Server: &OpAMPServer{
+					HTTP: &httpFields{
+						commonFields: commonFields{
+							Endpoint: "https://127.0.0.1:4320/v1/opamp",
+						},
+					},
+				},
+			},
+			wantErr: func(t assert.TestingT, err error, _ ...any) bool {
+				return assert.Equal(t, "runtime_state::metrics_endpoint must be provided to use the reports_runtime_state capability", err.Error())
+			},
+		},
+		{
+			name: "reports_runtime_state capability with metrics endpoint",
+			fields: fields{
+				Capabilities: Capabilities{
+					ReportsRuntimeState: true,
+				},
+				RuntimeState: RuntimeState{
+					MetricsEndpoint: "http://localhost:8888/metrics",
+				},
+				Server: &OpAMPServer{
+					HTTP: &httpFields{
+						commonFields: commonFields{
+							Endpoint: "https://127.0.0.1:4320/v1/opamp",
+						},
+					},
+				},
+			},
+			wantErr: assert.NoError,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -365,6 +409,7 @@ func TestConfig_Validate(t *testing.T) {
 				Server:       tt.fields.Server,
 				InstanceUID:  tt.fields.InstanceUID,
 				Capabilities: tt.fields.Capabilities,
+				RuntimeState: tt.fields.RuntimeState,
 			}
 			tt.wantErr(t, cfg.Validate())
 		})
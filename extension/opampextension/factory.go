@@ -35,6 +35,9 @@ func NewFactory() extension.Factory {
 	)
 }
 
+// defaultMetricsEndpoint is the default address of the collector's internal telemetry.
+const defaultMetricsEndpoint = "http://localhost:8888/metrics"
+
 func createDefaultConfig() component.Config {
 	return &Config{
 		Server: &OpAMPServer{},
@@ -45,6 +48,9 @@ func createDefaultConfig() component.Config {
 			AcceptsRestartCommand:      false,
 		},
 		PPIDPollInterval: 5 * time.Second,
+		RuntimeState: RuntimeState{
+			MetricsEndpoint: defaultMetricsEndpoint,
+		},
 	}
 }
 
@@ -79,6 +79,7 @@ type opampAgent struct {
 	opampClient client.OpAMPClient
 
 	customCapabilityRegistry *customCapabilityRegistry
+	runtimeStateReporter     *runtimeStateReporter
 
 	statusAggregator     statusAggregator
 	statusSubscriptionWg *sync.WaitGroup
@@ -186,6 +187,32 @@ func (o *opampAgent) Start(ctx context.Context, host component.Host) error {
 
 	o.logger.Debug("OpAMP client started")
 
+	if o.capabilities.ReportsRuntimeState {
+		if err := o.startRuntimeStateReporter(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (o *opampAgent) startRuntimeStateReporter() error {
+	handler, err := o.customCapabilityRegistry.Register(runtimeStateCapability)
+	if err != nil {
+		return fmt.Errorf("failed to register the runtime state capability: %w", err)
+	}
+
+	var effectiveConfig func() *confmap.Conf
+	if o.capabilities.ReportsEffectiveConfig {
+		effectiveConfig = func() *confmap.Conf {
+			o.eclk.RLock()
+			defer o.eclk.RUnlock()
+			return o.effectiveConfig
+		}
+	}
+
+	o.runtimeStateReporter = newRuntimeStateReporter(o.logger, o.cfg.RuntimeState.MetricsEndpoint, handler, effectiveConfig)
+	o.runtimeStateReporter.start()
 	return nil
 }
 
@@ -196,6 +223,9 @@ func (o *opampAgent) Shutdown(ctx context.Context) error {
 
 	o.statusSubscriptionWg.Wait()
 	o.componentHealthWg.Wait()
+	if o.runtimeStateReporter != nil {
+		o.runtimeStateReporter.stop()
+	}
 	if o.componentStatusCh != nil {
 		close(o.componentStatusCh)
 	}
@@ -0,0 +1,341 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package opampextension // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/opampextension"
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/open-telemetry/opamp-go/client/types"
+	"github.com/open-telemetry/opamp-go/protobufs"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
+	"github.com/prometheus/common/model"
+	"go.opentelemetry.io/collector/confmap"
+	"go.uber.org/zap"
+	"gopkg.in/yaml.v3"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/opampcustommessages"
+)
+
+const (
+	// runtimeStateCapability is the custom capability answering server queries for the
+	// runtime state of the collector.
+	runtimeStateCapability = "io.opentelemetry.collector.runtime_state"
+
+	// runtimeStateQueryMessageType is the type of the messages the server sends to query
+	// the runtime state, with an optional JSON encoded runtimeStateQuery as data.
+	runtimeStateQueryMessageType = "query"
+	// runtimeStateMessageType is the type of the messages answering a query, with the JSON
+	// encoded runtimeState as data.
+	runtimeStateMessageType = "state"
+	// runtimeStateErrorMessageType is the type of the messages answering a query that could
+	// not be answered, with the error message as data.
+	runtimeStateErrorMessageType = "error"
+
+	runtimeStateTimeout          = 10 * time.Second
+	maxRuntimeStateSendAttempts  = 10
+	internalTelemetryTotalSuffix = "_total"
+)
+
+// componentKinds are the kinds of components whose internal telemetry is reported, each
+// kind's metrics are named otelcol_<kind>_* and identify the component with a <kind> label.
+var componentKinds = []string{"receiver", "processor", "exporter", "connector"}
+
+// runtimeStateQuery is a query for the runtime state sent by the server.
+type runtimeStateQuery struct {
+	// RemoteConfig is the YAML configuration the server expects the collector to run with.
+	// When set, the state includes its differences with the local configuration.
+	RemoteConfig string `json:"remote_config,omitempty"`
+}
+
+// runtimeState is the runtime state of the collector.
+type runtimeState struct {
+	Timestamp time.Time `json:"timestamp"`
+	// Components holds the internal telemetry of each component, such as exporter queue
+	// sizes and the numbers of items sent, failed or dropped, keyed by "<kind>:<id>".
+	Components map[string]map[string]float64 `json:"components"`
+	// Pipelines holds the components of each pipeline of the local configuration.
+	Pipelines map[string]map[string][]string `json:"pipelines,omitempty"`
+	// ConfigDiff holds the differences between the local and the remote configuration.
+	ConfigDiff *configDiff `json:"config_diff,omitempty"`
+}
+
+// configDiff holds the differences between two configurations, keyed by the path of the
+// settings, e.g. "exporters::otlp::endpoint".
+type configDiff struct {
+	// Added are the settings only found in the remote configuration.
+	Added map[string]any `json:"added,omitempty"`
+	// Removed are the settings only found in the local configuration.
+	Removed map[string]any `json:"removed,omitempty"`
+	// Changed are the settings whose values differ.
+	Changed map[string]configChange `json:"changed,omitempty"`
+}
+
+type configChange struct {
+	Local  any `json:"local"`
+	Remote any `json:"remote"`
+}
+
+// runtimeStateReporter answers the queries for runtime state the server sends through the
+// runtime state custom capability.
+type runtimeStateReporter struct {
+	logger          *zap.Logger
+	metricsEndpoint string
+	client          *http.Client
+	handler         opampcustommessages.CustomCapabilityHandler
+	// effectiveConfig returns the local configuration, it is nil when the effective
+	// configuration must not be reported.
+	effectiveConfig func() *confmap.Conf
+
+	doneCh chan struct{}
+	doneWg sync.WaitGroup
+}
+
+func newRuntimeStateReporter(
+	logger *zap.Logger,
+	metricsEndpoint string,
+	handler opampcustommessages.CustomCapabilityHandler,
+	effectiveConfig func() *confmap.Conf,
+) *runtimeStateReporter {
+	return &runtimeStateReporter{
+		logger:          logger,
+		metricsEndpoint: metricsEndpoint,
+		client:          &http.Client{Timeout: runtimeStateTimeout},
+		handler:         handler,
+		effectiveConfig: effectiveConfig,
+		doneCh:          make(chan struct{}),
+	}
+}
+
+func (r *runtimeStateReporter) start() {
+	r.doneWg.Go(func() {
+		for {
+			select {
+			case msg, ok := <-r.handler.Message():
+				if !ok {
+					return
+				}
+				r.processMessage(msg)
+			case <-r.doneCh:
+				return
+			}
+		}
+	})
+}
+
+func (r *runtimeStateReporter) stop() {
+	close(r.doneCh)
+	r.doneWg.Wait()
+	r.handler.Unregister()
+	r.client.CloseIdleConnections()
+}
+
+func (r *runtimeStateReporter) processMessage(msg *protobufs.CustomMessage) {
+	if msg.Type != runtimeStateQueryMessageType {
+		r.logger.Debug("Ignoring runtime state message of unknown type", zap.String("type", msg.Type))
+		return
+	}
+
+	var query runtimeStateQuery
+	if len(msg.Data) > 0 {
+		if err := json.Unmarshal(msg.Data, &query); err != nil {
+			r.send(runtimeStateErrorMessageType, fmt.Appendf(nil, "invalid query: %v", err))
+			return
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), runtimeStateTimeout)
+	defer cancel()
+	state, err := r.collect(ctx, query)
+	if err != nil {
+		r.send(runtimeStateErrorMessageType, []byte(err.Error()))
+		return
+	}
+	data, err := json.Marshal(state)
+	if err != nil {
+		r.send(runtimeStateErrorMessageType, []byte(err.Error()))
+		return
+	}
+	r.send(runtimeStateMessageType, data)
+}
+
+func (r *runtimeStateReporter) send(messageType string, data []byte) {
+	for attempt := range maxRuntimeStateSendAttempts {
+		sendingChan, err := r.handler.SendMessage(messageType, data)
+		switch {
+		case err == nil:
+			return
+		case errors.Is(err, types.ErrCustomMessagePending):
+			select {
+			case <-sendingChan:
+			case <-r.doneCh:
+				return
+			}
+		default:
+			r.logger.Error("Failed to send runtime state", zap.Error(err), zap.Int("attempt", attempt))
+			return
+		}
+	}
+	r.logger.Error("Failed to send runtime state after multiple attempts", zap.Int("max_attempts", maxRuntimeStateSendAttempts))
+}
+
+func (r *runtimeStateReporter) collect(ctx context.Context, query runtimeStateQuery) (*runtimeState, error) {
+	components, err := r.componentMetrics(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("read internal telemetry: %w", err)
+	}
+	state := &runtimeState{
+		Timestamp:  time.Now(),
+		Components: components,
+	}
+
+	if r.effectiveConfig == nil {
+		if query.RemoteConfig != "" {
+			return nil, errors.New("config diff requires the reports_effective_config capability")
+		}
+		return state, nil
+	}
+	local := r.effectiveConfig()
+	if local == nil {
+		if query.RemoteConfig != "" {
+			return nil, errors.New("the local configuration is not available yet")
+		}
+		return state, nil
+	}
+
+	state.Pipelines = pipelineComponents(local)
+	if query.RemoteConfig != "" {
+		var remote map[string]any
+		if err := yaml.Unmarshal([]byte(query.RemoteConfig), &remote); err != nil {
+			return nil, fmt.Errorf("invalid remote config: %w", err)
+		}
+		state.ConfigDiff = diffConfigs(local, confmap.NewFromStringMap(remote))
+	}
+	return state, nil
+}
+
+// componentMetrics reads the internal telemetry and returns the metrics of each component.
+// Series of a component for the same metric, e.g. for different signals, are summed.
+func (r *runtimeStateReporter) componentMetrics(ctx context.Context) (map[string]map[string]float64, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, r.metricsEndpoint, http.NoBody)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+
+	parser := expfmt.NewTextParser(model.UTF8Validation)
+	families, err := parser.TextToMetricFamilies(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	components := map[string]map[string]float64{}
+	for name, family := range families {
+		for _, kind := range componentKinds {
+			metric, ok := strings.CutPrefix(name, "otelcol_"+kind+"_")
+			if !ok {
+				continue
+			}
+			metric = strings.TrimSuffix(metric, internalTelemetryTotalSuffix)
+			for _, m := range family.GetMetric() {
+				value, ok := metricValue(family.GetType(), m)
+				if !ok {
+					continue
+				}
+				for _, label := range m.GetLabel() {
+					if label.GetName() != kind {
+						continue
+					}
+					key := kind + ":" + label.GetValue()
+					if components[key] == nil {
+						components[key] = map[string]float64{}
+					}
+					components[key][metric] += value
+				}
+			}
+		}
+	}
+	return components, nil
+}
+
+func metricValue(metricType dto.MetricType, m *dto.Metric) (float64, bool) {
+	switch metricType {
+	case dto.MetricType_COUNTER:
+		return m.GetCounter().GetValue(), true
+	case dto.MetricType_GAUGE:
+		return m.GetGauge().GetValue(), true
+	case dto.MetricType_UNTYPED:
+		return m.GetUntyped().GetValue(), true
+	default:
+		return 0, false
+	}
+}
+
+// pipelineComponents returns the receivers, processors and exporters of each pipeline of
+// the configuration.
+func pipelineComponents(conf *confmap.Conf) map[string]map[string][]string {
+	pipelines, ok := conf.Get("service::pipelines").(map[string]any)
+	if !ok {
+		return nil
+	}
+	result := make(map[string]map[string][]string, len(pipelines))
+	for name, p := range pipelines {
+		pipeline, ok := p.(map[string]any)
+		if !ok {
+			continue
+		}
+		components := map[string][]string{}
+		for _, kind := range []string{"receivers", "processors", "exporters"} {
+			ids, ok := pipeline[kind].([]any)
+			if !ok {
+				continue
+			}
+			for _, id := range ids {
+				components[kind] = append(components[kind], fmt.Sprint(id))
+			}
+		}
+		result[name] = components
+	}
+	return result
+}
+
+// diffConfigs returns the differences between the local and remote configurations.
+func diffConfigs(local, remote *confmap.Conf) *configDiff {
+	diff := &configDiff{
+		Added:   map[string]any{},
+		Removed: map[string]any{},
+		Changed: map[string]configChange{},
+	}
+	for _, key := range local.AllKeys() {
+		localValue := local.Get(key)
+		if !remote.IsSet(key) {
+			diff.Removed[key] = localValue
+			continue
+		}
+		if remoteValue := remote.Get(key); !reflect.DeepEqual(localValue, remoteValue) {
+			diff.Changed[key] = configChange{Local: localValue, Remote: remoteValue}
+		}
+	}
+	for _, key := range remote.AllKeys() {
+		if !local.IsSet(key) {
+			diff.Added[key] = remote.Get(key)
+		}
+	}
+	return diff
+}
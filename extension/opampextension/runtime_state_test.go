@@ -0,0 +1,211 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package opampextension
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/open-telemetry/opamp-go/protobufs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/confmap"
+	"go.uber.org/zap"
+)
+
+const testInternalTelemetry = `# HELP otelcol_exporter_queue_size Current size of the retry queue (in batches).
+# TYPE otelcol_exporter_queue_size gauge
+otelcol_exporter_queue_size{data_type="traces",exporter="otlp"} 12
+otelcol_exporter_queue_size{data_type="logs",exporter="otlp"} 3
+# HELP otelcol_exporter_send_failed_spans_total Number of spans in failed attempts to send to destination.
+# TYPE otelcol_exporter_send_failed_spans_total counter
+otelcol_exporter_send_failed_spans_total{exporter="otlp"} 5
+# HELP otelcol_receiver_refused_spans_total Number of spans that could not be pushed into the pipeline.
+# TYPE otelcol_receiver_refused_spans_total counter
+otelcol_receiver_refused_spans_total{receiver="otlp",transport="grpc"} 7
+# HELP otelcol_exporter_send_latency Duration of the send attempts.
+# TYPE otelcol_exporter_send_latency histogram
+otelcol_exporter_send_latency_bucket{exporter="otlp",le="+Inf"} 1
+otelcol_exporter_send_latency_sum{exporter="otlp"} 0.5
+otelcol_exporter_send_latency_count{exporter="otlp"} 1
+# HELP otelcol_process_uptime_seconds_total Uptime of the process.
+# TYPE otelcol_process_uptime_seconds_total counter
+otelcol_process_uptime_seconds_total 42
+`
+
+func newTestMetricsServer(t *testing.T, status int) *httptest.Server {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(testInternalTelemetry))
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func newTestRuntimeStateReporter(t *testing.T, endpoint string, effectiveConfig func() *confmap.Conf) (*customCapabilityRegistry, <-chan *protobufs.CustomMessage) {
+	sent := make(chan *protobufs.CustomMessage, 1)
+	client := mockCustomCapabilityClient{
+		sendCustomMessage: func(message *protobufs.CustomMessage) (chan struct{}, error) {
+			sent <- message
+			return make(chan struct{}), nil
+		},
+	}
+	registry := newCustomCapabilityRegistry(zap.NewNop(), client)
+	handler, err := registry.Register(runtimeStateCapability)
+	require.NoError(t, err)
+
+	reporter := newRuntimeStateReporter(zap.NewNop(), endpoint, handler, effectiveConfig)
+	reporter.start()
+	t.Cleanup(reporter.stop)
+	return registry, sent
+}
+
+func receiveMessage(t *testing.T, sent <-chan *protobufs.CustomMessage) *protobufs.CustomMessage {
+	select {
+	case msg := <-sent:
+		return msg
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "timed out waiting for the runtime state")
+		return nil
+	}
+}
+
+func TestRuntimeStateReporter_Components(t *testing.T) {
+	srv := newTestMetricsServer(t, http.StatusOK)
+	registry, sent := newTestRuntimeStateReporter(t, srv.URL, nil)
+
+	registry.ProcessMessage(&protobufs.CustomMessage{
+		Capability: runtimeStateCapability,
+		Type:       runtimeStateQueryMessageType,
+	})
+
+	msg := receiveMessage(t, sent)
+	require.Equal(t, runtimeStateCapability, msg.Capability)
+	require.Equal(t, runtimeStateMessageType, msg.Type)
+
+	var state runtimeState
+	require.NoError(t, json.Unmarshal(msg.Data, &state))
+	assert.Equal(t, map[string]map[string]float64{
+		"exporter:otlp": {
+			"queue_size":        15,
+			"send_failed_spans": 5,
+		},
+		"receiver:otlp": {
+			"refused_spans": 7,
+		},
+	}, state.Components)
+	assert.Nil(t, state.Pipelines)
+	assert.Nil(t, state.ConfigDiff)
+}
+
+func TestRuntimeStateReporter_ConfigDiff(t *testing.T) {
+	srv := newTestMetricsServer(t, http.StatusOK)
+	local := confmap.NewFromStringMap(map[string]any{
+		"receivers": map[string]any{
+			"otlp": map[string]any{"protocols": map[string]any{"grpc": nil}},
+		},
+		"exporters": map[string]any{
+			"otlp": map[string]any{"endpoint": "backend:4317"},
+		},
+		"service": map[string]any{
+			"pipelines": map[string]any{
+				"traces": map[string]any{
+					"receivers": []any{"otlp"},
+					"exporters": []any{"otlp"},
+				},
+			},
+		},
+	})
+	registry, sent := newTestRuntimeStateReporter(t, srv.URL, func() *confmap.Conf { return local })
+
+	query, err := json.Marshal(runtimeStateQuery{RemoteConfig: `
+exporters:
+  otlp:
+    endpoint: other:4317
+    compression: zstd
+service:
+  pipelines:
+    traces:
+      receivers: [otlp]
+      exporters: [otlp]
+`})
+	require.NoError(t, err)
+	registry.ProcessMessage(&protobufs.CustomMessage{
+		Capability: runtimeStateCapability,
+		Type:       runtimeStateQueryMessageType,
+		Data:       query,
+	})
+
+	msg := receiveMessage(t, sent)
+	require.Equal(t, runtimeStateMessageType, msg.Type, string(msg.Data))
+
+	var state runtimeState
+	require.NoError(t, json.Unmarshal(msg.Data, &state))
+	assert.Equal(t, map[string]map[string][]string{
+		"traces": {
+			"receivers": {"otlp"},
+			"exporters": {"otlp"},
+		},
+	}, state.Pipelines)
+	require.NotNil(t, state.ConfigDiff)
+	assert.Equal(t, map[string]any{"exporters::otlp::compression": "zstd"}, state.ConfigDiff.Added)
+	assert.Equal(t, map[string]any{"receivers::otlp::protocols::grpc": nil}, state.ConfigDiff.Removed)
+	assert.Equal(t, map[string]configChange{
+		"exporters::otlp::endpoint": {Local: "backend:4317", Remote: "other:4317"},
+	}, state.ConfigDiff.Changed)
+}
+
+func TestRuntimeStateReporter_Errors(t *testing.T) {
+	tests := []struct {
+		name            string
+		status          int
+		effectiveConfig func() *confmap.Conf
+		data            string
+		wantErr         string
+	}{
+		{
+			name:    "invalid query",
+			status:  http.StatusOK,
+			data:    "{",
+			wantErr: "invalid query: unexpected end of JSON input",
+		},
+		{
+			name:    "internal telemetry unavailable",
+			status:  http.StatusInternalServerError,
+			wantErr: "read internal telemetry: unexpected status code 500",
+		},
+		{
+			name:    "config diff without effective config",
+			status:  http.StatusOK,
+			data:    `{"remote_config":"receivers: {}"}`,
+			wantErr: "config diff requires the reports_effective_config capability",
+		},
+		{
+			name:            "invalid remote config",
+			status:          http.StatusOK,
+			effectiveConfig: func() *confmap.Conf { return confmap.New() },
+			data:            `{"remote_config":"[invalid"}`,
+			wantErr:         "invalid remote config",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := newTestMetricsServer(t, tt.status)
+			registry, sent := newTestRuntimeStateReporter(t, srv.URL, tt.effectiveConfig)
+
+			registry.ProcessMessage(&protobufs.CustomMessage{
+				Capability: runtimeStateCapability,
+				Type:       runtimeStateQueryMessageType,
+				Data:       []byte(tt.data),
+			})
+
+			msg := receiveMessage(t, sent)
+			assert.Equal(t, runtimeStateErrorMessageType, msg.Type)
+			assert.Contains(t, string(msg.Data), tt.wantErr)
+		})
+	}
+}
@@ -246,8 +246,12 @@ func intVal(dp pmetric.NumberDataPoint) int64 {
 
 func mergeHistogramDataPoints(dpsMap map[string]pmetric.HistogramDataPointSlice, to pmetric.HistogramDataPointSlice) {
 	for _, dps := range dpsMap {
+		// Data points with different explicit bounds are merged into the bounds they have in common,
+		// every bucket of a data point is entirely contained in one of these common buckets.
+		bounds := commonExplicitBounds(dps)
 		dp := to.AppendEmpty()
 		dps.At(0).MoveTo(dp)
+		rebucketHistogram(dp, bounds)
 		counts := dp.BucketCounts()
 		for i := 1; i < dps.Len(); i++ {
 			if dps.At(i).Count() == 0 {
@@ -261,8 +265,16 @@ func mergeHistogramDataPoints(dpsMap map[string]pmetric.HistogramDataPointSlice,
 			if dp.HasMax() && dp.Max() < dps.At(i).Max() {
 				dp.SetMax(dps.At(i).Max())
 			}
+			rebucketHistogram(dps.At(i), bounds)
+			if counts.Len() == 0 {
+				dps.At(i).ExplicitBounds().CopyTo(dp.ExplicitBounds())
+			}
 			for b := 0; b < dps.At(i).BucketCounts().Len(); b++ {
-				counts.SetAt(b, counts.At(b)+dps.At(i).BucketCounts().At(b))
+				if b < counts.Len() {
+					counts.SetAt(b, counts.At(b)+dps.At(i).BucketCounts().At(b))
+				} else {
+					counts.Append(dps.At(i).BucketCounts().At(b))
+				}
 			}
 			dps.At(i).Exemplars().MoveAndAppendTo(dp.Exemplars())
 			if dps.At(i).StartTimestamp() < dp.StartTimestamp() {
@@ -272,18 +284,66 @@ func mergeHistogramDataPoints(dpsMap map[string]pmetric.HistogramDataPointSlice,
 	}
 }
 
+// commonExplicitBounds returns the explicit bounds shared by all the data points having buckets.
+func commonExplicitBounds(dps pmetric.HistogramDataPointSlice) []float64 {
+	var bounds []float64
+	found := false
+	for i := 0; i < dps.Len(); i++ {
+		dp := dps.At(i)
+		if dp.BucketCounts().Len() == 0 {
+			continue
+		}
+		if !found {
+			bounds = dp.ExplicitBounds().AsRaw()
+			found = true
+			continue
+		}
+		dpBounds := dp.ExplicitBounds().AsRaw()
+		bounds = slices.DeleteFunc(bounds, func(b float64) bool {
+			return !slices.Contains(dpBounds, b)
+		})
+	}
+	return bounds
+}
+
+// rebucketHistogram moves the bucket counts of dp into the buckets delimited by the given explicit bounds,
+// which must be a subset of the explicit bounds of dp.
+func rebucketHistogram(dp pmetric.HistogramDataPoint, bounds []float64) {
+	if dp.BucketCounts().Len() == 0 || dp.ExplicitBounds().Len() == len(bounds) {
+		return
+	}
+	counts := make([]uint64, len(bounds)+1)
+	for i := 0; i < dp.BucketCounts().Len(); i++ {
+		// The bucket upper bound is either one of the common bounds or lower than the next one.
+		idx := len(bounds)
+		if i < dp.ExplicitBounds().Len() {
+			idx, _ = slices.BinarySearch(bounds, dp.ExplicitBounds().At(i))
+		}
+		counts[idx] += dp.BucketCounts().At(i)
+	}
+	dp.ExplicitBounds().FromRaw(bounds)
+	dp.BucketCounts().FromRaw(counts)
+}
+
 func mergeExponentialHistogramDataPoints(dpsMap map[string]pmetric.ExponentialHistogramDataPointSlice,
 	to pmetric.ExponentialHistogramDataPointSlice,
 ) {
 	for _, dps := range dpsMap {
+		// Data points with different scales are merged at the lowest scale of the group.
+		scale := dps.At(0).Scale()
+		for i := 1; i < dps.Len(); i++ {
+			scale = min(scale, dps.At(i).Scale())
+		}
 		dp := to.AppendEmpty()
 		dps.At(0).MoveTo(dp)
+		downscaleExponentialHistogram(dp, scale)
 		negatives := dp.Negative().BucketCounts()
 		positives := dp.Positive().BucketCounts()
 		for i := 1; i < dps.Len(); i++ {
 			if dps.At(i).Count() == 0 {
 				continue
 			}
+			downscaleExponentialHistogram(dps.At(i), scale)
 			dp.SetCount(dp.Count() + dps.At(i).Count())
 			dp.SetSum(dp.Sum() + dps.At(i).Sum())
 			dp.SetZeroCount(dp.ZeroCount() + dps.At(i).ZeroCount())
@@ -294,22 +354,8 @@ func mergeExponentialHistogramDataPoints(dpsMap map[string]pmetric.ExponentialHi
 				dp.SetMax(dps.At(i).Max())
 			}
 
-			// Check if offsets are different, indicating we need to adjust dp offsets and bucket counts after merging
-			negOffsetDiff := dp.Negative().Offset() != dps.At(i).Negative().Offset()
-			posOffsetDiff := dp.Positive().Offset() != dps.At(i).Positive().Offset()
-
-			mergeExponentialHistogramBuckets(negatives, dps.At(i).Negative().BucketCounts(), dp.Negative().Offset(), dps.At(i).Negative().Offset())
-			mergeExponentialHistogramBuckets(positives, dps.At(i).Positive().BucketCounts(), dp.Positive().Offset(), dps.At(i).Positive().Offset())
-
-			// Only adjust offsets and remove leading zero buckets if we had different offsets and the buckets are not empty
-			if negOffsetDiff && dps.At(i).Negative().BucketCounts().Len() != 0 {
-				dp.Negative().SetOffset(min(dp.Negative().Offset(), dps.At(i).Negative().Offset()))
-				trimBuckets(negatives)
-			}
-			if posOffsetDiff && dps.At(i).Positive().BucketCounts().Len() != 0 {
-				dp.Positive().SetOffset(min(dp.Positive().Offset(), dps.At(i).Positive().Offset()))
-				trimBuckets(positives)
-			}
+			dp.Negative().SetOffset(mergeExponentialHistogramBuckets(negatives, dps.At(i).Negative().BucketCounts(), dp.Negative().Offset(), dps.At(i).Negative().Offset()))
+			dp.Positive().SetOffset(mergeExponentialHistogramBuckets(positives, dps.At(i).Positive().BucketCounts(), dp.Positive().Offset(), dps.At(i).Positive().Offset()))
 
 			dps.At(i).Exemplars().MoveAndAppendTo(dp.Exemplars())
 			if dps.At(i).StartTimestamp() < dp.StartTimestamp() {
@@ -319,7 +365,44 @@ func mergeExponentialHistogramDataPoints(dpsMap map[string]pmetric.ExponentialHi
 	}
 }
 
-func mergeExponentialHistogramBuckets(tgt, src pcommon.UInt64Slice, tgtOff, srcOff int32) {
+// downscaleExponentialHistogram lowers the scale of dp to the given scale, merging its buckets accordingly.
+func downscaleExponentialHistogram(dp pmetric.ExponentialHistogramDataPoint, scale int32) {
+	change := dp.Scale() - scale
+	if change <= 0 {
+		return
+	}
+	downscaleExponentialHistogramBuckets(dp.Positive(), change)
+	downscaleExponentialHistogramBuckets(dp.Negative(), change)
+	dp.SetScale(scale)
+}
+
+func downscaleExponentialHistogramBuckets(buckets pmetric.ExponentialHistogramDataPointBuckets, change int32) {
+	// Lowering the scale by change merges 2^change consecutive buckets, the bucket at index i
+	// is moved to the bucket at index i >> change.
+	offset := buckets.Offset() >> change
+	counts := buckets.BucketCounts()
+	if counts.Len() == 0 {
+		buckets.SetOffset(offset)
+		return
+	}
+	downscaled := make([]uint64, ((buckets.Offset()+int32(counts.Len()-1))>>change)-offset+1)
+	for i := 0; i < counts.Len(); i++ {
+		downscaled[((buckets.Offset()+int32(i))>>change)-offset] += counts.At(i)
+	}
+	buckets.SetOffset(offset)
+	counts.FromRaw(downscaled)
+}
+
+// mergeExponentialHistogramBuckets adds the src buckets to the tgt buckets and returns the offset of the merged buckets.
+func mergeExponentialHistogramBuckets(tgt, src pcommon.UInt64Slice, tgtOff, srcOff int32) int32 {
+	if src.Len() == 0 {
+		return tgtOff
+	}
+	if tgt.Len() == 0 {
+		src.CopyTo(tgt)
+		return srcOff
+	}
+
 	// Both data points have the same offset - simple element-wise addition
 	if tgtOff == srcOff {
 		for b := 0; b < src.Len(); b++ {
@@ -329,53 +412,39 @@ func mergeExponentialHistogramBuckets(tgt, src pcommon.UInt64Slice, tgtOff, srcO
 				tgt.Append(src.At(b))
 			}
 		}
-		return
+		return tgtOff
 	}
 
-	// Source offset is less than target offset - source data point covers lower values
+	// Source offset is less than target offset - source data point covers lower values,
+	// shift the target buckets so that they start at the source offset.
 	if srcOff < tgtOff {
-		for b := src.Len() - 1; b >= 0; b-- {
-			count := src.At(b)
-			if count > 0 {
-				tgt.Append(0)
-				for i := tgt.Len() - 1; i > 0; i-- {
-					tgt.SetAt(i, tgt.At(i-1))
-				}
-				tgt.SetAt(0, count)
-			}
-		}
-		return
+		shifted := make([]uint64, int(tgtOff-srcOff)+tgt.Len())
+		copy(shifted[tgtOff-srcOff:], tgt.AsRaw())
+		tgt.FromRaw(shifted)
+		tgtOff = srcOff
 	}
 
-	// Source offset is greater than target offset - source data point covers higher values
 	for b := 0; b < src.Len(); b++ {
-		count := src.At(b)
-		if count == 0 {
-			continue
-		}
-
 		idx := b + int(srcOff-tgtOff)
-		if idx >= 0 {
-			if idx < tgt.Len() {
-				tgt.SetAt(idx, tgt.At(idx)+count)
-			} else {
-				for tgt.Len() <= idx {
-					tgt.Append(0)
-				}
-				tgt.SetAt(idx, tgt.At(idx)+count)
-			}
+		for tgt.Len() <= idx {
+			tgt.Append(0)
 		}
+		tgt.SetAt(idx, tgt.At(idx)+src.At(b))
 	}
+
+	// Remove leading zero buckets.
+	return tgtOff + trimBuckets(tgt)
 }
 
-func trimBuckets(buckets pcommon.UInt64Slice) {
+// trimBuckets removes the leading zero buckets and returns the number of removed buckets.
+func trimBuckets(buckets pcommon.UInt64Slice) int32 {
 	zeroCount := 0
 	for i := 0; i < buckets.Len() && buckets.At(i) == 0; i++ {
 		zeroCount++
 	}
 
 	if zeroCount == 0 {
-		return
+		return 0
 	}
 
 	newBuckets := make([]uint64, buckets.Len()-zeroCount)
@@ -383,6 +452,7 @@ func trimBuckets(buckets pcommon.UInt64Slice) {
 		newBuckets[i-zeroCount] = buckets.At(i)
 	}
 	buckets.FromRaw(newBuckets)
+	return int32(zeroCount)
 }
 
 func groupNumberDataPoints(dps pmetric.NumberDataPointSlice, useStartTime bool,
@@ -406,10 +476,7 @@ func groupHistogramDataPoints(dps pmetric.HistogramDataPointSlice, useStartTime
 ) {
 	for i := 0; i < dps.Len(); i++ {
 		dp := dps.At(i)
-		keyHashParts := make([]any, 0, dp.ExplicitBounds().Len()+4)
-		for b := 0; b < dp.ExplicitBounds().Len(); b++ {
-			keyHashParts = append(keyHashParts, dp.ExplicitBounds().At(b))
-		}
+		keyHashParts := make([]any, 0, 4)
 		if useStartTime {
 			keyHashParts = append(keyHashParts, dp.StartTimestamp().String())
 		}
@@ -429,7 +496,7 @@ func groupExponentialHistogramDataPoints(dps pmetric.ExponentialHistogramDataPoi
 	for i := 0; i < dps.Len(); i++ {
 		dp := dps.At(i)
 		keyHashParts := make([]any, 0, 4)
-		keyHashParts = append(keyHashParts, dp.Scale(), dp.HasMin(), dp.HasMax(), uint32(dp.Flags()))
+		keyHashParts = append(keyHashParts, dp.HasMin(), dp.HasMax(), uint32(dp.Flags()))
 		if useStartTime {
 			keyHashParts = append(keyHashParts, dp.StartTimestamp().String())
 		}
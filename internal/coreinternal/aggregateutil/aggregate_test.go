@@ -276,7 +276,7 @@ func Test_GroupDataPoints(t *testing.T) {
 
 	hashHistogram := dataPointHashKey(mapAttr, pcommon.NewTimestampFromTime(time.Time{}), false, false, 0)
 
-	hashExpHistogram := dataPointHashKey(mapAttr, pcommon.NewTimestampFromTime(time.Time{}), 0, false, false, 0)
+	hashExpHistogram := dataPointHashKey(mapAttr, pcommon.NewTimestampFromTime(time.Time{}), false, false, 0)
 
 	tests := []struct {
 		name     string
@@ -396,7 +396,7 @@ func Test_MergeDataPoints(t *testing.T) {
 
 	hashHistogram := dataPointHashKey(mapAttr, pcommon.NewTimestampFromTime(time.Time{}), false, false, 0)
 
-	hashExpHistogram := dataPointHashKey(mapAttr, pcommon.NewTimestampFromTime(time.Time{}), 0, false, false, 0)
+	hashExpHistogram := dataPointHashKey(mapAttr, pcommon.NewTimestampFromTime(time.Time{}), false, false, 0)
 
 	tests := []struct {
 		name     string
@@ -538,6 +538,91 @@ func Test_MergeDataPoints(t *testing.T) {
 				return m
 			},
 		},
+		{
+			name: "histogram with different bounds",
+			aggGroup: AggGroups{
+				histogram: map[string]pmetric.HistogramDataPointSlice{
+					hashHistogram: testDataHistogramWithDifferentBounds(),
+				},
+			},
+			typ: Sum,
+			want: func() pmetric.Metric {
+				m := pmetric.NewMetric()
+				s := m.SetEmptyHistogram()
+				d := s.DataPoints().AppendEmpty()
+				d.Attributes().PutStr("attr1", "val1")
+				d.SetCount(12)
+				d.SetSum(0)
+				// First data point: bounds [1, 5, 10], buckets [1, 2, 3, 0]
+				// Second data point: bounds [5, 10], buckets [4, 0, 2]
+				// Common bounds [5, 10]: [1+2+4, 3+0, 0+2] = [7, 3, 2]
+				d.ExplicitBounds().FromRaw([]float64{5, 10})
+				d.BucketCounts().FromRaw([]uint64{7, 3, 2})
+				return m
+			},
+			in: func() pmetric.Metric {
+				m := pmetric.NewMetric()
+				m.SetEmptyHistogram()
+				return m
+			},
+		},
+		{
+			name: "exp histogram with different scales",
+			aggGroup: AggGroups{
+				expHistogram: map[string]pmetric.ExponentialHistogramDataPointSlice{
+					hashExpHistogram: testDataExpHistogramWithDifferentScales(),
+				},
+			},
+			typ: Sum,
+			want: func() pmetric.Metric {
+				m := pmetric.NewMetric()
+				s := m.SetEmptyExponentialHistogram()
+				d := s.DataPoints().AppendEmpty()
+				d.Attributes().PutStr("attr1", "val1")
+				d.SetScale(0)
+				d.SetCount(15)
+				d.SetSum(0)
+				// First data point at scale 1: positive offset -1, buckets [1, 2, 3, 4] downscaled to
+				// offset -1, buckets [1, 2+3, 4].
+				// Second data point at scale 0: positive offset 0, buckets [5]
+				// Result: offset -1, buckets [1, 5+5, 4]
+				d.Positive().SetOffset(-1)
+				d.Positive().BucketCounts().FromRaw([]uint64{1, 10, 4})
+				return m
+			},
+			in: func() pmetric.Metric {
+				m := pmetric.NewMetric()
+				m.SetEmptyExponentialHistogram()
+				return m
+			},
+		},
+		{
+			name: "exp histogram with lower offset and empty buckets",
+			aggGroup: AggGroups{
+				expHistogram: map[string]pmetric.ExponentialHistogramDataPointSlice{
+					hashExpHistogram: testDataExpHistogramWithLowerOffset(),
+				},
+			},
+			typ: Sum,
+			want: func() pmetric.Metric {
+				m := pmetric.NewMetric()
+				s := m.SetEmptyExponentialHistogram()
+				d := s.DataPoints().AppendEmpty()
+				d.Attributes().PutStr("attr1", "val1")
+				d.SetCount(6)
+				d.SetSum(0)
+				// First data point: positive offset 5, buckets [3]
+				// Second data point: positive offset 0, buckets [1, 0, 2]
+				// Result: [1, 0, 2, 0, 0, 3]
+				d.Positive().BucketCounts().FromRaw([]uint64{1, 0, 2, 0, 0, 3})
+				return m
+			},
+			in: func() pmetric.Metric {
+				m := pmetric.NewMetric()
+				m.SetEmptyExponentialHistogram()
+				return m
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -656,3 +741,59 @@ func testDataExpHistogramWithDifferentOffsets() pmetric.ExponentialHistogramData
 
 	return dataWant
 }
+
+func testDataHistogramWithDifferentBounds() pmetric.HistogramDataPointSlice {
+	dataWant := pmetric.NewHistogramDataPointSlice()
+
+	dWant := dataWant.AppendEmpty()
+	dWant.Attributes().PutStr("attr1", "val1")
+	dWant.SetCount(6)
+	dWant.ExplicitBounds().FromRaw([]float64{1, 5, 10})
+	dWant.BucketCounts().FromRaw([]uint64{1, 2, 3, 0})
+
+	dWant2 := dataWant.AppendEmpty()
+	dWant2.Attributes().PutStr("attr1", "val1")
+	dWant2.SetCount(6)
+	dWant2.ExplicitBounds().FromRaw([]float64{5, 10})
+	dWant2.BucketCounts().FromRaw([]uint64{4, 0, 2})
+
+	return dataWant
+}
+
+func testDataExpHistogramWithDifferentScales() pmetric.ExponentialHistogramDataPointSlice {
+	dataWant := pmetric.NewExponentialHistogramDataPointSlice()
+
+	dWant := dataWant.AppendEmpty()
+	dWant.Attributes().PutStr("attr1", "val1")
+	dWant.SetScale(1)
+	dWant.SetCount(10)
+	dWant.Positive().SetOffset(-1)
+	dWant.Positive().BucketCounts().FromRaw([]uint64{1, 2, 3, 4})
+
+	dWant2 := dataWant.AppendEmpty()
+	dWant2.Attributes().PutStr("attr1", "val1")
+	dWant2.SetScale(0)
+	dWant2.SetCount(5)
+	dWant2.Positive().SetOffset(0)
+	dWant2.Positive().BucketCounts().FromRaw([]uint64{5})
+
+	return dataWant
+}
+
+func testDataExpHistogramWithLowerOffset() pmetric.ExponentialHistogramDataPointSlice {
+	dataWant := pmetric.NewExponentialHistogramDataPointSlice()
+
+	dWant := dataWant.AppendEmpty()
+	dWant.Attributes().PutStr("attr1", "val1")
+	dWant.SetCount(3)
+	dWant.Positive().SetOffset(5)
+	dWant.Positive().BucketCounts().FromRaw([]uint64{3})
+
+	dWant2 := dataWant.AppendEmpty()
+	dWant2.Attributes().PutStr("attr1", "val1")
+	dWant2.SetCount(3)
+	dWant2.Positive().SetOffset(0)
+	dWant2.Positive().BucketCounts().FromRaw([]uint64{1, 0, 2})
+
+	return dataWant
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlscope"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlspan"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlspanevent"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottltrace"
 )
 
 // NewBoolExprForSpan creates a BoolExpr[*ottlspan.TransformContext] that will return true if any of the given OTTL conditions evaluate to true.
@@ -59,6 +60,26 @@ func NewBoolExprForSpanEventWithOptions(conditions []string, functions map[strin
 	return &c, nil
 }
 
+// NewBoolExprForTrace creates a BoolExpr[*ottltrace.TransformContext] that will return true if any of the given OTTL conditions evaluate to true.
+// The passed in functions should use the ottltrace.TransformContext.
+func NewBoolExprForTrace(conditions []string, functions map[string]ottl.Factory[*ottltrace.TransformContext], errorMode ottl.ErrorMode, set component.TelemetrySettings) (*ottl.ConditionSequence[*ottltrace.TransformContext], error) {
+	return NewBoolExprForTraceWithOptions(conditions, functions, errorMode, set, nil)
+}
+
+// NewBoolExprForTraceWithOptions is like NewBoolExprForTrace, but with additional options.
+func NewBoolExprForTraceWithOptions(conditions []string, functions map[string]ottl.Factory[*ottltrace.TransformContext], errorMode ottl.ErrorMode, set component.TelemetrySettings, parserOptions []ottl.Option[*ottltrace.TransformContext]) (*ottl.ConditionSequence[*ottltrace.TransformContext], error) {
+	parser, err := ottltrace.NewParser(functions, set, parserOptions...)
+	if err != nil {
+		return nil, err
+	}
+	statements, err := parser.ParseConditions(conditions)
+	if err != nil {
+		return nil, err
+	}
+	c := ottltrace.NewConditionSequence(statements, set, ottltrace.WithConditionSequenceErrorMode(errorMode))
+	return &c, nil
+}
+
 // NewBoolExprForMetric creates a BoolExpr[*ottlmetric.TransformContext] that will return true if any of the given OTTL conditions evaluate to true.
 // The passed in functions should use the ottlmetric.TransformContext.
 // If a function named `match` is not present in the function map it will be added automatically so that parsing works as expected
@@ -8,6 +8,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/pdata/ptrace"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottldatapoint"
@@ -18,6 +19,7 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlscope"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlspan"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlspanevent"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottltrace"
 )
 
 func Test_NewBoolExprForSpan(t *testing.T) {
@@ -126,6 +128,50 @@ func Test_NewBoolExprForSpanEventWithOptions(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func Test_NewBoolExprForTrace(t *testing.T) {
+	td := ptrace.NewTraces()
+	spans := td.ResourceSpans().AppendEmpty().ScopeSpans().AppendEmpty().Spans()
+	spans.AppendEmpty().SetName("root")
+	child := spans.AppendEmpty()
+	child.SetParentSpanID([8]byte{1, 2, 3, 4, 5, 6, 7, 8})
+	child.Status().SetCode(ptrace.StatusCodeError)
+
+	tests := []struct {
+		name           string
+		conditions     []string
+		expectedResult bool
+	}{
+		{
+			name: "error count",
+			conditions: []string{
+				"trace.error_count > 0",
+			},
+			expectedResult: true,
+		},
+		{
+			name: "root span",
+			conditions: []string{
+				`trace.root_span.name == "other"`,
+				"trace.span_count > 2",
+			},
+			expectedResult: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			traceBoolExpr, err := NewBoolExprForTraceWithOptions(tt.conditions, StandardTraceFuncs(), ottl.PropagateError, componenttest.NewNopTelemetrySettings(), []ottl.Option[*ottltrace.TransformContext]{ottltrace.EnablePathContextNames()})
+			assert.NoError(t, err)
+			assert.NotNil(t, traceBoolExpr)
+			tCtx := ottltrace.NewTransformContextPtr(ottltrace.GroupSpansByTrace(td)[0])
+			defer tCtx.Close()
+			result, err := traceBoolExpr.Eval(t.Context(), tCtx)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedResult, result)
+		})
+	}
+}
+
 func Test_NewBoolExprForMetric(t *testing.T) {
 	tests := []struct {
 		name           string
@@ -18,6 +18,7 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlscope"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlspan"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlspanevent"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottltrace"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
 )
 
@@ -32,6 +33,10 @@ func StandardSpanEventFuncs() map[string]ottl.Factory[*ottlspanevent.TransformCo
 	return ottlfuncs.StandardConverters[*ottlspanevent.TransformContext]()
 }
 
+func StandardTraceFuncs() map[string]ottl.Factory[*ottltrace.TransformContext] {
+	return ottlfuncs.StandardConverters[*ottltrace.TransformContext]()
+}
+
 func StandardMetricFuncs() map[string]ottl.Factory[*ottlmetric.TransformContext] {
 	m := ottlfuncs.StandardConverters[*ottlmetric.TransformContext]()
 	hasAttributeOnDatapointFactory := newHasAttributeOnDatapointFactory()
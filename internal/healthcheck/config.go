@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config/configgrpc"
@@ -26,22 +27,36 @@ type (
 	PathConfig                   = http.PathConfig
 	GRPCConfig                   = grpc.Config
 	ComponentHealthConfig        = common.ComponentHealthConfig
+	DataFlowConfig               = common.DataFlowConfig
+	PipelineDataFlowConfig       = common.PipelineDataFlowConfig
 	CheckCollectorPipelineConfig = http.CheckCollectorPipelineConfig
 	ResponseBodyConfig           = http.ResponseBodyConfig
 )
 
 const (
-	httpConfigKey   = "http"
-	grpcConfigKey   = "grpc"
-	DefaultGRPCPort = 13132
-	DefaultHTTPPort = 13133
+	httpConfigKey     = "http"
+	grpcConfigKey     = "grpc"
+	dataFlowConfigKey = "data_flow"
+	DefaultGRPCPort   = 13132
+	DefaultHTTPPort   = 13133
+
+	// DefaultMetricsEndpoint is the default address of the collector's internal telemetry.
+	DefaultMetricsEndpoint = "http://localhost:8888/metrics"
+	// DefaultCheckInterval is the default interval of the data flow checks.
+	DefaultCheckInterval = 30 * time.Second
 )
 
 var (
-	ErrMissingProtocol      = errors.New("must specify at least one protocol")
-	ErrGRPCEndpointRequired = errors.New("grpc endpoint required")
-	ErrHTTPEndpointRequired = errors.New("http endpoint required")
-	ErrInvalidPath          = errors.New("path must start with /")
+	ErrMissingProtocol       = errors.New("must specify at least one protocol")
+	ErrGRPCEndpointRequired  = errors.New("grpc endpoint required")
+	ErrHTTPEndpointRequired  = errors.New("http endpoint required")
+	ErrInvalidPath           = errors.New("path must start with /")
+	ErrLivenessNeedsDataFlow = errors.New("http liveness requires data_flow")
+
+	ErrDataFlowMetricsEndpointRequired = common.ErrDataFlowMetricsEndpointRequired
+	ErrDataFlowInvalidCheckInterval    = common.ErrDataFlowInvalidCheckInterval
+	ErrDataFlowMissingPipelines        = common.ErrDataFlowMissingPipelines
+	ErrDataFlowInvalidPipeline         = common.ErrDataFlowInvalidPipeline
 )
 
 // endpointForPort returns a localhost endpoint for the given port.
@@ -63,6 +78,10 @@ type Config struct {
 
 	// ComponentHealthConfig is v2 config shared between http and grpc services
 	ComponentHealthConfig *common.ComponentHealthConfig `mapstructure:"component_health"`
+
+	// DataFlowConfig is v2 config for liveness based on data flow, shared between http and
+	// grpc services
+	DataFlowConfig *common.DataFlowConfig `mapstructure:"data_flow"`
 }
 
 var _ component.Config = (*Config)(nil)
@@ -93,6 +112,14 @@ func (c *Config) Validate() error {
 		if c.HTTPConfig.Config.Enabled && !strings.HasPrefix(c.HTTPConfig.Config.Path, "/") {
 			return ErrInvalidPath
 		}
+		if c.HTTPConfig.Liveness.Enabled {
+			if c.DataFlowConfig == nil {
+				return ErrLivenessNeedsDataFlow
+			}
+			if !strings.HasPrefix(c.HTTPConfig.Liveness.Path, "/") {
+				return ErrInvalidPath
+			}
+		}
 	}
 
 	if c.GRPCConfig != nil && c.GRPCConfig.NetAddr.Endpoint == "" {
@@ -124,6 +151,10 @@ func (c *Config) Unmarshal(conf *confmap.Conf) error {
 				Enabled: false,
 				Path:    "/config",
 			},
+			Liveness: http.PathConfig{
+				Enabled: false,
+				Path:    "/liveness",
+			},
 		}
 	}
 	if conf.IsSet(grpcConfigKey) {
@@ -137,6 +168,13 @@ func (c *Config) Unmarshal(conf *confmap.Conf) error {
 		}
 	}
 
+	if conf.IsSet(dataFlowConfigKey) {
+		c.DataFlowConfig = &common.DataFlowConfig{
+			MetricsEndpoint: DefaultMetricsEndpoint,
+			CheckInterval:   DefaultCheckInterval,
+		}
+	}
+
 	err := conf.Unmarshal(c)
 	if err != nil {
 		return err
@@ -181,6 +219,10 @@ func NewDefaultConfig() component.Config {
 				Enabled: false,
 				Path:    "/config",
 			},
+			Liveness: http.PathConfig{
+				Enabled: false,
+				Path:    "/liveness",
+			},
 		},
 		GRPCConfig: &grpc.Config{
 			ServerConfig: configgrpc.ServerConfig{
@@ -15,6 +15,7 @@ import (
 	"go.uber.org/multierr"
 	"go.uber.org/zap"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/healthcheck/internal/dataflow"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/healthcheck/internal/grpc"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/healthcheck/internal/http"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/status"
@@ -58,12 +59,19 @@ func NewHealthCheckExtension(
 
 	aggregator := status.NewAggregator(errPriority)
 
+	var liveness *dataflow.Checker
+	if config.UseV2 && config.DataFlowConfig != nil {
+		liveness = dataflow.NewChecker(config.DataFlowConfig, set.TelemetrySettings)
+		comps = append(comps, liveness)
+	}
+
 	if config.UseV2 && config.GRPCConfig != nil {
 		grpcServer := grpc.NewServer(
 			config.GRPCConfig,
 			config.ComponentHealthConfig,
 			set.TelemetrySettings,
 			aggregator,
+			liveness,
 		)
 		comps = append(comps, grpcServer)
 	}
@@ -75,6 +83,7 @@ func NewHealthCheckExtension(
 			config.ComponentHealthConfig,
 			set.TelemetrySettings,
 			aggregator,
+			liveness,
 		)
 		comps = append(comps, httpServer)
 	}
@@ -3,7 +3,13 @@
 
 package common // import "github.com/open-telemetry/opentelemetry-collector-contrib/internal/healthcheck/internal/common"
 
-import "time"
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/collector/pipeline"
+)
 
 type ComponentHealthConfig struct {
 	IncludePermanent   bool          `mapstructure:"include_permanent_errors"`
@@ -14,3 +20,72 @@ type ComponentHealthConfig struct {
 func (c ComponentHealthConfig) Enabled() bool {
 	return c.IncludePermanent || c.IncludeRecoverable
 }
+
+var (
+	ErrDataFlowMetricsEndpointRequired = errors.New("data_flow metrics_endpoint required")
+	ErrDataFlowInvalidCheckInterval    = errors.New("data_flow check_interval must be positive")
+	ErrDataFlowMissingPipelines        = errors.New("data_flow must configure at least one pipeline")
+	ErrDataFlowInvalidPipeline         = errors.New("invalid data_flow pipeline")
+)
+
+// DataFlowConfig is v2 config for liveness checks based on the data flowing through
+// pipelines. Data flow is measured with the exporter metrics of the collector's internal
+// telemetry, read from MetricsEndpoint.
+type DataFlowConfig struct {
+	// MetricsEndpoint is the URL of the collector's internal telemetry in the Prometheus
+	// text format.
+	MetricsEndpoint string `mapstructure:"metrics_endpoint"`
+
+	// CheckInterval is how often the internal telemetry is read and the checks evaluated.
+	CheckInterval time.Duration `mapstructure:"check_interval"`
+
+	// Pipelines holds the checks for each pipeline.
+	Pipelines map[pipeline.ID]PipelineDataFlowConfig `mapstructure:"pipelines"`
+}
+
+// PipelineDataFlowConfig holds the data flow checks of a single pipeline.
+type PipelineDataFlowConfig struct {
+	// Window is the period the checks are evaluated over.
+	Window time.Duration `mapstructure:"window"`
+
+	// MinItems is the minimum number of items the pipeline's exporters must have sent
+	// during the window. Zero disables the check.
+	MinItems int64 `mapstructure:"min_items"`
+
+	// MaxExporterFailureRatio is the maximum ratio of items the pipeline's exporters failed
+	// to send during the window. Zero disables the check.
+	MaxExporterFailureRatio float64 `mapstructure:"max_exporter_failure_ratio"`
+}
+
+// Validate checks if the data flow configuration is valid
+func (c *DataFlowConfig) Validate() error {
+	if c.MetricsEndpoint == "" {
+		return ErrDataFlowMetricsEndpointRequired
+	}
+	if c.CheckInterval <= 0 {
+		return ErrDataFlowInvalidCheckInterval
+	}
+	if len(c.Pipelines) == 0 {
+		return ErrDataFlowMissingPipelines
+	}
+	for id, pc := range c.Pipelines {
+		switch id.Signal() {
+		case pipeline.SignalTraces, pipeline.SignalMetrics, pipeline.SignalLogs:
+		default:
+			return fmt.Errorf("%w %q: signal %q is not supported", ErrDataFlowInvalidPipeline, id, id.Signal())
+		}
+		if pc.Window <= 0 {
+			return fmt.Errorf("%w %q: window must be positive", ErrDataFlowInvalidPipeline, id)
+		}
+		if pc.MinItems < 0 {
+			return fmt.Errorf("%w %q: min_items must not be negative", ErrDataFlowInvalidPipeline, id)
+		}
+		if pc.MaxExporterFailureRatio < 0 || pc.MaxExporterFailureRatio > 1 {
+			return fmt.Errorf("%w %q: max_exporter_failure_ratio must be between 0 and 1", ErrDataFlowInvalidPipeline, id)
+		}
+		if pc.MinItems == 0 && pc.MaxExporterFailureRatio == 0 {
+			return fmt.Errorf("%w %q: min_items or max_exporter_failure_ratio must be set", ErrDataFlowInvalidPipeline, id)
+		}
+	}
+	return nil
+}
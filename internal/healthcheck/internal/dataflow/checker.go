@@ -0,0 +1,305 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package dataflow // import "github.com/open-telemetry/opentelemetry-collector-contrib/internal/healthcheck/internal/dataflow"
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/confmap"
+	"go.opentelemetry.io/collector/extension/extensioncapabilities"
+	"go.opentelemetry.io/collector/pipeline"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/healthcheck/internal/common"
+)
+
+// PipelineStatus is the result of the data flow checks of a pipeline.
+type PipelineStatus struct {
+	Healthy      bool      `json:"healthy"`
+	Reason       string    `json:"reason,omitempty"`
+	Window       string    `json:"window"`
+	Items        int64     `json:"items"`
+	FailedItems  int64     `json:"failed_items"`
+	FailureRatio float64   `json:"failure_ratio"`
+	Timestamp    time.Time `json:"timestamp"`
+}
+
+// Report is the liveness of the collector, or of a single pipeline, based on data flow.
+type Report struct {
+	Healthy   bool                      `json:"healthy"`
+	Pipelines map[string]PipelineStatus `json:"pipelines"`
+}
+
+// maxFailedScrapes is the number of consecutive failures to read the internal telemetry
+// after which the pipelines are reported unhealthy.
+const maxFailedScrapes = 3
+
+type sample struct {
+	timestamp time.Time
+	sent      float64
+	failed    float64
+}
+
+// serviceConfig is the part of the collector configuration needed to find the exporters
+// of each pipeline.
+type serviceConfig struct {
+	Service struct {
+		Pipelines map[pipeline.ID]struct {
+			Exporters []component.ID `mapstructure:"exporters"`
+		} `mapstructure:"pipelines"`
+	} `mapstructure:"service"`
+}
+
+// Checker periodically reads the exporter metrics of the collector's internal telemetry and
+// evaluates, for each configured pipeline, whether its exporters sent enough items during
+// the window and whether the ratio of items they failed to send stays below the maximum.
+type Checker struct {
+	config    *common.DataFlowConfig
+	telemetry component.TelemetrySettings
+	client    *http.Client
+
+	mu            sync.RWMutex
+	exporters     map[pipeline.ID][]component.ID
+	samples       map[pipeline.ID][]sample
+	statuses      map[pipeline.ID]PipelineStatus
+	failedScrapes int
+
+	stopCh chan struct{}
+	doneWg sync.WaitGroup
+}
+
+var (
+	_ component.Component                 = (*Checker)(nil)
+	_ extensioncapabilities.ConfigWatcher = (*Checker)(nil)
+)
+
+func NewChecker(config *common.DataFlowConfig, telemetry component.TelemetrySettings) *Checker {
+	c := &Checker{
+		config:    config,
+		telemetry: telemetry,
+		client:    &http.Client{Timeout: config.CheckInterval},
+		samples:   map[pipeline.ID][]sample{},
+		statuses:  map[pipeline.ID]PipelineStatus{},
+		stopCh:    make(chan struct{}),
+	}
+	for id, pc := range config.Pipelines {
+		c.statuses[id] = PipelineStatus{
+			Healthy: true,
+			Reason:  "waiting for the first check",
+			Window:  pc.Window.String(),
+		}
+	}
+	return c
+}
+
+// Start implements the component.Component interface.
+func (c *Checker) Start(context.Context, component.Host) error {
+	c.doneWg.Go(func() {
+		ticker := time.NewTicker(c.config.CheckInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				c.check()
+			case <-c.stopCh:
+				return
+			}
+		}
+	})
+	return nil
+}
+
+// Shutdown implements the component.Component interface.
+func (c *Checker) Shutdown(context.Context) error {
+	select {
+	case <-c.stopCh:
+	default:
+		close(c.stopCh)
+	}
+	c.doneWg.Wait()
+	c.client.CloseIdleConnections()
+	return nil
+}
+
+// NotifyConfig implements the extensioncapabilities.ConfigWatcher interface.
+func (c *Checker) NotifyConfig(_ context.Context, conf *confmap.Conf) error {
+	var sc serviceConfig
+	if err := conf.Unmarshal(&sc, confmap.WithIgnoreUnused()); err != nil {
+		c.telemetry.Logger.Warn("could not read pipelines from config", zap.Error(err))
+		return err
+	}
+	exporters := make(map[pipeline.ID][]component.ID, len(sc.Service.Pipelines))
+	for id, p := range sc.Service.Pipelines {
+		exporters[id] = p.Exporters
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.exporters = exporters
+	// Counters of different exporters cannot be compared, start over.
+	c.samples = map[pipeline.ID][]sample{}
+	return nil
+}
+
+// Interval returns how often the checks are evaluated.
+func (c *Checker) Interval() time.Duration {
+	return c.config.CheckInterval
+}
+
+// Report returns the liveness of the pipeline with the given name, or of all configured
+// pipelines if the name is empty. It returns false if the pipeline has no data flow checks.
+func (c *Checker) Report(name string) (*Report, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	report := &Report{Healthy: true, Pipelines: map[string]PipelineStatus{}}
+	if name != "" {
+		var id pipeline.ID
+		if err := id.UnmarshalText([]byte(name)); err != nil {
+			return nil, false
+		}
+		st, ok := c.statuses[id]
+		if !ok {
+			return nil, false
+		}
+		report.Healthy = st.Healthy
+		report.Pipelines[name] = st
+		return report, true
+	}
+	for id, st := range c.statuses {
+		report.Healthy = report.Healthy && st.Healthy
+		report.Pipelines[id.String()] = st
+	}
+	return report, true
+}
+
+func (c *Checker) check() {
+	cs, err := c.scrape()
+	if err != nil {
+		c.telemetry.Logger.Warn("could not read internal telemetry", zap.Error(err))
+		c.scrapeFailed(err, time.Now())
+		return
+	}
+	c.record(cs, time.Now())
+}
+
+// scrape reads the exporter counters from the internal telemetry metrics endpoint.
+func (c *Checker) scrape() (counters, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), c.config.CheckInterval)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.config.MetricsEndpoint, http.NoBody)
+	if err != nil {
+		return counters{}, err
+	}
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return counters{}, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return counters{}, fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+	cs, err := parseCounters(resp.Body)
+	if err != nil {
+		return counters{}, fmt.Errorf("could not parse internal telemetry: %w", err)
+	}
+	return cs, nil
+}
+
+// scrapeFailed records a failure to read the internal telemetry. Once it failed
+// maxFailedScrapes consecutive times, the data flow is unknown and all pipelines are
+// reported unhealthy rather than keeping their last status.
+func (c *Checker) scrapeFailed(err error, now time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.failedScrapes++
+	if c.failedScrapes < maxFailedScrapes {
+		return
+	}
+	for id, pc := range c.config.Pipelines {
+		c.statuses[id] = PipelineStatus{
+			Healthy:   false,
+			Reason:    fmt.Sprintf("internal telemetry could not be read %d consecutive times: %v", c.failedScrapes, err),
+			Window:    pc.Window.String(),
+			Timestamp: now,
+		}
+	}
+}
+
+// record adds a sample of the counters taken at now and evaluates the checks.
+func (c *Checker) record(cs counters, now time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.failedScrapes = 0
+
+	for id, pc := range c.config.Pipelines {
+		st := PipelineStatus{Healthy: true, Window: pc.Window.String(), Timestamp: now}
+
+		exporters, ok := c.exporters[id]
+		if !ok {
+			st.Healthy = false
+			st.Reason = "pipeline not found in the collector configuration"
+			c.statuses[id] = st
+			continue
+		}
+
+		latest := sample{timestamp: now}
+		items := itemsBySignal[id.Signal()]
+		for _, exporter := range exporters {
+			key := counterKey{items: items, exporter: exporter.String()}
+			latest.sent += cs.sent[key]
+			latest.failed += cs.failed[key]
+		}
+
+		// Keep the samples within the window and the most recent one before it, which is
+		// the baseline the deltas are computed from.
+		samples := c.samples[id]
+		samples = append(samples, latest)
+		start := now.Add(-pc.Window)
+		first := 0
+		for i, s := range samples {
+			if s.timestamp.After(start) {
+				break
+			}
+			first = i
+		}
+		samples = append(samples[:0], samples[first:]...)
+		c.samples[id] = samples
+
+		baseline := samples[0]
+		if baseline.timestamp.After(start) {
+			st.Reason = "collecting data for the window"
+			c.statuses[id] = st
+			continue
+		}
+
+		sent, failed := latest.sent-baseline.sent, latest.failed-baseline.failed
+		if sent < 0 || failed < 0 {
+			// The counters were reset.
+			sent, failed = latest.sent, latest.failed
+		}
+		st.Items, st.FailedItems = int64(sent), int64(failed)
+		if total := sent + failed; total > 0 {
+			st.FailureRatio = failed / total
+		}
+
+		switch {
+		case pc.MinItems > 0 && st.Items < pc.MinItems:
+			st.Healthy = false
+			st.Reason = fmt.Sprintf("%d items sent during the window, at least %d required", st.Items, pc.MinItems)
+		case pc.MaxExporterFailureRatio > 0 && st.FailureRatio > pc.MaxExporterFailureRatio:
+			st.Healthy = false
+			st.Reason = fmt.Sprintf("exporter failure ratio %.3f exceeds %.3f", st.FailureRatio, pc.MaxExporterFailureRatio)
+		}
+		c.statuses[id] = st
+	}
+}
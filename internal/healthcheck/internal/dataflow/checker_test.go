@@ -0,0 +1,203 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package dataflow // import "github.com/open-telemetry/opentelemetry-collector-contrib/internal/healthcheck/internal/dataflow"
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/confmap"
+	"go.opentelemetry.io/collector/pipeline"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/healthcheck/internal/common"
+)
+
+var (
+	tracesID  = pipeline.NewID(pipeline.SignalTraces)
+	metricsID = pipeline.NewIDWithName(pipeline.SignalMetrics, "other")
+)
+
+func newTestChecker(t *testing.T, endpoint string) *Checker {
+	checker := NewChecker(&common.DataFlowConfig{
+		MetricsEndpoint: endpoint,
+		CheckInterval:   10 * time.Millisecond,
+		Pipelines: map[pipeline.ID]common.PipelineDataFlowConfig{
+			tracesID: {
+				Window:                  time.Minute,
+				MinItems:                10,
+				MaxExporterFailureRatio: 0.5,
+			},
+		},
+	}, componenttest.NewNopTelemetrySettings())
+
+	require.NoError(t, checker.NotifyConfig(t.Context(), confmap.NewFromStringMap(map[string]any{
+		"exporters": map[string]any{"otlp": nil, "debug": nil},
+		"service": map[string]any{
+			"pipelines": map[string]any{
+				"traces": map[string]any{
+					"receivers": []any{"otlp"},
+					"exporters": []any{"otlp", "debug"},
+				},
+				metricsID.String(): map[string]any{
+					"receivers": []any{"otlp"},
+					"exporters": []any{"otlp"},
+				},
+			},
+		},
+	})))
+	return checker
+}
+
+func spanCounters(exporter string, sent, failed float64) counters {
+	return counters{
+		sent:   map[counterKey]float64{{items: "spans", exporter: exporter}: sent},
+		failed: map[counterKey]float64{{items: "spans", exporter: exporter}: failed},
+	}
+}
+
+func tracesStatus(t *testing.T, checker *Checker) PipelineStatus {
+	report, ok := checker.Report("traces")
+	require.True(t, ok)
+	return report.Pipelines["traces"]
+}
+
+func TestCheckerRecord(t *testing.T) {
+	checker := newTestChecker(t, "")
+	start := time.Now()
+
+	st := tracesStatus(t, checker)
+	assert.True(t, st.Healthy)
+	assert.Equal(t, "waiting for the first check", st.Reason)
+
+	checker.record(spanCounters("otlp", 100, 0), start)
+	st = tracesStatus(t, checker)
+	assert.True(t, st.Healthy)
+	assert.Equal(t, "collecting data for the window", st.Reason)
+
+	// Enough items sent during the window.
+	checker.record(spanCounters("otlp", 150, 10), start.Add(30*time.Second))
+	checker.record(spanCounters("otlp", 200, 10), start.Add(time.Minute))
+	st = tracesStatus(t, checker)
+	assert.True(t, st.Healthy)
+	assert.Empty(t, st.Reason)
+	assert.Equal(t, int64(100), st.Items)
+	assert.Equal(t, int64(10), st.FailedItems)
+	assert.InDelta(t, 10.0/110.0, st.FailureRatio, 1e-9)
+
+	// Data stopped flowing.
+	checker.record(spanCounters("otlp", 205, 10), start.Add(90*time.Second))
+	checker.record(spanCounters("otlp", 205, 10), start.Add(2*time.Minute))
+	st = tracesStatus(t, checker)
+	assert.False(t, st.Healthy)
+	assert.Equal(t, int64(5), st.Items)
+	assert.Equal(t, "5 items sent during the window, at least 10 required", st.Reason)
+
+	// Exporters failing.
+	checker.record(spanCounters("otlp", 305, 210), start.Add(3*time.Minute))
+	st = tracesStatus(t, checker)
+	assert.False(t, st.Healthy)
+	assert.Equal(t, "exporter failure ratio 0.667 exceeds 0.500", st.Reason)
+
+	report, ok := checker.Report("")
+	require.True(t, ok)
+	assert.False(t, report.Healthy)
+	assert.Len(t, report.Pipelines, 1)
+}
+
+func TestCheckerCounterReset(t *testing.T) {
+	checker := newTestChecker(t, "")
+	start := time.Now()
+	checker.record(spanCounters("debug", 1000, 0), start)
+	checker.record(spanCounters("debug", 20, 0), start.Add(time.Minute))
+
+	st := tracesStatus(t, checker)
+	assert.True(t, st.Healthy)
+	assert.Equal(t, int64(20), st.Items)
+}
+
+func TestCheckerPipelineNotFound(t *testing.T) {
+	checker := NewChecker(&common.DataFlowConfig{
+		CheckInterval: time.Second,
+		Pipelines: map[pipeline.ID]common.PipelineDataFlowConfig{
+			tracesID: {Window: time.Minute, MinItems: 1},
+		},
+	}, componenttest.NewNopTelemetrySettings())
+	require.NoError(t, checker.NotifyConfig(t.Context(), confmap.New()))
+
+	checker.record(counters{}, time.Now())
+	st := tracesStatus(t, checker)
+	assert.False(t, st.Healthy)
+	assert.Equal(t, "pipeline not found in the collector configuration", st.Reason)
+}
+
+func TestCheckerReportNotFound(t *testing.T) {
+	checker := newTestChecker(t, "")
+	for _, name := range []string{metricsID.String(), "logs", "invalid/"} {
+		_, ok := checker.Report(name)
+		assert.False(t, ok, name)
+	}
+}
+
+func TestCheckerScrapes(t *testing.T) {
+	var sent atomic.Int64
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		fmt.Fprintf(w, "otelcol_exporter_sent_spans_total{exporter=\"otlp\"} %d\n", sent.Add(100))
+	}))
+	defer ts.Close()
+
+	checker := newTestChecker(t, ts.URL)
+	require.NoError(t, checker.Start(t.Context(), componenttest.NewNopHost()))
+	defer func() {
+		require.NoError(t, checker.Shutdown(t.Context()))
+	}()
+
+	assert.Eventually(t, func() bool {
+		checker.mu.RLock()
+		defer checker.mu.RUnlock()
+		return len(checker.samples[tracesID]) > 1
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestCheckerScrapeFailures(t *testing.T) {
+	var fail atomic.Bool
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		if fail.Load() {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		fmt.Fprintln(w, "otelcol_exporter_sent_spans_total{exporter=\"otlp\"} 100")
+	}))
+	defer ts.Close()
+
+	checker := newTestChecker(t, ts.URL)
+	checker.config.CheckInterval = time.Second
+	checker.client.Timeout = time.Second
+	checker.check()
+	assert.True(t, tracesStatus(t, checker).Healthy)
+
+	fail.Store(true)
+	for range maxFailedScrapes - 1 {
+		checker.check()
+	}
+	assert.True(t, tracesStatus(t, checker).Healthy)
+
+	checker.check()
+	st := tracesStatus(t, checker)
+	assert.False(t, st.Healthy)
+	assert.Equal(t, "internal telemetry could not be read 3 consecutive times: unexpected status code 500", st.Reason)
+
+	// A successful scrape resumes the checks.
+	fail.Store(false)
+	checker.check()
+	st = tracesStatus(t, checker)
+	assert.True(t, st.Healthy)
+	assert.Equal(t, "collecting data for the window", st.Reason)
+}
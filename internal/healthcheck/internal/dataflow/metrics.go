@@ -0,0 +1,93 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package dataflow // import "github.com/open-telemetry/opentelemetry-collector-contrib/internal/healthcheck/internal/dataflow"
+
+import (
+	"io"
+	"strings"
+
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
+	"github.com/prometheus/common/model"
+	"go.opentelemetry.io/collector/pipeline"
+)
+
+const (
+	sentPrefix   = "otelcol_exporter_sent_"
+	failedPrefix = "otelcol_exporter_send_failed_"
+	totalSuffix  = "_total"
+	exporterKey  = "exporter"
+)
+
+// itemsBySignal maps a pipeline signal to the item name used by the exporter metrics.
+var itemsBySignal = map[pipeline.Signal]string{
+	pipeline.SignalTraces:  "spans",
+	pipeline.SignalMetrics: "metric_points",
+	pipeline.SignalLogs:    "log_records",
+}
+
+type counterKey struct {
+	items    string
+	exporter string
+}
+
+// counters holds the number of items sent and failed by each exporter.
+type counters struct {
+	sent   map[counterKey]float64
+	failed map[counterKey]float64
+}
+
+// parseCounters reads the exporter counters from internal telemetry in the Prometheus text
+// format. Series of the same exporter, e.g. with different data types, are summed.
+func parseCounters(r io.Reader) (counters, error) {
+	c := counters{
+		sent:   map[counterKey]float64{},
+		failed: map[counterKey]float64{},
+	}
+	parser := expfmt.NewTextParser(model.UTF8Validation)
+	families, err := parser.TextToMetricFamilies(r)
+	if err != nil {
+		return counters{}, err
+	}
+	for name, family := range families {
+		name = strings.TrimSuffix(name, totalSuffix)
+
+		var target map[counterKey]float64
+		var items string
+		switch {
+		case strings.HasPrefix(name, sentPrefix):
+			target, items = c.sent, strings.TrimPrefix(name, sentPrefix)
+		case strings.HasPrefix(name, failedPrefix):
+			target, items = c.failed, strings.TrimPrefix(name, failedPrefix)
+		default:
+			continue
+		}
+
+		for _, m := range family.GetMetric() {
+			value, ok := counterValue(family.GetType(), m)
+			if !ok {
+				continue
+			}
+			var exporter string
+			for _, label := range m.GetLabel() {
+				if label.GetName() == exporterKey {
+					exporter = label.GetValue()
+				}
+			}
+			target[counterKey{items: items, exporter: exporter}] += value
+		}
+	}
+	return c, nil
+}
+
+func counterValue(metricType dto.MetricType, m *dto.Metric) (float64, bool) {
+	switch metricType {
+	case dto.MetricType_COUNTER:
+		return m.GetCounter().GetValue(), true
+	case dto.MetricType_UNTYPED:
+		return m.GetUntyped().GetValue(), true
+	default:
+		return 0, false
+	}
+}
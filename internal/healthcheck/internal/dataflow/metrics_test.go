@@ -0,0 +1,72 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package dataflow // import "github.com/open-telemetry/opentelemetry-collector-contrib/internal/healthcheck/internal/dataflow"
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testMetrics = `# HELP otelcol_exporter_sent_spans_total Number of spans successfully sent to destination.
+# TYPE otelcol_exporter_sent_spans_total counter
+otelcol_exporter_sent_spans_total{exporter="otlp",otel_scope_name="go.opentelemetry.io/collector/exporter/exporterhelper"} 100
+otelcol_exporter_sent_spans_total{exporter="otlp/2",service_name="a \"quoted\", {braced} value"} 5
+otelcol_exporter_send_failed_spans_total{exporter="otlp"} 10
+otelcol_exporter_sent_log_records{exporter="debug",data_type="logs"} 3 1700000000000
+otelcol_exporter_sent_log_records{exporter="debug",data_type="profiles"} 4
+otelcol_exporter_sent_metric_points_total 7
+otelcol_receiver_accepted_spans_total{receiver="otlp"} 1000
+`
+
+func TestParseCounters(t *testing.T) {
+	cs, err := parseCounters(strings.NewReader(testMetrics))
+	require.NoError(t, err)
+
+	assert.Equal(t, map[counterKey]float64{
+		{items: "spans", exporter: "otlp"}:        100,
+		{items: "spans", exporter: "otlp/2"}:      5,
+		{items: "log_records", exporter: "debug"}: 7,
+		{items: "metric_points", exporter: ""}:    7,
+	}, cs.sent)
+	assert.Equal(t, map[counterKey]float64{
+		{items: "spans", exporter: "otlp"}: 10,
+	}, cs.failed)
+}
+
+func TestParseCountersInvalid(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{
+			name:  "unterminated label set",
+			input: `otelcol_exporter_sent_spans_total{exporter="otlp"`,
+		},
+		{
+			name:  "unterminated label value",
+			input: `otelcol_exporter_sent_spans_total{exporter="otlp} 1`,
+		},
+		{
+			name:  "invalid label",
+			input: `otelcol_exporter_sent_spans_total{exporter} 1`,
+		},
+		{
+			name:  "missing value",
+			input: `otelcol_exporter_sent_spans_total{exporter="otlp"}`,
+		},
+		{
+			name:  "invalid value",
+			input: `otelcol_exporter_sent_spans_total{exporter="otlp"} many`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := parseCounters(strings.NewReader(tt.input + "\n"))
+			require.Error(t, err)
+		})
+	}
+}
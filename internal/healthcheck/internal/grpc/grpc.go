@@ -5,6 +5,7 @@ package grpc // import "github.com/open-telemetry/opentelemetry-collector-contri
 
 import (
 	"context"
+	"strings"
 	"time"
 
 	"go.opentelemetry.io/collector/component/componentstatus"
@@ -15,6 +16,13 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/status"
 )
 
+const (
+	// livenessService is the service reporting the data flow liveness of the collector.
+	// The liveness of a single pipeline is reported by the service "liveness:<pipeline>".
+	livenessService       = "liveness"
+	livenessServicePrefix = livenessService + ":"
+)
+
 var (
 	errNotFound     = grpcstatus.Error(codes.NotFound, "Service not found.")
 	errShuttingDown = grpcstatus.Error(codes.Canceled, "Server shutting down.")
@@ -37,6 +45,14 @@ func (s *Server) Check(
 	_ context.Context,
 	req *healthpb.HealthCheckRequest,
 ) (*healthpb.HealthCheckResponse, error) {
+	if pipeline, ok := s.livenessScope(req.Service); ok {
+		sst, ok := s.livenessServingStatus(pipeline)
+		if !ok {
+			return nil, errNotFound
+		}
+		return &healthpb.HealthCheckResponse{Status: sst}, nil
+	}
+
 	st, ok := s.aggregator.AggregateStatus(status.Scope(req.Service), status.Concise)
 	if !ok {
 		return nil, errNotFound
@@ -48,6 +64,10 @@ func (s *Server) Check(
 }
 
 func (s *Server) Watch(req *healthpb.HealthCheckRequest, stream healthpb.Health_WatchServer) error {
+	if pipeline, ok := s.livenessScope(req.Service); ok {
+		return s.watchLiveness(pipeline, stream)
+	}
+
 	sub, unsub := s.aggregator.Subscribe(status.Scope(req.Service), status.Concise)
 	defer unsub()
 
@@ -135,3 +155,52 @@ func (s *Server) toServingStatus(
 
 	return statusToServingStatusMap[ev.Status()]
 }
+
+// livenessScope reports whether service is a liveness service and returns the pipeline it
+// refers to, empty for the collector.
+func (s *Server) livenessScope(service string) (string, bool) {
+	if s.liveness == nil {
+		return "", false
+	}
+	if service == livenessService {
+		return "", true
+	}
+	return strings.CutPrefix(service, livenessServicePrefix)
+}
+
+func (s *Server) livenessServingStatus(pipeline string) (healthpb.HealthCheckResponse_ServingStatus, bool) {
+	report, ok := s.liveness.Report(pipeline)
+	if !ok {
+		return healthpb.HealthCheckResponse_SERVICE_UNKNOWN, false
+	}
+	if !report.Healthy {
+		return healthpb.HealthCheckResponse_NOT_SERVING, true
+	}
+	return healthpb.HealthCheckResponse_SERVING, true
+}
+
+// watchLiveness sends the liveness of the pipeline every time it changes. Checks run
+// periodically, so the liveness is polled at the check interval.
+func (s *Server) watchLiveness(pipeline string, stream healthpb.Health_WatchServer) error {
+	ticker := time.NewTicker(s.liveness.Interval())
+	defer ticker.Stop()
+
+	var lastServingStatus healthpb.HealthCheckResponse_ServingStatus = -1
+	for {
+		sst, _ := s.livenessServingStatus(pipeline)
+		if sst != lastServingStatus {
+			lastServingStatus = sst
+			if err := stream.Send(&healthpb.HealthCheckResponse{Status: sst}); err != nil {
+				return errStreamSend
+			}
+		}
+
+		select {
+		case <-ticker.C:
+		case <-s.stopCh:
+			return errShuttingDown
+		case <-stream.Context().Done():
+			return errStreamEnded
+		}
+	}
+}
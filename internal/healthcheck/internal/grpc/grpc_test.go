@@ -698,6 +698,7 @@ func TestCheck(t *testing.T) {
 				tc.componentHealthSettings,
 				componenttest.NewNopTelemetrySettings(),
 				status.NewAggregator(internalhelpers.ErrPriority(tc.componentHealthSettings)),
+				nil,
 			)
 			require.NoError(t, server.Start(t.Context(), componenttest.NewNopHost()))
 			t.Cleanup(func() {
@@ -1540,6 +1541,7 @@ func TestWatch(t *testing.T) {
 				tc.componentHealthSettings,
 				componenttest.NewNopTelemetrySettings(),
 				status.NewAggregator(internalhelpers.ErrPriority(tc.componentHealthSettings)),
+				nil,
 			)
 			require.NoError(t, server.Start(t.Context(), componenttest.NewNopHost()))
 			t.Cleanup(func() {
@@ -1608,3 +1610,57 @@ func TestWatch(t *testing.T) {
 		})
 	}
 }
+
+func TestLiveness(t *testing.T) {
+	addr := testutil.GetAvailableLocalAddress(t)
+	server := NewServer(
+		&Config{
+			ServerConfig: configgrpc.ServerConfig{
+				NetAddr: confignet.AddrConfig{
+					Endpoint:  addr,
+					Transport: confignet.TransportTypeTCP,
+				},
+			},
+		},
+		&common.ComponentHealthConfig{},
+		componenttest.NewNopTelemetrySettings(),
+		status.NewAggregator(status.PriorityPermanent),
+		internalhelpers.NewLivenessChecker(t),
+	)
+	require.NoError(t, server.Start(t.Context(), componenttest.NewNopHost()))
+	t.Cleanup(func() {
+		//nolint:usetesting // cleanup functions may run after test context is cancelled
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		require.NoError(t, server.Shutdown(ctx))
+	})
+
+	cc, err := grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	defer func() {
+		assert.NoError(t, cc.Close())
+	}()
+	client := healthpb.NewHealthClient(cc)
+
+	_, err = client.Check(t.Context(), &healthpb.HealthCheckRequest{Service: "liveness:metrics"})
+	require.Equal(t, errNotFound, err)
+
+	// The pipeline is healthy until the first check, which may already have run.
+	ctx, cancel := context.WithTimeout(t.Context(), 5*time.Second)
+	defer cancel()
+	stream, err := client.Watch(ctx, &healthpb.HealthCheckRequest{Service: "liveness:traces"})
+	require.NoError(t, err)
+	resp, err := stream.Recv()
+	require.NoError(t, err)
+	if resp.Status == healthpb.HealthCheckResponse_SERVING {
+		resp, err = stream.Recv()
+		require.NoError(t, err)
+	}
+	assert.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, resp.Status)
+
+	for _, service := range []string{"liveness", "liveness:traces"} {
+		resp, err := client.Check(t.Context(), &healthpb.HealthCheckRequest{Service: service})
+		require.NoError(t, err)
+		assert.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, resp.Status, service)
+	}
+}
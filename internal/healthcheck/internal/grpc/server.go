@@ -14,6 +14,7 @@ import (
 	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/healthcheck/internal/common"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/healthcheck/internal/dataflow"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/status"
 )
 
@@ -21,9 +22,11 @@ type Server struct {
 	healthpb.UnimplementedHealthServer
 	grpcServer            *grpc.Server
 	aggregator            *status.Aggregator
+	liveness              *dataflow.Checker
 	config                *Config
 	componentHealthConfig *common.ComponentHealthConfig
 	telemetry             component.TelemetrySettings
+	stopCh                chan struct{}
 	doneCh                chan struct{}
 	doneOnce              sync.Once
 }
@@ -35,12 +38,15 @@ func NewServer(
 	componentHealthConfig *common.ComponentHealthConfig,
 	telemetry component.TelemetrySettings,
 	aggregator *status.Aggregator,
+	liveness *dataflow.Checker,
 ) *Server {
 	srv := &Server{
 		config:                config,
 		componentHealthConfig: componentHealthConfig,
 		telemetry:             telemetry,
 		aggregator:            aggregator,
+		liveness:              liveness,
+		stopCh:                make(chan struct{}),
 		doneCh:                make(chan struct{}),
 	}
 	if srv.componentHealthConfig == nil {
@@ -81,6 +87,8 @@ func (s *Server) Shutdown(ctx context.Context) error {
 	if s.grpcServer == nil {
 		return nil
 	}
+	// Release liveness watchers, they would otherwise block the graceful stop.
+	close(s.stopCh)
 	// Stop the server - this will eventually release the port even if context times out
 	s.grpcServer.GracefulStop()
 	select {
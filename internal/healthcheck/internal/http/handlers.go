@@ -42,3 +42,21 @@ func (s *Server) configHandler() http.Handler {
 		}
 	})
 }
+
+func (s *Server) livenessHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		report, ok := s.liveness.Report(r.URL.Query().Get("pipeline"))
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		code := http.StatusOK
+		if !report.Healthy {
+			code = http.StatusServiceUnavailable
+		}
+		if err := respondWithJSON(code, report, w); err != nil {
+			s.telemetry.Logger.Warn(err.Error())
+		}
+	})
+}
@@ -21,6 +21,7 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/healthcheck/internal/common"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/healthcheck/internal/dataflow"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/status"
 )
 
@@ -32,6 +33,7 @@ type Server struct {
 	responder      responder
 	colconf        atomic.Value
 	aggregator     *status.Aggregator
+	liveness       *dataflow.Checker
 	startTimestamp time.Time
 	doneWg         sync.WaitGroup
 	doneCh         chan struct{}
@@ -49,12 +51,14 @@ func NewServer(
 	componentHealthConfig *common.ComponentHealthConfig,
 	telemetry component.TelemetrySettings,
 	aggregator *status.Aggregator,
+	liveness *dataflow.Checker,
 ) *Server {
 	now := time.Now()
 	srv := &Server{
 		telemetry:  telemetry,
 		mux:        http.NewServeMux(),
 		aggregator: aggregator,
+		liveness:   liveness,
 		doneCh:     make(chan struct{}),
 	}
 
@@ -71,6 +75,9 @@ func NewServer(
 		if config.Config.Enabled {
 			srv.mux.Handle(config.Config.Path, srv.configHandler())
 		}
+		if config.Liveness.Enabled && liveness != nil {
+			srv.mux.Handle(config.Liveness.Path, srv.livenessHandler())
+		}
 	} else {
 		srv.httpConfig = legacyConfig.ServerConfig
 		if legacyConfig.ResponseBody != nil {
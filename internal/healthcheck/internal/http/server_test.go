@@ -2997,6 +2997,7 @@ func TestStatus(t *testing.T) {
 				tc.componentHealthConfig,
 				componenttest.NewNopTelemetrySettings(),
 				status.NewAggregator(internalhelpers.ErrPriority(tc.componentHealthConfig)),
+				nil,
 			)
 
 			require.NoError(t, server.Start(t.Context(), componenttest.NewNopHost()))
@@ -3217,6 +3218,7 @@ func TestConfig(t *testing.T) {
 				&common.ComponentHealthConfig{},
 				componenttest.NewNopTelemetrySettings(),
 				status.NewAggregator(status.PriorityPermanent),
+				nil,
 			)
 
 			require.NoError(t, server.Start(t.Context(), componenttest.NewNopHost()))
@@ -3257,3 +3259,63 @@ func TestConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestLiveness(t *testing.T) {
+	config := &Config{
+		ServerConfig: confighttp.ServerConfig{
+			NetAddr: confignet.AddrConfig{
+				Transport: "tcp",
+				Endpoint:  testutil.GetAvailableLocalAddress(t),
+			},
+		},
+		Liveness: PathConfig{
+			Enabled: true,
+			Path:    "/liveness",
+		},
+	}
+	server := NewServer(
+		config,
+		LegacyConfig{UseV2: true},
+		&common.ComponentHealthConfig{},
+		componenttest.NewNopTelemetrySettings(),
+		status.NewAggregator(status.PriorityPermanent),
+		internalhelpers.NewLivenessChecker(t),
+	)
+	require.NoError(t, server.Start(t.Context(), componenttest.NewNopHost()))
+	ts := httptest.NewServer(server.mux)
+	defer func() {
+		ts.Close()
+		http.DefaultTransport.(*http.Transport).CloseIdleConnections()
+		require.NoError(t, server.Shutdown(t.Context()))
+	}()
+
+	get := func(t assert.TestingT, query string) (int, map[string]any) {
+		resp, err := http.Get(ts.URL + "/liveness" + query)
+		if !assert.NoError(t, err) {
+			return 0, nil
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode == http.StatusNotFound {
+			return resp.StatusCode, nil
+		}
+		var body map[string]any
+		assert.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+		return resp.StatusCode, body
+	}
+
+	code, _ := get(t, "?pipeline=metrics")
+	assert.Equal(t, http.StatusNotFound, code)
+
+	assert.EventuallyWithT(t, func(tt *assert.CollectT) {
+		code, body := get(tt, "?pipeline=traces")
+		assert.Equal(tt, http.StatusServiceUnavailable, code)
+		healthy, ok := body["healthy"].(bool)
+		assert.True(tt, ok)
+		assert.False(tt, healthy)
+	}, time.Second, 10*time.Millisecond)
+
+	code, body := get(t, "")
+	assert.Equal(t, http.StatusServiceUnavailable, code)
+	pipelines := body["pipelines"].(map[string]any)
+	assert.Equal(t, "pipeline not found in the collector configuration", pipelines["traces"].(map[string]any)["reason"])
+}
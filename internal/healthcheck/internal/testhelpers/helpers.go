@@ -4,7 +4,19 @@
 package testhelpers // import "github.com/open-telemetry/opentelemetry-collector-contrib/internal/healthcheck/internal/testhelpers"
 
 import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/confmap"
+	"go.opentelemetry.io/collector/pipeline"
+
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/healthcheck/internal/common"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/healthcheck/internal/dataflow"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/status"
 )
 
@@ -14,3 +26,25 @@ func ErrPriority(config *common.ComponentHealthConfig) status.ErrorPriority {
 	}
 	return status.PriorityPermanent
 }
+
+// NewLivenessChecker returns a started data flow checker for the traces pipeline. The
+// pipeline is missing from the collector configuration, so the checker reports it healthy
+// until its first check, and unhealthy afterwards.
+func NewLivenessChecker(t *testing.T) *dataflow.Checker {
+	ts := httptest.NewServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {}))
+	checker := dataflow.NewChecker(&common.DataFlowConfig{
+		MetricsEndpoint: ts.URL,
+		CheckInterval:   10 * time.Millisecond,
+		Pipelines: map[pipeline.ID]common.PipelineDataFlowConfig{
+			pipeline.NewID(pipeline.SignalTraces): {Window: time.Minute, MinItems: 1},
+		},
+	}, componenttest.NewNopTelemetrySettings())
+	require.NoError(t, checker.NotifyConfig(t.Context(), confmap.New()))
+	require.NoError(t, checker.Start(t.Context(), componenttest.NewNopHost()))
+	t.Cleanup(func() {
+		//nolint:usetesting // cleanup functions may run after test context is cancelled
+		require.NoError(t, checker.Shutdown(context.Background()))
+		ts.Close()
+	})
+	return checker
+}
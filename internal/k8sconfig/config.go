@@ -209,6 +209,26 @@ func MakeDynamicClient(apiConf APIConfig) (dynamic.Interface, error) {
 	return client, nil
 }
 
+// MakeMetadataClient can take configuration if needed for other types of auth
+// and return a client retrieving only the metadata of objects
+func MakeMetadataClient(apiConf APIConfig) (metadata.Interface, error) {
+	if err := apiConf.Validate(); err != nil {
+		return nil, err
+	}
+
+	authConf, err := CreateRestConfig(apiConf)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := metadata.NewForConfig(authConf)
+	if err != nil {
+		return nil, err
+	}
+
+	return client, nil
+}
+
 // MakeOpenShiftQuotaClient can take configuration if needed for other types of auth
 // and return an OpenShift quota API client
 func MakeOpenShiftQuotaClient(apiConf APIConfig) (quotaclientset.Interface, error) {
@@ -86,6 +86,7 @@ type Query struct {
 	SQL                string      `mapstructure:"sql"`
 	Metrics            []MetricCfg `mapstructure:"metrics"`
 	Logs               []LogsCfg   `mapstructure:"logs"`
+	Traces             []TracesCfg `mapstructure:"traces"`
 	TrackingColumn     string      `mapstructure:"tracking_column"`
 	TrackingStartValue string      `mapstructure:"tracking_start_value"`
 }
@@ -95,14 +96,19 @@ func (q Query) Validate() error {
 	if q.SQL == "" {
 		errs = append(errs, errors.New("'query.sql' cannot be empty"))
 	}
-	if len(q.Logs) == 0 && len(q.Metrics) == 0 {
-		errs = append(errs, errors.New("at least one of 'query.logs' and 'query.metrics' must not be empty"))
+	if len(q.Logs) == 0 && len(q.Metrics) == 0 && len(q.Traces) == 0 {
+		errs = append(errs, errors.New("at least one of 'query.logs', 'query.metrics' and 'query.traces' must not be empty"))
 	}
 	for _, logs := range q.Logs {
 		if err := logs.Validate(); err != nil {
 			errs = append(errs, err)
 		}
 	}
+	for _, traces := range q.Traces {
+		if err := traces.Validate(); err != nil {
+			errs = append(errs, err)
+		}
+	}
 	for i := range q.Metrics {
 		metric := &q.Metrics[i]
 		if err := metric.Validate(); err != nil {
@@ -125,6 +131,61 @@ func (config LogsCfg) Validate() error {
 	return errors.Join(errs...)
 }
 
+type TracesCfg struct {
+	TraceIDColumn       string   `mapstructure:"trace_id_column"`
+	SpanIDColumn        string   `mapstructure:"span_id_column"`
+	ParentSpanIDColumn  string   `mapstructure:"parent_span_id_column"`
+	SpanNameColumn      string   `mapstructure:"span_name_column"`
+	SpanKind            SpanKind `mapstructure:"span_kind"`
+	StartTsColumn       string   `mapstructure:"start_ts_column"`
+	EndTsColumn         string   `mapstructure:"end_ts_column"`
+	StatusCodeColumn    string   `mapstructure:"status_code_column"`
+	StatusMessageColumn string   `mapstructure:"status_message_column"`
+	AttributeColumns    []string `mapstructure:"attribute_columns"`
+}
+
+func (config TracesCfg) Validate() error {
+	var errs []error
+	if config.TraceIDColumn == "" {
+		errs = append(errs, errors.New("'trace_id_column' must not be empty"))
+	}
+	if config.SpanIDColumn == "" {
+		errs = append(errs, errors.New("'span_id_column' must not be empty"))
+	}
+	if config.SpanNameColumn == "" {
+		errs = append(errs, errors.New("'span_name_column' must not be empty"))
+	}
+	if config.StartTsColumn == "" {
+		errs = append(errs, errors.New("'start_ts_column' must not be empty"))
+	}
+	if config.EndTsColumn == "" {
+		errs = append(errs, errors.New("'end_ts_column' must not be empty"))
+	}
+	if err := config.SpanKind.Validate(); err != nil {
+		errs = append(errs, err)
+	}
+	return errors.Join(errs...)
+}
+
+type SpanKind string
+
+const (
+	SpanKindUnspecified SpanKind = ""
+	SpanKindInternal    SpanKind = "internal"
+	SpanKindServer      SpanKind = "server"
+	SpanKindClient      SpanKind = "client"
+	SpanKindProducer    SpanKind = "producer"
+	SpanKindConsumer    SpanKind = "consumer"
+)
+
+func (k SpanKind) Validate() error {
+	switch k {
+	case SpanKindUnspecified, SpanKindInternal, SpanKindServer, SpanKindClient, SpanKindProducer, SpanKindConsumer:
+		return nil
+	}
+	return fmt.Errorf("traces config has unsupported span_kind: '%s'", k)
+}
+
 type MetricCfg struct {
 	MetricName       string            `mapstructure:"metric_name"`
 	ValueColumn      string            `mapstructure:"value_column"`
@@ -0,0 +1,552 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottl // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/iancoleman/strcase"
+)
+
+// The relative costs used to estimate the cost of executing a statement. They are not
+// measured values, and only allow comparing statements with each other.
+const (
+	statementCost      = 1
+	pathCost           = 1
+	keyCost            = 1
+	functionCost       = 2
+	comparisonCost     = 1
+	mathOperationCost  = 1
+	staticPatternCost  = 5
+	dynamicPatternCost = 50
+	// forEachCostFactor is the assumed number of items iterated by a for each statement.
+	forEachCostFactor = 10
+)
+
+// AnalysisFindingKind identifies the kind of issue reported by an AnalysisFinding.
+//
+// Experimental: *NOTE* this API is subject to change or removal in the future.
+type AnalysisFindingKind string
+
+const (
+	// FindingUnreachable is reported for statements whose condition can never be met.
+	FindingUnreachable AnalysisFindingKind = "unreachable"
+	// FindingOverwritten is reported for statements writing a path which is unconditionally
+	// overwritten by a following statement before being read.
+	FindingOverwritten AnalysisFindingKind = "overwritten"
+	// FindingUnusedVariable is reported for statements defining a variable which is never read.
+	FindingUnusedVariable AnalysisFindingKind = "unused_variable"
+	// FindingUnusedCache is reported for statements writing a cache path which is never read.
+	FindingUnusedCache AnalysisFindingKind = "unused_cache"
+	// FindingDynamicPattern is reported for statements compiling a pattern every time they
+	// are executed.
+	FindingDynamicPattern AnalysisFindingKind = "dynamic_pattern"
+)
+
+// AnalysisFinding is an issue found by the analysis of a statement.
+//
+// Experimental: *NOTE* this API is subject to change or removal in the future.
+type AnalysisFinding struct {
+	Kind    AnalysisFindingKind
+	Message string
+}
+
+// StatementAnalysis is the result of the static analysis of a single statement.
+//
+// Experimental: *NOTE* this API is subject to change or removal in the future.
+type StatementAnalysis struct {
+	// Index is the position of the statement in the analyzed statements.
+	Index int
+	// Statement is the original text of the statement.
+	Statement string
+	// Reads holds the paths and variables read by the statement, including by its condition.
+	// Paths indexed by a value only known at execution time are truncated before that key.
+	Reads []string
+	// Writes holds the paths and variables modified by the statement.
+	Writes []string
+	// Functions holds the names of the editors and converters invoked by the statement.
+	Functions []string
+	// StaticPatterns is the number of patterns compiled once, when the statement is parsed.
+	StaticPatterns int
+	// DynamicPatterns is the number of patterns compiled every time the statement is executed.
+	DynamicPatterns int
+	// EstimatedCost is a rough estimate of the cost of executing the statement, relative to
+	// the other statements. It does not account for the size of the processed data.
+	EstimatedCost int
+	// Findings holds the issues found in the statement.
+	Findings []AnalysisFinding
+}
+
+// StatementsAnalysis is the result of the static analysis of a block of statements.
+//
+// Experimental: *NOTE* this API is subject to change or removal in the future.
+type StatementsAnalysis struct {
+	Statements []StatementAnalysis
+	// WrittenNotRead holds the paths and variables written by a statement and not read by any
+	// following statement. Written telemetry paths are usually read by later components, and
+	// are only listed for reference, unlike variables and cache paths which are reported as
+	// findings of the statements writing them.
+	WrittenNotRead []string
+	// EstimatedCost is the sum of the statements estimated costs.
+	EstimatedCost int
+}
+
+// Findings returns the findings of all the statements.
+func (a *StatementsAnalysis) Findings() []AnalysisFinding {
+	var findings []AnalysisFinding
+	for _, s := range a.Statements {
+		findings = append(findings, s.Findings...)
+	}
+	return findings
+}
+
+// AnalyzeStatements parses and statically analyzes the given statements as a block, the way
+// they are executed by a StatementSequence. It reports the paths read and written by each
+// statement, the patterns they compile, an estimate of their cost, and the statements that
+// are unreachable or whose effects are never observed.
+// If parsing the statements fails, it returns the ParseStatements error.
+//
+// Experimental: *NOTE* this API is subject to change or removal in the future.
+func (p *Parser[K]) AnalyzeStatements(statements []string) (*StatementsAnalysis, error) {
+	parsedStatements, err := p.ParseStatements(statements)
+	if err != nil {
+		return nil, err
+	}
+
+	analysis := &StatementsAnalysis{Statements: make([]StatementAnalysis, len(statements))}
+	analyzers := make([]*statementAnalyzer[K], len(statements))
+	for i, statement := range statements {
+		parsed, err := parseStatement(statement)
+		if err != nil {
+			return nil, err
+		}
+		a := &statementAnalyzer[K]{parser: p, loopVariables: map[string]struct{}{}}
+		a.analyzeStatement(parsed)
+		a.unreachable = isAlwaysFalse(parsedStatements[i].condition) && parsed.ForEach == nil
+		a.unconditional = parsed.WhereClause == nil && parsed.ForEach == nil
+		analyzers[i] = a
+
+		sa := StatementAnalysis{
+			Index:           i,
+			Statement:       statement,
+			Functions:       a.functions,
+			StaticPatterns:  a.staticPatterns,
+			DynamicPatterns: a.dynamicPatterns,
+			EstimatedCost:   a.cost,
+		}
+		for _, r := range a.reads {
+			sa.Reads = appendUnique(sa.Reads, r.text)
+		}
+		for _, w := range a.writes {
+			sa.Writes = appendUnique(sa.Writes, w.text)
+		}
+		if a.unreachable {
+			sa.Findings = append(sa.Findings, AnalysisFinding{
+				Kind:    FindingUnreachable,
+				Message: fmt.Sprintf("statement %d is never executed, its condition is always false", i),
+			})
+		}
+		if a.dynamicPatterns > 0 {
+			sa.Findings = append(sa.Findings, AnalysisFinding{
+				Kind:    FindingDynamicPattern,
+				Message: fmt.Sprintf("statement %d compiles %d pattern(s) every time it is executed, consider using string literals", i, a.dynamicPatterns),
+			})
+		}
+		analysis.Statements[i] = sa
+		analysis.EstimatedCost += a.cost
+	}
+
+	for i, a := range analyzers {
+		if a.unreachable {
+			continue
+		}
+		for _, w := range a.writes {
+			overwrittenBy, read := nextAccess(analyzers[i+1:], w)
+			if overwrittenBy >= 0 {
+				analysis.Statements[i].Findings = append(analysis.Statements[i].Findings, AnalysisFinding{
+					Kind:    FindingOverwritten,
+					Message: fmt.Sprintf("%s written by statement %d is overwritten by statement %d before being read", w.text, i, i+1+overwrittenBy),
+				})
+				continue
+			}
+			if read {
+				continue
+			}
+			analysis.WrittenNotRead = appendUnique(analysis.WrittenNotRead, w.text)
+			switch {
+			case w.variable:
+				analysis.Statements[i].Findings = append(analysis.Statements[i].Findings, AnalysisFinding{
+					Kind:    FindingUnusedVariable,
+					Message: fmt.Sprintf("variable %s defined by statement %d is never read", w.text, i),
+				})
+			case w.cache:
+				analysis.Statements[i].Findings = append(analysis.Statements[i].Findings, AnalysisFinding{
+					Kind:    FindingUnusedCache,
+					Message: fmt.Sprintf("%s written by statement %d is never read", w.text, i),
+				})
+			}
+		}
+	}
+	return analysis, nil
+}
+
+// nextAccess looks for the first of the following statements accessing the written path,
+// returning the index of the statement if it overwrites the path, and whether it reads it.
+func nextAccess[K any](following []*statementAnalyzer[K], w pathAccess) (int, bool) {
+	for j, next := range following {
+		if next.unreachable {
+			continue
+		}
+		for _, r := range next.reads {
+			if r.overlaps(w) {
+				return -1, true
+			}
+		}
+		if !next.unconditional || !w.static {
+			continue
+		}
+		for _, o := range next.writes {
+			if o.overwrite && o.static && (o.text == w.text || isSubPath(w.text, o.text)) {
+				return j, false
+			}
+		}
+	}
+	return -1, false
+}
+
+func isAlwaysFalse[K any](expr boolExpr[K]) bool {
+	if f, ok := expr.(*literalBoolExpr[K]); ok {
+		return !f.getValue()
+	}
+	return false
+}
+
+func appendUnique(values []string, value string) []string {
+	if slices.Contains(values, value) {
+		return values
+	}
+	return append(values, value)
+}
+
+// pathAccess is a path or a variable read or written by a statement.
+type pathAccess struct {
+	text string
+	// static is set when all the keys of the path are literals.
+	static bool
+	// overwrite is set for writes replacing the previous value without reading it.
+	overwrite bool
+	variable  bool
+	cache     bool
+}
+
+func (a pathAccess) overlaps(other pathAccess) bool {
+	return a.text == other.text || isSubPath(a.text, other.text) || isSubPath(other.text, a.text)
+}
+
+// isSubPath returns whether the path is a field or an item of the parent path.
+func isSubPath(path, parent string) bool {
+	return len(path) > len(parent) && strings.HasPrefix(path, parent) && (path[len(parent)] == '[' || path[len(parent)] == '.')
+}
+
+// newPathAccess returns the access to the given path. The text of paths indexed by values
+// only known at execution time is truncated before the first of those keys.
+func newPathAccess(p *path) pathAccess {
+	var builder strings.Builder
+	access := pathAccess{static: true}
+	if p.Context != "" {
+		builder.WriteString(p.Context)
+	}
+	for i, f := range p.Fields {
+		if i == 0 && f.Name == "cache" {
+			access.cache = true
+		}
+		if builder.Len() > 0 {
+			builder.WriteString(".")
+		}
+		builder.WriteString(f.Name)
+		for _, k := range f.Keys {
+			switch {
+			case k.String != nil:
+				builder.WriteString("[" + strconv.Quote(*k.String) + "]")
+			case k.Int != nil:
+				builder.WriteString("[" + strconv.FormatInt(*k.Int, 10) + "]")
+			default:
+				access.static = false
+			}
+			if !access.static {
+				break
+			}
+		}
+		if !access.static {
+			break
+		}
+	}
+	access.text = builder.String()
+	return access
+}
+
+// statementAnalyzer walks the grammar of a statement, collecting the paths it accesses, the
+// functions it invokes and its estimated cost.
+type statementAnalyzer[K any] struct {
+	parser          *Parser[K]
+	reads           []pathAccess
+	writes          []pathAccess
+	functions       []string
+	staticPatterns  int
+	dynamicPatterns int
+	cost            int
+	loopVariables   map[string]struct{}
+	unreachable     bool
+	unconditional   bool
+}
+
+func (a *statementAnalyzer[K]) analyzeStatement(ps *parsedStatement) {
+	a.cost += statementCost
+	switch {
+	case ps.Let != nil:
+		a.value(&ps.Let.Value)
+		a.booleanExpression(ps.WhereClause)
+		a.writes = append(a.writes, pathAccess{text: ps.Let.Variable, static: true, overwrite: true, variable: true})
+	case ps.ForEach != nil:
+		a.value(&ps.ForEach.Iterable)
+		for _, name := range ps.ForEach.Variables {
+			a.loopVariables[name] = struct{}{}
+		}
+		// The editor and the where clause are evaluated for each item.
+		cost := a.cost
+		a.editor(&ps.Editor)
+		a.booleanExpression(ps.WhereClause)
+		a.cost = cost + (a.cost-cost)*forEachCostFactor
+	default:
+		a.editor(&ps.Editor)
+		a.booleanExpression(ps.WhereClause)
+	}
+}
+
+func (a *statementAnalyzer[K]) editor(ed *editor) {
+	a.cost += functionCost
+	a.functions = appendUnique(a.functions, ed.Function)
+	a.arguments(ed.Function, ed.Arguments, true)
+}
+
+func (a *statementAnalyzer[K]) converter(c *converter) {
+	a.cost += functionCost
+	a.functions = appendUnique(a.functions, c.Function)
+	a.arguments(c.Function, c.Arguments, false)
+	a.keys(c.Keys)
+}
+
+func (a *statementAnalyzer[K]) arguments(function string, args []argument, isEditor bool) {
+	types := a.parser.argumentTypes(function, args)
+	for i := range args {
+		arg := &args[i]
+		typeName := types[i].typeName
+		switch {
+		case arg.FunctionName != nil:
+			a.cost += functionCost
+			a.functions = appendUnique(a.functions, *arg.FunctionName)
+		case strings.HasPrefix(typeName, "StringGetter") && strings.HasSuffix(types[i].name, "Pattern"):
+			if arg.Value.String != nil {
+				a.staticPatterns++
+				a.cost += staticPatternCost
+			} else {
+				a.dynamicPatterns++
+				a.cost += dynamicPatternCost
+				a.value(&arg.Value)
+			}
+		case isEditor && isSetterType(typeName) && arg.Value.Literal != nil && arg.Value.Literal.Path != nil:
+			target := arg.Value.Literal.Path
+			access := newPathAccess(target)
+			access.overwrite = strings.HasPrefix(typeName, "Setter")
+			if !access.overwrite {
+				a.reads = append(a.reads, access)
+			}
+			a.writes = append(a.writes, access)
+			a.cost += pathCost
+			for _, f := range target.Fields {
+				a.keys(f.Keys)
+			}
+		default:
+			a.value(&arg.Value)
+		}
+	}
+}
+
+func isSetterType(typeName string) bool {
+	for _, prefix := range []string{"Setter", "GetSetter", "PMapGetSetter", "PSliceGetSetter"} {
+		if strings.HasPrefix(typeName, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+func (a *statementAnalyzer[K]) keys(keys []key) {
+	for i := range keys {
+		a.cost += keyCost
+		if keys[i].MathExpression != nil {
+			a.mathExpression(keys[i].MathExpression)
+		}
+		if keys[i].Expression != nil {
+			a.mathExprLiteral(keys[i].Expression)
+		}
+	}
+}
+
+func (a *statementAnalyzer[K]) value(v *value) {
+	switch {
+	case v.Literal != nil:
+		a.mathExprLiteral(v.Literal)
+	case v.MathExpression != nil:
+		a.mathExpression(v.MathExpression)
+	case v.Map != nil:
+		for _, item := range v.Map.Values {
+			if item.Value != nil {
+				a.value(item.Value)
+			}
+		}
+	case v.List != nil:
+		for i := range v.List.Values {
+			a.value(&v.List.Values[i])
+		}
+	}
+}
+
+func (a *statementAnalyzer[K]) mathExprLiteral(m *mathExprLiteral) {
+	switch {
+	case m.Converter != nil:
+		a.converter(m.Converter)
+	case m.Path != nil:
+		a.cost += pathCost
+		a.reads = append(a.reads, newPathAccess(m.Path))
+		for _, f := range m.Path.Fields {
+			a.keys(f.Keys)
+		}
+	case m.Variable != nil:
+		if _, ok := a.loopVariables[m.Variable.Name]; !ok {
+			a.reads = append(a.reads, pathAccess{text: m.Variable.Name, static: true, variable: true})
+		}
+		a.cost += len(m.Variable.Keys) * keyCost
+	}
+}
+
+func (a *statementAnalyzer[K]) mathExpression(m *mathExpression) {
+	a.addSubTerm(m.Left)
+	for _, r := range m.Right {
+		a.cost += mathOperationCost
+		a.addSubTerm(r.Term)
+	}
+}
+
+func (a *statementAnalyzer[K]) addSubTerm(t *addSubTerm) {
+	if t == nil {
+		return
+	}
+	a.mathValue(t.Left)
+	for _, r := range t.Right {
+		a.cost += mathOperationCost
+		a.mathValue(r.Value)
+	}
+}
+
+func (a *statementAnalyzer[K]) mathValue(v *mathValue) {
+	if v == nil {
+		return
+	}
+	if v.Literal != nil {
+		a.mathExprLiteral(v.Literal)
+	}
+	if v.SubExpression != nil {
+		a.mathExpression(v.SubExpression)
+	}
+}
+
+func (a *statementAnalyzer[K]) booleanExpression(be *booleanExpression) {
+	if be == nil {
+		return
+	}
+	a.term(be.Left)
+	for _, r := range be.Right {
+		a.term(r.Term)
+	}
+}
+
+func (a *statementAnalyzer[K]) term(t *term) {
+	if t == nil {
+		return
+	}
+	a.booleanValue(t.Left)
+	for _, r := range t.Right {
+		a.booleanValue(r.Value)
+	}
+}
+
+func (a *statementAnalyzer[K]) booleanValue(b *booleanValue) {
+	if b == nil {
+		return
+	}
+	switch {
+	case b.Comparison != nil:
+		a.cost += comparisonCost
+		a.value(&b.Comparison.Left)
+		a.value(&b.Comparison.Right)
+	case b.ConstExpr != nil && b.ConstExpr.Converter != nil:
+		a.converter(b.ConstExpr.Converter)
+	case b.SubExpr != nil:
+		a.booleanExpression(b.SubExpr)
+	}
+}
+
+type argumentType struct {
+	name     string
+	typeName string
+}
+
+// argumentTypes returns the name and the type name of the function's arguments fields the
+// given arguments are assigned to, following the rules of buildArgs. The values are empty
+// for arguments which cannot be resolved.
+func (p *Parser[K]) argumentTypes(function string, args []argument) []argumentType {
+	types := make([]argumentType, len(args))
+	f, ok := p.functions[function]
+	if !ok {
+		return types
+	}
+	defaultArgs := f.CreateDefaultArguments()
+	if defaultArgs == nil || reflect.TypeOf(defaultArgs).Kind() != reflect.Pointer {
+		return types
+	}
+	argsVal := reflect.ValueOf(defaultArgs).Elem()
+	if argsVal.Kind() != reflect.Struct {
+		return types
+	}
+	for i, arg := range args {
+		var field reflect.Value
+		var name string
+		if arg.Name == "" {
+			if i >= argsVal.NumField() {
+				continue
+			}
+			field = argsVal.Field(i)
+			name = argsVal.Type().Field(i).Name
+		} else {
+			name = strcase.ToCamel(arg.Name)
+			field = argsVal.FieldByName(name)
+			if !field.IsValid() {
+				continue
+			}
+		}
+		fieldType := field.Type()
+		if strings.HasPrefix(fieldType.Name(), "Optional") && field.CanInterface() {
+			if manager, ok := field.Interface().(optionalManager); ok {
+				fieldType = manager.get().Type()
+			}
+		}
+		types[i] = argumentType{name: name, typeName: fieldType.Name()}
+	}
+	return types
+}
@@ -0,0 +1,171 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottl_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Test_AnalyzeStatements(t *testing.T) {
+	parser := newVariablesTestParser(t)
+	analysis, err := parser.AnalyzeStatements([]string{
+		`set(attributes["a"], body) where IsMatch(body, "^x")`,
+		`replace_pattern(body, "a", "b")`,
+		`set(attributes["b"], "x") where IsMatch(body, attributes["pattern"])`,
+		`for each $k in attributes: delete_key(attributes, $k)`,
+		`set(attributes[body], "x")`,
+	})
+	require.NoError(t, err)
+	require.Len(t, analysis.Statements, 5)
+
+	assert.Equal(t, ottl.StatementAnalysis{
+		Index:          0,
+		Statement:      `set(attributes["a"], body) where IsMatch(body, "^x")`,
+		Reads:          []string{"body"},
+		Writes:         []string{`attributes["a"]`},
+		Functions:      []string{"set", "IsMatch"},
+		StaticPatterns: 1,
+		EstimatedCost:  14,
+	}, analysis.Statements[0])
+
+	assert.Equal(t, []string{"body"}, analysis.Statements[1].Reads)
+	assert.Equal(t, []string{"body"}, analysis.Statements[1].Writes)
+	assert.Equal(t, 1, analysis.Statements[1].StaticPatterns)
+
+	assert.Equal(t, []string{"body", `attributes["pattern"]`}, analysis.Statements[2].Reads)
+	assert.Equal(t, 1, analysis.Statements[2].DynamicPatterns)
+	assert.Equal(t, []ottl.AnalysisFinding{{
+		Kind:    ottl.FindingDynamicPattern,
+		Message: "statement 2 compiles 1 pattern(s) every time it is executed, consider using string literals",
+	}}, analysis.Statements[2].Findings)
+
+	assert.Equal(t, []string{"attributes"}, analysis.Statements[3].Reads)
+	assert.Equal(t, []string{"attributes"}, analysis.Statements[3].Writes)
+	assert.Equal(t, 32, analysis.Statements[3].EstimatedCost)
+
+	assert.Equal(t, []string{"body"}, analysis.Statements[4].Reads)
+	assert.Equal(t, []string{"attributes"}, analysis.Statements[4].Writes)
+
+	total := 0
+	for _, s := range analysis.Statements {
+		total += s.EstimatedCost
+	}
+	assert.Equal(t, total, analysis.EstimatedCost)
+}
+
+func Test_AnalyzeStatements_Findings(t *testing.T) {
+	tests := []struct {
+		name           string
+		statements     []string
+		want           []ottl.AnalysisFinding
+		writtenNotRead []string
+	}{
+		{
+			name: "unreachable",
+			statements: []string{
+				`set(attributes["a"], "x") where false`,
+				`set(attributes["b"], "x") where body == "x" and false`,
+			},
+			want: []ottl.AnalysisFinding{
+				{Kind: ottl.FindingUnreachable, Message: "statement 0 is never executed, its condition is always false"},
+				{Kind: ottl.FindingUnreachable, Message: "statement 1 is never executed, its condition is always false"},
+			},
+		},
+		{
+			name: "overwritten",
+			statements: []string{
+				`set(attributes["a"], "x")`,
+				`set(attributes["a"], "y")`,
+			},
+			want: []ottl.AnalysisFinding{
+				{Kind: ottl.FindingOverwritten, Message: `attributes["a"] written by statement 0 is overwritten by statement 1 before being read`},
+			},
+			writtenNotRead: []string{`attributes["a"]`},
+		},
+		{
+			name: "overwritten by parent",
+			statements: []string{
+				`set(attributes["a"], "x")`,
+				`set(attributes, {"b": "y"})`,
+			},
+			want: []ottl.AnalysisFinding{
+				{Kind: ottl.FindingOverwritten, Message: `attributes["a"] written by statement 0 is overwritten by statement 1 before being read`},
+			},
+			writtenNotRead: []string{"attributes"},
+		},
+		{
+			name: "read before overwritten",
+			statements: []string{
+				`set(attributes["a"], "x")`,
+				`set(attributes["b"], attributes["a"])`,
+				`set(attributes["a"], "y")`,
+			},
+			writtenNotRead: []string{`attributes["b"]`, `attributes["a"]`},
+		},
+		{
+			name: "conditionally overwritten",
+			statements: []string{
+				`set(attributes["a"], "x")`,
+				`set(attributes["a"], "y") where body == "y"`,
+			},
+			writtenNotRead: []string{`attributes["a"]`},
+		},
+		{
+			name: "overwritten by unreachable statement",
+			statements: []string{
+				`set(attributes["a"], "x")`,
+				`set(attributes["a"], "y") where false`,
+			},
+			want: []ottl.AnalysisFinding{
+				{Kind: ottl.FindingUnreachable, Message: "statement 1 is never executed, its condition is always false"},
+			},
+			writtenNotRead: []string{`attributes["a"]`},
+		},
+		{
+			name: "unused variable and cache",
+			statements: []string{
+				`let $unused = body`,
+				`set(cache["tmp"], body)`,
+				`let $used = 1`,
+				`set(attributes["n"], $used)`,
+			},
+			want: []ottl.AnalysisFinding{
+				{Kind: ottl.FindingUnusedVariable, Message: "variable $unused defined by statement 0 is never read"},
+				{Kind: ottl.FindingUnusedCache, Message: `cache["tmp"] written by statement 1 is never read`},
+			},
+			writtenNotRead: []string{"$unused", `cache["tmp"]`, `attributes["n"]`},
+		},
+		{
+			name: "reassigned variable",
+			statements: []string{
+				`let $n = 1`,
+				`let $n = 2`,
+				`set(attributes["n"], $n)`,
+			},
+			want: []ottl.AnalysisFinding{
+				{Kind: ottl.FindingOverwritten, Message: "$n written by statement 0 is overwritten by statement 1 before being read"},
+			},
+			writtenNotRead: []string{`attributes["n"]`},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			analysis, err := newVariablesTestParser(t).AnalyzeStatements(tt.statements)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, analysis.Findings())
+			assert.Equal(t, tt.writtenNotRead, analysis.WrittenNotRead)
+		})
+	}
+}
+
+func Test_AnalyzeStatements_ParseError(t *testing.T) {
+	parser := newVariablesTestParser(t)
+	_, err := parser.AnalyzeStatements([]string{`set(attributes["a"], $undefined)`})
+	assert.ErrorContains(t, err, "undefined variable $undefined")
+}
@@ -21,6 +21,7 @@ var defaultContextInferPriority = []string{
 	"metric",
 	"spanevent",
 	"span",
+	"trace",
 	"profile",
 	"scope",
 	"instrumentation_scope",
@@ -293,10 +294,7 @@ func (*priorityContextInferrer) getStatementsHints(statements []string) ([]prior
 			return nil, err
 		}
 		visitor := newGrammarContextInferrerVisitor()
-		parsed.Editor.accept(&visitor)
-		if parsed.WhereClause != nil {
-			parsed.WhereClause.accept(&visitor)
-		}
+		parsed.accept(&visitor)
 		hints = append(hints, visitor)
 	}
 	return hints, nil
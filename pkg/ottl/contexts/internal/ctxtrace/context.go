@@ -0,0 +1,29 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ctxtrace // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/internal/ctxtrace"
+
+import (
+	"iter"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/internal/ctxspan"
+)
+
+const (
+	readOnlyPathErrMsg = "%q is read-only and cannot be modified"
+	noRootSpanErrMsg   = "the trace does not include its root span, \"trace.root_span\" cannot be modified"
+	Name               = "trace"
+	DocRef             = "https://github.com/open-telemetry/opentelemetry-collector-contrib/tree/main/pkg/ottl/contexts/ottltrace"
+)
+
+type Context interface {
+	// GetSpan returns the root span of the trace, or an empty span if the trace has none.
+	ctxspan.Context
+	GetTraceID() pcommon.TraceID
+	HasRootSpan() bool
+	// GetTraceSpans returns the spans of the trace along with their resource.
+	GetTraceSpans() iter.Seq2[pcommon.Resource, ptrace.Span]
+}
@@ -0,0 +1,285 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ctxtrace // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/internal/ctxtrace"
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	conventions "go.opentelemetry.io/otel/semconv/v1.38.0"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/internal/ctxerror"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/internal/ctxspan"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/internal/ctxutil"
+)
+
+// PathGetSetter returns the accessor of the given trace path. Aggregate paths are computed
+// from the spans of the trace every time they are read and cannot be modified, the root span
+// can be modified through the root_span paths. When the trace does not include its root span,
+// the root_span paths return nil and cannot be modified.
+func PathGetSetter[K Context](path ottl.Path[K]) (ottl.GetSetter[K], error) {
+	if path == nil {
+		return nil, ctxerror.New("nil", "nil", Name, DocRef)
+	}
+	switch path.Name() {
+	case "trace_id":
+		nextPath := path.Next()
+		if nextPath != nil {
+			if nextPath.Name() == "string" {
+				return accessStringTraceID[K](), nil
+			}
+			return nil, ctxerror.New(nextPath.Name(), nextPath.String(), Name, DocRef)
+		}
+		return accessTraceID[K](), nil
+	case "root_span":
+		nextPath := path.Next()
+		if nextPath == nil {
+			return nil, ctxerror.New(path.Name(), path.String(), Name, DocRef)
+		}
+		accessor, err := ctxspan.PathGetSetter[K](nextPath)
+		if err != nil {
+			return nil, err
+		}
+		return accessRootSpan(accessor), nil
+	case "has_root_span":
+		return accessHasRootSpan[K](), nil
+	case "span_count":
+		return accessSpanCount[K](), nil
+	case "error_count":
+		return accessErrorCount[K](), nil
+	case "start_time_unix_nano":
+		return accessStartTimeUnixNano[K](), nil
+	case "end_time_unix_nano":
+		return accessEndTimeUnixNano[K](), nil
+	case "start_time":
+		return accessStartTime[K](), nil
+	case "end_time":
+		return accessEndTime[K](), nil
+	case "duration":
+		return accessDuration[K](), nil
+	case "services":
+		return accessServices[K](), nil
+	case "service_durations":
+		mapKeys := path.Keys()
+		if mapKeys == nil {
+			return accessServiceDurations[K](), nil
+		}
+		return accessServiceDurationsKey[K](mapKeys), nil
+	case "span_count_by_attribute":
+		mapKeys := path.Keys()
+		if mapKeys == nil {
+			return nil, fmt.Errorf("%q requires an attribute key, e.g. %s[\"db.system\"]", path.String(), path.String())
+		}
+		return accessSpanCountByAttribute[K](mapKeys), nil
+	default:
+		return nil, ctxerror.New(path.Name(), path.String(), Name, DocRef)
+	}
+}
+
+func readOnlySetter[K any](path string) ottl.StandardGetSetter[K] {
+	return ottl.StandardGetSetter[K]{
+		Setter: func(context.Context, K, any) error {
+			return fmt.Errorf(readOnlyPathErrMsg, path)
+		},
+	}
+}
+
+func readOnlyGetSetter[K any](path string, getter func(ctx context.Context, tCtx K) (any, error)) ottl.StandardGetSetter[K] {
+	gs := readOnlySetter[K](path)
+	gs.Getter = getter
+	return gs
+}
+
+// accessRootSpan guards the accessor of a root span path against traces without root span,
+// whose root span would otherwise be an empty span detached from the trace.
+func accessRootSpan[K Context](accessor ottl.GetSetter[K]) ottl.StandardGetSetter[K] {
+	return ottl.StandardGetSetter[K]{
+		Getter: func(ctx context.Context, tCtx K) (any, error) {
+			if !tCtx.HasRootSpan() {
+				return nil, nil
+			}
+			return accessor.Get(ctx, tCtx)
+		},
+		Setter: func(ctx context.Context, tCtx K, val any) error {
+			if !tCtx.HasRootSpan() {
+				return errors.New(noRootSpanErrMsg)
+			}
+			return accessor.Set(ctx, tCtx, val)
+		},
+	}
+}
+
+func accessTraceID[K Context]() ottl.StandardGetSetter[K] {
+	return readOnlyGetSetter("trace.trace_id", func(_ context.Context, tCtx K) (any, error) {
+		return tCtx.GetTraceID(), nil
+	})
+}
+
+func accessStringTraceID[K Context]() ottl.StandardGetSetter[K] {
+	return readOnlyGetSetter("trace.trace_id.string", func(_ context.Context, tCtx K) (any, error) {
+		id := tCtx.GetTraceID()
+		return hex.EncodeToString(id[:]), nil
+	})
+}
+
+func accessHasRootSpan[K Context]() ottl.StandardGetSetter[K] {
+	return readOnlyGetSetter("trace.has_root_span", func(_ context.Context, tCtx K) (any, error) {
+		return tCtx.HasRootSpan(), nil
+	})
+}
+
+func accessSpanCount[K Context]() ottl.StandardGetSetter[K] {
+	return readOnlyGetSetter("trace.span_count", func(_ context.Context, tCtx K) (any, error) {
+		var count int64
+		for range tCtx.GetTraceSpans() {
+			count++
+		}
+		return count, nil
+	})
+}
+
+func accessErrorCount[K Context]() ottl.StandardGetSetter[K] {
+	return readOnlyGetSetter("trace.error_count", func(_ context.Context, tCtx K) (any, error) {
+		var count int64
+		for _, span := range tCtx.GetTraceSpans() {
+			if span.Status().Code() == ptrace.StatusCodeError {
+				count++
+			}
+		}
+		return count, nil
+	})
+}
+
+// timeBounds returns the earliest start and the latest end timestamps of the spans of the trace.
+func timeBounds[K Context](tCtx K) (start, end pcommon.Timestamp) {
+	for _, span := range tCtx.GetTraceSpans() {
+		if start == 0 || span.StartTimestamp() < start {
+			start = span.StartTimestamp()
+		}
+		if span.EndTimestamp() > end {
+			end = span.EndTimestamp()
+		}
+	}
+	return start, end
+}
+
+func accessStartTimeUnixNano[K Context]() ottl.StandardGetSetter[K] {
+	return readOnlyGetSetter("trace.start_time_unix_nano", func(_ context.Context, tCtx K) (any, error) {
+		start, _ := timeBounds(tCtx)
+		return start.AsTime().UnixNano(), nil
+	})
+}
+
+func accessEndTimeUnixNano[K Context]() ottl.StandardGetSetter[K] {
+	return readOnlyGetSetter("trace.end_time_unix_nano", func(_ context.Context, tCtx K) (any, error) {
+		_, end := timeBounds(tCtx)
+		return end.AsTime().UnixNano(), nil
+	})
+}
+
+func accessStartTime[K Context]() ottl.StandardGetSetter[K] {
+	return readOnlyGetSetter("trace.start_time", func(_ context.Context, tCtx K) (any, error) {
+		start, _ := timeBounds(tCtx)
+		return start.AsTime(), nil
+	})
+}
+
+func accessEndTime[K Context]() ottl.StandardGetSetter[K] {
+	return readOnlyGetSetter("trace.end_time", func(_ context.Context, tCtx K) (any, error) {
+		_, end := timeBounds(tCtx)
+		return end.AsTime(), nil
+	})
+}
+
+func accessDuration[K Context]() ottl.StandardGetSetter[K] {
+	return readOnlyGetSetter("trace.duration", func(_ context.Context, tCtx K) (any, error) {
+		start, end := timeBounds(tCtx)
+		return spanDuration(start, end).Nanoseconds(), nil
+	})
+}
+
+func spanDuration(start, end pcommon.Timestamp) time.Duration {
+	if end < start {
+		return 0
+	}
+	return end.AsTime().Sub(start.AsTime())
+}
+
+func serviceName(resource pcommon.Resource) string {
+	if name, ok := resource.Attributes().Get(string(conventions.ServiceNameKey)); ok {
+		return name.AsString()
+	}
+	return ""
+}
+
+func accessServices[K Context]() ottl.StandardGetSetter[K] {
+	return readOnlyGetSetter("trace.services", func(_ context.Context, tCtx K) (any, error) {
+		var names []string
+		for resource := range tCtx.GetTraceSpans() {
+			if name := serviceName(resource); !slices.Contains(names, name) {
+				names = append(names, name)
+			}
+		}
+		slices.Sort(names)
+		services := pcommon.NewSlice()
+		services.EnsureCapacity(len(names))
+		for _, name := range names {
+			services.AppendEmpty().SetStr(name)
+		}
+		return services, nil
+	})
+}
+
+// serviceDurations returns the sum of the durations of the spans of each service, in nanoseconds.
+func serviceDurations[K Context](tCtx K) pcommon.Map {
+	durations := pcommon.NewMap()
+	for resource, span := range tCtx.GetTraceSpans() {
+		name := serviceName(resource)
+		d := spanDuration(span.StartTimestamp(), span.EndTimestamp()).Nanoseconds()
+		if current, ok := durations.Get(name); ok {
+			current.SetInt(current.Int() + d)
+			continue
+		}
+		durations.PutInt(name, d)
+	}
+	return durations
+}
+
+func accessServiceDurations[K Context]() ottl.StandardGetSetter[K] {
+	return readOnlyGetSetter("trace.service_durations", func(_ context.Context, tCtx K) (any, error) {
+		return serviceDurations(tCtx), nil
+	})
+}
+
+func accessServiceDurationsKey[K Context](keys []ottl.Key[K]) ottl.StandardGetSetter[K] {
+	return readOnlyGetSetter("trace.service_durations", func(ctx context.Context, tCtx K) (any, error) {
+		return ctxutil.GetMapValue[K](ctx, tCtx, serviceDurations(tCtx), keys)
+	})
+}
+
+func accessSpanCountByAttribute[K Context](keys []ottl.Key[K]) ottl.StandardGetSetter[K] {
+	return readOnlyGetSetter("trace.span_count_by_attribute", func(ctx context.Context, tCtx K) (any, error) {
+		if len(keys) > 1 {
+			return nil, errors.New("cannot index span_count_by_attribute with more than one key")
+		}
+		name, err := ctxutil.GetMapKeyName[K](ctx, tCtx, keys[0])
+		if err != nil {
+			return nil, err
+		}
+		var count int64
+		for _, span := range tCtx.GetTraceSpans() {
+			if _, ok := span.Attributes().Get(*name); ok {
+				count++
+			}
+		}
+		return count, nil
+	})
+}
@@ -0,0 +1,291 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ctxtrace_test
+
+import (
+	"iter"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/internal/ctxtrace"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/internal/pathtest"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottltest"
+)
+
+var (
+	traceID = [16]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}
+	rootID  = [8]byte{1, 2, 3, 4, 5, 6, 7, 8}
+	startTS = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+)
+
+func TestPathGetSetter(t *testing.T) {
+	services := pcommon.NewSlice()
+	services.AppendEmpty().SetStr("backend")
+	services.AppendEmpty().SetStr("frontend")
+
+	durations := pcommon.NewMap()
+	durations.PutInt("frontend", int64(10*time.Second))
+	durations.PutInt("backend", int64(7*time.Second))
+
+	tests := []struct {
+		name string
+		path ottl.Path[*testContext]
+		want any
+	}{
+		{
+			name: "trace_id",
+			path: &pathtest.Path[*testContext]{N: "trace_id"},
+			want: pcommon.TraceID(traceID),
+		},
+		{
+			name: "trace_id string",
+			path: &pathtest.Path[*testContext]{N: "trace_id", NextPath: &pathtest.Path[*testContext]{N: "string"}},
+			want: "0102030405060708090a0b0c0d0e0f10",
+		},
+		{
+			name: "has_root_span",
+			path: &pathtest.Path[*testContext]{N: "has_root_span"},
+			want: true,
+		},
+		{
+			name: "span_count",
+			path: &pathtest.Path[*testContext]{N: "span_count"},
+			want: int64(3),
+		},
+		{
+			name: "error_count",
+			path: &pathtest.Path[*testContext]{N: "error_count"},
+			want: int64(1),
+		},
+		{
+			name: "span_count_by_attribute",
+			path: &pathtest.Path[*testContext]{
+				N:        "span_count_by_attribute",
+				KeySlice: []ottl.Key[*testContext]{&pathtest.Key[*testContext]{S: ottltest.Strp("db.system")}},
+			},
+			want: int64(2),
+		},
+		{
+			name: "start_time_unix_nano",
+			path: &pathtest.Path[*testContext]{N: "start_time_unix_nano"},
+			want: startTS.UnixNano(),
+		},
+		{
+			name: "end_time_unix_nano",
+			path: &pathtest.Path[*testContext]{N: "end_time_unix_nano"},
+			want: startTS.Add(10 * time.Second).UnixNano(),
+		},
+		{
+			name: "start_time",
+			path: &pathtest.Path[*testContext]{N: "start_time"},
+			want: startTS,
+		},
+		{
+			name: "end_time",
+			path: &pathtest.Path[*testContext]{N: "end_time"},
+			want: startTS.Add(10 * time.Second),
+		},
+		{
+			name: "duration",
+			path: &pathtest.Path[*testContext]{N: "duration"},
+			want: int64(10 * time.Second),
+		},
+		{
+			name: "services",
+			path: &pathtest.Path[*testContext]{N: "services"},
+			want: services,
+		},
+		{
+			name: "service_durations",
+			path: &pathtest.Path[*testContext]{N: "service_durations"},
+			want: durations,
+		},
+		{
+			name: "service_durations key",
+			path: &pathtest.Path[*testContext]{
+				N:        "service_durations",
+				KeySlice: []ottl.Key[*testContext]{&pathtest.Key[*testContext]{S: ottltest.Strp("backend")}},
+			},
+			want: int64(7 * time.Second),
+		},
+		{
+			name: "service_durations missing key",
+			path: &pathtest.Path[*testContext]{
+				N:        "service_durations",
+				KeySlice: []ottl.Key[*testContext]{&pathtest.Key[*testContext]{S: ottltest.Strp("unknown")}},
+			},
+			want: nil,
+		},
+		{
+			name: "root_span name",
+			path: &pathtest.Path[*testContext]{N: "root_span", NextPath: &pathtest.Path[*testContext]{N: "name"}},
+			want: "GET /",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			accessor, err := ctxtrace.PathGetSetter[*testContext](tt.path)
+			require.NoError(t, err)
+
+			got, err := accessor.Get(t.Context(), newTestContext())
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestPathGetSetter_ReadOnly(t *testing.T) {
+	accessor, err := ctxtrace.PathGetSetter[*testContext](&pathtest.Path[*testContext]{N: "span_count"})
+	require.NoError(t, err)
+	err = accessor.Set(t.Context(), newTestContext(), int64(1))
+	assert.EqualError(t, err, `"trace.span_count" is read-only and cannot be modified`)
+}
+
+func TestPathGetSetter_RootSpan(t *testing.T) {
+	tCtx := newTestContext()
+	accessor, err := ctxtrace.PathGetSetter[*testContext](&pathtest.Path[*testContext]{
+		N: "root_span",
+		NextPath: &pathtest.Path[*testContext]{
+			N:        "attributes",
+			KeySlice: []ottl.Key[*testContext]{&pathtest.Key[*testContext]{S: ottltest.Strp("trace.error")}},
+		},
+	})
+	require.NoError(t, err)
+	require.NoError(t, accessor.Set(t.Context(), tCtx, true))
+
+	val, ok := tCtx.spans[0].Attributes().Get("trace.error")
+	require.True(t, ok)
+	assert.True(t, val.Bool())
+
+	tCtx.resources, tCtx.spans = tCtx.resources[1:], tCtx.spans[1:]
+	got, err := accessor.Get(t.Context(), tCtx)
+	require.NoError(t, err)
+	assert.Nil(t, got)
+}
+
+func TestPathGetSetter_NoRootSpan(t *testing.T) {
+	tCtx := newTestContext()
+	tCtx.resources, tCtx.spans = tCtx.resources[1:], tCtx.spans[1:]
+
+	accessor, err := ctxtrace.PathGetSetter[*testContext](&pathtest.Path[*testContext]{
+		N:        "root_span",
+		NextPath: &pathtest.Path[*testContext]{N: "name"},
+	})
+	require.NoError(t, err)
+
+	got, err := accessor.Get(t.Context(), tCtx)
+	require.NoError(t, err)
+	assert.Nil(t, got)
+
+	err = accessor.Set(t.Context(), tCtx, "renamed")
+	assert.EqualError(t, err, `the trace does not include its root span, "trace.root_span" cannot be modified`)
+	for _, span := range tCtx.spans {
+		assert.NotEqual(t, "renamed", span.Name())
+	}
+}
+
+func TestPathGetSetter_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		path    ottl.Path[*testContext]
+		wantErr string
+	}{
+		{
+			name:    "unknown",
+			path:    &pathtest.Path[*testContext]{N: "unknown"},
+			wantErr: `segment "unknown" from path "unknown" is not a valid path`,
+		},
+		{
+			name:    "root_span without field",
+			path:    &pathtest.Path[*testContext]{N: "root_span"},
+			wantErr: `segment "root_span" from path "root_span" is not a valid path`,
+		},
+		{
+			name:    "span_count_by_attribute without key",
+			path:    &pathtest.Path[*testContext]{N: "span_count_by_attribute"},
+			wantErr: "requires an attribute key",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ctxtrace.PathGetSetter[*testContext](tt.path)
+			assert.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
+
+type testContext struct {
+	resources []pcommon.Resource
+	spans     []ptrace.Span
+}
+
+func (c *testContext) GetSpan() ptrace.Span {
+	for _, span := range c.spans {
+		if span.ParentSpanID().IsEmpty() {
+			return span
+		}
+	}
+	return ptrace.NewSpan()
+}
+
+func (c *testContext) GetTraceID() pcommon.TraceID {
+	return traceID
+}
+
+func (c *testContext) HasRootSpan() bool {
+	for _, span := range c.spans {
+		if span.ParentSpanID().IsEmpty() {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *testContext) GetTraceSpans() iter.Seq2[pcommon.Resource, ptrace.Span] {
+	return func(yield func(pcommon.Resource, ptrace.Span) bool) {
+		for i, span := range c.spans {
+			if !yield(c.resources[i], span) {
+				return
+			}
+		}
+	}
+}
+
+// newTestContext returns a trace made of a frontend root span lasting 10s, and of two
+// backend database spans lasting 3s and 4s, one of which failed.
+func newTestContext() *testContext {
+	frontend := pcommon.NewResource()
+	frontend.Attributes().PutStr("service.name", "frontend")
+	backend := pcommon.NewResource()
+	backend.Attributes().PutStr("service.name", "backend")
+
+	newSpan := func(name string, start, end time.Duration) ptrace.Span {
+		span := ptrace.NewSpan()
+		span.SetTraceID(traceID)
+		span.SetName(name)
+		span.SetStartTimestamp(pcommon.NewTimestampFromTime(startTS.Add(start)))
+		span.SetEndTimestamp(pcommon.NewTimestampFromTime(startTS.Add(end)))
+		return span
+	}
+	root := newSpan("GET /", 0, 10*time.Second)
+	root.SetSpanID(rootID)
+	query := newSpan("SELECT", time.Second, 4*time.Second)
+	query.SetParentSpanID(rootID)
+	query.Attributes().PutStr("db.system", "postgresql")
+	failed := newSpan("INSERT", 5*time.Second, 9*time.Second)
+	failed.SetParentSpanID(rootID)
+	failed.Attributes().PutStr("db.system", "postgresql")
+	failed.Status().SetCode(ptrace.StatusCodeError)
+
+	return &testContext{
+		resources: []pcommon.Resource{frontend, backend, backend},
+		spans:     []ptrace.Span{root, query, failed},
+	}
+}
@@ -160,6 +160,13 @@ func WithStatementSequenceErrorMode(errorMode ottl.ErrorMode) StatementSequenceO
 	}
 }
 
+// WithStatementSequenceObserver sets the observer notified after each statement execution of a statement sequence.
+func WithStatementSequenceObserver(observer ottl.StatementObserver) StatementSequenceOption {
+	return func(s *ottl.StatementSequence[*TransformContext]) {
+		ottl.WithStatementSequenceObserver[*TransformContext](observer)(s)
+	}
+}
+
 // NewStatementSequence creates a new statement sequence with the provided statements and options.
 func NewStatementSequence(statements []*ottl.Statement[*TransformContext], telemetrySettings component.TelemetrySettings, options ...StatementSequenceOption) ottl.StatementSequence[*TransformContext] {
 	s := ottl.NewStatementSequence(statements, telemetrySettings)
@@ -0,0 +1,254 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottltrace // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottltrace"
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"iter"
+	"sync"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.uber.org/zap/zapcore"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/internal/ctxcache"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/internal/ctxcommon"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/internal/ctxotelcol"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/internal/ctxspan"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/internal/ctxtrace"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/internal/logging"
+)
+
+var tcPool = sync.Pool{
+	New: func() any {
+		return &TransformContext{cache: pcommon.NewMap()}
+	},
+}
+
+// ContextName is the name of the context for traces.
+// Experimental: *NOTE* this constant is subject to change or removal in the future.
+const ContextName = ctxtrace.Name
+
+var _ zapcore.ObjectMarshaler = (*TransformContext)(nil)
+
+// Span is a span of a trace along with its hierarchy.
+type Span struct {
+	ResourceSpans ptrace.ResourceSpans
+	ScopeSpans    ptrace.ScopeSpans
+	Span          ptrace.Span
+}
+
+// GroupSpansByTrace groups the spans of the given traces by trace ID. The groups are ordered
+// by the first appearance of their trace ID, and the spans of each group keep their order.
+func GroupSpansByTrace(td ptrace.Traces) [][]Span {
+	var groups [][]Span
+	indexes := map[pcommon.TraceID]int{}
+	for _, rs := range td.ResourceSpans().All() {
+		for _, ss := range rs.ScopeSpans().All() {
+			for _, span := range ss.Spans().All() {
+				idx, ok := indexes[span.TraceID()]
+				if !ok {
+					idx = len(groups)
+					indexes[span.TraceID()] = idx
+					groups = append(groups, nil)
+				}
+				groups[idx] = append(groups[idx], Span{ResourceSpans: rs, ScopeSpans: ss, Span: span})
+			}
+		}
+	}
+	return groups
+}
+
+// TransformContext represents the spans of a trace.
+type TransformContext struct {
+	spans []Span
+	root  int
+	cache pcommon.Map
+}
+
+// MarshalLogObject serializes the TransformContext into a zapcore.ObjectEncoder for logging.
+func (tCtx *TransformContext) MarshalLogObject(encoder zapcore.ObjectEncoder) error {
+	traceID := tCtx.GetTraceID()
+	encoder.AddString("trace_id", hex.EncodeToString(traceID[:]))
+	encoder.AddInt("span_count", len(tCtx.spans))
+	var err error
+	if tCtx.HasRootSpan() {
+		err = encoder.AddObject("root_span", logging.Span(tCtx.GetSpan()))
+	}
+	err = errors.Join(err, encoder.AddObject("cache", logging.Map(tCtx.cache)))
+	return err
+}
+
+// TransformContextOption represents an option for configuring a TransformContext.
+type TransformContextOption func(*TransformContext)
+
+// NewTransformContextPtr returns a new TransformContext over the given spans, which must share
+// the same trace ID, from a pool of contexts. The root span is the first span without a parent.
+// Caller must call TransformContext.Close on the returned TransformContext.
+func NewTransformContextPtr(spans []Span, options ...TransformContextOption) *TransformContext {
+	tCtx := tcPool.Get().(*TransformContext)
+	tCtx.spans = spans
+	tCtx.root = -1
+	for i, s := range spans {
+		if s.Span.ParentSpanID().IsEmpty() {
+			tCtx.root = i
+			break
+		}
+	}
+	for _, opt := range options {
+		opt(tCtx)
+	}
+	return tCtx
+}
+
+// Close the current TransformContext.
+// After this function returns this instance cannot be used.
+func (tCtx *TransformContext) Close() {
+	tCtx.spans = nil
+	tCtx.root = -1
+	tCtx.cache.Clear()
+	tcPool.Put(tCtx)
+}
+
+// GetSpans returns the spans of the trace from the TransformContext.
+func (tCtx *TransformContext) GetSpans() []Span {
+	return tCtx.spans
+}
+
+// GetTraceID returns the trace ID of the spans from the TransformContext.
+func (tCtx *TransformContext) GetTraceID() pcommon.TraceID {
+	if len(tCtx.spans) == 0 {
+		return pcommon.NewTraceIDEmpty()
+	}
+	return tCtx.spans[0].Span.TraceID()
+}
+
+// HasRootSpan returns whether the trace of the TransformContext includes its root span.
+func (tCtx *TransformContext) HasRootSpan() bool {
+	return tCtx.root >= 0
+}
+
+// GetSpan returns the root span of the trace from the TransformContext, or an empty span
+// detached from the trace if the root span is not part of the trace. Use HasRootSpan to
+// tell them apart; the trace.root_span paths return nil and cannot be set in that case.
+func (tCtx *TransformContext) GetSpan() ptrace.Span {
+	if !tCtx.HasRootSpan() {
+		return ptrace.NewSpan()
+	}
+	return tCtx.spans[tCtx.root].Span
+}
+
+// GetTraceSpans returns the spans of the trace along with their resource.
+func (tCtx *TransformContext) GetTraceSpans() iter.Seq2[pcommon.Resource, ptrace.Span] {
+	return func(yield func(pcommon.Resource, ptrace.Span) bool) {
+		for _, s := range tCtx.spans {
+			if !yield(s.ResourceSpans.Resource(), s.Span) {
+				return
+			}
+		}
+	}
+}
+
+// EnablePathContextNames enables the support for path's context names on statements.
+// When this option is configured, all statement's paths must have a valid context prefix,
+// otherwise an error is reported.
+//
+// Experimental: *NOTE* this option is subject to change or removal in the future.
+func EnablePathContextNames() ottl.Option[*TransformContext] {
+	return func(p *ottl.Parser[*TransformContext]) {
+		ottl.WithPathContextNames[*TransformContext]([]string{
+			ctxtrace.Name,
+			ctxotelcol.Name,
+		})(p)
+	}
+}
+
+// StatementSequenceOption represents an option for configuring a statement sequence.
+type StatementSequenceOption func(*ottl.StatementSequence[*TransformContext])
+
+// WithStatementSequenceErrorMode sets the error mode for a statement sequence.
+func WithStatementSequenceErrorMode(errorMode ottl.ErrorMode) StatementSequenceOption {
+	return func(s *ottl.StatementSequence[*TransformContext]) {
+		ottl.WithStatementSequenceErrorMode[*TransformContext](errorMode)(s)
+	}
+}
+
+// WithStatementSequenceObserver sets the observer notified after each statement execution of a statement sequence.
+func WithStatementSequenceObserver(observer ottl.StatementObserver) StatementSequenceOption {
+	return func(s *ottl.StatementSequence[*TransformContext]) {
+		ottl.WithStatementSequenceObserver[*TransformContext](observer)(s)
+	}
+}
+
+// NewStatementSequence creates a new statement sequence with the provided statements and options.
+func NewStatementSequence(statements []*ottl.Statement[*TransformContext], telemetrySettings component.TelemetrySettings, options ...StatementSequenceOption) ottl.StatementSequence[*TransformContext] {
+	s := ottl.NewStatementSequence(statements, telemetrySettings)
+	for _, op := range options {
+		op(&s)
+	}
+	return s
+}
+
+// ConditionSequenceOption represents an option for configuring a condition sequence.
+type ConditionSequenceOption func(*ottl.ConditionSequence[*TransformContext])
+
+// WithConditionSequenceErrorMode sets the error mode for a condition sequence.
+func WithConditionSequenceErrorMode(errorMode ottl.ErrorMode) ConditionSequenceOption {
+	return func(c *ottl.ConditionSequence[*TransformContext]) {
+		ottl.WithConditionSequenceErrorMode[*TransformContext](errorMode)(c)
+	}
+}
+
+// NewConditionSequence creates a new condition sequence with the provided conditions and options.
+func NewConditionSequence(conditions []*ottl.Condition[*TransformContext], telemetrySettings component.TelemetrySettings, options ...ConditionSequenceOption) ottl.ConditionSequence[*TransformContext] {
+	c := ottl.NewConditionSequence(conditions, telemetrySettings)
+	for _, op := range options {
+		op(&c)
+	}
+	return c
+}
+
+// NewParser creates a new trace parser with the provided functions and options.
+func NewParser(
+	functions map[string]ottl.Factory[*TransformContext],
+	telemetrySettings component.TelemetrySettings,
+	options ...ottl.Option[*TransformContext],
+) (ottl.Parser[*TransformContext], error) {
+	return ctxcommon.NewParser(
+		functions,
+		telemetrySettings,
+		pathExpressionParser(getCache),
+		parseEnum,
+		options...,
+	)
+}
+
+func parseEnum(val *ottl.EnumSymbol) (*ottl.Enum, error) {
+	if val != nil {
+		if enum, ok := ctxspan.SymbolTable[*val]; ok {
+			return &enum, nil
+		}
+		return nil, fmt.Errorf("enum symbol, %s, not found", *val)
+	}
+	return nil, errors.New("enum symbol not provided")
+}
+
+func getCache(tCtx *TransformContext) pcommon.Map {
+	return tCtx.cache
+}
+
+func pathExpressionParser(cacheGetter ctxcache.Getter[*TransformContext]) ottl.PathExpressionParser[*TransformContext] {
+	return ctxcommon.PathExpressionParser[*TransformContext](
+		ctxtrace.Name,
+		ctxtrace.DocRef,
+		cacheGetter,
+		map[string]ottl.PathExpressionParser[*TransformContext]{
+			ctxtrace.Name:   ctxtrace.PathGetSetter[*TransformContext],
+			ctxotelcol.Name: ctxotelcol.PathGetSetter[*TransformContext],
+		})
+}
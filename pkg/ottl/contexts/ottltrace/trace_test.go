@@ -0,0 +1,161 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottltrace
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/internal/pathtest"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottltest"
+)
+
+var (
+	traceID  = [16]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}
+	traceID2 = [16]byte{16, 15, 14, 13, 12, 11, 10, 9, 8, 7, 6, 5, 4, 3, 2, 1}
+	spanID   = [8]byte{1, 2, 3, 4, 5, 6, 7, 8}
+	spanID2  = [8]byte{8, 7, 6, 5, 4, 3, 2, 1}
+)
+
+// createTelemetry returns two resources holding spans of two traces. The first trace has a
+// root span and a failed child span in another service, the second trace only has a child span.
+func createTelemetry() ptrace.Traces {
+	td := ptrace.NewTraces()
+
+	frontend := td.ResourceSpans().AppendEmpty()
+	frontend.Resource().Attributes().PutStr("service.name", "frontend")
+	frontendSpans := frontend.ScopeSpans().AppendEmpty().Spans()
+	root := frontendSpans.AppendEmpty()
+	root.SetTraceID(traceID)
+	root.SetSpanID(spanID)
+	root.SetName("root")
+	orphan := frontendSpans.AppendEmpty()
+	orphan.SetTraceID(traceID2)
+	orphan.SetSpanID(spanID2)
+	orphan.SetParentSpanID(spanID)
+	orphan.SetName("orphan")
+
+	backend := td.ResourceSpans().AppendEmpty()
+	backend.Resource().Attributes().PutStr("service.name", "backend")
+	child := backend.ScopeSpans().AppendEmpty().Spans().AppendEmpty()
+	child.SetTraceID(traceID)
+	child.SetSpanID(spanID2)
+	child.SetParentSpanID(spanID)
+	child.SetName("child")
+	child.Status().SetCode(ptrace.StatusCodeError)
+
+	return td
+}
+
+func Test_GroupSpansByTrace(t *testing.T) {
+	groups := GroupSpansByTrace(createTelemetry())
+	require.Len(t, groups, 2)
+
+	require.Len(t, groups[0], 2)
+	assert.Equal(t, "root", groups[0][0].Span.Name())
+	assert.Equal(t, "child", groups[0][1].Span.Name())
+	name, _ := groups[0][1].ResourceSpans.Resource().Attributes().Get("service.name")
+	assert.Equal(t, "backend", name.Str())
+
+	require.Len(t, groups[1], 1)
+	assert.Equal(t, "orphan", groups[1][0].Span.Name())
+}
+
+func Test_NewTransformContextPtr(t *testing.T) {
+	groups := GroupSpansByTrace(createTelemetry())
+
+	tCtx := NewTransformContextPtr(groups[0])
+	assert.Equal(t, pcommon.TraceID(traceID), tCtx.GetTraceID())
+	assert.True(t, tCtx.HasRootSpan())
+	assert.Equal(t, "root", tCtx.GetSpan().Name())
+	tCtx.Close()
+
+	tCtx = NewTransformContextPtr(groups[1])
+	assert.Equal(t, pcommon.TraceID(traceID2), tCtx.GetTraceID())
+	assert.False(t, tCtx.HasRootSpan())
+	assert.Empty(t, tCtx.GetSpan().Name())
+	tCtx.Close()
+}
+
+func Test_newPathGetSetter_cache(t *testing.T) {
+	tCtx := NewTransformContextPtr(GroupSpansByTrace(createTelemetry())[0])
+	defer tCtx.Close()
+
+	accessor, err := pathExpressionParser(getCache)(&pathtest.Path[*TransformContext]{N: "cache"})
+	require.NoError(t, err)
+	newCache := pcommon.NewMap()
+	newCache.PutStr("temp", "value")
+	require.NoError(t, accessor.Set(t.Context(), tCtx, newCache))
+	assert.Equal(t, newCache.AsRaw(), tCtx.cache.AsRaw())
+}
+
+func Test_StatementSequence(t *testing.T) {
+	parser, err := NewParser(ottlfuncs.StandardFuncs[*TransformContext](), componenttest.NewNopTelemetrySettings(), EnablePathContextNames())
+	require.NoError(t, err)
+	statements, err := parser.ParseStatements([]string{
+		`set(trace.root_span.attributes["trace.error_count"], trace.error_count) where trace.has_root_span and trace.error_count > 0`,
+		`set(trace.root_span.attributes["trace.services"], trace.services) where trace.has_root_span`,
+		`set(trace.root_span.attributes["trace.backend_duration"], trace.service_durations["backend"]) where trace.has_root_span`,
+	})
+	require.NoError(t, err)
+	sequence := NewStatementSequence(statements, componenttest.NewNopTelemetrySettings())
+
+	td := createTelemetry()
+	for _, spans := range GroupSpansByTrace(td) {
+		tCtx := NewTransformContextPtr(spans)
+		require.NoError(t, sequence.Execute(t.Context(), tCtx))
+		tCtx.Close()
+	}
+
+	root := td.ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(0)
+	assert.Equal(t, map[string]any{
+		"trace.error_count":      int64(1),
+		"trace.services":         []any{"backend", "frontend"},
+		"trace.backend_duration": int64(0),
+	}, root.Attributes().AsRaw())
+	orphan := td.ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(1)
+	assert.Equal(t, map[string]any{}, orphan.Attributes().AsRaw())
+}
+
+func Test_RootSpanMissing(t *testing.T) {
+	parser, err := NewParser(ottlfuncs.StandardFuncs[*TransformContext](), componenttest.NewNopTelemetrySettings(), EnablePathContextNames())
+	require.NoError(t, err)
+	statement, err := parser.ParseStatement(`set(trace.root_span.name, "renamed")`)
+	require.NoError(t, err)
+
+	td := createTelemetry()
+	tCtx := NewTransformContextPtr(GroupSpansByTrace(td)[1])
+	defer tCtx.Close()
+	_, _, err = statement.Execute(t.Context(), tCtx)
+	assert.ErrorContains(t, err, "the trace does not include its root span")
+	assert.NotEqual(t, "renamed", td.ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(1).Name())
+}
+
+func Test_ReadOnlyPath(t *testing.T) {
+	parser, err := NewParser(ottlfuncs.StandardFuncs[*TransformContext](), componenttest.NewNopTelemetrySettings(), EnablePathContextNames())
+	require.NoError(t, err)
+	statement, err := parser.ParseStatement(`set(trace.span_count, 0)`)
+	require.NoError(t, err)
+
+	tCtx := NewTransformContextPtr(GroupSpansByTrace(createTelemetry())[0])
+	defer tCtx.Close()
+	_, _, err = statement.Execute(t.Context(), tCtx)
+	assert.ErrorContains(t, err, `"trace.span_count" is read-only and cannot be modified`)
+}
+
+func Test_ParseEnum(t *testing.T) {
+	enum, err := parseEnum((*ottl.EnumSymbol)(ottltest.Strp("STATUS_CODE_ERROR")))
+	require.NoError(t, err)
+	assert.Equal(t, ottl.Enum(ptrace.StatusCodeError), *enum)
+
+	_, err = parseEnum((*ottl.EnumSymbol)(ottltest.Strp("UNKNOWN")))
+	assert.Error(t, err)
+}
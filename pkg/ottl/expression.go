@@ -1129,6 +1129,9 @@ func (p *Parser[K]) newGetter(val value) (Getter[K], error) {
 		if eL.Converter != nil {
 			return p.newGetterFromConverter(*eL.Converter)
 		}
+		if eL.Variable != nil {
+			return p.newVariableGetter(eL.Variable)
+		}
 	}
 
 	if val.List != nil {
@@ -61,6 +61,10 @@ func buildOriginalKeysText(keys []key) string {
 				if k.Expression.Path != nil {
 					builder.WriteString(buildOriginalText(k.Expression.Path))
 				}
+				if k.Expression.Variable != nil {
+					builder.WriteString(k.Expression.Variable.Name)
+					builder.WriteString(buildOriginalKeysText(k.Expression.Variable.Keys))
+				}
 				if k.Expression.Float != nil {
 					builder.WriteString(strconv.FormatFloat(*k.Expression.Float, 'f', 10, 64))
 				}
@@ -244,6 +248,13 @@ func (p *Parser[K]) newKeys(keys []key) ([]Key[K], error) {
 				}
 				getter = g
 			}
+			if keys[i].Expression.Variable != nil {
+				g, err := p.newVariableGetter(keys[i].Expression.Variable)
+				if err != nil {
+					return nil, err
+				}
+				getter = g
+			}
 		}
 		if keys[i].MathExpression != nil {
 			g, err := p.evaluateMathExpression(keys[i].MathExpression)
@@ -556,6 +567,9 @@ func (p *Parser[K]) buildArg(argVal value, argType reflect.Type) (any, error) {
 		if argVal.Literal != nil && argVal.Literal.Path != nil {
 			return p.buildGetSetterFromPath(argVal.Literal.Path)
 		}
+		if argVal.Literal != nil && argVal.Literal.Variable != nil {
+			return nil, fmt.Errorf("must be a path, variable %s can only be set with a let statement", argVal.Literal.Variable.Name)
+		}
 		return nil, errors.New("must be a path")
 	case strings.HasPrefix(name, "Getter"):
 		arg, err := p.newGetter(argVal)
@@ -13,9 +13,11 @@ import (
 
 // parsedStatement represents a parsed statement. It is the entry point into the statement DSL.
 type parsedStatement struct {
-	Editor editor `parser:"(@@"`
+	Let     *letStatement  `parser:"( @@"`
+	ForEach *forEachClause `parser:"| @@?"`
+	Editor  editor         `parser:"( @@"`
 	// If converter is matched then return error
-	Converter   *converter         `parser:"|@@)"`
+	Converter   *converter         `parser:"| @@ ) )"`
 	WhereClause *booleanExpression `parser:"( 'where' @@ )?"`
 }
 
@@ -25,12 +27,36 @@ func (p *parsedStatement) checkForCustomError() error {
 		validator.add(fmt.Errorf("editor names must start with a lowercase letter but got '%v'", p.Converter.Function))
 	}
 
-	p.Editor.accept(validator)
+	p.accept(validator)
+
+	return validator.join()
+}
+
+func (p *parsedStatement) accept(v grammarVisitor) {
+	if p.Let != nil {
+		p.Let.Value.accept(v)
+	} else {
+		if p.ForEach != nil {
+			p.ForEach.Iterable.accept(v)
+		}
+		p.Editor.accept(v)
+	}
 	if p.WhereClause != nil {
-		p.WhereClause.accept(validator)
+		p.WhereClause.accept(v)
 	}
+}
 
-	return validator.join()
+// letStatement binds the result of a value to a variable, e.g. `let $body = ParseJSON(body)`.
+type letStatement struct {
+	Variable string `parser:"'let' @Variable '='"`
+	Value    value  `parser:"@@"`
+}
+
+// forEachClause invokes the statement's editor for each item of a map or a slice, binding
+// the items to variables, e.g. `for each $key, $value in attributes: ...`.
+type forEachClause struct {
+	Variables []string `parser:"'for' 'each' @Variable ( ',' @Variable )?"`
+	Iterable  value    `parser:"'in' @@ ':'"`
 }
 
 type constExpr struct {
@@ -367,6 +393,7 @@ type mathExprLiteral struct {
 	Converter *converter `parser:"| @@"`
 	Float     *float64   `parser:"| @Float"`
 	Int       *int64     `parser:"| @Int"`
+	Variable  *variable  `parser:"| @@"`
 	Path      *path      `parser:"| @@ )"`
 }
 
@@ -383,6 +410,12 @@ func (m *mathExprLiteral) accept(v grammarVisitor) {
 	}
 }
 
+// variable is a reference to a variable bound by a let statement or a for each clause.
+type variable struct {
+	Name string `parser:"@Variable"`
+	Keys []key  `parser:"( @@ )*"`
+}
+
 type mathValue struct {
 	UnaryOp       *mathOp          `parser:"@OpAddSub?"`
 	Literal       *mathExprLiteral `parser:"( @@"`
@@ -519,6 +552,7 @@ func buildLexer() *lexer.StatefulDefinition {
 		{Name: `LBrace`, Pattern: `\{`},
 		{Name: `RBrace`, Pattern: `\}`},
 		{Name: `Colon`, Pattern: `\:`},
+		{Name: `Variable`, Pattern: `\$[a-zA-Z_][a-zA-Z0-9_]*`},
 		{Name: `Punct`, Pattern: `[,.\[\]]`},
 		{Name: `Uppercase`, Pattern: `[A-Z][A-Z0-9_]*`},
 		{Name: `Lowercase`, Pattern: `[a-z][a-z0-9_]*`},
@@ -595,4 +629,12 @@ func (g *grammarCustomErrorsVisitor) visitMathExprLiteral(v *mathExprLiteral) {
 	if v.Editor != nil {
 		g.add(fmt.Errorf("converter names must start with an uppercase letter but got '%v'", v.Editor.Function))
 	}
+	if v.Variable != nil {
+		for _, k := range v.Variable.Keys {
+			if k.String == nil && k.Int == nil {
+				g.add(fmt.Errorf("variables may only be indexed by string or int literals, but got %s%s", v.Variable.Name, buildOriginalKeysText(v.Variable.Keys)))
+				break
+			}
+		}
+	}
 }
@@ -191,6 +191,27 @@ func Test_lexer(t *testing.T) {
 			{"String", `"foo"`},
 			{"Punct", "]"},
 		}},
+		{"Let statement", `let $body = ParseJSON(body)`, false, []result{
+			{"Lowercase", "let"},
+			{"Variable", "$body"},
+			{"Equal", "="},
+			{"Uppercase", "P"},
+			{"Lowercase", "arse"},
+			{"Uppercase", "JSON"},
+			{"LParen", "("},
+			{"Lowercase", "body"},
+			{"RParen", ")"},
+		}},
+		{"For each clause", `for each $k, $v in attributes:`, false, []result{
+			{"Lowercase", "for"},
+			{"Lowercase", "each"},
+			{"Variable", "$k"},
+			{"Punct", ","},
+			{"Variable", "$v"},
+			{"Lowercase", "in"},
+			{"Lowercase", "attributes"},
+			{"Colon", ":"},
+		}},
 		{"Float variations with trailing dot", "1. 2. 3.", false, []result{
 			{"Float", "1."},
 			{"Float", "2."},
@@ -10,6 +10,7 @@ import (
 	"sort"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/alecthomas/participle/v2"
 	"go.opentelemetry.io/collector/component"
@@ -24,6 +25,9 @@ type Statement[K any] struct {
 	condition         boolExpr[K]
 	origText          string
 	telemetrySettings component.TelemetrySettings
+	// declaresVariables is set for let statements and for each statements, which need
+	// variables to be held by the context.
+	declaresVariables bool
 }
 
 // Execute is a function that will execute the statement's function if the statement's condition is met.
@@ -31,6 +35,9 @@ type Statement[K any] struct {
 // If the statement contains no condition, the function will run and true will be returned.
 // In addition, the functions return value is always returned.
 func (s *Statement[K]) Execute(ctx context.Context, tCtx K) (any, bool, error) {
+	if s.declaresVariables {
+		ctx = contextWithVariables(ctx)
+	}
 	condition, err := s.condition.Eval(ctx, tCtx)
 	defer func() {
 		if s.telemetrySettings.Logger.Core().Enabled(zap.DebugLevel) {
@@ -69,6 +76,8 @@ type Parser[K any] struct {
 	enumParser        EnumParser
 	telemetrySettings component.TelemetrySettings
 	pathContextNames  map[string]struct{}
+	// variables holds the variables that can be referred to while parsing a statement.
+	variables *variableScope
 }
 
 // NewParser creates a new Parser
@@ -122,14 +131,17 @@ func WithPathContextNames[K any](contexts []string) Option[K] {
 }
 
 // ParseStatements parses string statements into ottl.Statement objects ready for execution.
+// The statements form a block: variables defined by a statement can be referred to by the
+// following ones.
 // Returns a slice of statements and a nil error on successful parsing.
 // If parsing fails, returns nil and a joined error containing each error per failed statement.
 func (p *Parser[K]) ParseStatements(statements []string) ([]*Statement[K], error) {
 	parsedStatements := make([]*Statement[K], 0, len(statements))
 	var parseErrs []error
 
+	scope := newVariableScope()
 	for _, statement := range statements {
-		ps, err := p.ParseStatement(statement)
+		ps, err := p.parseStatement(statement, scope)
 		if err != nil {
 			parseErrs = append(parseErrs, fmt.Errorf("unable to parse OTTL statement %q: %w", statement, err))
 			continue
@@ -148,23 +160,47 @@ func (p *Parser[K]) ParseStatements(statements []string) ([]*Statement[K], error
 // Returns a Statement and a nil error on successful parsing.
 // If parsing fails, returns nil and an error.
 func (p *Parser[K]) ParseStatement(statement string) (*Statement[K], error) {
+	return p.parseStatement(statement, newVariableScope())
+}
+
+func (p *Parser[K]) parseStatement(statement string, scope *variableScope) (*Statement[K], error) {
 	parsed, err := parseStatement(statement)
 	if err != nil {
 		return nil, err
 	}
-	function, err := p.newFunctionCall(parsed.Editor)
-	if err != nil {
-		return nil, err
-	}
-	expression, err := p.newBoolExpr(parsed.WhereClause)
-	if err != nil {
-		return nil, err
+	sp := p.withVariableScope(scope)
+
+	var function Expr[K]
+	var expression boolExpr[K]
+	switch {
+	case parsed.Let != nil:
+		function, expression, err = sp.newLetStatement(parsed.Let, parsed.WhereClause)
+		if err != nil {
+			return nil, err
+		}
+	case parsed.ForEach != nil:
+		// The where clause is evaluated for each item.
+		function, err = sp.newForEachStatement(parsed.ForEach, parsed.Editor, parsed.WhereClause)
+		if err != nil {
+			return nil, err
+		}
+		expression = newAlwaysTrue[K]()
+	default:
+		function, err = sp.newFunctionCall(parsed.Editor)
+		if err != nil {
+			return nil, err
+		}
+		expression, err = sp.newBoolExpr(parsed.WhereClause)
+		if err != nil {
+			return nil, err
+		}
 	}
 	return &Statement[K]{
 		function:          function,
 		condition:         expression,
 		origText:          statement,
 		telemetrySettings: p.telemetrySettings,
+		declaresVariables: parsed.Let != nil || parsed.ForEach != nil,
 	}, nil
 }
 
@@ -364,8 +400,26 @@ type StatementSequence[K any] struct {
 	statements        []*Statement[K]
 	errorMode         ErrorMode
 	telemetrySettings component.TelemetrySettings
+	declaresVariables bool
+	observer          StatementObserver
 }
 
+// StatementExecution describes an execution of a statement of a StatementSequence.
+type StatementExecution struct {
+	// Statement is the original text of the statement.
+	Statement string
+	// ConditionMatched is set when the statement's condition was met and its function invoked.
+	ConditionMatched bool
+	// Duration is the time spent evaluating the statement's condition and function.
+	Duration time.Duration
+	// Err is the error returned by the statement, if any.
+	Err error
+}
+
+// StatementObserver is notified after each execution of a statement of a StatementSequence.
+// It is invoked synchronously, and must be safe for concurrent use.
+type StatementObserver func(ctx context.Context, execution StatementExecution)
+
 // StatementSequenceOption is an option for a StatementSequence
 type StatementSequenceOption[K any] func(*StatementSequence[K])
 
@@ -376,6 +430,14 @@ func WithStatementSequenceErrorMode[K any](errorMode ErrorMode) StatementSequenc
 	}
 }
 
+// WithStatementSequenceObserver sets a StatementObserver notified after each execution of the
+// statements of a StatementSequence. The statements are not timed when no observer is set.
+func WithStatementSequenceObserver[K any](observer StatementObserver) StatementSequenceOption[K] {
+	return func(s *StatementSequence[K]) {
+		s.observer = observer
+	}
+}
+
 // NewStatementSequence creates a new StatementSequence with the provided Statement slice and component.TelemetrySettings.
 // The default ErrorMode is `Propagate`.
 // You may also augment the StatementSequence with a slice of StatementSequenceOption.
@@ -388,6 +450,9 @@ func NewStatementSequence[K any](statements []*Statement[K], telemetrySettings c
 	for _, op := range options {
 		op(&s)
 	}
+	for _, statement := range statements {
+		s.declaresVariables = s.declaresVariables || statement.declaresVariables
+	}
 	return s
 }
 
@@ -399,8 +464,24 @@ func (s *StatementSequence[K]) Execute(ctx context.Context, tCtx K) error {
 	if s.telemetrySettings.Logger.Core().Enabled(zap.DebugLevel) {
 		s.telemetrySettings.Logger.Debug("initial TransformContext before executing StatementSequence", zap.Any("TransformContext", tCtx))
 	}
+	if s.declaresVariables {
+		// Variables are scoped to a single execution of the sequence.
+		ctx = newVariablesContext(ctx)
+	}
 	for _, statement := range s.statements {
-		_, _, err := statement.Execute(ctx, tCtx)
+		var start time.Time
+		if s.observer != nil {
+			start = time.Now()
+		}
+		_, matched, err := statement.Execute(ctx, tCtx)
+		if s.observer != nil {
+			s.observer(ctx, StatementExecution{
+				Statement:        statement.origText,
+				ConditionMatched: matched,
+				Duration:         time.Since(start),
+				Err:              err,
+			})
+		}
 		if err != nil {
 			if s.errorMode == PropagateError {
 				err = fmt.Errorf("failed to execute statement: %v, %w", statement.origText, err)
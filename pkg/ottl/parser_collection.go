@@ -82,6 +82,7 @@ type ParserCollection[R any] struct {
 	modifiedLogging           bool
 	Settings                  component.TelemetrySettings
 	ErrorMode                 ErrorMode
+	StatementObserver         StatementObserver
 }
 
 // ParserCollectionOption is a configurable ParserCollection option.
@@ -192,6 +193,7 @@ type (
 		parseStatements       parserCollectionContextParserFunc[R, StatementsGetter]
 		parseConditions       parserCollectionContextParserFunc[R, ConditionsGetter]
 		parseValueExpressions parserCollectionContextParserFunc[R, ValueExpressionsGetter]
+		analyzeStatements     func(context string, statements []string, prependPathsContext bool) (*StatementsAnalysis, error)
 	}
 )
 
@@ -306,6 +308,24 @@ func createStatementsParserWithConverter[K, R any](converter ParsedStatementsCon
 	}
 }
 
+// createStatementsAnalyzer is a method to create the necessary analyzer wrapper and shadowing the K type.
+func createStatementsAnalyzer[K any](parser *Parser[K]) func(string, []string, bool) (*StatementsAnalysis, error) {
+	return func(context string, statements []string, prependPathsContext bool) (*StatementsAnalysis, error) {
+		if !prependPathsContext {
+			return parser.AnalyzeStatements(statements)
+		}
+		analyzingStatements := make([]string, 0, len(statements))
+		for _, statement := range statements {
+			prependedStatement, err := parser.prependContextToStatementPaths(context, statement)
+			if err != nil {
+				return nil, err
+			}
+			analyzingStatements = append(analyzingStatements, prependedStatement)
+		}
+		return parser.AnalyzeStatements(analyzingStatements)
+	}
+}
+
 // WithConditionConverter sets the condition converter for the given context.
 // The provided converter function will be used to convert parsed OTTL conditions into a common representation of type R.
 // The context's OTTL parser will parse the conditions, and the converter function will transform the parsed conditions into the desired representation.
@@ -353,7 +373,9 @@ func WithParserCollectionContext[K, R any](
 		if _, ok := parser.pathContextNames[context]; !ok {
 			return fmt.Errorf(`context "%s" must be a valid "%T" path context name`, context, parser)
 		}
-		pcp := &ParserCollectionContextParser[R]{}
+		pcp := &ParserCollectionContextParser[R]{
+			analyzeStatements: createStatementsAnalyzer(parser),
+		}
 		for _, o := range opts {
 			o(pcp, parser)
 		}
@@ -395,6 +417,18 @@ func WithParserCollectionErrorMode[R any](errorMode ErrorMode) ParserCollectionO
 	}
 }
 
+// WithParserCollectionStatementObserver has no effect on the ParserCollection, but might be used
+// by the ParsedStatementsConverter functions to create StatementSequence observed by the given
+// StatementObserver.
+//
+// Experimental: *NOTE* this API is subject to change or removal in the future.
+func WithParserCollectionStatementObserver[R any](observer StatementObserver) ParserCollectionOption[R] {
+	return func(tp *ParserCollection[R]) error {
+		tp.StatementObserver = observer
+		return nil
+	}
+}
+
 // EnableParserCollectionModifiedPathsLogging controls the modification logs.
 // When enabled, it logs any modifications performed by the parsing operations,
 // instructing users to rewrite the statements accordingly.
@@ -442,6 +476,16 @@ func WithContextInferenceConditions(conditions []string) ParserCollectionContext
 //
 // Experimental: *NOTE* this API is subject to change or removal in the future.
 func (pc *ParserCollection[R]) ParseStatements(statements StatementsGetter, options ...ParserCollectionContextInferenceOption) (R, error) {
+	inferredContext, err := pc.inferStatementsContext(statements, options...)
+	if err != nil {
+		return *new(R), err
+	}
+	return pc.ParseStatementsWithContext(inferredContext, statements, false)
+}
+
+// inferStatementsContext infers the context of the given statements, returning an error if
+// it is not supported by the [ParserCollection].
+func (pc *ParserCollection[R]) inferStatementsContext(statements StatementsGetter, options ...ParserCollectionContextInferenceOption) (string, error) {
 	statementsValues := statements.GetStatements()
 
 	parseStatementsOpts := parseCollectionContextInferenceOptions{}
@@ -460,19 +504,19 @@ func (pc *ParserCollection[R]) ParseStatements(statements StatementsGetter, opti
 	}
 
 	if err != nil {
-		return *new(R), fmt.Errorf("unable to infer a valid context (%+q) from statements %+q and conditions %+q: %w", pc.supportedContextNames(), statementsValues, conditionsValues, err)
+		return "", fmt.Errorf("unable to infer a valid context (%+q) from statements %+q and conditions %+q: %w", pc.supportedContextNames(), statementsValues, conditionsValues, err)
 	}
 
 	if inferredContext == "" {
-		return *new(R), fmt.Errorf("unable to infer context from statements %+q and conditions %+q, path's first segment must be a valid context name %+q, and at least one context must be capable of parsing all statements", pc.supportedContextNames(), statementsValues, conditionsValues)
+		return "", fmt.Errorf("unable to infer context from statements %+q and conditions %+q, path's first segment must be a valid context name %+q, and at least one context must be capable of parsing all statements", pc.supportedContextNames(), statementsValues, conditionsValues)
 	}
 
 	_, ok := pc.contextParsers[inferredContext]
 	if !ok {
-		return *new(R), fmt.Errorf(`context "%s" inferred from the statements %+q and conditions %+q is not a supported context: %+q`, inferredContext, statementsValues, conditionsValues, pc.supportedContextNames())
+		return "", fmt.Errorf(`context "%s" inferred from the statements %+q and conditions %+q is not a supported context: %+q`, inferredContext, statementsValues, conditionsValues, pc.supportedContextNames())
 	}
 
-	return pc.ParseStatementsWithContext(inferredContext, statements, false)
+	return inferredContext, nil
 }
 
 // ParseStatementsWithContext parses the given statements into [R] using the configured
@@ -502,6 +546,33 @@ func (pc *ParserCollection[R]) ParseStatementsWithContext(context string, statem
 	)
 }
 
+// AnalyzeStatements statically analyzes the given statements using the ottl.Parser of the
+// context inferred from the statements, the same way ParseStatements infers it.
+// See [Parser.AnalyzeStatements] for the details of the analysis.
+//
+// Experimental: *NOTE* this API is subject to change or removal in the future.
+func (pc *ParserCollection[R]) AnalyzeStatements(statements StatementsGetter, options ...ParserCollectionContextInferenceOption) (*StatementsAnalysis, error) {
+	inferredContext, err := pc.inferStatementsContext(statements, options...)
+	if err != nil {
+		return nil, err
+	}
+	return pc.AnalyzeStatementsWithContext(inferredContext, statements, false)
+}
+
+// AnalyzeStatementsWithContext statically analyzes the given statements using the provided
+// context's ottl.Parser. The context value must be supported by the [ParserCollection],
+// otherwise an error is returned. The prependPathsContext argument has the same meaning as
+// for ParseStatementsWithContext.
+//
+// Experimental: *NOTE* this API is subject to change or removal in the future.
+func (pc *ParserCollection[R]) AnalyzeStatementsWithContext(context string, statements StatementsGetter, prependPathsContext bool) (*StatementsAnalysis, error) {
+	contextParser, ok := pc.contextParsers[context]
+	if !ok {
+		return nil, fmt.Errorf(`unknown context "%s" for statements: %v`, context, statements.GetStatements())
+	}
+	return contextParser.analyzeStatements(context, statements.GetStatements(), prependPathsContext)
+}
+
 // ParseConditions parses the given conditions into [R] using the configured context's ottl.Parser
 // and subsequently calling the ParsedConditionsConverter function.
 // The condition's context is automatically inferred from the [Path.Context] values, choosing the
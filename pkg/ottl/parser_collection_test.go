@@ -191,6 +191,21 @@ func Test_WithParserCollectionErrorMode(t *testing.T) {
 	require.Equal(t, PropagateError, pc.ErrorMode)
 }
 
+func Test_WithParserCollectionStatementObserver(t *testing.T) {
+	var observed bool
+	pc, err := NewParserCollection[any](
+		componenttest.NewNopTelemetrySettings(),
+		WithParserCollectionStatementObserver[any](func(context.Context, StatementExecution) {
+			observed = true
+		}),
+	)
+
+	require.NoError(t, err)
+	require.NotNil(t, pc.StatementObserver)
+	pc.StatementObserver(t.Context(), StatementExecution{})
+	assert.True(t, observed)
+}
+
 func Test_EnableParserCollectionModifiedPathsLogging_True(t *testing.T) {
 	ps := mockParser(t, WithPathContextNames[any]([]string{"dummy"}))
 	core, observedLogs := observer.New(zap.InfoLevel)
@@ -285,6 +300,47 @@ func Test_ParseStatements_Success(t *testing.T) {
 	assert.NotNil(t, result)
 }
 
+func Test_AnalyzeStatements(t *testing.T) {
+	ps := mockParser(t, WithPathContextNames[any]([]string{"foo"}))
+
+	pc, err := NewParserCollection(
+		componenttest.NewNopTelemetrySettings(),
+		WithParserCollectionContext("foo", ps, WithStatementConverter(newNopParsedStatementsConverter[any]())),
+	)
+	require.NoError(t, err)
+
+	statements := mockGetter{values: []string{`set(foo.attributes["bar"], "foo")`, `set(foo.attributes["bar"], "bar")`}}
+	analysis, err := pc.AnalyzeStatements(statements)
+	require.NoError(t, err)
+
+	require.Len(t, analysis.Statements, 2)
+	assert.Equal(t, []string{`foo.attributes["bar"]`}, analysis.Statements[0].Writes)
+	assert.Equal(t, []AnalysisFinding{{
+		Kind:    FindingOverwritten,
+		Message: `foo.attributes["bar"] written by statement 0 is overwritten by statement 1 before being read`,
+	}}, analysis.Findings())
+}
+
+func Test_AnalyzeStatementsWithContext(t *testing.T) {
+	ps := mockParser(t, WithPathContextNames[any]([]string{"foo"}))
+
+	pc, err := NewParserCollection(
+		componenttest.NewNopTelemetrySettings(),
+		WithParserCollectionContext("foo", ps, WithStatementConverter(newNopParsedStatementsConverter[any]())),
+	)
+	require.NoError(t, err)
+
+	statements := mockGetter{values: []string{`set(attributes["bar"], "foo")`}}
+	analysis, err := pc.AnalyzeStatementsWithContext("foo", statements, true)
+	require.NoError(t, err)
+	require.Len(t, analysis.Statements, 1)
+	assert.Equal(t, `set(foo.attributes["bar"], "foo")`, analysis.Statements[0].Statement)
+	assert.Equal(t, []string{`foo.attributes["bar"]`}, analysis.WrittenNotRead)
+
+	_, err = pc.AnalyzeStatementsWithContext("bar", statements, true)
+	assert.ErrorContains(t, err, `unknown context "bar"`)
+}
+
 func Test_ParseStatements_MultipleContexts_Success(t *testing.T) {
 	fooParser := mockParser(t, WithPathContextNames[any]([]string{"foo"}))
 	barParser := mockParser(t, WithPathContextNames[any]([]string{"bar"}))
@@ -2492,6 +2492,19 @@ func Test_parseStatement(t *testing.T) {
 		{statement: `Test()`, wantErr: true},
 		{statement: `set() where test(foo)["key"] == "bar"`, wantErrContaining: converterNameErrorPrefix},
 		{statement: `set() where test(foo)["key"] == "bar"`, wantErrContaining: editorWithIndexErrorPrefix},
+		{statement: `let $x = 1`},
+		{statement: `let $x = ParseJSON(body)["key"] where $y == 1`},
+		{statement: `let x = 1`, wantErr: true},
+		{statement: `let $x`, wantErr: true},
+		{statement: `let $x = set(foo)`, wantErrContaining: converterNameErrorPrefix},
+		{statement: `set(attributes[$k], $v["key"][0])`},
+		{statement: `set(attributes["a"], $v[attributes["key"]])`, wantErrContaining: "variables may only be indexed by string or int literals"},
+		{statement: `for each $k in attributes: delete_key(attributes, $k) where IsMatch($k, "^tmp")`},
+		{statement: `for each $i, $v in attributes["list"]: set(attributes[$v], $i)`},
+		{statement: `for each $a, $b, $c in attributes: set(foo, $a)`, wantErr: true},
+		{statement: `for each $k in attributes set(foo, $k)`, wantErr: true},
+		{statement: `for each $k in attributes: Set(foo, $k)`, wantErr: true},
+		{statement: `for $k in attributes: set(foo, $k)`, wantErr: true},
 	}
 	pat := regexp.MustCompile("[^a-zA-Z0-9]+")
 	for _, tt := range tests {
@@ -2780,6 +2793,46 @@ func Test_Statements_Execute_Error(t *testing.T) {
 	}
 }
 
+func Test_StatementSequence_Observer(t *testing.T) {
+	var executions []StatementExecution
+	statements := NewStatementSequence([]*Statement[any]{
+		{
+			condition:         newAlwaysTrue[any](),
+			function:          Expr[any]{exprFunc: func(context.Context, any) (any, error) { return nil, nil }},
+			origText:          "matched",
+			telemetrySettings: componenttest.NewNopTelemetrySettings(),
+		},
+		{
+			condition:         newAlwaysFalse[any](),
+			function:          Expr[any]{exprFunc: func(context.Context, any) (any, error) { return nil, nil }},
+			origText:          "not matched",
+			telemetrySettings: componenttest.NewNopTelemetrySettings(),
+		},
+		{
+			condition:         newAlwaysTrue[any](),
+			function:          Expr[any]{exprFunc: func(context.Context, any) (any, error) { return nil, errors.New("test") }},
+			origText:          "failed",
+			telemetrySettings: componenttest.NewNopTelemetrySettings(),
+		},
+	}, componenttest.NewNopTelemetrySettings(),
+		WithStatementSequenceErrorMode[any](IgnoreError),
+		WithStatementSequenceObserver[any](func(_ context.Context, execution StatementExecution) {
+			executions = append(executions, execution)
+		}),
+	)
+
+	require.NoError(t, statements.Execute(t.Context(), nil))
+	require.Len(t, executions, 3)
+	assert.Equal(t, "matched", executions[0].Statement)
+	assert.True(t, executions[0].ConditionMatched)
+	assert.NoError(t, executions[0].Err)
+	assert.Equal(t, "not matched", executions[1].Statement)
+	assert.False(t, executions[1].ConditionMatched)
+	assert.Equal(t, "failed", executions[2].Statement)
+	assert.True(t, executions[2].ConditionMatched)
+	assert.EqualError(t, executions[2].Err, "test")
+}
+
 func Test_ConditionSequence_Eval(t *testing.T) {
 	tests := []struct {
 		name           string
@@ -249,6 +249,82 @@ func BenchmarkStatementSequenceExecuteMetrics(b *testing.B) {
 	}
 }
 
+func BenchmarkStatementSequenceExecuteVariables(b *testing.B) {
+	settings := componenttest.NewNopTelemetrySettings()
+	parser, err := ottllog.NewParser(ottlfuncs.StandardFuncs[*ottllog.TransformContext](), settings, ottllog.EnablePathContextNames())
+	if err != nil {
+		b.Fatalf("failed to create log parser: %v", err)
+	}
+
+	scenarios := []struct {
+		name       string
+		statements []string
+	}{
+		{
+			name: "parse_json_repeated",
+			statements: []string{
+				`set(log.attributes["user"], ParseJSON(log.body)["user"])`,
+				`set(log.attributes["region"], ParseJSON(log.body)["region"])`,
+				`set(log.attributes["status"], ParseJSON(log.body)["status"])`,
+			},
+		},
+		{
+			name: "parse_json_cache",
+			statements: []string{
+				`set(log.cache["body"], ParseJSON(log.body))`,
+				`set(log.attributes["user"], log.cache["body"]["user"])`,
+				`set(log.attributes["region"], log.cache["body"]["region"])`,
+				`set(log.attributes["status"], log.cache["body"]["status"])`,
+			},
+		},
+		{
+			name: "parse_json_variable",
+			statements: []string{
+				`let $body = ParseJSON(log.body)`,
+				`set(log.attributes["user"], $body["user"])`,
+				`set(log.attributes["region"], $body["region"])`,
+				`set(log.attributes["status"], $body["status"])`,
+			},
+		},
+		{
+			name: "for_each_filter",
+			statements: []string{
+				`for each $key, $value in log.attributes: set(log.cache[$key], $value) where IsMatch($key, "^source_1")`,
+			},
+		},
+	}
+
+	ctx := b.Context()
+
+	for _, scenario := range scenarios {
+		parsed, err := parser.ParseStatements(scenario.statements)
+		if err != nil {
+			b.Fatalf("failed to parse log statements: %v", err)
+		}
+		sequence := ottllog.NewStatementSequence(parsed, settings)
+
+		contexts := make([]*ottllog.TransformContext, benchmarkContextPoolSize)
+		for i := range contexts {
+			contexts[i] = newBenchmarkLogContext(50)
+			contexts[i].GetLogRecord().Body().SetStr(`{"user":"alice","region":"us-west-2","status":200,"tags":["prod","critical"]}`)
+		}
+
+		b.Run(scenario.name, func(b *testing.B) {
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; b.Loop(); i++ {
+				if err := sequence.Execute(ctx, contexts[i%len(contexts)]); err != nil {
+					b.Fatalf("failed to execute log statements: %v", err)
+				}
+			}
+		})
+
+		for i := range contexts {
+			contexts[i].Close()
+		}
+	}
+}
+
 func BenchmarkConditionSequenceEvalLogs(b *testing.B) {
 	settings := componenttest.NewNopTelemetrySettings()
 	parser, err := ottllog.NewParser(ottlfuncs.StandardFuncs[*ottllog.TransformContext](), settings, ottllog.EnablePathContextNames())
@@ -0,0 +1,368 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottl // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/internal/ottlcommon"
+)
+
+// variableType is the type of a variable known at parse time, used to report invalid
+// usages of variables when parsing statements.
+type variableType int
+
+const (
+	variableTypeAny variableType = iota
+	variableTypeString
+	variableTypeInt
+	variableTypeFloat
+	variableTypeBool
+	variableTypeBytes
+	variableTypeMap
+	variableTypeList
+)
+
+func (t variableType) String() string {
+	switch t {
+	case variableTypeString:
+		return "string"
+	case variableTypeInt:
+		return "int"
+	case variableTypeFloat:
+		return "float"
+	case variableTypeBool:
+		return "bool"
+	case variableTypeBytes:
+		return "bytes"
+	case variableTypeMap:
+		return "map"
+	case variableTypeList:
+		return "list"
+	default:
+		return "any"
+	}
+}
+
+// indexableBy returns whether values of the type may be indexed by the given key.
+func (t variableType) indexableBy(k key) bool {
+	switch t {
+	case variableTypeAny:
+		return true
+	case variableTypeMap:
+		return k.String != nil
+	case variableTypeList, variableTypeBytes:
+		return k.Int != nil
+	default:
+		return false
+	}
+}
+
+// iterable returns whether values of the type may be iterated over by a for each clause.
+func (t variableType) iterable() bool {
+	return t == variableTypeAny || t == variableTypeMap || t == variableTypeList
+}
+
+type variableDefinition struct {
+	slot int
+	typ  variableType
+}
+
+// variableScope holds the variables defined while parsing a block of statements. Each
+// variable is assigned a slot, which is its index in the variables of an execution.
+type variableScope struct {
+	defined map[string]variableDefinition
+	slots   int
+}
+
+func newVariableScope() *variableScope {
+	return &variableScope{defined: map[string]variableDefinition{}}
+}
+
+// define defines the variable, or updates its type if it is already defined, and returns
+// its slot.
+func (s *variableScope) define(name string, typ variableType) int {
+	if def, ok := s.defined[name]; ok {
+		if def.typ != typ {
+			def.typ = variableTypeAny
+			s.defined[name] = def
+		}
+		return def.slot
+	}
+	s.defined[name] = variableDefinition{slot: s.slots, typ: typ}
+	s.slots++
+	return s.slots - 1
+}
+
+func (s *variableScope) undefine(name string) {
+	delete(s.defined, name)
+}
+
+func (s *variableScope) lookup(name string) (variableDefinition, bool) {
+	if s == nil {
+		return variableDefinition{}, false
+	}
+	def, ok := s.defined[name]
+	return def, ok
+}
+
+// typeOf returns the type of the value when it is known at parse time.
+func (s *variableScope) typeOf(val value) variableType {
+	switch {
+	case val.String != nil:
+		return variableTypeString
+	case val.Bool != nil:
+		return variableTypeBool
+	case val.Bytes != nil:
+		return variableTypeBytes
+	case val.Enum != nil:
+		return variableTypeInt
+	case val.Map != nil:
+		return variableTypeMap
+	case val.List != nil:
+		return variableTypeList
+	case val.Literal != nil:
+		switch {
+		case val.Literal.Int != nil:
+			return variableTypeInt
+		case val.Literal.Float != nil:
+			return variableTypeFloat
+		case val.Literal.Variable != nil && len(val.Literal.Variable.Keys) == 0:
+			if def, ok := s.lookup(val.Literal.Variable.Name); ok {
+				return def.typ
+			}
+		}
+	}
+	return variableTypeAny
+}
+
+type variablesContextKey struct{}
+
+// variables holds the values of the variables while executing statements, indexed by slot.
+type variables struct {
+	values []any
+}
+
+func (v *variables) get(slot int) any {
+	if v == nil || slot >= len(v.values) {
+		return nil
+	}
+	return v.values[slot]
+}
+
+func (v *variables) set(slot int, val any) {
+	if slot >= len(v.values) {
+		v.values = append(v.values, make([]any, slot+1-len(v.values))...)
+	}
+	v.values[slot] = val
+}
+
+// contextWithVariables returns a context holding new variables, unless ctx already holds some.
+func contextWithVariables(ctx context.Context) context.Context {
+	if _, ok := ctx.Value(variablesContextKey{}).(*variables); ok {
+		return ctx
+	}
+	return newVariablesContext(ctx)
+}
+
+func newVariablesContext(ctx context.Context) context.Context {
+	return context.WithValue(ctx, variablesContextKey{}, &variables{})
+}
+
+func variablesFromContext(ctx context.Context) *variables {
+	v, _ := ctx.Value(variablesContextKey{}).(*variables)
+	return v
+}
+
+// withVariableScope returns a copy of the parser resolving variables in the given scope.
+func (p *Parser[K]) withVariableScope(scope *variableScope) *Parser[K] {
+	sp := *p
+	sp.variables = scope
+	return &sp
+}
+
+func (p *Parser[K]) newVariableGetter(v *variable) (Getter[K], error) {
+	def, ok := p.variables.lookup(v.Name)
+	if !ok {
+		return nil, fmt.Errorf("undefined variable %s", v.Name)
+	}
+	if len(v.Keys) > 0 && !def.typ.indexableBy(v.Keys[0]) {
+		return nil, fmt.Errorf("variable %s of type %s cannot be indexed by %s", v.Name, def.typ, buildOriginalKeysText(v.Keys[:1]))
+	}
+	slot := def.slot
+	return &exprGetter[K]{
+		expr: Expr[K]{exprFunc: func(ctx context.Context, _ K) (any, error) {
+			return variablesFromContext(ctx).get(slot), nil
+		}},
+		keys: v.Keys,
+	}, nil
+}
+
+// newLetStatement returns the function and condition of a let statement. The variable is
+// defined after parsing the value and the where clause, which may only refer to the
+// variable if it was defined by a previous statement.
+func (p *Parser[K]) newLetStatement(let *letStatement, where *booleanExpression) (Expr[K], boolExpr[K], error) {
+	getter, err := p.newGetter(let.Value)
+	if err != nil {
+		return Expr[K]{}, nil, err
+	}
+	condition, err := p.newBoolExpr(where)
+	if err != nil {
+		return Expr[K]{}, nil, err
+	}
+	slot := p.variables.define(let.Variable, p.variables.typeOf(let.Value))
+	return Expr[K]{exprFunc: func(ctx context.Context, tCtx K) (any, error) {
+		val, err := getter.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		variablesFromContext(ctx).set(slot, val)
+		return nil, nil
+	}}, condition, nil
+}
+
+// newForEachStatement returns a function invoking the editor for each item of the iterable
+// whose condition is met. The loop variables are only defined within the statement.
+func (p *Parser[K]) newForEachStatement(fe *forEachClause, ed editor, where *booleanExpression) (Expr[K], error) {
+	typ := p.variables.typeOf(fe.Iterable)
+	if !typ.iterable() {
+		return Expr[K]{}, fmt.Errorf("cannot iterate over a value of type %s", typ)
+	}
+	iterable, err := p.newGetter(fe.Iterable)
+	if err != nil {
+		return Expr[K]{}, err
+	}
+
+	// The first variable is set to the keys of maps and the indexes of lists, or to the
+	// elements of lists if it is the only one.
+	types := []variableType{variableTypeAny, variableTypeAny}
+	switch {
+	case typ == variableTypeMap:
+		types[0] = variableTypeString
+	case typ == variableTypeList && len(fe.Variables) == 2:
+		types[0] = variableTypeInt
+	}
+	slots := make([]int, len(fe.Variables))
+	for i, name := range fe.Variables {
+		if _, ok := p.variables.lookup(name); ok {
+			return Expr[K]{}, fmt.Errorf("variable %s is already defined", name)
+		}
+		slots[i] = p.variables.define(name, types[i])
+	}
+	defer func() {
+		for _, name := range fe.Variables {
+			p.variables.undefine(name)
+		}
+	}()
+
+	body, err := p.newFunctionCall(ed)
+	if err != nil {
+		return Expr[K]{}, err
+	}
+	condition, err := p.newBoolExpr(where)
+	if err != nil {
+		return Expr[K]{}, err
+	}
+
+	return Expr[K]{exprFunc: func(ctx context.Context, tCtx K) (any, error) {
+		val, err := iterable.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		vars := variablesFromContext(ctx)
+		return nil, forEachItem(val, len(slots) == 2, func(key, item any) error {
+			if len(slots) == 2 {
+				vars.set(slots[0], key)
+				vars.set(slots[1], item)
+			} else {
+				vars.set(slots[0], item)
+			}
+			ok, err := condition.Eval(ctx, tCtx)
+			if err != nil || !ok {
+				return err
+			}
+			_, err = body.Eval(ctx, tCtx)
+			return err
+		})
+	}}, nil
+}
+
+// forEachItem calls yield for each item of a map or slice. Map items are their keys, or
+// their keys and values if withKeys is set. Slice items are their elements, along with their
+// indexes if withKeys is set. The items are copied first so that yield may modify the value.
+func forEachItem(val any, withKeys bool, yield func(key, item any) error) error {
+	switch v := val.(type) {
+	case nil:
+		return nil
+	case pcommon.Map:
+		keys := make([]any, 0, v.Len())
+		items := make([]any, 0, v.Len())
+		for k, item := range v.All() {
+			keys = append(keys, k)
+			items = append(items, ottlcommon.GetValue(item))
+		}
+		return yieldMapItems(keys, items, withKeys, yield)
+	case map[string]any:
+		keys := make([]any, 0, len(v))
+		items := make([]any, 0, len(v))
+		for _, k := range slices.Sorted(maps.Keys(v)) {
+			keys = append(keys, k)
+			items = append(items, v[k])
+		}
+		return yieldMapItems(keys, items, withKeys, yield)
+	case pcommon.Slice:
+		items := make([]any, 0, v.Len())
+		for _, item := range v.All() {
+			items = append(items, ottlcommon.GetValue(item))
+		}
+		return yieldSliceItems(items, yield)
+	case []any:
+		return yieldSliceItems(slices.Clone(v), yield)
+	case []string:
+		return yieldSliceItems(toAnySlice(v), yield)
+	case []int64:
+		return yieldSliceItems(toAnySlice(v), yield)
+	case []float64:
+		return yieldSliceItems(toAnySlice(v), yield)
+	case []bool:
+		return yieldSliceItems(toAnySlice(v), yield)
+	default:
+		return fmt.Errorf("cannot iterate over a value of type %T", val)
+	}
+}
+
+func yieldMapItems(keys, items []any, withKeys bool, yield func(key, item any) error) error {
+	for i, k := range keys {
+		item := k
+		if withKeys {
+			item = items[i]
+		}
+		if err := yield(k, item); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func yieldSliceItems(items []any, yield func(key, item any) error) error {
+	for i, item := range items {
+		if err := yield(int64(i), item); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func toAnySlice[T any](s []T) []any {
+	result := make([]any, len(s))
+	for i, v := range s {
+		result[i] = v
+	}
+	return result
+}
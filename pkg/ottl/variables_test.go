@@ -0,0 +1,218 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottl_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/pdata/plog"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottllog"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+)
+
+func newVariablesTestParser(t *testing.T) ottl.Parser[*ottllog.TransformContext] {
+	parser, err := ottllog.NewParser(ottlfuncs.StandardFuncs[*ottllog.TransformContext](), componenttest.NewNopTelemetrySettings())
+	require.NoError(t, err)
+	return parser
+}
+
+func newVariablesTestLog(attributes map[string]any, body string) plog.Logs {
+	logs := plog.NewLogs()
+	record := logs.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords().AppendEmpty()
+	_ = record.Attributes().FromRaw(attributes)
+	record.Body().SetStr(body)
+	return logs
+}
+
+func executeStatements(t *testing.T, statements []string, logs plog.Logs) map[string]any {
+	parser := newVariablesTestParser(t)
+	parsed, err := parser.ParseStatements(statements)
+	require.NoError(t, err)
+	sequence := ottllog.NewStatementSequence(parsed, componenttest.NewNopTelemetrySettings())
+
+	rl := logs.ResourceLogs().At(0)
+	sl := rl.ScopeLogs().At(0)
+	for _, record := range sl.LogRecords().All() {
+		tCtx := ottllog.NewTransformContextPtr(rl, sl, record)
+		require.NoError(t, sequence.Execute(t.Context(), tCtx))
+		tCtx.Close()
+	}
+	return sl.LogRecords().At(0).Attributes().AsRaw()
+}
+
+func Test_Variables(t *testing.T) {
+	tests := []struct {
+		name       string
+		statements []string
+		attributes map[string]any
+		body       string
+		want       map[string]any
+	}{
+		{
+			name: "let",
+			statements: []string{
+				`let $body = ParseJSON(body)`,
+				`set(attributes["user"], $body["user"]["name"])`,
+				`set(attributes["tags"], Len($body["tags"]))`,
+			},
+			body: `{"user":{"name":"alice"},"tags":["a","b"]}`,
+			want: map[string]any{"user": "alice", "tags": int64(2)},
+		},
+		{
+			name: "let with where clause",
+			statements: []string{
+				`let $level = "unknown"`,
+				`let $level = attributes["level"] where attributes["level"] != nil`,
+				`set(attributes["severity"], $level)`,
+			},
+			attributes: map[string]any{"other": "value"},
+			want:       map[string]any{"other": "value", "severity": "unknown"},
+		},
+		{
+			name: "reassign",
+			statements: []string{
+				`let $n = 1`,
+				`let $n = $n + 1`,
+				`set(attributes["n"], $n * 10)`,
+			},
+			want: map[string]any{"n": int64(20)},
+		},
+		{
+			name: "for each map key",
+			statements: []string{
+				`for each $key in attributes: delete_key(attributes, $key) where IsMatch($key, "^tmp\\.")`,
+			},
+			attributes: map[string]any{"tmp.a": "a", "keep": "b", "tmp.b": "c"},
+			want:       map[string]any{"keep": "b"},
+		},
+		{
+			name: "for each map key and value",
+			statements: []string{
+				`let $user = ParseJSON(body)["user"]`,
+				`for each $key, $value in $user: set(attributes[Concat(["user", $key], ".")], $value) where $value != "secret"`,
+			},
+			body: `{"user":{"name":"alice","password":"secret"}}`,
+			want: map[string]any{"user.name": "alice"},
+		},
+		{
+			name: "for each list index and element",
+			statements: []string{
+				`for each $i, $tag in ["a", "b"]: set(attributes[Format("tag_%d", [$i])], $tag)`,
+			},
+			want: map[string]any{"tag_0": "a", "tag_1": "b"},
+		},
+		{
+			name: "for each list element",
+			statements: []string{
+				`for each $tag in attributes["tags"]: set(attributes[$tag], true)`,
+			},
+			attributes: map[string]any{"tags": []any{"x", "y"}},
+			want:       map[string]any{"tags": []any{"x", "y"}, "x": true, "y": true},
+		},
+		{
+			name: "for each nil",
+			statements: []string{
+				`for each $tag in attributes["missing"]: set(attributes[$tag], true)`,
+			},
+			attributes: map[string]any{"a": "b"},
+			want:       map[string]any{"a": "b"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := executeStatements(t, tt.statements, newVariablesTestLog(tt.attributes, tt.body))
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_Variables_ScopedToExecution(t *testing.T) {
+	logs := newVariablesTestLog(map[string]any{"flag": true}, "")
+	second := logs.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().AppendEmpty()
+	second.Attributes().PutBool("flag", false)
+
+	executeStatements(t, []string{
+		`let $value = "flagged" where attributes["flag"] == true`,
+		`set(attributes["value"], $value) where $value != nil`,
+	}, logs)
+
+	records := logs.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords()
+	assert.Equal(t, map[string]any{"flag": true, "value": "flagged"}, records.At(0).Attributes().AsRaw())
+	assert.Equal(t, map[string]any{"flag": false}, records.At(1).Attributes().AsRaw())
+}
+
+func Test_Variables_ParseErrors(t *testing.T) {
+	tests := []struct {
+		name       string
+		statements []string
+		wantErr    string
+	}{
+		{
+			name:       "undefined",
+			statements: []string{`set(attributes["a"], $undefined)`},
+			wantErr:    "undefined variable $undefined",
+		},
+		{
+			name:       "used before definition",
+			statements: []string{`set(attributes["a"], $x)`, `let $x = 1`},
+			wantErr:    "undefined variable $x",
+		},
+		{
+			name:       "defined in its own where clause",
+			statements: []string{`let $x = 1 where $x == nil`},
+			wantErr:    "undefined variable $x",
+		},
+		{
+			name:       "index scalar",
+			statements: []string{`let $s = "str"`, `set(attributes["a"], $s["key"])`},
+			wantErr:    "variable $s of type string cannot be indexed by [key]",
+		},
+		{
+			name:       "index map by int",
+			statements: []string{`let $m = {"a": 1}`, `set(attributes["a"], $m[0])`},
+			wantErr:    "variable $m of type map cannot be indexed by [0]",
+		},
+		{
+			name:       "index by expression",
+			statements: []string{`let $m = {"a": 1}`, `set(attributes["a"], $m[attributes["key"]])`},
+			wantErr:    "variables may only be indexed by string or int literals",
+		},
+		{
+			name:       "iterate scalar",
+			statements: []string{`let $n = 1`, `for each $v in $n: set(attributes["a"], $v)`},
+			wantErr:    "cannot iterate over a value of type int",
+		},
+		{
+			name:       "loop variable out of scope",
+			statements: []string{`for each $k in attributes: set(attributes[$k], "x")`, `set(attributes["a"], $k)`},
+			wantErr:    "undefined variable $k",
+		},
+		{
+			name:       "loop variable shadows variable",
+			statements: []string{`let $k = 1`, `for each $k in attributes: set(attributes[$k], "x")`},
+			wantErr:    "variable $k is already defined",
+		},
+		{
+			name:       "set variable",
+			statements: []string{`let $x = 1`, `set($x, 2)`},
+			wantErr:    "variable $x can only be set with a let statement",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := newVariablesTestParser(t).ParseStatements(tt.statements)
+			assert.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
+
+func Test_Variables_Condition(t *testing.T) {
+	_, err := newVariablesTestParser(t).ParseCondition(`$x == 1`)
+	assert.ErrorContains(t, err, "undefined variable $x")
+}
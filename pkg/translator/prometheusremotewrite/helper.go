@@ -332,7 +332,6 @@ func getPromExemplars[T exemplarType](pt T) []prompb.Exemplar {
 	promExemplars := make([]prompb.Exemplar, 0, pt.Exemplars().Len())
 	for i := 0; i < pt.Exemplars().Len(); i++ {
 		exemplar := pt.Exemplars().At(i)
-		exemplarRunes := 0
 
 		var promExemplar prompb.Exemplar
 		switch exemplar.ValueType() {
@@ -347,47 +346,55 @@ func getPromExemplars[T exemplarType](pt T) []prompb.Exemplar {
 				Timestamp: timestamp.FromTime(exemplar.Timestamp().AsTime()),
 			}
 		}
-		if traceID := exemplar.TraceID(); !traceID.IsEmpty() {
-			val := hex.EncodeToString(traceID[:])
-			exemplarRunes += utf8.RuneCountInString(otlptranslator.ExemplarTraceIDKey) + utf8.RuneCountInString(val)
-			promLabel := prompb.Label{
-				Name:  otlptranslator.ExemplarTraceIDKey,
-				Value: val,
-			}
-			promExemplar.Labels = append(promExemplar.Labels, promLabel)
-		}
-		if spanID := exemplar.SpanID(); !spanID.IsEmpty() {
-			val := hex.EncodeToString(spanID[:])
-			exemplarRunes += utf8.RuneCountInString(otlptranslator.ExemplarSpanIDKey) + utf8.RuneCountInString(val)
-			promLabel := prompb.Label{
-				Name:  otlptranslator.ExemplarSpanIDKey,
-				Value: val,
-			}
-			promExemplar.Labels = append(promExemplar.Labels, promLabel)
-		}
+		promExemplar.Labels = getPromExemplarLabels(exemplar)
+		promExemplars = append(promExemplars, promExemplar)
+	}
 
-		attrs := exemplar.FilteredAttributes()
-		labelsFromAttributes := make([]prompb.Label, 0, attrs.Len())
-		for key, value := range attrs.All() {
-			val := value.AsString()
-			exemplarRunes += utf8.RuneCountInString(key) + utf8.RuneCountInString(val)
-			promLabel := prompb.Label{
-				Name:  key,
-				Value: val,
-			}
+	return promExemplars
+}
 
-			labelsFromAttributes = append(labelsFromAttributes, promLabel)
-		}
-		if exemplarRunes <= maxExemplarRunes {
-			// only append filtered attributes if it does not cause exemplar
-			// labels to exceed the max number of runes
-			promExemplar.Labels = append(promExemplar.Labels, labelsFromAttributes...)
+// getPromExemplarLabels returns the trace ID, span ID and filtered attributes of the exemplar as labels.
+// The filtered attributes are only added if they don't cause the labels to exceed the max number of runes.
+func getPromExemplarLabels(exemplar pmetric.Exemplar) []prompb.Label {
+	var labels []prompb.Label
+	exemplarRunes := 0
+	if traceID := exemplar.TraceID(); !traceID.IsEmpty() {
+		val := hex.EncodeToString(traceID[:])
+		exemplarRunes += utf8.RuneCountInString(otlptranslator.ExemplarTraceIDKey) + utf8.RuneCountInString(val)
+		promLabel := prompb.Label{
+			Name:  otlptranslator.ExemplarTraceIDKey,
+			Value: val,
+		}
+		labels = append(labels, promLabel)
+	}
+	if spanID := exemplar.SpanID(); !spanID.IsEmpty() {
+		val := hex.EncodeToString(spanID[:])
+		exemplarRunes += utf8.RuneCountInString(otlptranslator.ExemplarSpanIDKey) + utf8.RuneCountInString(val)
+		promLabel := prompb.Label{
+			Name:  otlptranslator.ExemplarSpanIDKey,
+			Value: val,
+		}
+		labels = append(labels, promLabel)
+	}
+
+	attrs := exemplar.FilteredAttributes()
+	labelsFromAttributes := make([]prompb.Label, 0, attrs.Len())
+	for key, value := range attrs.All() {
+		val := value.AsString()
+		exemplarRunes += utf8.RuneCountInString(key) + utf8.RuneCountInString(val)
+		promLabel := prompb.Label{
+			Name:  key,
+			Value: val,
 		}
 
-		promExemplars = append(promExemplars, promExemplar)
+		labelsFromAttributes = append(labelsFromAttributes, promLabel)
 	}
-
-	return promExemplars
+	if exemplarRunes <= maxExemplarRunes {
+		// only append filtered attributes if it does not cause exemplar
+		// labels to exceed the max number of runes
+		labels = append(labels, labelsFromAttributes...)
+	}
+	return labels
 }
 
 // mostRecentTimestampInMetric returns the latest timestamp in a batch of metrics
@@ -588,8 +588,8 @@ func Test_getPromExemplarsV2(t *testing.T) {
 				{
 					Value:     floatVal1,
 					Timestamp: timestamp.FromTime(tnow),
-					// TODO: after deal with examplar labels on getPromExemplarsV2, add the labels here
-					// LabelsRefs: []uint32{},
+					// trace_id, trace ID value, span_id, span ID value, label11 and value11
+					LabelsRefs: []uint32{1, 2, 3, 4, 5, 6},
 				},
 			},
 		},
@@ -600,16 +600,18 @@ func Test_getPromExemplarsV2(t *testing.T) {
 				{
 					Value:     float64(intVal2),
 					Timestamp: timestamp.FromTime(tnow),
-					// TODO: after deal with examplar labels on getPromExemplarsV2, add the labels here
-					// LabelsRefs: []uint32{},
+					// trace_id, trace ID value, span_id, span ID value, label11 and value11
+					LabelsRefs: []uint32{1, 2, 3, 4, 5, 6},
 				},
 			},
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			requests := getPromExemplarsV2(tt.histogram)
+			symbolTable := writev2.NewSymbolTable()
+			requests := getPromExemplarsV2(tt.histogram, &symbolTable)
 			assert.Exactly(t, tt.expected, requests)
+			assert.Equal(t, []string{"", otlptranslator.ExemplarTraceIDKey, traceIDValue1, otlptranslator.ExemplarSpanIDKey, spanIDValue1, label11, value11}, symbolTable.Symbols())
 		})
 	}
 }
@@ -77,22 +77,23 @@ func (c *prometheusConverterV2) addResourceTargetInfoV2(resource pcommon.Resourc
 	return nil
 }
 
-// addSampleWithLabels is a helper function to create and add a sample with labels
-func (c *prometheusConverterV2) addSampleWithLabels(sampleValue float64, timestamp int64, noRecordedValue bool,
+// addSampleWithLabels is a helper function to create and add a sample with labels.
+// It returns the time series the sample was added to.
+func (c *prometheusConverterV2) addSampleWithLabels(sampleValue float64, timestamp, startTimestamp int64, noRecordedValue bool,
 	baseName string, baseLabels []prompb.Label, labelName, labelValue string, metadata metadata,
-) {
+) *writev2.TimeSeries {
 	sample := &writev2.Sample{
-		Value:     sampleValue,
-		Timestamp: timestamp,
+		Value:          sampleValue,
+		Timestamp:      timestamp,
+		StartTimestamp: startTimestamp,
 	}
 	if noRecordedValue {
 		sample.Value = math.Float64frombits(value.StaleNaN)
 	}
 	if labelName != "" && labelValue != "" {
-		c.addSample(sample, createLabels(baseName, baseLabels, labelName, labelValue), metadata)
-	} else {
-		c.addSample(sample, createLabels(baseName, baseLabels), metadata)
+		return c.addSample(sample, createLabels(baseName, baseLabels, labelName, labelValue), metadata)
 	}
+	return c.addSample(sample, createLabels(baseName, baseLabels), metadata)
 }
 
 func (c *prometheusConverterV2) addSummaryDataPoints(dataPoints pmetric.SummaryDataPointSlice, resource pcommon.Resource, scope pcommon.InstrumentationScope,
@@ -102,6 +103,7 @@ func (c *prometheusConverterV2) addSummaryDataPoints(dataPoints pmetric.SummaryD
 	for x := 0; x < dataPoints.Len(); x++ {
 		pt := dataPoints.At(x)
 		timestamp := convertTimeStamp(pt.Timestamp())
+		startTimestamp := convertTimeStamp(pt.StartTimestamp())
 		baseLabels, err := createAttributes(resource, pt.Attributes(), scope, settings.ExternalLabels, nil, false, c.labelNamer, settings.DisableScopeInfo)
 		if err != nil {
 			errs = multierr.Append(errs, err)
@@ -110,14 +112,14 @@ func (c *prometheusConverterV2) addSummaryDataPoints(dataPoints pmetric.SummaryD
 		noRecordedValue := pt.Flags().NoRecordedValue()
 
 		// Add sum and count samples
-		c.addSampleWithLabels(pt.Sum(), timestamp, noRecordedValue, baseName+sumStr, baseLabels, "", "", metadata)
-		c.addSampleWithLabels(float64(pt.Count()), timestamp, noRecordedValue, baseName+countStr, baseLabels, "", "", metadata)
+		c.addSampleWithLabels(pt.Sum(), timestamp, startTimestamp, noRecordedValue, baseName+sumStr, baseLabels, "", "", metadata)
+		c.addSampleWithLabels(float64(pt.Count()), timestamp, startTimestamp, noRecordedValue, baseName+countStr, baseLabels, "", "", metadata)
 
 		// Process quantiles
 		for i := 0; i < pt.QuantileValues().Len(); i++ {
 			qt := pt.QuantileValues().At(i)
 			percentileStr := strconv.FormatFloat(qt.Quantile(), 'f', -1, 64)
-			c.addSampleWithLabels(qt.Value(), timestamp, noRecordedValue, baseName, baseLabels, quantileStr, percentileStr, metadata)
+			c.addSampleWithLabels(qt.Value(), timestamp, startTimestamp, noRecordedValue, baseName, baseLabels, quantileStr, percentileStr, metadata)
 		}
 	}
 	return errs
@@ -130,6 +132,7 @@ func (c *prometheusConverterV2) addHistogramDataPoints(dataPoints pmetric.Histog
 	for x := 0; x < dataPoints.Len(); x++ {
 		pt := dataPoints.At(x)
 		timestamp := convertTimeStamp(pt.Timestamp())
+		startTimestamp := convertTimeStamp(pt.StartTimestamp())
 		baseLabels, err := createAttributes(resource, pt.Attributes(), scope, settings.ExternalLabels, nil, false, c.labelNamer, settings.DisableScopeInfo)
 		if err != nil {
 			errs = multierr.Append(errs, err)
@@ -140,26 +143,44 @@ func (c *prometheusConverterV2) addHistogramDataPoints(dataPoints pmetric.Histog
 		// If the sum is unset, it indicates the _sum metric point should be
 		// omitted
 		if pt.HasSum() {
-			c.addSampleWithLabels(pt.Sum(), timestamp, noRecordedValue, baseName+sumStr, baseLabels, "", "", metadata)
+			c.addSampleWithLabels(pt.Sum(), timestamp, startTimestamp, noRecordedValue, baseName+sumStr, baseLabels, "", "", metadata)
 		}
 
 		// treat count as a sample in an individual TimeSeries
-		c.addSampleWithLabels(float64(pt.Count()), timestamp, noRecordedValue, baseName+countStr, baseLabels, "", "", metadata)
+		c.addSampleWithLabels(float64(pt.Count()), timestamp, startTimestamp, noRecordedValue, baseName+countStr, baseLabels, "", "", metadata)
 
 		// cumulative count for conversion to cumulative histogram
 		var cumulativeCount uint64
 
+		// bucketSeries holds the time series of each bucket, ordered by bound.
+		bucketSeries := make([]*writev2.TimeSeries, 0, pt.ExplicitBounds().Len()+1)
+
 		// process each bound, based on histograms proto definition, # of buckets = # of explicit bounds + 1
 		for i := 0; i < pt.ExplicitBounds().Len() && i < pt.BucketCounts().Len(); i++ {
 			bound := pt.ExplicitBounds().At(i)
 			cumulativeCount += pt.BucketCounts().At(i)
 			boundStr := strconv.FormatFloat(bound, 'f', -1, 64)
-			c.addSampleWithLabels(float64(cumulativeCount), timestamp, noRecordedValue, baseName+bucketStr, baseLabels, leStr, boundStr, metadata)
+			ts := c.addSampleWithLabels(float64(cumulativeCount), timestamp, startTimestamp, noRecordedValue, baseName+bucketStr, baseLabels, leStr, boundStr, metadata)
+			bucketSeries = append(bucketSeries, ts)
 		}
 		// add le=+Inf bucket
-		c.addSampleWithLabels(float64(pt.Count()), timestamp, noRecordedValue, baseName+bucketStr, baseLabels, leStr, pInfStr, metadata)
+		ts := c.addSampleWithLabels(float64(pt.Count()), timestamp, startTimestamp, noRecordedValue, baseName+bucketStr, baseLabels, leStr, pInfStr, metadata)
+		bucketSeries = append(bucketSeries, ts)
 
-		// TODO implement exemplars support
+		c.addBucketExemplars(pt, bucketSeries)
 	}
 	return errs
 }
+
+// addBucketExemplars adds each exemplar of the data point to the time series of
+// the first bucket whose upper bound is greater than or equal to the exemplar value.
+func (c *prometheusConverterV2) addBucketExemplars(pt pmetric.HistogramDataPoint, bucketSeries []*writev2.TimeSeries) {
+	bounds := pt.ExplicitBounds()
+	for _, exemplar := range getPromExemplarsV2(pt, &c.symbolTable) {
+		i := 0
+		for i < len(bucketSeries)-1 && exemplar.Value > bounds.At(i) {
+			i++
+		}
+		bucketSeries[i].Exemplars = append(bucketSeries[i].Exemplars, exemplar)
+	}
+}
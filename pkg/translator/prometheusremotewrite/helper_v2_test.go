@@ -191,7 +191,7 @@ func TestPrometheusConverterV2_AddSummaryDataPoints(t *testing.T) {
 					timeSeriesSignature(labels): {
 						LabelsRefs: []uint32{1, 3},
 						Samples: []writev2.Sample{
-							{Value: 0, Timestamp: convertTimeStamp(ts)},
+							{Value: 0, Timestamp: convertTimeStamp(ts), StartTimestamp: convertTimeStamp(ts)},
 						},
 						Metadata: writev2.Metadata{
 							Type:    writev2.Metadata_METRIC_TYPE_SUMMARY,
@@ -201,7 +201,7 @@ func TestPrometheusConverterV2_AddSummaryDataPoints(t *testing.T) {
 					timeSeriesSignature(sumLabels): {
 						LabelsRefs: []uint32{1, 2},
 						Samples: []writev2.Sample{
-							{Value: 0, Timestamp: convertTimeStamp(ts)},
+							{Value: 0, Timestamp: convertTimeStamp(ts), StartTimestamp: convertTimeStamp(ts)},
 						},
 						Metadata: writev2.Metadata{
 							Type:    writev2.Metadata_METRIC_TYPE_SUMMARY,
@@ -314,7 +314,7 @@ func TestPrometheusConverterV2_AddHistogramDataPoints(t *testing.T) {
 					timeSeriesSignature(infLabels): {
 						LabelsRefs: []uint32{1, 3, 4, 5},
 						Samples: []writev2.Sample{
-							{Value: 0, Timestamp: convertTimeStamp(ts)},
+							{Value: 0, Timestamp: convertTimeStamp(ts), StartTimestamp: convertTimeStamp(ts)},
 						},
 						Metadata: writev2.Metadata{
 							Type:    writev2.Metadata_METRIC_TYPE_HISTOGRAM,
@@ -324,7 +324,7 @@ func TestPrometheusConverterV2_AddHistogramDataPoints(t *testing.T) {
 					timeSeriesSignature(labels): {
 						LabelsRefs: []uint32{1, 2},
 						Samples: []writev2.Sample{
-							{Value: 0, Timestamp: convertTimeStamp(ts)},
+							{Value: 0, Timestamp: convertTimeStamp(ts), StartTimestamp: convertTimeStamp(ts)},
 						},
 						Metadata: writev2.Metadata{
 							Type:    writev2.Metadata_METRIC_TYPE_HISTOGRAM,
@@ -598,6 +598,7 @@ func TestPrometheusConverterV2_AddSampleWithLabels(t *testing.T) {
 			converter.addSampleWithLabels(
 				tt.sampleValue,
 				tt.timestamp,
+				0,
 				tt.noRecordedValue,
 				tt.baseName,
 				tt.baseLabels,
@@ -613,3 +614,40 @@ func TestPrometheusConverterV2_AddSampleWithLabels(t *testing.T) {
 		})
 	}
 }
+
+func TestPrometheusConverterV2_AddHistogramDataPointsExemplars(t *testing.T) {
+	metric := pmetric.NewMetric()
+	metric.SetName("test_hist")
+	metric.SetEmptyHistogram().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	pt := metric.Histogram().DataPoints().AppendEmpty()
+	pt.SetCount(3)
+	pt.ExplicitBounds().FromRaw([]float64{1, 10})
+	pt.BucketCounts().FromRaw([]uint64{1, 1, 1})
+	for _, v := range []float64{0.5, 10, 100} {
+		pt.Exemplars().AppendEmpty().SetDoubleValue(v)
+	}
+
+	converter := newPrometheusConverterV2(Settings{})
+	require.NoError(t, converter.addHistogramDataPoints(
+		metric.Histogram().DataPoints(),
+		pcommon.NewResource(),
+		pcommon.NewInstrumentationScope(),
+		Settings{},
+		metric.Name(),
+		metadata{Type: otelMetricTypeToPromMetricTypeV2(metric)},
+	))
+
+	bucketExemplars := func(le string) []writev2.Exemplar {
+		labels := []prompb.Label{
+			{Name: model.MetricNameLabel, Value: "test_hist_bucket"},
+			{Name: model.BucketLabel, Value: le},
+		}
+		ts := converter.unique[timeSeriesSignature(labels)]
+		require.NotNil(t, ts)
+		return ts.Exemplars
+	}
+	// Each exemplar is added to the first bucket that contains its value.
+	assert.Equal(t, []writev2.Exemplar{{Value: 0.5}}, bucketExemplars("1"))
+	assert.Equal(t, []writev2.Exemplar{{Value: 10}}, bucketExemplars("10"))
+	assert.Equal(t, []writev2.Exemplar{{Value: 100}}, bucketExemplars("+Inf"))
+}
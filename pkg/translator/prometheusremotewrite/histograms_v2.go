@@ -15,6 +15,9 @@ import (
 	"go.uber.org/multierr"
 )
 
+// customBucketsSchema is the schema of Prometheus Native Histograms with custom buckets.
+const customBucketsSchema = -53
+
 func (c *prometheusConverterV2) addExponentialHistogramDataPoints(dataPoints pmetric.ExponentialHistogramDataPointSlice,
 	resource pcommon.Resource, scope pcommon.InstrumentationScope, settings Settings, name string, metadata metadata,
 ) error {
@@ -36,13 +39,90 @@ func (c *prometheusConverterV2) addExponentialHistogramDataPoints(dataPoints pme
 
 		ts := c.getOrCreateTimeSeries(lbls, metadata)
 		ts.Histograms = append(ts.Histograms, histogram)
+		ts.Exemplars = append(ts.Exemplars, getPromExemplarsV2(pt, &c.symbolTable)...)
+	}
 
-		// TODO handle exemplars
+	return errs
+}
+
+func (c *prometheusConverterV2) addCustomBucketsHistogramDataPoints(dataPoints pmetric.HistogramDataPointSlice,
+	resource pcommon.Resource, scope pcommon.InstrumentationScope, settings Settings, name string, metadata metadata,
+) error {
+	var errs error
+	for x := 0; x < dataPoints.Len(); x++ {
+		pt := dataPoints.At(x)
+
+		lbls, err := createAttributes(resource, pt.Attributes(), scope, settings.ExternalLabels, nil, true, c.labelNamer, settings.DisableScopeInfo, model.MetricNameLabel, name)
+		if err != nil {
+			errs = multierr.Append(errs, err)
+			continue
+		}
+
+		ts := c.getOrCreateTimeSeries(lbls, metadata)
+		ts.Histograms = append(ts.Histograms, explicitHistogramToCustomBucketsHistogramV2(pt))
+		ts.Exemplars = append(ts.Exemplars, getPromExemplarsV2(pt, &c.symbolTable)...)
 	}
 
 	return errs
 }
 
+// explicitHistogramToCustomBucketsHistogramV2 translates OTel Explicit Histogram data point
+// to Prometheus Native Histogram with custom buckets (NHCB).
+func explicitHistogramToCustomBucketsHistogramV2(p pmetric.HistogramDataPoint) writev2.Histogram {
+	spans, deltas := convertCustomBucketsLayoutV2(p.BucketCounts())
+
+	h := writev2.Histogram{
+		// See exponentialToNativeHistogramV2 for why the reset hint is left unspecified.
+		ResetHint:      writev2.Histogram_RESET_HINT_UNSPECIFIED,
+		Schema:         customBucketsSchema,
+		CustomValues:   p.ExplicitBounds().AsRaw(),
+		PositiveSpans:  spans,
+		PositiveDeltas: deltas,
+
+		Timestamp:      convertTimeStamp(p.Timestamp()),
+		StartTimestamp: convertTimeStamp(p.StartTimestamp()),
+	}
+
+	if p.Flags().NoRecordedValue() {
+		h.Sum = math.Float64frombits(value.StaleNaN)
+		h.Count = &writev2.Histogram_CountInt{CountInt: value.StaleNaN}
+	} else {
+		if p.HasSum() {
+			h.Sum = p.Sum()
+		}
+		h.Count = &writev2.Histogram_CountInt{CountInt: p.Count()}
+	}
+	return h
+}
+
+// convertCustomBucketsLayoutV2 translates OTel Explicit Histogram bucket counts to the
+// Prometheus Native Histogram sparse bucket representation, where the bucket index is
+// the index of the custom bucket bound. Empty buckets are skipped.
+func convertCustomBucketsLayoutV2(bucketCounts pcommon.UInt64Slice) ([]writev2.BucketSpan, []int64) {
+	var (
+		spans     []writev2.BucketSpan
+		deltas    []int64
+		prevCount int64
+		// nextIdx is the index following the last bucket of the current span.
+		nextIdx int32
+	)
+	for i := 0; i < bucketCounts.Len(); i++ {
+		count := int64(bucketCounts.At(i))
+		if count == 0 {
+			continue
+		}
+		idx := int32(i)
+		if len(spans) == 0 || idx > nextIdx {
+			spans = append(spans, writev2.BucketSpan{Offset: idx - nextIdx})
+		}
+		spans[len(spans)-1].Length++
+		deltas = append(deltas, count-prevCount)
+		prevCount = count
+		nextIdx = idx + 1
+	}
+	return spans, deltas
+}
+
 // exponentialToNativeHistogramV2 translates OTel Exponential Histogram data point
 // to Prometheus Native Histogram.
 func exponentialToNativeHistogramV2(p pmetric.ExponentialHistogramDataPoint) (writev2.Histogram, error) {
@@ -85,7 +165,8 @@ func exponentialToNativeHistogramV2(p pmetric.ExponentialHistogramDataPoint) (wr
 		NegativeSpans:  nSpans,
 		NegativeDeltas: nDeltas,
 
-		Timestamp: convertTimeStamp(p.Timestamp()),
+		Timestamp:      convertTimeStamp(p.Timestamp()),
+		StartTimestamp: convertTimeStamp(p.StartTimestamp()),
 	}
 
 	if p.Flags().NoRecordedValue() {
@@ -10,6 +10,7 @@ import (
 
 	"github.com/prometheus/common/model"
 	"github.com/prometheus/otlptranslator"
+	"github.com/prometheus/prometheus/model/value"
 	"github.com/prometheus/prometheus/prompb"
 	writev2 "github.com/prometheus/prometheus/prompb/io/prometheus/write/v2"
 	prom "github.com/prometheus/prometheus/storage/remote/otlptranslator/prometheusremotewrite"
@@ -440,6 +441,7 @@ func TestExponentialToNativeHistogramV2(t *testing.T) {
 					PositiveSpans:  []writev2.BucketSpan{{Offset: 2, Length: 2}},
 					PositiveDeltas: []int64{1, 0},
 					Timestamp:      500,
+					StartTimestamp: 100,
 				}
 			},
 		},
@@ -473,6 +475,7 @@ func TestExponentialToNativeHistogramV2(t *testing.T) {
 					PositiveSpans:  []writev2.BucketSpan{{Offset: 2, Length: 2}},
 					PositiveDeltas: []int64{1, 0},
 					Timestamp:      500,
+					StartTimestamp: 100,
 				}
 			},
 		},
@@ -652,6 +655,7 @@ func TestPrometheusConverterV2_addExponentialHistogramDataPoints(t *testing.T) {
 								PositiveDeltas: []int64{4, -2, -1},
 							},
 						},
+						Exemplars: []writev2.Exemplar{{Value: 1}, {Value: 2}},
 						Metadata: writev2.Metadata{
 							Type: writev2.Metadata_METRIC_TYPE_HISTOGRAM,
 						},
@@ -707,6 +711,7 @@ func TestPrometheusConverterV2_addExponentialHistogramDataPoints(t *testing.T) {
 								PositiveDeltas: []int64{4, -2},
 							},
 						},
+						Exemplars: []writev2.Exemplar{{Value: 1}},
 						Metadata: writev2.Metadata{
 							Type: writev2.Metadata_METRIC_TYPE_HISTOGRAM,
 						},
@@ -723,6 +728,7 @@ func TestPrometheusConverterV2_addExponentialHistogramDataPoints(t *testing.T) {
 								NegativeDeltas: []int64{4, -2, -1},
 							},
 						},
+						Exemplars: []writev2.Exemplar{{Value: 2}},
 						Metadata: writev2.Metadata{
 							Type: writev2.Metadata_METRIC_TYPE_HISTOGRAM,
 						},
@@ -759,3 +765,111 @@ func TestPrometheusConverterV2_addExponentialHistogramDataPoints(t *testing.T) {
 		})
 	}
 }
+
+func TestExplicitHistogramToCustomBucketsHistogramV2(t *testing.T) {
+	pt := pmetric.NewHistogramDataPoint()
+	pt.SetStartTimestamp(pcommon.NewTimestampFromTime(time.UnixMilli(100)))
+	pt.SetTimestamp(pcommon.NewTimestampFromTime(time.UnixMilli(500)))
+	pt.SetCount(5)
+	pt.SetSum(20)
+	pt.ExplicitBounds().FromRaw([]float64{1, 5, 10})
+	pt.BucketCounts().FromRaw([]uint64{2, 0, 0, 3})
+
+	assert.Equal(t, writev2.Histogram{
+		Count:          &writev2.Histogram_CountInt{CountInt: 5},
+		Sum:            20,
+		Schema:         customBucketsSchema,
+		CustomValues:   []float64{1, 5, 10},
+		PositiveSpans:  []writev2.BucketSpan{{Offset: 0, Length: 1}, {Offset: 2, Length: 1}},
+		PositiveDeltas: []int64{2, 1},
+		Timestamp:      500,
+		StartTimestamp: 100,
+	}, explicitHistogramToCustomBucketsHistogramV2(pt))
+
+	pt.SetFlags(pmetric.DefaultDataPointFlags.WithNoRecordedValue(true))
+	h := explicitHistogramToCustomBucketsHistogramV2(pt)
+	assert.True(t, value.IsStaleNaN(h.Sum))
+	assert.Equal(t, &writev2.Histogram_CountInt{CountInt: value.StaleNaN}, h.Count)
+}
+
+func TestConvertCustomBucketsLayoutV2(t *testing.T) {
+	tests := []struct {
+		name         string
+		bucketCounts []uint64
+		wantSpans    []writev2.BucketSpan
+		wantDeltas   []int64
+	}{
+		{
+			name: "no buckets",
+		},
+		{
+			name:         "all buckets empty",
+			bucketCounts: []uint64{0, 0, 0},
+		},
+		{
+			name:         "contiguous buckets",
+			bucketCounts: []uint64{1, 3, 2},
+			wantSpans:    []writev2.BucketSpan{{Offset: 0, Length: 3}},
+			wantDeltas:   []int64{1, 2, -1},
+		},
+		{
+			name:         "leading and inner empty buckets",
+			bucketCounts: []uint64{0, 0, 4, 0, 0, 0, 1, 2},
+			wantSpans:    []writev2.BucketSpan{{Offset: 2, Length: 1}, {Offset: 3, Length: 2}},
+			wantDeltas:   []int64{4, -3, 1},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bucketCounts := pcommon.NewUInt64Slice()
+			bucketCounts.FromRaw(tt.bucketCounts)
+			spans, deltas := convertCustomBucketsLayoutV2(bucketCounts)
+			assert.Equal(t, tt.wantSpans, spans)
+			assert.Equal(t, tt.wantDeltas, deltas)
+		})
+	}
+}
+
+func TestPrometheusConverterV2_addCustomBucketsHistogramDataPoints(t *testing.T) {
+	metric := pmetric.NewMetric()
+	metric.SetName("test_hist")
+	metric.SetEmptyHistogram().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	pt := metric.Histogram().DataPoints().AppendEmpty()
+	pt.SetCount(2)
+	pt.ExplicitBounds().FromRaw([]float64{1})
+	pt.BucketCounts().FromRaw([]uint64{1, 1})
+	pt.Exemplars().AppendEmpty().SetDoubleValue(0.5)
+
+	converter := newPrometheusConverterV2(Settings{})
+	require.NoError(t, converter.addCustomBucketsHistogramDataPoints(
+		metric.Histogram().DataPoints(),
+		pcommon.NewResource(),
+		pcommon.NewInstrumentationScope(),
+		Settings{},
+		metric.Name(),
+		metadata{Type: otelMetricTypeToPromMetricTypeV2(metric)},
+	))
+
+	labels := []prompb.Label{
+		{Name: model.MetricNameLabel, Value: "test_hist"},
+	}
+	assert.Equal(t, map[uint64]*writev2.TimeSeries{
+		timeSeriesSignature(labels): {
+			LabelsRefs: []uint32{1, 2},
+			Histograms: []writev2.Histogram{
+				{
+					Count:          &writev2.Histogram_CountInt{CountInt: 2},
+					Schema:         customBucketsSchema,
+					CustomValues:   []float64{1},
+					PositiveSpans:  []writev2.BucketSpan{{Offset: 0, Length: 2}},
+					PositiveDeltas: []int64{1, 0},
+				},
+			},
+			Exemplars: []writev2.Exemplar{{Value: 0.5}},
+			Metadata: writev2.Metadata{
+				Type: writev2.Metadata_METRIC_TYPE_HISTOGRAM,
+			},
+		},
+	}, converter.unique)
+	assert.Empty(t, converter.conflicts)
+}
@@ -26,6 +26,9 @@ type Settings struct {
 	DisableScopeInfo  bool
 	AddMetricSuffixes bool
 	SendMetadata      bool
+	// ConvertHistogramsToNHCB converts explicit bucket histograms to native histograms
+	// with custom buckets. It is only supported by FromMetricsV2.
+	ConvertHistogramsToNHCB bool
 }
 
 // FromMetrics converts pmetric.Metrics to Prometheus remote write format.
@@ -125,7 +125,11 @@ func (c *prometheusConverterV2) fromMetrics(md pmetric.Metrics, settings Setting
 					if dataPoints.Len() == 0 {
 						break
 					}
-					errs = multierr.Append(errs, c.addHistogramDataPoints(dataPoints, resource, scope, settings, promName, m))
+					if settings.ConvertHistogramsToNHCB {
+						errs = multierr.Append(errs, c.addCustomBucketsHistogramDataPoints(dataPoints, resource, scope, settings, promName, m))
+					} else {
+						errs = multierr.Append(errs, c.addHistogramDataPoints(dataPoints, resource, scope, settings, promName, m))
+					}
 				case pmetric.MetricTypeExponentialHistogram:
 					dataPoints := metric.ExponentialHistogram().DataPoints()
 					if dataPoints.Len() == 0 {
@@ -164,9 +168,11 @@ func (c *prometheusConverterV2) timeSeries() []writev2.TimeSeries {
 	return allTS
 }
 
-func (c *prometheusConverterV2) addSample(sample *writev2.Sample, lbls []prompb.Label, metadata metadata) {
+// addSample adds the sample to the time series corresponding to lbls, and returns that time series.
+func (c *prometheusConverterV2) addSample(sample *writev2.Sample, lbls []prompb.Label, metadata metadata) *writev2.TimeSeries {
 	ts := c.getOrCreateTimeSeries(lbls, metadata)
 	ts.Samples = append(ts.Samples, *sample)
+	return ts
 }
 
 // isSameMetricV2 checks if two time series are the same metric
@@ -59,7 +59,8 @@ func (c *prometheusConverterV2) addSumNumberDataPoints(dataPoints pmetric.Number
 
 		sample := &writev2.Sample{
 			// convert ns to ms
-			Timestamp: convertTimeStamp(pt.Timestamp()),
+			Timestamp:      convertTimeStamp(pt.Timestamp()),
+			StartTimestamp: convertTimeStamp(pt.StartTimestamp()),
 		}
 		switch pt.ValueType() {
 		case pmetric.NumberDataPointValueTypeInt:
@@ -70,33 +71,31 @@ func (c *prometheusConverterV2) addSumNumberDataPoints(dataPoints pmetric.Number
 		if pt.Flags().NoRecordedValue() {
 			sample.Value = math.Float64frombits(value.StaleNaN)
 		}
-		// TODO: properly add exemplars to the TimeSeries
-		c.addSample(sample, lbls, metadata)
+		ts := c.addSample(sample, lbls, metadata)
+		ts.Exemplars = append(ts.Exemplars, getPromExemplarsV2(pt, &c.symbolTable)...)
 	}
 	return errs
 }
 
 // getPromExemplarsV2 returns a slice of writev2.Exemplar from pdata exemplars.
-func getPromExemplarsV2[T exemplarType](pt T) []writev2.Exemplar {
+// The exemplar labels are added to the symbols table.
+func getPromExemplarsV2[T exemplarType](pt T, symbolTable *writev2.SymbolsTable) []writev2.Exemplar {
 	promExemplars := make([]writev2.Exemplar, 0, pt.Exemplars().Len())
 	for i := 0; i < pt.Exemplars().Len(); i++ {
 		exemplar := pt.Exemplars().At(i)
 
-		var promExemplar writev2.Exemplar
-
+		promExemplar := writev2.Exemplar{
+			Timestamp: timestamp.FromTime(exemplar.Timestamp().AsTime()),
+		}
 		switch exemplar.ValueType() {
 		case pmetric.ExemplarValueTypeInt:
-			promExemplar = writev2.Exemplar{
-				Value:     float64(exemplar.IntValue()),
-				Timestamp: timestamp.FromTime(exemplar.Timestamp().AsTime()),
-			}
+			promExemplar.Value = float64(exemplar.IntValue())
 		case pmetric.ExemplarValueTypeDouble:
-			promExemplar = writev2.Exemplar{
-				Value:     exemplar.DoubleValue(),
-				Timestamp: timestamp.FromTime(exemplar.Timestamp().AsTime()),
-			}
+			promExemplar.Value = exemplar.DoubleValue()
+		}
+		for _, l := range getPromExemplarLabels(exemplar) {
+			promExemplar.LabelsRefs = append(promExemplar.LabelsRefs, symbolTable.Symbolize(l.Name), symbolTable.Symbolize(l.Value))
 		}
-		// TODO append labels to promExemplar.Labels
 
 		promExemplars = append(promExemplars, promExemplar)
 	}
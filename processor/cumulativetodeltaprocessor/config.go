@@ -43,6 +43,14 @@ type Config struct {
 	// Cannot be used with deprecated Metrics config option.
 	Include MatchMetrics `mapstructure:"include"`
 	Exclude MatchMetrics `mapstructure:"exclude"`
+
+	// Storage is the ID of a storage extension used to persist the tracking state, so that deltas
+	// remain continuous across collector restarts. The state is kept in memory only if not set.
+	Storage *component.ID `mapstructure:"storage"`
+
+	// FlushInterval is the interval at which the tracking state is written to storage. The state
+	// is also written on shutdown. Set to 0 to only write the state on shutdown.
+	FlushInterval time.Duration `mapstructure:"flush_interval"`
 }
 
 type MatchMetrics struct {
@@ -67,6 +75,10 @@ func (config *Config) Validate() error {
 		return errors.New("metrics must be supplied if match_type is set")
 	}
 
+	if config.FlushInterval < 0 {
+		return errors.New("flush_interval must not be negative")
+	}
+
 	for _, metricType := range config.Exclude.MetricTypes {
 		if valid := validMetricTypes[strings.ToLower(metricType)]; !valid {
 			return fmt.Errorf(
@@ -23,6 +23,8 @@ import (
 func TestLoadConfig(t *testing.T) {
 	t.Parallel()
 
+	storageID := component.MustNewIDWithName("file_storage", "cumulativetodelta")
+
 	tests := []struct {
 		id           component.ID
 		expected     component.Config
@@ -51,8 +53,9 @@ func TestLoadConfig(t *testing.T) {
 						RegexpConfig: nil,
 					},
 				},
-				MaxStaleness: 10 * time.Second,
-				InitialValue: tracking.InitialValueAuto,
+				MaxStaleness:  10 * time.Second,
+				InitialValue:  tracking.InitialValueAuto,
+				FlushInterval: time.Minute,
 			},
 		},
 		{
@@ -80,8 +83,9 @@ func TestLoadConfig(t *testing.T) {
 						RegexpConfig: nil,
 					},
 				},
-				MaxStaleness: 10 * time.Second,
-				InitialValue: tracking.InitialValueAuto,
+				MaxStaleness:  10 * time.Second,
+				InitialValue:  tracking.InitialValueAuto,
+				FlushInterval: time.Minute,
 			},
 		},
 		{
@@ -111,8 +115,9 @@ func TestLoadConfig(t *testing.T) {
 						"histogram",
 					},
 				},
-				MaxStaleness: 10 * time.Second,
-				InitialValue: tracking.InitialValueAuto,
+				MaxStaleness:  10 * time.Second,
+				InitialValue:  tracking.InitialValueAuto,
+				FlushInterval: time.Minute,
 			},
 		},
 		{
@@ -134,24 +139,40 @@ func TestLoadConfig(t *testing.T) {
 		{
 			id: component.NewIDWithName(metadata.Type, "auto"),
 			expected: &Config{
-				MaxStaleness: 1 * time.Hour,
-				InitialValue: tracking.InitialValueAuto,
+				MaxStaleness:  1 * time.Hour,
+				InitialValue:  tracking.InitialValueAuto,
+				FlushInterval: time.Minute,
 			},
 		},
 		{
 			id: component.NewIDWithName(metadata.Type, "keep"),
 			expected: &Config{
-				MaxStaleness: 1 * time.Hour,
-				InitialValue: tracking.InitialValueKeep,
+				MaxStaleness:  1 * time.Hour,
+				InitialValue:  tracking.InitialValueKeep,
+				FlushInterval: time.Minute,
 			},
 		},
 		{
 			id: component.NewIDWithName(metadata.Type, "drop"),
 			expected: &Config{
-				MaxStaleness: 1 * time.Hour,
-				InitialValue: tracking.InitialValueDrop,
+				MaxStaleness:  1 * time.Hour,
+				InitialValue:  tracking.InitialValueDrop,
+				FlushInterval: time.Minute,
 			},
 		},
+		{
+			id: component.NewIDWithName(metadata.Type, "storage"),
+			expected: &Config{
+				MaxStaleness:  1 * time.Hour,
+				InitialValue:  tracking.InitialValueAuto,
+				Storage:       &storageID,
+				FlushInterval: 30 * time.Second,
+			},
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "negative_flush_interval"),
+			errorMessage: "flush_interval must not be negative",
+		},
 	}
 
 	for _, tt := range tests {
@@ -28,7 +28,8 @@ func NewFactory() processor.Factory {
 
 func createDefaultConfig() component.Config {
 	return &Config{
-		MaxStaleness: 1 * time.Hour,
+		MaxStaleness:  1 * time.Hour,
+		FlushInterval: time.Minute,
 	}
 }
 
@@ -43,7 +44,7 @@ func createMetricsProcessor(
 		return nil, errors.New("configuration parsing error")
 	}
 
-	metricsProcessor, err := newCumulativeToDeltaProcessor(processorConfig, set.Logger)
+	metricsProcessor, err := newCumulativeToDeltaProcessor(processorConfig, set)
 	if err != nil {
 		return nil, err
 	}
@@ -55,5 +56,6 @@ func createMetricsProcessor(
 		nextConsumer,
 		metricsProcessor.processMetrics,
 		processorhelper.WithCapabilities(processorCapabilities),
+		processorhelper.WithStart(metricsProcessor.start),
 		processorhelper.WithShutdown(metricsProcessor.shutdown))
 }
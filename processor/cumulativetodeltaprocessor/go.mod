@@ -13,7 +13,9 @@ require (
 	go.opentelemetry.io/collector/confmap/xconfmap v0.147.1-0.20260309153054-85fc1918516c
 	go.opentelemetry.io/collector/consumer v1.53.1-0.20260309153054-85fc1918516c
 	go.opentelemetry.io/collector/consumer/consumertest v0.147.1-0.20260309153054-85fc1918516c
+	go.opentelemetry.io/collector/extension/xextension v0.147.1-0.20260309153054-85fc1918516c
 	go.opentelemetry.io/collector/pdata v1.53.1-0.20260309153054-85fc1918516c
+	go.opentelemetry.io/collector/pipeline v1.53.1-0.20260309153054-85fc1918516c
 	go.opentelemetry.io/collector/processor v1.53.1-0.20260309153054-85fc1918516c
 	go.opentelemetry.io/collector/processor/processorhelper v0.147.1-0.20260309153054-85fc1918516c
 	go.opentelemetry.io/collector/processor/processortest v0.147.1-0.20260309153054-85fc1918516c
@@ -44,12 +46,12 @@ require (
 	go.opentelemetry.io/auto/sdk v1.2.1 // indirect
 	go.opentelemetry.io/collector/component/componentstatus v0.147.1-0.20260309153054-85fc1918516c // indirect
 	go.opentelemetry.io/collector/consumer/xconsumer v0.147.1-0.20260309153054-85fc1918516c // indirect
+	go.opentelemetry.io/collector/extension v1.53.1-0.20260309153054-85fc1918516c // indirect
 	go.opentelemetry.io/collector/featuregate v1.53.1-0.20260309153054-85fc1918516c // indirect
 	go.opentelemetry.io/collector/internal/componentalias v0.147.1-0.20260309153054-85fc1918516c // indirect
 	go.opentelemetry.io/collector/pdata/pprofile v0.147.1-0.20260309153054-85fc1918516c // indirect
 	go.opentelemetry.io/collector/pdata/testdata v0.147.1-0.20260309153054-85fc1918516c // indirect
 	go.opentelemetry.io/collector/pdata/xpdata v0.147.1-0.20260309153054-85fc1918516c // indirect
-	go.opentelemetry.io/collector/pipeline v1.53.1-0.20260309153054-85fc1918516c // indirect
 	go.opentelemetry.io/collector/processor/xprocessor v0.147.1-0.20260309153054-85fc1918516c // indirect
 	go.opentelemetry.io/otel v1.42.0 // indirect
 	go.opentelemetry.io/otel/metric v1.42.0 // indirect
@@ -0,0 +1,87 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package tracking // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/cumulativetodeltaprocessor/internal/tracking"
+
+import (
+	"bytes"
+	"encoding/gob"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+// persistedStatesVersion is incremented whenever the encoding of the persisted states changes.
+const persistedStatesVersion = 1
+
+type persistedStates struct {
+	Version int
+	// States maps the hashable identity of a stream to its last observed point.
+	States map[string]ValuePoint
+}
+
+// MarshalStates encodes the last observed point of every tracked stream, so that
+// the states can be restored with UnmarshalStates after a restart.
+func (t *MetricTracker) MarshalStates() ([]byte, error) {
+	ps := persistedStates{
+		Version: persistedStatesVersion,
+		States:  map[string]ValuePoint{},
+	}
+	t.states.Range(func(key, value any) bool {
+		s := value.(*state)
+		s.Lock()
+		// The point is cloned because Convert may modify histogram buckets in place.
+		ps.States[key.(string)] = s.prevPoint.clone()
+		s.Unlock()
+		return true
+	})
+
+	var b bytes.Buffer
+	if err := gob.NewEncoder(&b).Encode(ps); err != nil {
+		return nil, fmt.Errorf("failed to encode tracking states: %w", err)
+	}
+	return b.Bytes(), nil
+}
+
+// UnmarshalStates restores states encoded by MarshalStates and returns the number of restored streams.
+// States that would have been removed as stale by now are skipped, and streams that are already
+// tracked keep their current state.
+func (t *MetricTracker) UnmarshalStates(data []byte, now time.Time) (int, error) {
+	var ps persistedStates
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&ps); err != nil {
+		return 0, fmt.Errorf("failed to decode tracking states: %w", err)
+	}
+	if ps.Version != persistedStatesVersion {
+		return 0, fmt.Errorf("unsupported tracking states version %d", ps.Version)
+	}
+
+	var staleBefore pcommon.Timestamp
+	if t.maxStaleness > 0 {
+		staleBefore = pcommon.NewTimestampFromTime(now.Add(-t.maxStaleness))
+	}
+
+	restored := 0
+	for key, point := range ps.States {
+		if point.ObservedTimestamp < staleBefore {
+			continue
+		}
+		if _, loaded := t.states.LoadOrStore(key, &state{prevPoint: point}); !loaded {
+			restored++
+		}
+	}
+	return restored, nil
+}
+
+func (point *ValuePoint) clone() ValuePoint {
+	out := *point
+	if point.HistogramValue != nil {
+		val := point.HistogramValue.Clone()
+		out.HistogramValue = &val
+	}
+	if point.ExponentialHistogramValue != nil {
+		val := point.ExponentialHistogramValue.Clone()
+		out.ExponentialHistogramValue = &val
+	}
+	return out
+}
@@ -0,0 +1,117 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package tracking
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.uber.org/zap"
+)
+
+func TestMetricTracker_MarshalUnmarshalStates(t *testing.T) {
+	now := time.Now()
+	sumID := MetricIdentity{
+		Resource:               pcommon.NewResource(),
+		InstrumentationLibrary: pcommon.NewInstrumentationScope(),
+		MetricType:             pmetric.MetricTypeSum,
+		MetricIsMonotonic:      true,
+		MetricName:             "sum",
+		Attributes:             pcommon.NewMap(),
+		MetricValueType:        pmetric.NumberDataPointValueTypeInt,
+		StartTimestamp:         pcommon.NewTimestampFromTime(now.Add(-time.Hour)),
+	}
+	sumID.Resource.Attributes().PutStr("host.name", "host-1")
+	histogramID := sumID
+	histogramID.MetricType = pmetric.MetricTypeHistogram
+	histogramID.MetricName = "histogram"
+
+	m := NewMetricTracker(t.Context(), zap.NewNop(), 0, InitialValueDrop)
+	_, valid := m.Convert(MetricPoint{
+		Identity: sumID,
+		Value:    ValuePoint{ObservedTimestamp: pcommon.NewTimestampFromTime(now.Add(-time.Minute)), IntValue: 100},
+	})
+	require.False(t, valid)
+	_, valid = m.Convert(MetricPoint{
+		Identity: histogramID,
+		Value: ValuePoint{
+			ObservedTimestamp: pcommon.NewTimestampFromTime(now.Add(-time.Minute)),
+			HistogramValue: &HistogramPoint{
+				Count:        10,
+				Sum:          50,
+				BucketBounds: []float64{1, 10},
+				BucketCounts: []uint64{2, 3, 5},
+			},
+		},
+	})
+	require.False(t, valid)
+
+	data, err := m.MarshalStates()
+	require.NoError(t, err)
+
+	// A restarted tracker continues from the restored states instead of dropping the first points.
+	restarted := NewMetricTracker(t.Context(), zap.NewNop(), 0, InitialValueDrop)
+	restored, err := restarted.UnmarshalStates(data, now)
+	require.NoError(t, err)
+	assert.Equal(t, 2, restored)
+
+	out, valid := restarted.Convert(MetricPoint{
+		Identity: sumID,
+		Value:    ValuePoint{ObservedTimestamp: pcommon.NewTimestampFromTime(now), IntValue: 120},
+	})
+	require.True(t, valid)
+	assert.Equal(t, int64(20), out.IntValue)
+	assert.Equal(t, pcommon.NewTimestampFromTime(now.Add(-time.Minute)), out.StartTimestamp)
+
+	out, valid = restarted.Convert(MetricPoint{
+		Identity: histogramID,
+		Value: ValuePoint{
+			ObservedTimestamp: pcommon.NewTimestampFromTime(now),
+			HistogramValue: &HistogramPoint{
+				Count:        15,
+				Sum:          70,
+				BucketBounds: []float64{1, 10},
+				BucketCounts: []uint64{3, 5, 7},
+			},
+		},
+	})
+	require.True(t, valid)
+	assert.Equal(t, uint64(5), out.HistogramValue.Count)
+	assert.Equal(t, 20.0, out.HistogramValue.Sum)
+	assert.Equal(t, []uint64{1, 2, 2}, out.HistogramValue.BucketCounts)
+}
+
+func TestMetricTracker_UnmarshalStatesSkipsStale(t *testing.T) {
+	now := time.Now()
+	source := NewMetricTracker(t.Context(), zap.NewNop(), 0, InitialValueAuto)
+	source.states.Store("fresh", &state{prevPoint: ValuePoint{ObservedTimestamp: pcommon.NewTimestampFromTime(now.Add(-time.Minute))}})
+	source.states.Store("stale", &state{prevPoint: ValuePoint{ObservedTimestamp: pcommon.NewTimestampFromTime(now.Add(-time.Hour))}})
+	data, err := source.MarshalStates()
+	require.NoError(t, err)
+
+	m := &MetricTracker{logger: zap.NewNop(), maxStaleness: 10 * time.Minute}
+	restored, err := m.UnmarshalStates(data, now)
+	require.NoError(t, err)
+	assert.Equal(t, 1, restored)
+	_, ok := m.states.Load("fresh")
+	assert.True(t, ok)
+	_, ok = m.states.Load("stale")
+	assert.False(t, ok)
+
+	// Without max_staleness, all states are restored.
+	m = &MetricTracker{logger: zap.NewNop()}
+	restored, err = m.UnmarshalStates(data, now)
+	require.NoError(t, err)
+	assert.Equal(t, 2, restored)
+}
+
+func TestMetricTracker_UnmarshalStatesInvalid(t *testing.T) {
+	m := &MetricTracker{logger: zap.NewNop()}
+	_, err := m.UnmarshalStates([]byte("not a state"), time.Now())
+	assert.ErrorContains(t, err, "failed to decode tracking states")
+}
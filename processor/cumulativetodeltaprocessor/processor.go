@@ -5,12 +5,18 @@ package cumulativetodeltaprocessor // import "github.com/open-telemetry/opentele
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math"
 	"strings"
+	"sync"
+	"time"
 
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/extension/xextension/storage"
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/processor"
 	"go.uber.org/zap"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter/filterset"
@@ -25,14 +31,27 @@ type cumulativeToDeltaProcessor struct {
 	logger             *zap.Logger
 	deltaCalculator    *tracking.MetricTracker
 	cancelFunc         context.CancelFunc
+
+	id            component.ID
+	storageID     *component.ID
+	flushInterval time.Duration
+	storageClient storage.Client
+	holdsStorage  bool
+	stopFlushing  chan struct{}
+	flushWg       sync.WaitGroup
 }
 
-func newCumulativeToDeltaProcessor(config *Config, logger *zap.Logger) (*cumulativeToDeltaProcessor, error) {
+func newCumulativeToDeltaProcessor(config *Config, set processor.Settings) (*cumulativeToDeltaProcessor, error) {
 	ctx, cancel := context.WithCancel(context.Background())
+	logger := set.Logger
 
 	p := &cumulativeToDeltaProcessor{
-		logger:     logger,
-		cancelFunc: cancel,
+		logger:        logger,
+		cancelFunc:    cancel,
+		id:            set.ID,
+		storageID:     config.Storage,
+		flushInterval: config.FlushInterval,
+		stopFlushing:  make(chan struct{}),
 	}
 	if len(config.Include.Metrics) > 0 {
 		p.includeFS, _ = filterset.CreateFilterSet(config.Include.Metrics, &config.Include.Config)
@@ -167,11 +186,88 @@ func (ctdp *cumulativeToDeltaProcessor) processMetrics(_ context.Context, md pme
 	return md, nil
 }
 
-func (ctdp *cumulativeToDeltaProcessor) shutdown(context.Context) error {
-	ctdp.cancelFunc()
+// start restores the tracking state from storage, if configured, and starts flushing it periodically.
+func (ctdp *cumulativeToDeltaProcessor) start(ctx context.Context, host component.Host) error {
+	if ctdp.storageID == nil {
+		return nil
+	}
+
+	if err := acquireStorage(ctdp.id); err != nil {
+		return err
+	}
+	ctdp.holdsStorage = true
+
+	client, err := getStorageClient(ctx, host, *ctdp.storageID, ctdp.id)
+	if err != nil {
+		return err
+	}
+	ctdp.storageClient = client
+
+	data, err := client.Get(ctx, trackingStateKey)
+	if err != nil {
+		return fmt.Errorf("failed to read tracking state from storage: %w", err)
+	}
+	if data != nil {
+		restored, err := ctdp.deltaCalculator.UnmarshalStates(data, time.Now())
+		if err != nil {
+			// Losing the state only costs the first point of each stream, so don't fail the start.
+			ctdp.logger.Warn("Failed to restore tracking state, starting with an empty state", zap.Error(err))
+		} else {
+			ctdp.logger.Debug("Restored tracking state", zap.Int("streams", restored))
+		}
+	}
+
+	if ctdp.flushInterval > 0 {
+		ctdp.flushWg.Add(1)
+		go ctdp.flushPeriodically()
+	}
+	return nil
+}
+
+func (ctdp *cumulativeToDeltaProcessor) flushPeriodically() {
+	defer ctdp.flushWg.Done()
+
+	ticker := time.NewTicker(ctdp.flushInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			if err := ctdp.flush(context.Background()); err != nil {
+				ctdp.logger.Error("Failed to persist tracking state", zap.Error(err))
+			}
+		case <-ctdp.stopFlushing:
+			return
+		}
+	}
+}
+
+// flush writes the tracking state to storage.
+func (ctdp *cumulativeToDeltaProcessor) flush(ctx context.Context) error {
+	data, err := ctdp.deltaCalculator.MarshalStates()
+	if err != nil {
+		return err
+	}
+	if err := ctdp.storageClient.Set(ctx, trackingStateKey, data); err != nil {
+		return fmt.Errorf("failed to write tracking state to storage: %w", err)
+	}
 	return nil
 }
 
+func (ctdp *cumulativeToDeltaProcessor) shutdown(ctx context.Context) error {
+	ctdp.cancelFunc()
+	if ctdp.holdsStorage {
+		defer releaseStorage(ctdp.id)
+	}
+	if ctdp.storageClient == nil {
+		return nil
+	}
+
+	close(ctdp.stopFlushing)
+	ctdp.flushWg.Wait()
+	err := ctdp.flush(ctx)
+	return errors.Join(err, ctdp.storageClient.Close(ctx))
+}
+
 func (ctdp *cumulativeToDeltaProcessor) shouldConvertMetric(metric pmetric.Metric) bool {
 	return (ctdp.includeFS == nil || ctdp.includeFS.Matches(metric.Name())) &&
 		(len(ctdp.includeMetricTypes) == 0 || ctdp.includeMetricTypes[metric.Type()]) &&
@@ -0,0 +1,55 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package cumulativetodeltaprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/cumulativetodeltaprocessor"
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/extension/xextension/storage"
+	"go.opentelemetry.io/collector/pipeline"
+)
+
+// trackingStateKey is the storage key of the persisted tracking state.
+const trackingStateKey = "tracking_state"
+
+// storageUsers holds the IDs of the processors persisting their tracking state. A processor
+// used in several pipelines is instantiated once per pipeline, and the instances can't tell
+// their pipelines apart, so they would overwrite each other's state.
+var (
+	storageUsersMu sync.Mutex
+	storageUsers   = map[component.ID]struct{}{}
+)
+
+func acquireStorage(componentID component.ID) error {
+	storageUsersMu.Lock()
+	defer storageUsersMu.Unlock()
+	if _, ok := storageUsers[componentID]; ok {
+		return fmt.Errorf("processor %q persists its tracking state and cannot be used in several pipelines, define a processor per pipeline instead", componentID)
+	}
+	storageUsers[componentID] = struct{}{}
+	return nil
+}
+
+func releaseStorage(componentID component.ID) {
+	storageUsersMu.Lock()
+	defer storageUsersMu.Unlock()
+	delete(storageUsers, componentID)
+}
+
+func getStorageClient(ctx context.Context, host component.Host, storageID, componentID component.ID) (storage.Client, error) {
+	ext, found := host.GetExtensions()[storageID]
+	if !found {
+		return nil, fmt.Errorf("storage extension '%s' not found", storageID)
+	}
+
+	storageExt, ok := ext.(storage.Extension)
+	if !ok {
+		return nil, fmt.Errorf("non-storage extension '%s' found", storageID)
+	}
+
+	return storageExt.GetClient(ctx, component.KindProcessor, componentID, pipeline.SignalMetrics.String())
+}
@@ -0,0 +1,157 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package cumulativetodeltaprocessor
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/extension/xextension/storage"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/processor"
+	"go.opentelemetry.io/collector/processor/processortest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/cumulativetodeltaprocessor/internal/metadata"
+)
+
+type memoryStorage struct {
+	component.StartFunc
+	component.ShutdownFunc
+	data       map[string][]byte
+	clientName string
+}
+
+func (s *memoryStorage) GetClient(_ context.Context, _ component.Kind, _ component.ID, name string) (storage.Client, error) {
+	s.clientName = name
+	return &memoryClient{data: s.data}, nil
+}
+
+type memoryClient struct {
+	data map[string][]byte
+}
+
+func (c *memoryClient) Get(_ context.Context, key string) ([]byte, error) {
+	return c.data[key], nil
+}
+
+func (c *memoryClient) Set(_ context.Context, key string, value []byte) error {
+	c.data[key] = value
+	return nil
+}
+
+func (c *memoryClient) Delete(_ context.Context, key string) error {
+	delete(c.data, key)
+	return nil
+}
+
+func (*memoryClient) Batch(context.Context, ...*storage.Operation) error {
+	return errors.New("not implemented")
+}
+
+func (*memoryClient) Close(context.Context) error {
+	return nil
+}
+
+type storageHost struct {
+	extensions map[component.ID]component.Component
+}
+
+func (h *storageHost) GetExtensions() map[component.ID]component.Component {
+	return h.extensions
+}
+
+func cumulativeSum(value int64, startTime, ts time.Time) pmetric.Metrics {
+	md := pmetric.NewMetrics()
+	m := md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics().AppendEmpty()
+	m.SetName("requests")
+	sum := m.SetEmptySum()
+	sum.SetIsMonotonic(true)
+	sum.SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	dp := sum.DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(pcommon.NewTimestampFromTime(startTime))
+	dp.SetTimestamp(pcommon.NewTimestampFromTime(ts))
+	dp.SetIntValue(value)
+	return md
+}
+
+func TestProcessorPersistsTrackingState(t *testing.T) {
+	storageID := component.MustNewID("memory_storage")
+	storageExt := &memoryStorage{data: map[string][]byte{}}
+	host := &storageHost{extensions: map[component.ID]component.Component{
+		storageID: storageExt,
+	}}
+	cfg := createDefaultConfig().(*Config)
+	cfg.Storage = &storageID
+
+	now := time.Now()
+	startTime := now.Add(-time.Hour)
+
+	run := func(md pmetric.Metrics) []pmetric.Metrics {
+		next := new(consumertest.MetricsSink)
+		p, err := NewFactory().CreateMetrics(t.Context(), processortest.NewNopSettings(metadata.Type), cfg, next)
+		require.NoError(t, err)
+		require.NoError(t, p.Start(t.Context(), host))
+		require.NoError(t, p.ConsumeMetrics(t.Context(), md))
+		require.NoError(t, p.Shutdown(t.Context()))
+		return next.AllMetrics()
+	}
+
+	// The first point of the stream is dropped, since it started before the processor.
+	got := run(cumulativeSum(100, startTime, now.Add(-time.Minute)))
+	require.Len(t, got, 1)
+	assert.Zero(t, got[0].DataPointCount())
+
+	// After a restart, the delta is computed from the persisted state.
+	got = run(cumulativeSum(150, startTime, now))
+	require.Len(t, got, 1)
+	dp := got[0].ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Sum().DataPoints().At(0)
+	assert.Equal(t, int64(50), dp.IntValue())
+	assert.Equal(t, pcommon.NewTimestampFromTime(now.Add(-time.Minute)), dp.StartTimestamp())
+	assert.Equal(t, "metrics", storageExt.clientName)
+}
+
+func TestProcessorStorageInSeveralPipelines(t *testing.T) {
+	storageID := component.MustNewID("memory_storage")
+	host := &storageHost{extensions: map[component.ID]component.Component{
+		storageID: &memoryStorage{data: map[string][]byte{}},
+	}}
+	cfg := createDefaultConfig().(*Config)
+	cfg.Storage = &storageID
+
+	// The collector creates an instance of the processor, with the same ID, for each pipeline.
+	create := func() processor.Metrics {
+		p, err := NewFactory().CreateMetrics(t.Context(), processortest.NewNopSettings(metadata.Type), cfg, consumertest.NewNop())
+		require.NoError(t, err)
+		return p
+	}
+	first, second := create(), create()
+	require.NoError(t, first.Start(t.Context(), host))
+	assert.ErrorContains(t, second.Start(t.Context(), host), "cannot be used in several pipelines")
+	require.NoError(t, second.Shutdown(t.Context()))
+	require.NoError(t, first.Shutdown(t.Context()))
+
+	// The storage is released on shutdown, so the processor can be started again.
+	third := create()
+	require.NoError(t, third.Start(t.Context(), host))
+	require.NoError(t, third.Shutdown(t.Context()))
+}
+
+func TestProcessorMissingStorageExtension(t *testing.T) {
+	storageID := component.MustNewID("missing_storage")
+	cfg := createDefaultConfig().(*Config)
+	cfg.Storage = &storageID
+
+	p, err := NewFactory().CreateMetrics(t.Context(), processortest.NewNopSettings(metadata.Type), cfg, consumertest.NewNop())
+	require.NoError(t, err)
+	assert.ErrorContains(t, p.Start(t.Context(), componenttest.NewNopHost()), "storage extension 'missing_storage' not found")
+	assert.NoError(t, p.Shutdown(t.Context()))
+}
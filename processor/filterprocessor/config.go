@@ -27,6 +27,7 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlresource"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlspan"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlspanevent"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottltrace"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/filterprocessor/internal/condition"
 )
 
@@ -61,6 +62,7 @@ type Config struct {
 	metricFunctions    map[string]ottl.Factory[*ottlmetric.TransformContext]
 	spanEventFunctions map[string]ottl.Factory[*ottlspanevent.TransformContext]
 	spanFunctions      map[string]ottl.Factory[*ottlspan.TransformContext]
+	traceFunctions     map[string]ottl.Factory[*ottltrace.TransformContext]
 	profileFunctions   map[string]ottl.Factory[*ottlprofile.TransformContext]
 }
 
@@ -570,6 +572,7 @@ func (cfg *Config) newTraceParserCollection(telemetrySettings component.Telemetr
 	return condition.NewTraceParserCollection(telemetrySettings,
 		condition.WithSpanParser(cfg.spanFunctions),
 		condition.WithSpanEventParser(cfg.spanEventFunctions),
+		condition.WithTraceParser(cfg.traceFunctions),
 		condition.WithTraceErrorMode(cfg.ErrorMode),
 		condition.WithTraceCommonParsers(cfg.resourceFunctions),
 	)
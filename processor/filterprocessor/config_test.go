@@ -1102,6 +1102,31 @@ func TestLoadingConfigOTTL(t *testing.T) {
 				},
 			},
 		},
+		{
+			id: component.NewIDWithName(metadata.Type, "sampling"),
+			expected: &Config{
+				ErrorMode: ottl.PropagateError,
+				LogConditions: []condition.ContextConditions{
+					{
+						Conditions: []string{`log.severity_number < SEVERITY_NUMBER_WARN`},
+						Sampling:   &condition.Sampling{KeepOneIn: 10, Key: "log.body"},
+					},
+				},
+				TraceConditions: []condition.ContextConditions{
+					{
+						Conditions: []string{`attributes["http.route"] == "/health"`},
+						Context:    "span",
+						Sampling:   &condition.Sampling{KeepPerSecond: 5},
+					},
+				},
+			},
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "sampling_both_options"),
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "sampling_invalid_key"),
+		},
 		{
 			id:           component.NewIDWithName(metadata.Type, "mix_trace_conditions"),
 			errorMessage: `cannot use context inferred trace conditions "trace_conditions" and the settings "traces.resource", "traces.span", "traces.spanevent" at the same time`,
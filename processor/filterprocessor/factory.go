@@ -23,6 +23,7 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlresource"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlspan"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlspanevent"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottltrace"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/filterprocessor/internal/metadata"
 )
 
@@ -35,6 +36,7 @@ type filterProcessorFactory struct {
 	metricFunctions                     map[string]ottl.Factory[*ottlmetric.TransformContext]
 	spanEventFunctions                  map[string]ottl.Factory[*ottlspanevent.TransformContext]
 	spanFunctions                       map[string]ottl.Factory[*ottlspan.TransformContext]
+	traceFunctions                      map[string]ottl.Factory[*ottltrace.TransformContext]
 	profileFunctions                    map[string]ottl.Factory[*ottlprofile.TransformContext]
 	defaultResourceFunctionsOverridden  bool
 	defaultDataPointFunctionsOverridden bool
@@ -42,6 +44,7 @@ type filterProcessorFactory struct {
 	defaultMetricFunctionsOverridden    bool
 	defaultSpanEventFunctionsOverridden bool
 	defaultSpanFunctionsOverridden      bool
+	defaultTraceFunctionsOverridden     bool
 	defaultProfileFunctionsOverridden   bool
 }
 
@@ -174,6 +177,18 @@ func WithSpanFunctionsNew(spanFunctions []ottl.Factory[*ottlspan.TransformContex
 	}
 }
 
+// WithTraceFunctions will override the default OTTL trace context functions with the provided traceFunctions in the resulting processor.
+// Subsequent uses of WithTraceFunctions will merge the provided traceFunctions with the previously registered functions.
+func WithTraceFunctions(traceFunctions []ottl.Factory[*ottltrace.TransformContext]) FactoryOption {
+	return func(factory *filterProcessorFactory) {
+		if !factory.defaultTraceFunctionsOverridden {
+			factory.traceFunctions = map[string]ottl.Factory[*ottltrace.TransformContext]{}
+			factory.defaultTraceFunctionsOverridden = true
+		}
+		factory.traceFunctions = mergeFunctionsToMap(factory.traceFunctions, traceFunctions)
+	}
+}
+
 // Deprecated: [v0.145.0] use WithProfileFunctionsNew.
 func WithProfileFunctions(profileFunctions []ottl.Factory[ottlprofile.TransformContext]) FactoryOption {
 	newProfileFunctions := make([]ottl.Factory[*ottlprofile.TransformContext], 0, len(profileFunctions))
@@ -209,6 +224,7 @@ func NewFactoryWithOptions(options ...FactoryOption) processor.Factory {
 		metricFunctions:    defaultMetricFunctionsMap(),
 		spanEventFunctions: defaultSpanEventFunctionsMap(),
 		spanFunctions:      defaultSpanFunctionsMap(),
+		traceFunctions:     defaultTraceFunctionsMap(),
 		profileFunctions:   defaultProfileFunctionsMap(),
 	}
 	for _, o := range options {
@@ -234,6 +250,7 @@ func (f *filterProcessorFactory) createDefaultConfig() component.Config {
 		metricFunctions:    f.metricFunctions,
 		spanEventFunctions: f.spanEventFunctions,
 		spanFunctions:      f.spanFunctions,
+		traceFunctions:     f.traceFunctions,
 		profileFunctions:   f.profileFunctions,
 	}
 }
@@ -295,11 +312,12 @@ func (f *filterProcessorFactory) createTracesProcessor(
 	cfg component.Config,
 	nextConsumer consumer.Traces,
 ) (processor.Traces, error) {
-	if f.defaultResourceFunctionsOverridden || f.defaultSpanEventFunctionsOverridden || f.defaultSpanFunctionsOverridden {
+	if f.defaultResourceFunctionsOverridden || f.defaultSpanEventFunctionsOverridden || f.defaultSpanFunctionsOverridden || f.defaultTraceFunctionsOverridden {
 		set.Logger.Debug("non-default OTTL trace functions have been registered in the \"filter\" processor",
 			zap.Bool("resource", f.defaultResourceFunctionsOverridden),
 			zap.Bool("span", f.defaultSpanFunctionsOverridden),
 			zap.Bool("spanevent", f.defaultSpanEventFunctionsOverridden),
+			zap.Bool("trace", f.defaultTraceFunctionsOverridden),
 		)
 	}
 	fp, err := newFilterSpansProcessor(set, cfg.(*Config))
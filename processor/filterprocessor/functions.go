@@ -16,6 +16,7 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlresource"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlspan"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlspanevent"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottltrace"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
 )
 
@@ -76,6 +77,10 @@ func DefaultSpanEventFunctionsNew() []ottl.Factory[*ottlspanevent.TransformConte
 	return slices.Collect(maps.Values(defaultSpanEventFunctionsMap()))
 }
 
+func DefaultTraceFunctions() []ottl.Factory[*ottltrace.TransformContext] {
+	return slices.Collect(maps.Values(defaultTraceFunctionsMap()))
+}
+
 // Deprecated: [v0.145.0] use DefaultProfileFunctionsNew.
 func DefaultProfileFunctions() []ottl.Factory[ottlprofile.TransformContext] {
 	return slices.Collect(maps.Values(ottlfuncs.StandardConverters[ottlprofile.TransformContext]()))
@@ -109,6 +114,10 @@ func defaultSpanEventFunctionsMap() map[string]ottl.Factory[*ottlspanevent.Trans
 	return filterottl.StandardSpanEventFuncs()
 }
 
+func defaultTraceFunctionsMap() map[string]ottl.Factory[*ottltrace.TransformContext] {
+	return filterottl.StandardTraceFuncs()
+}
+
 func defaultProfileFunctionsMap() map[string]ottl.Factory[*ottlprofile.TransformContext] {
 	return filterottl.StandardProfileFuncs()
 }
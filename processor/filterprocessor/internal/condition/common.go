@@ -18,7 +18,7 @@ type resourceConditionBuilder[R any] func([]*ottl.Condition[*ottlresource.Transf
 // scopeConditionBuilder creates signal-specific conditions (parsedLogConditions, parsedMetricConditions, parsedTraceConditions, parsedProfileConditions) from scope conditions
 type scopeConditionBuilder[R any] func([]*ottl.Condition[*ottlscope.TransformContext], component.TelemetrySettings, ottl.ErrorMode) R
 
-func withCommonParsers[R any](resourceFunctions map[string]ottl.Factory[*ottlresource.TransformContext], resourceBuilder resourceConditionBuilder[R], scopeBuilder scopeConditionBuilder[R]) ottl.ParserCollectionOption[R] {
+func withCommonParsers[R any](resourceFunctions map[string]ottl.Factory[*ottlresource.TransformContext], resourceBuilder resourceConditionBuilder[R], scopeBuilder scopeConditionBuilder[R], samplingKeyBuilder func(key any) R) ottl.ParserCollectionOption[R] {
 	return func(pc *ottl.ParserCollection[R]) error {
 		rp, err := ottlresource.NewParser(resourceFunctions, pc.Settings, ottlresource.EnablePathContextNames())
 		if err != nil {
@@ -29,12 +29,14 @@ func withCommonParsers[R any](resourceFunctions map[string]ottl.Factory[*ottlres
 			return err
 		}
 
-		err = ottl.WithParserCollectionContext(ottlresource.ContextName, &rp, ottl.WithConditionConverter[*ottlresource.TransformContext, R](resourceConditionsConverter(resourceBuilder)))(pc)
+		err = ottl.WithParserCollectionContext(ottlresource.ContextName, &rp, ottl.WithConditionConverter[*ottlresource.TransformContext, R](resourceConditionsConverter(resourceBuilder)),
+			ottl.WithValueExpressionConverter(samplingKeyConverter[*ottlresource.TransformContext](samplingKeyBuilder)))(pc)
 		if err != nil {
 			return err
 		}
 
-		err = ottl.WithParserCollectionContext(ottlscope.ContextName, &sp, ottl.WithConditionConverter[*ottlscope.TransformContext, R](scopeConditionsConverter(scopeBuilder)))(pc)
+		err = ottl.WithParserCollectionContext(ottlscope.ContextName, &sp, ottl.WithConditionConverter[*ottlscope.TransformContext, R](scopeConditionsConverter(scopeBuilder)),
+			ottl.WithValueExpressionConverter(samplingKeyConverter[*ottlscope.TransformContext](samplingKeyBuilder)))(pc)
 		if err != nil {
 			return err
 		}
@@ -19,6 +19,7 @@ const (
 	Scope     ContextID = "scope"
 	Span      ContextID = "span"
 	SpanEvent ContextID = "spanevent"
+	Trace     ContextID = "trace"
 	Metric    ContextID = "metric"
 	DataPoint ContextID = "datapoint"
 	Log       ContextID = "log"
@@ -28,7 +29,7 @@ const (
 func (c *ContextID) UnmarshalText(text []byte) error {
 	str := ContextID(strings.ToLower(string(text)))
 	switch str {
-	case Resource, Scope, Span, SpanEvent, Metric, DataPoint, Log, Profile:
+	case Resource, Scope, Span, SpanEvent, Trace, Metric, DataPoint, Log, Profile:
 		*c = str
 		return nil
 	default:
@@ -43,6 +44,8 @@ type ContextConditions struct {
 	// ErrorMode determines how the processor reacts to errors that occur while processing
 	// this group of conditions. When provided, it overrides the default Config ErrorMode.
 	ErrorMode ottl.ErrorMode `mapstructure:"error_mode"`
+	// Sampling keeps some of the items matching the conditions, instead of dropping all of them.
+	Sampling *Sampling `mapstructure:"sampling"`
 }
 
 func (c ContextConditions) GetConditions() []string {
@@ -31,6 +31,19 @@ type parsedLogConditions struct {
 	logConditions      []*ottl.Condition[*ottllog.TransformContext]
 	telemetrySettings  component.TelemetrySettings
 	errorMode          ottl.ErrorMode
+	// samplingKey is the parsed sampling key, a value expression of the context it was parsed in.
+	samplingKey any
+}
+
+func (lc parsedLogConditions) contextName() string {
+	switch {
+	case len(lc.logConditions) > 0:
+		return ottllog.ContextName
+	case len(lc.scopeConditions) > 0:
+		return ottlscope.ContextName
+	default:
+		return ottlresource.ContextName
+	}
 }
 
 func (lc LogsConsumer) ConsumeLogs(ctx context.Context, ld plog.Logs) error {
@@ -106,6 +119,10 @@ func newLogConditionsFromScope(sc []*ottl.Condition[*ottlscope.TransformContext]
 	}
 }
 
+func newLogConditionsFromSamplingKey(key any) parsedLogConditions {
+	return parsedLogConditions{samplingKey: key}
+}
+
 func newLogsConsumer(lc *parsedLogConditions) LogsConsumer {
 	var rExpr expr.BoolExpr[*ottlresource.TransformContext]
 	var sExpr expr.BoolExpr[*ottlscope.TransformContext]
@@ -143,7 +160,9 @@ func WithLogParser(functions map[string]ottl.Factory[*ottllog.TransformContext])
 		if err != nil {
 			return err
 		}
-		return ottl.WithParserCollectionContext(ottllog.ContextName, &logParser, ottl.WithConditionConverter(convertLogConditions))(pc)
+		return ottl.WithParserCollectionContext(ottllog.ContextName, &logParser,
+			ottl.WithConditionConverter(convertLogConditions),
+			ottl.WithValueExpressionConverter(samplingKeyConverter[*ottllog.TransformContext](newLogConditionsFromSamplingKey)))(pc)
 	}
 }
 
@@ -152,7 +171,7 @@ func WithLogErrorMode(errorMode ottl.ErrorMode) LogParserCollectionOption {
 }
 
 func WithLogCommonParsers(functions map[string]ottl.Factory[*ottlresource.TransformContext]) LogParserCollectionOption {
-	return LogParserCollectionOption(withCommonParsers(functions, newLogConditionsFromResource, newLogConditionsFromScope))
+	return LogParserCollectionOption(withCommonParsers(functions, newLogConditionsFromResource, newLogConditionsFromScope, newLogConditionsFromSamplingKey))
 }
 
 func NewLogParserCollection(settings component.TelemetrySettings, options ...LogParserCollectionOption) (*LogParserCollection, error) {
@@ -191,9 +210,14 @@ func convertLogConditions(pc *ottl.ParserCollection[parsedLogConditions], condit
 // For undefined context, each condition is parsed independently.
 // Conditions are then grouped by their inferred context (resource, scope, log).
 // The conditions group's error mode takes precedence over the processor-level error mode.
+// When the group configures sampling, its conditions are all parsed in the same context.
 func (lpc *LogParserCollection) ParseContextConditions(contextConditions ContextConditions) (LogsConsumer, error) {
 	pc := ottl.ParserCollection[parsedLogConditions](*lpc)
 
+	if contextConditions.Sampling != nil {
+		return parseSampledLogConditions(&pc, contextConditions)
+	}
+
 	if contextConditions.Context != "" {
 		lc, err := pc.ParseConditionsWithContext(string(contextConditions.Context), contextConditions, true)
 		if err != nil {
@@ -233,3 +257,19 @@ func (lpc *LogParserCollection) ParseContextConditions(contextConditions Context
 
 	return newLogsConsumer(&aggregatedConditions), nil
 }
+
+func parseSampledLogConditions(pc *ottl.ParserCollection[parsedLogConditions], contextConditions ContextConditions) (LogsConsumer, error) {
+	lc, key, err := parseSampledConditions(pc, contextConditions, parsedLogConditions.contextName)
+	if err != nil {
+		return LogsConsumer{}, err
+	}
+	s, err := newSampler(contextConditions.Sampling)
+	if err != nil {
+		return LogsConsumer{}, err
+	}
+	consumer := newLogsConsumer(&lc)
+	consumer.resourceExpr = newSampledExpr(consumer.resourceExpr, s, key.samplingKey, lc.errorMode, lc.telemetrySettings)
+	consumer.scopeExpr = newSampledExpr(consumer.scopeExpr, s, key.samplingKey, lc.errorMode, lc.telemetrySettings)
+	consumer.logExpr = newSampledExpr(consumer.logExpr, s, key.samplingKey, lc.errorMode, lc.telemetrySettings)
+	return consumer, nil
+}
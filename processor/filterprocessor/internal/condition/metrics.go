@@ -34,6 +34,21 @@ type parsedMetricConditions struct {
 	dataPointConditions []*ottl.Condition[*ottldatapoint.TransformContext]
 	telemetrySettings   component.TelemetrySettings
 	errorMode           ottl.ErrorMode
+	// samplingKey is the parsed sampling key, a value expression of the context it was parsed in.
+	samplingKey any
+}
+
+func (mc parsedMetricConditions) contextName() string {
+	switch {
+	case len(mc.dataPointConditions) > 0:
+		return ottldatapoint.ContextName
+	case len(mc.metricConditions) > 0:
+		return ottlmetric.ContextName
+	case len(mc.scopeConditions) > 0:
+		return ottlscope.ContextName
+	default:
+		return ottlresource.ContextName
+	}
 }
 
 func (mc MetricsConsumer) ConsumeMetrics(ctx context.Context, md pmetric.Metrics) error {
@@ -219,6 +234,10 @@ func newMetricConditionsFromScope(sc []*ottl.Condition[*ottlscope.TransformConte
 	}
 }
 
+func newMetricConditionsFromSamplingKey(key any) parsedMetricConditions {
+	return parsedMetricConditions{samplingKey: key}
+}
+
 func newMetricsConsumer(mc *parsedMetricConditions) MetricsConsumer {
 	var rExpr expr.BoolExpr[*ottlresource.TransformContext]
 	var sExpr expr.BoolExpr[*ottlscope.TransformContext]
@@ -263,7 +282,9 @@ func WithMetricParser(functions map[string]ottl.Factory[*ottlmetric.TransformCon
 		if err != nil {
 			return err
 		}
-		return ottl.WithParserCollectionContext(ottlmetric.ContextName, &metricParser, ottl.WithConditionConverter(convertMetricConditions))(pc)
+		return ottl.WithParserCollectionContext(ottlmetric.ContextName, &metricParser,
+			ottl.WithConditionConverter(convertMetricConditions),
+			ottl.WithValueExpressionConverter(samplingKeyConverter[*ottlmetric.TransformContext](newMetricConditionsFromSamplingKey)))(pc)
 	}
 }
 
@@ -273,7 +294,9 @@ func WithDataPointParser(functions map[string]ottl.Factory[*ottldatapoint.Transf
 		if err != nil {
 			return err
 		}
-		return ottl.WithParserCollectionContext(ottldatapoint.ContextName, &dataPointParser, ottl.WithConditionConverter(convertDataPointConditions))(pc)
+		return ottl.WithParserCollectionContext(ottldatapoint.ContextName, &dataPointParser,
+			ottl.WithConditionConverter(convertDataPointConditions),
+			ottl.WithValueExpressionConverter(samplingKeyConverter[*ottldatapoint.TransformContext](newMetricConditionsFromSamplingKey)))(pc)
 	}
 }
 
@@ -282,7 +305,7 @@ func WithMetricErrorMode(errorMode ottl.ErrorMode) MetricParserCollectionOption
 }
 
 func WithMetricCommonParsers(functions map[string]ottl.Factory[*ottlresource.TransformContext]) MetricParserCollectionOption {
-	return MetricParserCollectionOption(withCommonParsers(functions, newMetricConditionsFromResource, newMetricConditionsFromScope))
+	return MetricParserCollectionOption(withCommonParsers(functions, newMetricConditionsFromResource, newMetricConditionsFromScope, newMetricConditionsFromSamplingKey))
 }
 
 func NewMetricParserCollection(settings component.TelemetrySettings, options ...MetricParserCollectionOption) (*MetricParserCollection, error) {
@@ -333,6 +356,9 @@ func convertDataPointConditions(pc *ottl.ParserCollection[parsedMetricConditions
 
 func (mpc *MetricParserCollection) ParseContextConditions(contextConditions ContextConditions) (MetricsConsumer, error) {
 	pc := ottl.ParserCollection[parsedMetricConditions](*mpc)
+	if contextConditions.Sampling != nil {
+		return parseSampledMetricConditions(&pc, contextConditions)
+	}
 	if contextConditions.Context != "" {
 		mc, err := pc.ParseConditionsWithContext(string(contextConditions.Context), contextConditions, true)
 		if err != nil {
@@ -377,3 +403,20 @@ func (mpc *MetricParserCollection) ParseContextConditions(contextConditions Cont
 
 	return newMetricsConsumer(&aggregatedConditions), nil
 }
+
+func parseSampledMetricConditions(pc *ottl.ParserCollection[parsedMetricConditions], contextConditions ContextConditions) (MetricsConsumer, error) {
+	mc, key, err := parseSampledConditions(pc, contextConditions, parsedMetricConditions.contextName)
+	if err != nil {
+		return MetricsConsumer{}, err
+	}
+	s, err := newSampler(contextConditions.Sampling)
+	if err != nil {
+		return MetricsConsumer{}, err
+	}
+	consumer := newMetricsConsumer(&mc)
+	consumer.resourceExpr = newSampledExpr(consumer.resourceExpr, s, key.samplingKey, mc.errorMode, mc.telemetrySettings)
+	consumer.scopeExpr = newSampledExpr(consumer.scopeExpr, s, key.samplingKey, mc.errorMode, mc.telemetrySettings)
+	consumer.metricExpr = newSampledExpr(consumer.metricExpr, s, key.samplingKey, mc.errorMode, mc.telemetrySettings)
+	consumer.dataPointExpr = newSampledExpr(consumer.dataPointExpr, s, key.samplingKey, mc.errorMode, mc.telemetrySettings)
+	return consumer, nil
+}
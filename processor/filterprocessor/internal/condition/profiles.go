@@ -31,6 +31,19 @@ type parsedProfileConditions struct {
 	profileConditions  []*ottl.Condition[*ottlprofile.TransformContext]
 	telemetrySettings  component.TelemetrySettings
 	errorMode          ottl.ErrorMode
+	// samplingKey is the parsed sampling key, a value expression of the context it was parsed in.
+	samplingKey any
+}
+
+func (pc parsedProfileConditions) contextName() string {
+	switch {
+	case len(pc.profileConditions) > 0:
+		return ottlprofile.ContextName
+	case len(pc.scopeConditions) > 0:
+		return ottlscope.ContextName
+	default:
+		return ottlresource.ContextName
+	}
 }
 
 func (pc ProfilesConsumer) ConsumeProfiles(ctx context.Context, pd pprofile.Profiles) error {
@@ -106,6 +119,10 @@ func newProfileConditionsFromScope(sc []*ottl.Condition[*ottlscope.TransformCont
 	}
 }
 
+func newProfileConditionsFromSamplingKey(key any) parsedProfileConditions {
+	return parsedProfileConditions{samplingKey: key}
+}
+
 func newProfilesConsumer(ppc *parsedProfileConditions) ProfilesConsumer {
 	var rExpr expr.BoolExpr[*ottlresource.TransformContext]
 	var sExpr expr.BoolExpr[*ottlscope.TransformContext]
@@ -143,7 +160,9 @@ func WithProfileParser(functions map[string]ottl.Factory[*ottlprofile.TransformC
 		if err != nil {
 			return err
 		}
-		return ottl.WithParserCollectionContext(ottlprofile.ContextName, &profileParser, ottl.WithConditionConverter(convertProfileConditions))(pc)
+		return ottl.WithParserCollectionContext(ottlprofile.ContextName, &profileParser,
+			ottl.WithConditionConverter(convertProfileConditions),
+			ottl.WithValueExpressionConverter(samplingKeyConverter[*ottlprofile.TransformContext](newProfileConditionsFromSamplingKey)))(pc)
 	}
 }
 
@@ -152,7 +171,7 @@ func WithProfileErrorMode(errorMode ottl.ErrorMode) ProfileParserCollectionOptio
 }
 
 func WithProfileCommonParsers(functions map[string]ottl.Factory[*ottlresource.TransformContext]) ProfileParserCollectionOption {
-	return ProfileParserCollectionOption(withCommonParsers(functions, newProfileConditionsFromResource, newProfileConditionsFromScope))
+	return ProfileParserCollectionOption(withCommonParsers(functions, newProfileConditionsFromResource, newProfileConditionsFromScope, newProfileConditionsFromSamplingKey))
 }
 
 func NewProfileParserCollection(settings component.TelemetrySettings, options ...ProfileParserCollectionOption) (*ProfileParserCollection, error) {
@@ -189,6 +208,9 @@ func convertProfileConditions(pc *ottl.ParserCollection[parsedProfileConditions]
 
 func (ppc *ProfileParserCollection) ParseContextConditions(contextConditions ContextConditions) (ProfilesConsumer, error) {
 	pc := ottl.ParserCollection[parsedProfileConditions](*ppc)
+	if contextConditions.Sampling != nil {
+		return parseSampledProfileConditions(&pc, contextConditions)
+	}
 	if contextConditions.Context != "" {
 		pConditions, err := pc.ParseConditionsWithContext(string(contextConditions.Context), contextConditions, true)
 		if err != nil {
@@ -228,3 +250,19 @@ func (ppc *ProfileParserCollection) ParseContextConditions(contextConditions Con
 
 	return newProfilesConsumer(&aggregatedConditions), nil
 }
+
+func parseSampledProfileConditions(pc *ottl.ParserCollection[parsedProfileConditions], contextConditions ContextConditions) (ProfilesConsumer, error) {
+	ppc, key, err := parseSampledConditions(pc, contextConditions, parsedProfileConditions.contextName)
+	if err != nil {
+		return ProfilesConsumer{}, err
+	}
+	s, err := newSampler(contextConditions.Sampling)
+	if err != nil {
+		return ProfilesConsumer{}, err
+	}
+	consumer := newProfilesConsumer(&ppc)
+	consumer.resourceExpr = newSampledExpr(consumer.resourceExpr, s, key.samplingKey, ppc.errorMode, ppc.telemetrySettings)
+	consumer.scopeExpr = newSampledExpr(consumer.scopeExpr, s, key.samplingKey, ppc.errorMode, ppc.telemetrySettings)
+	consumer.profileExpr = newSampledExpr(consumer.profileExpr, s, key.samplingKey, ppc.errorMode, ppc.telemetrySettings)
+	return consumer, nil
+}
@@ -0,0 +1,193 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package condition // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/filterprocessor/internal/condition"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter/expr"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+// maxSamplingKeys is the maximum number of keys tracked by a sampler. The tracked keys are
+// reset when it is exceeded, so that high cardinality keys don't grow the memory usage.
+const maxSamplingKeys = 10000
+
+// Sampling configures the conditions group to keep some of the matching items, instead of
+// dropping all of them.
+type Sampling struct {
+	_ struct{} // prevent unkeyed literals
+
+	// KeepOneIn keeps one in every KeepOneIn matching items, starting with the first one.
+	KeepOneIn int `mapstructure:"keep_one_in"`
+	// KeepPerSecond keeps at most KeepPerSecond matching items per second.
+	KeepPerSecond float64 `mapstructure:"keep_per_second"`
+	// Key is an OTTL value expression, evaluated in the context of the conditions, whose values
+	// are sampled separately. All the matching items are sampled together when empty.
+	Key string `mapstructure:"key"`
+}
+
+func (s *Sampling) Validate() error {
+	switch {
+	case s.KeepOneIn < 0:
+		return errors.New("sampling keep_one_in must not be negative")
+	case s.KeepPerSecond < 0:
+		return errors.New("sampling keep_per_second must not be negative")
+	case s.KeepOneIn == 0 && s.KeepPerSecond == 0:
+		return errors.New("sampling requires either keep_one_in or keep_per_second")
+	case s.KeepOneIn > 0 && s.KeepPerSecond > 0:
+		return errors.New("sampling keep_one_in and keep_per_second cannot be used at the same time")
+	}
+	return nil
+}
+
+// sampler decides which of the items matching the conditions are kept.
+type sampler struct {
+	keepOneIn int
+	perSecond float64
+	burst     float64
+	now       func() time.Time
+
+	mu      sync.Mutex
+	counts  map[string]int
+	buckets map[string]*tokenBucket
+}
+
+type tokenBucket struct {
+	tokens float64
+	last   time.Time
+}
+
+func newSampler(cfg *Sampling) (*sampler, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	return &sampler{
+		keepOneIn: cfg.KeepOneIn,
+		perSecond: cfg.KeepPerSecond,
+		burst:     math.Max(1, cfg.KeepPerSecond),
+		now:       time.Now,
+		counts:    map[string]int{},
+		buckets:   map[string]*tokenBucket{},
+	}, nil
+}
+
+// keep returns whether the matching item with the given key is kept.
+func (s *sampler) keep(key string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.keepOneIn > 0 {
+		count, ok := s.counts[key]
+		if !ok && len(s.counts) >= maxSamplingKeys {
+			clear(s.counts)
+		}
+		s.counts[key] = (count + 1) % s.keepOneIn
+		return count == 0
+	}
+
+	now := s.now()
+	bucket, ok := s.buckets[key]
+	if !ok {
+		if len(s.buckets) >= maxSamplingKeys {
+			clear(s.buckets)
+		}
+		bucket = &tokenBucket{tokens: s.burst, last: now}
+		s.buckets[key] = bucket
+	}
+	bucket.tokens = math.Min(s.burst, bucket.tokens+now.Sub(bucket.last).Seconds()*s.perSecond)
+	bucket.last = now
+	if bucket.tokens < 1 {
+		return false
+	}
+	bucket.tokens--
+	return true
+}
+
+// sampledExpr is a condition matching the items which match the conditions and are not kept
+// by the sampler.
+type sampledExpr[K any] struct {
+	expr              expr.BoolExpr[K]
+	sampler           *sampler
+	key               *ottl.ValueExpression[K]
+	errorMode         ottl.ErrorMode
+	telemetrySettings component.TelemetrySettings
+}
+
+// newSampledExpr returns the condition sampling the items matching boolExpr, or nil if
+// boolExpr is nil. The key is ignored unless it is a value expression of the context K.
+func newSampledExpr[K any](boolExpr expr.BoolExpr[K], s *sampler, key any, errorMode ottl.ErrorMode, telemetrySettings component.TelemetrySettings) expr.BoolExpr[K] {
+	if boolExpr == nil {
+		return nil
+	}
+	keyExpr, _ := key.(*ottl.ValueExpression[K])
+	return &sampledExpr[K]{
+		expr:              boolExpr,
+		sampler:           s,
+		key:               keyExpr,
+		errorMode:         errorMode,
+		telemetrySettings: telemetrySettings,
+	}
+}
+
+func (s *sampledExpr[K]) Eval(ctx context.Context, tCtx K) (bool, error) {
+	match, err := s.expr.Eval(ctx, tCtx)
+	if err != nil || !match {
+		return match, err
+	}
+	var key string
+	if s.key != nil {
+		val, keyErr := s.key.Eval(ctx, tCtx)
+		if keyErr != nil {
+			if s.errorMode == ottl.PropagateError {
+				return false, fmt.Errorf("failed to evaluate sampling key: %w", keyErr)
+			}
+			if s.errorMode == ottl.IgnoreError {
+				s.telemetrySettings.Logger.Warn("failed to evaluate sampling key", zap.Error(keyErr))
+			}
+		}
+		key = fmt.Sprint(val)
+	}
+	return !s.sampler.keep(key), nil
+}
+
+// samplingKeyConverter returns the converter of sampling keys, creating the parsed conditions
+// only holding the key with newParsed.
+func samplingKeyConverter[K, R any](newParsed func(key any) R) ottl.ParsedValueExpressionsConverter[K, R] {
+	return func(_ *ottl.ParserCollection[R], _ ottl.ValueExpressionsGetter, parsedValueExpressions []*ottl.ValueExpression[K]) (R, error) {
+		if len(parsedValueExpressions) != 1 {
+			return *new(R), errors.New("the sampling key must be a single value expression")
+		}
+		return newParsed(parsedValueExpressions[0]), nil
+	}
+}
+
+// parseSampledConditions parses the conditions of a group configuring sampling, which are all
+// parsed in the same context, along with the sampling key in that context. The key is returned
+// as the parsed conditions only holding it.
+func parseSampledConditions[R any](pc *ottl.ParserCollection[R], contextConditions ContextConditions, contextName func(R) string) (conditions, key R, err error) {
+	name := string(contextConditions.Context)
+	if name != "" {
+		conditions, err = pc.ParseConditionsWithContext(name, contextConditions, true)
+	} else {
+		conditions, err = pc.ParseConditions(contextConditions)
+		name = contextName(conditions)
+	}
+	if err != nil || contextConditions.Sampling.Key == "" {
+		return conditions, key, err
+	}
+	key, err = pc.ParseValueExpressionsWithContext(name, ottl.NewValueExpressionsGetter([]string{contextConditions.Sampling.Key}), true)
+	if err != nil {
+		return conditions, key, fmt.Errorf("invalid sampling key: %w", err)
+	}
+	return conditions, key, nil
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 
 	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/ptrace"
 	"go.opentelemetry.io/collector/processor/processorhelper"
 	"go.uber.org/multierr"
@@ -17,6 +18,7 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlscope"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlspan"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlspanevent"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottltrace"
 )
 
 type TracesConsumer struct {
@@ -24,6 +26,7 @@ type TracesConsumer struct {
 	scopeExpr     expr.BoolExpr[*ottlscope.TransformContext]
 	spanExpr      expr.BoolExpr[*ottlspan.TransformContext]
 	spanEventExpr expr.BoolExpr[*ottlspanevent.TransformContext]
+	traceExpr     expr.BoolExpr[*ottltrace.TransformContext]
 }
 
 // parsedTraceConditions is the type R for ParserCollection[R] that holds parsed OTTL conditions
@@ -32,12 +35,34 @@ type parsedTraceConditions struct {
 	scopeConditions     []*ottl.Condition[*ottlscope.TransformContext]
 	spanConditions      []*ottl.Condition[*ottlspan.TransformContext]
 	spanEventConditions []*ottl.Condition[*ottlspanevent.TransformContext]
+	traceConditions     []*ottl.Condition[*ottltrace.TransformContext]
 	telemetrySettings   component.TelemetrySettings
 	errorMode           ottl.ErrorMode
+	// samplingKey is the parsed sampling key, a value expression of the context it was parsed in.
+	samplingKey any
+}
+
+func (tc parsedTraceConditions) contextName() string {
+	switch {
+	case len(tc.spanEventConditions) > 0:
+		return ottlspanevent.ContextName
+	case len(tc.spanConditions) > 0:
+		return ottlspan.ContextName
+	case len(tc.traceConditions) > 0:
+		return ottltrace.ContextName
+	case len(tc.scopeConditions) > 0:
+		return ottlscope.ContextName
+	default:
+		return ottlresource.ContextName
+	}
 }
 
 func (tc TracesConsumer) ConsumeTraces(ctx context.Context, td ptrace.Traces) error {
 	var condErr error
+	var droppedTraces map[pcommon.TraceID]struct{}
+	if tc.traceExpr != nil {
+		droppedTraces, condErr = tc.evalTraces(ctx, td)
+	}
 	td.ResourceSpans().RemoveIf(func(rs ptrace.ResourceSpans) bool {
 		if tc.resourceExpr != nil {
 			rCtx := ottlresource.NewTransformContextPtr(rs.Resource(), rs)
@@ -52,7 +77,7 @@ func (tc TracesConsumer) ConsumeTraces(ctx context.Context, td ptrace.Traces) er
 			}
 		}
 
-		if tc.scopeExpr == nil && tc.spanExpr == nil && tc.spanEventExpr == nil {
+		if tc.scopeExpr == nil && tc.spanExpr == nil && tc.spanEventExpr == nil && len(droppedTraces) == 0 {
 			return rs.ScopeSpans().Len() == 0
 		}
 
@@ -70,11 +95,14 @@ func (tc TracesConsumer) ConsumeTraces(ctx context.Context, td ptrace.Traces) er
 				}
 			}
 
-			if tc.spanExpr == nil && tc.spanEventExpr == nil {
+			if tc.spanExpr == nil && tc.spanEventExpr == nil && len(droppedTraces) == 0 {
 				return ss.Spans().Len() == 0
 			}
 
 			ss.Spans().RemoveIf(func(span ptrace.Span) bool {
+				if _, ok := droppedTraces[span.TraceID()]; ok {
+					return true
+				}
 				if tc.spanExpr != nil {
 					spanCtx := ottlspan.NewTransformContextPtr(rs, ss, span)
 					spanCond, err := tc.spanExpr.Eval(ctx, spanCtx)
@@ -112,6 +140,24 @@ func (tc TracesConsumer) ConsumeTraces(ctx context.Context, td ptrace.Traces) er
 	return condErr
 }
 
+// evalTraces returns the IDs of the traces matching the trace conditions. Each trace is made
+// of the spans of td sharing its trace ID.
+func (tc TracesConsumer) evalTraces(ctx context.Context, td ptrace.Traces) (map[pcommon.TraceID]struct{}, error) {
+	var condErr error
+	dropped := map[pcommon.TraceID]struct{}{}
+	for _, spans := range ottltrace.GroupSpansByTrace(td) {
+		tCtx := ottltrace.NewTransformContextPtr(spans)
+		cond, err := tc.traceExpr.Eval(ctx, tCtx)
+		if err != nil {
+			condErr = multierr.Append(condErr, err)
+		} else if cond {
+			dropped[tCtx.GetTraceID()] = struct{}{}
+		}
+		tCtx.Close()
+	}
+	return dropped, condErr
+}
+
 func newTraceConditionsFromResource(rc []*ottl.Condition[*ottlresource.TransformContext], telemetrySettings component.TelemetrySettings, errorMode ottl.ErrorMode) parsedTraceConditions {
 	return parsedTraceConditions{
 		resourceConditions: rc,
@@ -128,11 +174,16 @@ func newTraceConditionsFromScope(sc []*ottl.Condition[*ottlscope.TransformContex
 	}
 }
 
+func newTraceConditionsFromSamplingKey(key any) parsedTraceConditions {
+	return parsedTraceConditions{samplingKey: key}
+}
+
 func newTracesConsumer(tc *parsedTraceConditions) TracesConsumer {
 	var rExpr expr.BoolExpr[*ottlresource.TransformContext]
 	var sExpr expr.BoolExpr[*ottlscope.TransformContext]
 	var spanExpr expr.BoolExpr[*ottlspan.TransformContext]
 	var spanEventExpr expr.BoolExpr[*ottlspanevent.TransformContext]
+	var traceExpr expr.BoolExpr[*ottltrace.TransformContext]
 
 	if len(tc.resourceConditions) > 0 {
 		cs := ottlresource.NewConditionSequence(tc.resourceConditions, tc.telemetrySettings, ottlresource.WithConditionSequenceErrorMode(tc.errorMode))
@@ -154,11 +205,17 @@ func newTracesConsumer(tc *parsedTraceConditions) TracesConsumer {
 		spanEventExpr = &cs
 	}
 
+	if len(tc.traceConditions) > 0 {
+		cs := ottltrace.NewConditionSequence(tc.traceConditions, tc.telemetrySettings, ottltrace.WithConditionSequenceErrorMode(tc.errorMode))
+		traceExpr = &cs
+	}
+
 	return TracesConsumer{
 		resourceExpr:  rExpr,
 		scopeExpr:     sExpr,
 		spanExpr:      spanExpr,
 		spanEventExpr: spanEventExpr,
+		traceExpr:     traceExpr,
 	}
 }
 
@@ -172,7 +229,9 @@ func WithSpanParser(functions map[string]ottl.Factory[*ottlspan.TransformContext
 		if err != nil {
 			return err
 		}
-		return ottl.WithParserCollectionContext(ottlspan.ContextName, &parser, ottl.WithConditionConverter(convertSpanConditions))(pc)
+		return ottl.WithParserCollectionContext(ottlspan.ContextName, &parser,
+			ottl.WithConditionConverter(convertSpanConditions),
+			ottl.WithValueExpressionConverter(samplingKeyConverter[*ottlspan.TransformContext](newTraceConditionsFromSamplingKey)))(pc)
 	}
 }
 
@@ -182,7 +241,21 @@ func WithSpanEventParser(functions map[string]ottl.Factory[*ottlspanevent.Transf
 		if err != nil {
 			return err
 		}
-		return ottl.WithParserCollectionContext(ottlspanevent.ContextName, &parser, ottl.WithConditionConverter(convertSpanEventConditions))(pc)
+		return ottl.WithParserCollectionContext(ottlspanevent.ContextName, &parser,
+			ottl.WithConditionConverter(convertSpanEventConditions),
+			ottl.WithValueExpressionConverter(samplingKeyConverter[*ottlspanevent.TransformContext](newTraceConditionsFromSamplingKey)))(pc)
+	}
+}
+
+func WithTraceParser(functions map[string]ottl.Factory[*ottltrace.TransformContext]) TraceParserCollectionOption {
+	return func(pc *ottl.ParserCollection[parsedTraceConditions]) error {
+		parser, err := ottltrace.NewParser(functions, pc.Settings, ottltrace.EnablePathContextNames())
+		if err != nil {
+			return err
+		}
+		return ottl.WithParserCollectionContext(ottltrace.ContextName, &parser,
+			ottl.WithConditionConverter(convertTraceConditions),
+			ottl.WithValueExpressionConverter(samplingKeyConverter[*ottltrace.TransformContext](newTraceConditionsFromSamplingKey)))(pc)
 	}
 }
 
@@ -191,7 +264,7 @@ func WithTraceErrorMode(errorMode ottl.ErrorMode) TraceParserCollectionOption {
 }
 
 func WithTraceCommonParsers(functions map[string]ottl.Factory[*ottlresource.TransformContext]) TraceParserCollectionOption {
-	return TraceParserCollectionOption(withCommonParsers(functions, newTraceConditionsFromResource, newTraceConditionsFromScope))
+	return TraceParserCollectionOption(withCommonParsers(functions, newTraceConditionsFromResource, newTraceConditionsFromScope, newTraceConditionsFromSamplingKey))
 }
 
 func NewTraceParserCollection(settings component.TelemetrySettings, options ...TraceParserCollectionOption) (*TraceParserCollection, error) {
@@ -238,8 +311,24 @@ func convertSpanEventConditions(pc *ottl.ParserCollection[parsedTraceConditions]
 	}, nil
 }
 
+func convertTraceConditions(pc *ottl.ParserCollection[parsedTraceConditions], conditions ottl.ConditionsGetter, parsedConditions []*ottl.Condition[*ottltrace.TransformContext]) (parsedTraceConditions, error) {
+	contextConditions, err := toContextConditions(conditions)
+	if err != nil {
+		return parsedTraceConditions{}, err
+	}
+	errorMode := getErrorMode(pc, contextConditions)
+	return parsedTraceConditions{
+		traceConditions:   parsedConditions,
+		telemetrySettings: pc.Settings,
+		errorMode:         errorMode,
+	}, nil
+}
+
 func (tpc *TraceParserCollection) ParseContextConditions(contextConditions ContextConditions) (TracesConsumer, error) {
 	pc := ottl.ParserCollection[parsedTraceConditions](*tpc)
+	if contextConditions.Sampling != nil {
+		return parseSampledTraceConditions(&pc, contextConditions)
+	}
 	if contextConditions.Context != "" {
 		tc, err := pc.ParseConditionsWithContext(string(contextConditions.Context), contextConditions, true)
 		if err != nil {
@@ -252,6 +341,7 @@ func (tpc *TraceParserCollection) ParseContextConditions(contextConditions Conte
 	var sConditions []*ottl.Condition[*ottlscope.TransformContext]
 	var spanConditions []*ottl.Condition[*ottlspan.TransformContext]
 	var spanEventConditions []*ottl.Condition[*ottlspanevent.TransformContext]
+	var traceConditions []*ottl.Condition[*ottltrace.TransformContext]
 
 	for _, cc := range contextConditions.GetConditions() {
 		tc, err := pc.ParseConditions(ContextConditions{Conditions: []string{cc}})
@@ -271,6 +361,9 @@ func (tpc *TraceParserCollection) ParseContextConditions(contextConditions Conte
 		if len(tc.spanEventConditions) > 0 {
 			spanEventConditions = append(spanEventConditions, tc.spanEventConditions...)
 		}
+		if len(tc.traceConditions) > 0 {
+			traceConditions = append(traceConditions, tc.traceConditions...)
+		}
 	}
 
 	aggregatedConditions := parsedTraceConditions{
@@ -278,9 +371,28 @@ func (tpc *TraceParserCollection) ParseContextConditions(contextConditions Conte
 		scopeConditions:     sConditions,
 		spanConditions:      spanConditions,
 		spanEventConditions: spanEventConditions,
+		traceConditions:     traceConditions,
 		telemetrySettings:   pc.Settings,
 		errorMode:           getErrorMode[parsedTraceConditions](&pc, &contextConditions),
 	}
 
 	return newTracesConsumer(&aggregatedConditions), nil
 }
+
+func parseSampledTraceConditions(pc *ottl.ParserCollection[parsedTraceConditions], contextConditions ContextConditions) (TracesConsumer, error) {
+	tc, key, err := parseSampledConditions(pc, contextConditions, parsedTraceConditions.contextName)
+	if err != nil {
+		return TracesConsumer{}, err
+	}
+	s, err := newSampler(contextConditions.Sampling)
+	if err != nil {
+		return TracesConsumer{}, err
+	}
+	consumer := newTracesConsumer(&tc)
+	consumer.resourceExpr = newSampledExpr(consumer.resourceExpr, s, key.samplingKey, tc.errorMode, tc.telemetrySettings)
+	consumer.scopeExpr = newSampledExpr(consumer.scopeExpr, s, key.samplingKey, tc.errorMode, tc.telemetrySettings)
+	consumer.spanExpr = newSampledExpr(consumer.spanExpr, s, key.samplingKey, tc.errorMode, tc.telemetrySettings)
+	consumer.spanEventExpr = newSampledExpr(consumer.spanEventExpr, s, key.samplingKey, tc.errorMode, tc.telemetrySettings)
+	consumer.traceExpr = newSampledExpr(consumer.traceExpr, s, key.samplingKey, tc.errorMode, tc.telemetrySettings)
+	return consumer, nil
+}
@@ -1268,6 +1268,94 @@ func Test_ProcessLogs_ConditionsErrorMode(t *testing.T) {
 	}
 }
 
+func Test_ProcessLogs_Sampling(t *testing.T) {
+	tests := []struct {
+		name       string
+		conditions []condition.ContextConditions
+		want       func(ld plog.Logs)
+	}{
+		{
+			name: "keep one in",
+			conditions: []condition.ContextConditions{
+				{
+					Conditions: []string{`log.attributes["http.method"] == "get"`},
+					Sampling:   &condition.Sampling{KeepOneIn: 2},
+				},
+			},
+			want: func(ld plog.Logs) {
+				for _, sl := range ld.ResourceLogs().At(0).ScopeLogs().All() {
+					sl.LogRecords().RemoveIf(func(log plog.LogRecord) bool {
+						return log.Body().AsString() == "operationB"
+					})
+				}
+			},
+		},
+		{
+			name: "keep one in per key",
+			conditions: []condition.ContextConditions{
+				{
+					Conditions: []string{`log.attributes["http.method"] == "get"`},
+					Sampling:   &condition.Sampling{KeepOneIn: 2, Key: "log.body"},
+				},
+			},
+			want: func(ld plog.Logs) {
+				ld.ResourceLogs().At(0).ScopeLogs().RemoveIf(func(sl plog.ScopeLogs) bool {
+					return sl.Scope().Name() == "scope1"
+				})
+			},
+		},
+		{
+			name: "keep per second",
+			conditions: []condition.ContextConditions{
+				{
+					Conditions: []string{`attributes["http.method"] == "get"`},
+					Context:    condition.ContextID("log"),
+					Sampling:   &condition.Sampling{KeepPerSecond: 1},
+				},
+			},
+			want: func(ld plog.Logs) {
+				ld.ResourceLogs().At(0).ScopeLogs().RemoveIf(func(sl plog.ScopeLogs) bool {
+					return sl.Scope().Name() == "scope1"
+				})
+				ld.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().RemoveIf(func(log plog.LogRecord) bool {
+					return log.Body().AsString() == "operationB"
+				})
+			},
+		},
+		{
+			name: "not matching items are kept",
+			conditions: []condition.ContextConditions{
+				{
+					Conditions: []string{`log.body == "operationA"`},
+					Sampling:   &condition.Sampling{KeepOneIn: 2},
+				},
+			},
+			want: func(ld plog.Logs) {
+				ld.ResourceLogs().At(0).ScopeLogs().At(1).LogRecords().RemoveIf(func(log plog.LogRecord) bool {
+					return log.Body().AsString() == "operationA"
+				})
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, _ := NewFactory().CreateDefaultConfig().(*Config)
+			cfg.LogConditions = tt.conditions
+
+			processor, err := newFilterLogsProcessor(processortest.NewNopSettings(metadata.Type), cfg)
+			require.NoError(t, err)
+
+			got, err := processor.processLogs(t.Context(), constructLogs())
+			require.NoError(t, err)
+
+			exTd := constructLogs()
+			tt.want(exTd)
+			assert.Equal(t, exTd, got)
+		})
+	}
+}
+
 func Test_Logs_NonDefaultFunctions(t *testing.T) {
 	type testCase struct {
 		name          string
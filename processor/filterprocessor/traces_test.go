@@ -431,6 +431,41 @@ func Test_ProcessTraces_DefinedContext(t *testing.T) {
 				}
 			},
 		},
+		{
+			name: "trace: drop by error count",
+			contextConditions: []condition.ContextConditions{
+				{Conditions: []string{`error_count > 0`}, Context: "trace"},
+			},
+			want: func(td ptrace.Traces) {
+				rs := td.ResourceSpans().At(0)
+				for i := 0; i < rs.ScopeSpans().Len(); i++ {
+					rs.ScopeSpans().At(i).Spans().RemoveIf(func(span ptrace.Span) bool {
+						return span.TraceID() == traceID
+					})
+				}
+			},
+		},
+		{
+			name: "trace: drop by root span",
+			contextConditions: []condition.ContextConditions{
+				{Conditions: []string{`has_root_span == true and root_span.name == "operationB"`}, Context: "trace"},
+			},
+			want: func(td ptrace.Traces) {
+				rs := td.ResourceSpans().At(0)
+				for i := 0; i < rs.ScopeSpans().Len(); i++ {
+					rs.ScopeSpans().At(i).Spans().RemoveIf(func(span ptrace.Span) bool {
+						return span.TraceID().IsEmpty()
+					})
+				}
+			},
+		},
+		{
+			name: "trace: drop everything",
+			contextConditions: []condition.ContextConditions{
+				{Conditions: []string{`span_count == 2`}, Context: "trace"},
+			},
+			filterEverything: true,
+		},
 		{
 			name: "inferring mixed contexts",
 			contextConditions: []condition.ContextConditions{
@@ -606,6 +641,25 @@ func Test_ProcessTraces_InferredContext(t *testing.T) {
 			},
 			input: constructTraces,
 		},
+		{
+			name: "trace: drop by span count and scope name",
+			contextConditions: []condition.ContextConditions{
+				{Conditions: []string{
+					`trace.span_count_by_attribute["http.method"] == 2 and trace.error_count == 0`,
+					`scope.name == "scope1"`,
+				}},
+			},
+			want: func(td ptrace.Traces) {
+				rs := td.ResourceSpans().At(0)
+				rs.ScopeSpans().RemoveIf(func(ss ptrace.ScopeSpans) bool {
+					return ss.Scope().Name() == "scope1"
+				})
+				rs.ScopeSpans().At(0).Spans().RemoveIf(func(span ptrace.Span) bool {
+					return span.Name() == "operationB"
+				})
+			},
+			input: constructTraces,
+		},
 		{
 			name: "zero-record lower-context: resource and spanevent with no events",
 			contextConditions: []condition.ContextConditions{
@@ -0,0 +1,304 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package intervalprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/intervalprocessor"
+
+import (
+	"slices"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/exp/metrics/identity"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/intervalprocessor/internal/metrics"
+)
+
+// aggregateGaugeDataPoints aggregates the gauge data points of each stream with the configured gauge function.
+func (p *intervalProcessor) aggregateGaugeDataPoints(dataPoints, mCloneDataPoints pmetric.NumberDataPointSlice, metricID identity.Metric) {
+	for i := 0; i < dataPoints.Len(); i++ {
+		dp := dataPoints.At(i)
+
+		streamID := identity.OfStream(metricID, dp)
+		existingDP, ok := p.numberLookup[streamID]
+		if !ok {
+			dpClone := mCloneDataPoints.AppendEmpty()
+			dp.CopyTo(dpClone)
+			if p.config.Aggregation.Gauge == GaugeAggregationAvg {
+				dpClone.SetDoubleValue(numberValue(dp))
+			}
+			p.numberLookup[streamID] = dpClone
+			p.gaugeCounts[streamID] = 1
+			continue
+		}
+
+		count := p.gaugeCounts[streamID]
+		mergeGaugeDataPoints(existingDP, dp, count, 1, p.config.Aggregation.Gauge)
+		p.gaugeCounts[streamID] = count + 1
+	}
+}
+
+// reaggregate removes the attributes listed in `drop_attributes` from the aggregated data points,
+// then merges the data points of each metric that end up in the same stream.
+func (p *intervalProcessor) reaggregate(md pmetric.Metrics) {
+	dropAttributes := p.config.Aggregation.DropAttributes
+
+	for i := 0; i < md.ResourceMetrics().Len(); i++ {
+		rm := md.ResourceMetrics().At(i)
+		resID := identity.OfResource(rm.Resource())
+		for j := 0; j < rm.ScopeMetrics().Len(); j++ {
+			sm := rm.ScopeMetrics().At(j)
+			scopeID := identity.OfScope(resID, sm.Scope())
+			for k := 0; k < sm.Metrics().Len(); k++ {
+				m := sm.Metrics().At(k)
+				metricID := identity.OfMetric(scopeID, m)
+
+				switch m.Type() {
+				case pmetric.MetricTypeGauge:
+					reaggregateDataPoints(m.Gauge().DataPoints(), metricID, dropAttributes, func(dst, src pmetric.NumberDataPoint, dstID, srcID identity.Stream) {
+						dstCount, srcCount := p.count(dstID), p.count(srcID)
+						mergeGaugeDataPoints(dst, src, dstCount, srcCount, p.config.Aggregation.Gauge)
+						p.gaugeCounts[dstID] = dstCount + srcCount
+					})
+				case pmetric.MetricTypeSum:
+					reaggregateDataPoints(m.Sum().DataPoints(), metricID, dropAttributes, func(dst, src pmetric.NumberDataPoint, _, _ identity.Stream) {
+						mergeSumDataPoints(dst, src)
+					})
+				case pmetric.MetricTypeHistogram:
+					reaggregateDataPoints(m.Histogram().DataPoints(), metricID, dropAttributes, func(dst, src pmetric.HistogramDataPoint, _, _ identity.Stream) {
+						if !mergeHistogramDataPoints(dst, src) {
+							keepNewer(dst, src)
+						}
+					})
+				case pmetric.MetricTypeExponentialHistogram:
+					reaggregateDataPoints(m.ExponentialHistogram().DataPoints(), metricID, dropAttributes, func(dst, src pmetric.ExponentialHistogramDataPoint, _, _ identity.Stream) {
+						if !mergeExponentialHistogramDataPoints(dst, src) {
+							keepNewer(dst, src)
+						}
+					})
+				case pmetric.MetricTypeSummary:
+					// Quantiles can't be merged, so the newest summary wins.
+					reaggregateDataPoints(m.Summary().DataPoints(), metricID, dropAttributes, func(dst, src pmetric.SummaryDataPoint, _, _ identity.Stream) {
+						keepNewer(dst, src)
+					})
+				case pmetric.MetricTypeEmpty:
+				}
+			}
+		}
+	}
+}
+
+// count returns the number of gauge points aggregated into the stream, which weighs the stream in averages.
+func (p *intervalProcessor) count(streamID identity.Stream) int {
+	if count, ok := p.gaugeCounts[streamID]; ok {
+		return count
+	}
+	return 1
+}
+
+// reaggregateDataPoints drops the given attributes from the data points and merges the data
+// points that end up with the same attributes. The stream IDs passed to merge identify the
+// streams the data points belonged to before dropping the attributes.
+func reaggregateDataPoints[DPS metrics.DataPointSlice[DP], DP metrics.DataPoint[DP]](dataPoints DPS, metricID identity.Metric, dropAttributes []string, merge func(dst, src DP, dstID, srcID identity.Stream)) {
+	type mergedDP struct {
+		dp       DP
+		streamID identity.Stream
+	}
+	merged := map[identity.Stream]mergedDP{}
+
+	dataPoints.RemoveIf(func(dp DP) bool {
+		srcID := identity.OfStream(metricID, dp)
+		dp.Attributes().RemoveIf(func(k string, _ pcommon.Value) bool {
+			return slices.Contains(dropAttributes, k)
+		})
+
+		streamID := identity.OfStream(metricID, dp)
+		existing, ok := merged[streamID]
+		if !ok {
+			merged[streamID] = mergedDP{dp: dp, streamID: srcID}
+			return false
+		}
+
+		merge(existing.dp, dp, existing.streamID, srcID)
+		return true
+	})
+}
+
+func mergeGaugeDataPoints(dst, src pmetric.NumberDataPoint, dstCount, srcCount int, fn GaugeAggregation) {
+	switch fn {
+	case GaugeAggregationMin:
+		if numberValue(src) < numberValue(dst) {
+			copyNumberValue(dst, src)
+		}
+	case GaugeAggregationMax:
+		if numberValue(src) > numberValue(dst) {
+			copyNumberValue(dst, src)
+		}
+	case GaugeAggregationAvg:
+		sum := numberValue(dst)*float64(dstCount) + numberValue(src)*float64(srcCount)
+		dst.SetDoubleValue(sum / float64(dstCount+srcCount))
+	default:
+		if src.Timestamp() > dst.Timestamp() {
+			copyNumberValue(dst, src)
+		}
+	}
+	dst.SetTimestamp(max(dst.Timestamp(), src.Timestamp()))
+}
+
+// mergeSumDataPoints adds the value of src to dst. Since each data point is the latest
+// cumulative value of a different stream, the result is the cumulative value of the merged stream.
+func mergeSumDataPoints(dst, src pmetric.NumberDataPoint) {
+	if dst.ValueType() == pmetric.NumberDataPointValueTypeInt && src.ValueType() == pmetric.NumberDataPointValueTypeInt {
+		dst.SetIntValue(dst.IntValue() + src.IntValue())
+	} else {
+		dst.SetDoubleValue(numberValue(dst) + numberValue(src))
+	}
+	mergeTimestamps(dst, src)
+	src.Exemplars().MoveAndAppendTo(dst.Exemplars())
+}
+
+// mergeHistogramDataPoints merges src into dst. Histograms with different bucket boundaries
+// can't be merged, in which case false is returned and dst is left unchanged.
+func mergeHistogramDataPoints(dst, src pmetric.HistogramDataPoint) bool {
+	if !slices.Equal(dst.ExplicitBounds().AsRaw(), src.ExplicitBounds().AsRaw()) ||
+		dst.BucketCounts().Len() != src.BucketCounts().Len() {
+		return false
+	}
+
+	for i := 0; i < dst.BucketCounts().Len(); i++ {
+		dst.BucketCounts().SetAt(i, dst.BucketCounts().At(i)+src.BucketCounts().At(i))
+	}
+	dst.SetCount(dst.Count() + src.Count())
+	if dst.HasSum() && src.HasSum() {
+		dst.SetSum(dst.Sum() + src.Sum())
+	} else {
+		dst.RemoveSum()
+	}
+	if dst.HasMin() && src.HasMin() {
+		dst.SetMin(min(dst.Min(), src.Min()))
+	} else {
+		dst.RemoveMin()
+	}
+	if dst.HasMax() && src.HasMax() {
+		dst.SetMax(max(dst.Max(), src.Max()))
+	} else {
+		dst.RemoveMax()
+	}
+	mergeTimestamps(dst, src)
+	src.Exemplars().MoveAndAppendTo(dst.Exemplars())
+	return true
+}
+
+// mergeExponentialHistogramDataPoints merges src into dst at the smaller of both scales.
+// Histograms with different zero thresholds can't be merged, in which case false is returned
+// and dst is left unchanged.
+func mergeExponentialHistogramDataPoints(dst, src pmetric.ExponentialHistogramDataPoint) bool {
+	if dst.ZeroThreshold() != src.ZeroThreshold() {
+		return false
+	}
+
+	scale := min(dst.Scale(), src.Scale())
+	mergeExponentialBuckets(dst.Positive(), src.Positive(), dst.Scale()-scale, src.Scale()-scale)
+	mergeExponentialBuckets(dst.Negative(), src.Negative(), dst.Scale()-scale, src.Scale()-scale)
+	dst.SetScale(scale)
+
+	dst.SetCount(dst.Count() + src.Count())
+	dst.SetZeroCount(dst.ZeroCount() + src.ZeroCount())
+	if dst.HasSum() && src.HasSum() {
+		dst.SetSum(dst.Sum() + src.Sum())
+	} else {
+		dst.RemoveSum()
+	}
+	if dst.HasMin() && src.HasMin() {
+		dst.SetMin(min(dst.Min(), src.Min()))
+	} else {
+		dst.RemoveMin()
+	}
+	if dst.HasMax() && src.HasMax() {
+		dst.SetMax(max(dst.Max(), src.Max()))
+	} else {
+		dst.RemoveMax()
+	}
+	mergeTimestamps(dst, src)
+	src.Exemplars().MoveAndAppendTo(dst.Exemplars())
+	return true
+}
+
+// mergeExponentialBuckets adds the buckets of src to dst, after reducing the scale of dst
+// by dstDownscale and the scale of src by srcDownscale.
+func mergeExponentialBuckets(dst, src pmetric.ExponentialHistogramDataPointBuckets, dstDownscale, srcDownscale int32) {
+	dstOffset, dstCounts := downscaleBuckets(dst, dstDownscale)
+	srcOffset, srcCounts := downscaleBuckets(src, srcDownscale)
+
+	switch {
+	case len(srcCounts) == 0:
+	case len(dstCounts) == 0:
+		dstOffset, dstCounts = srcOffset, srcCounts
+	default:
+		offset := min(dstOffset, srcOffset)
+		end := max(dstOffset+int32(len(dstCounts)), srcOffset+int32(len(srcCounts)))
+		counts := make([]uint64, end-offset)
+		for i, c := range dstCounts {
+			counts[dstOffset-offset+int32(i)] += c
+		}
+		for i, c := range srcCounts {
+			counts[srcOffset-offset+int32(i)] += c
+		}
+		dstOffset, dstCounts = offset, counts
+	}
+
+	dst.SetOffset(dstOffset)
+	dst.BucketCounts().FromRaw(dstCounts)
+}
+
+// downscaleBuckets returns the offset and counts of the buckets at a scale reduced by the
+// given number of steps, where each step merges pairs of adjacent buckets.
+func downscaleBuckets(buckets pmetric.ExponentialHistogramDataPointBuckets, by int32) (int32, []uint64) {
+	counts := buckets.BucketCounts().AsRaw()
+	if by == 0 || len(counts) == 0 {
+		return buckets.Offset(), counts
+	}
+
+	offset := buckets.Offset() >> by
+	last := (buckets.Offset() + int32(len(counts)) - 1) >> by
+	out := make([]uint64, last-offset+1)
+	for i, c := range counts {
+		out[((buckets.Offset()+int32(i))>>by)-offset] += c
+	}
+	return offset, out
+}
+
+type timestampedDataPoint interface {
+	StartTimestamp() pcommon.Timestamp
+	SetStartTimestamp(pcommon.Timestamp)
+	Timestamp() pcommon.Timestamp
+	SetTimestamp(pcommon.Timestamp)
+}
+
+// mergeTimestamps sets the start timestamp of dst to the earliest and its timestamp to the latest of both points.
+func mergeTimestamps[DP timestampedDataPoint](dst, src DP) {
+	if src.StartTimestamp() != 0 && (dst.StartTimestamp() == 0 || src.StartTimestamp() < dst.StartTimestamp()) {
+		dst.SetStartTimestamp(src.StartTimestamp())
+	}
+	dst.SetTimestamp(max(dst.Timestamp(), src.Timestamp()))
+}
+
+func keepNewer[DP metrics.DataPoint[DP]](dst, src DP) {
+	if src.Timestamp() > dst.Timestamp() {
+		src.CopyTo(dst)
+	}
+}
+
+func numberValue(dp pmetric.NumberDataPoint) float64 {
+	if dp.ValueType() == pmetric.NumberDataPointValueTypeInt {
+		return float64(dp.IntValue())
+	}
+	return dp.DoubleValue()
+}
+
+func copyNumberValue(dst, src pmetric.NumberDataPoint) {
+	if src.ValueType() == pmetric.NumberDataPointValueTypeInt {
+		dst.SetIntValue(src.IntValue())
+	} else {
+		dst.SetDoubleValue(src.DoubleValue())
+	}
+}
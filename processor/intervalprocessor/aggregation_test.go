@@ -0,0 +1,59 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package intervalprocessor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+)
+
+func TestDownscaleBuckets(t *testing.T) {
+	buckets := pmetric.NewExponentialHistogramDataPointBuckets()
+	buckets.SetOffset(-3)
+	buckets.BucketCounts().FromRaw([]uint64{1, 2, 3, 4, 5})
+
+	offset, counts := downscaleBuckets(buckets, 0)
+	assert.Equal(t, int32(-3), offset)
+	assert.Equal(t, []uint64{1, 2, 3, 4, 5}, counts)
+
+	// Indexes -3..1 map to -2, -1, -1, 0, 0 one scale down.
+	offset, counts = downscaleBuckets(buckets, 1)
+	assert.Equal(t, int32(-2), offset)
+	assert.Equal(t, []uint64{1, 5, 9}, counts)
+
+	offset, counts = downscaleBuckets(buckets, 2)
+	assert.Equal(t, int32(-1), offset)
+	assert.Equal(t, []uint64{6, 9}, counts)
+}
+
+func TestMergeExponentialBuckets(t *testing.T) {
+	dst := pmetric.NewExponentialHistogramDataPointBuckets()
+	dst.SetOffset(2)
+	dst.BucketCounts().FromRaw([]uint64{1, 1})
+	src := pmetric.NewExponentialHistogramDataPointBuckets()
+	src.SetOffset(-1)
+	src.BucketCounts().FromRaw([]uint64{3})
+
+	mergeExponentialBuckets(dst, src, 0, 0)
+	assert.Equal(t, int32(-1), dst.Offset())
+	assert.Equal(t, []uint64{3, 0, 0, 1, 1}, dst.BucketCounts().AsRaw())
+}
+
+func TestMergeHistogramDataPointsWithDifferentBounds(t *testing.T) {
+	dst := pmetric.NewHistogramDataPoint()
+	dst.ExplicitBounds().FromRaw([]float64{1, 10})
+	dst.BucketCounts().FromRaw([]uint64{1, 2, 3})
+	dst.SetCount(6)
+	src := pmetric.NewHistogramDataPoint()
+	src.ExplicitBounds().FromRaw([]float64{5})
+	src.BucketCounts().FromRaw([]uint64{4, 4})
+	src.SetCount(8)
+
+	require.False(t, mergeHistogramDataPoints(dst, src))
+	assert.Equal(t, []uint64{1, 2, 3}, dst.BucketCounts().AsRaw())
+	assert.Equal(t, uint64(6), dst.Count())
+}
@@ -5,6 +5,7 @@ package intervalprocessor // import "github.com/open-telemetry/opentelemetry-col
 
 import (
 	"errors"
+	"fmt"
 	"time"
 
 	"go.opentelemetry.io/collector/component"
@@ -21,6 +22,8 @@ type Config struct {
 	// PassThrough is a configuration that determines whether gauge and summary metrics should be passed through
 	// as they are or aggregated.
 	PassThrough PassThrough `mapstructure:"pass_through"`
+	// Aggregation configures how the data points received within an interval are aggregated.
+	Aggregation Aggregation `mapstructure:"aggregation"`
 }
 
 type PassThrough struct {
@@ -32,6 +35,24 @@ type PassThrough struct {
 	Summary bool `mapstructure:"summary"`
 }
 
+type Aggregation struct {
+	// Gauge is the function used to aggregate the points of a gauge stream within an interval.
+	// One of "last", "min", "max" or "avg".
+	Gauge GaugeAggregation `mapstructure:"gauge"`
+	// DropAttributes is a list of data point attributes that are removed from the aggregated
+	// metrics on export. Streams that only differ by these attributes are merged into a single stream.
+	DropAttributes []string `mapstructure:"drop_attributes"`
+}
+
+type GaugeAggregation string
+
+const (
+	GaugeAggregationLast GaugeAggregation = "last"
+	GaugeAggregationMin  GaugeAggregation = "min"
+	GaugeAggregationMax  GaugeAggregation = "max"
+	GaugeAggregationAvg  GaugeAggregation = "avg"
+)
+
 // Validate checks whether the input configuration has all of the required fields for the processor.
 // An error is returned if there are any invalid inputs.
 func (config *Config) Validate() error {
@@ -39,5 +60,12 @@ func (config *Config) Validate() error {
 		return ErrInvalidIntervalValue
 	}
 
+	switch config.Aggregation.Gauge {
+	case GaugeAggregationLast, GaugeAggregationMin, GaugeAggregationMax, GaugeAggregationAvg:
+	default:
+		return fmt.Errorf("invalid aggregation::gauge %q, must be one of %q, %q, %q or %q", config.Aggregation.Gauge,
+			GaugeAggregationLast, GaugeAggregationMin, GaugeAggregationMax, GaugeAggregationAvg)
+	}
+
 	return nil
 }
@@ -30,6 +30,9 @@ func createDefaultConfig() component.Config {
 			Gauge:   false,
 			Summary: false,
 		},
+		Aggregation: Aggregation{
+			Gauge: GaugeAggregationLast,
+		},
 	}
 }
 
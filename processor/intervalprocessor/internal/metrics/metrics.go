@@ -11,6 +11,7 @@ type DataPointSlice[DP DataPoint[DP]] interface {
 	Len() int
 	At(i int) DP
 	AppendEmpty() DP
+	RemoveIf(f func(DP) bool)
 }
 
 type DataPoint[Self any] interface {
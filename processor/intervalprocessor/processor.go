@@ -37,6 +37,8 @@ type intervalProcessor struct {
 	histogramLookup    map[identity.Stream]pmetric.HistogramDataPoint
 	expHistogramLookup map[identity.Stream]pmetric.ExponentialHistogramDataPoint
 	summaryLookup      map[identity.Stream]pmetric.SummaryDataPoint
+	// gaugeCounts is the number of points aggregated into each gauge stream, used to compute averages.
+	gaugeCounts map[identity.Stream]int
 
 	config *Config
 
@@ -61,6 +63,7 @@ func newProcessor(config *Config, log *zap.Logger, nextConsumer consumer.Metrics
 		histogramLookup:    map[identity.Stream]pmetric.HistogramDataPoint{},
 		expHistogramLookup: map[identity.Stream]pmetric.ExponentialHistogramDataPoint{},
 		summaryLookup:      map[identity.Stream]pmetric.SummaryDataPoint{},
+		gaugeCounts:        map[identity.Stream]int{},
 
 		config: config,
 
@@ -118,7 +121,12 @@ func (p *intervalProcessor) ConsumeMetrics(ctx context.Context, md pmetric.Metri
 					}
 
 					mClone, metricID := p.getOrCloneMetric(rm, sm, m)
-					aggregateDataPoints(m.Gauge().DataPoints(), mClone.Gauge().DataPoints(), metricID, p.numberLookup)
+					switch p.config.Aggregation.Gauge {
+					case GaugeAggregationMin, GaugeAggregationMax, GaugeAggregationAvg:
+						p.aggregateGaugeDataPoints(m.Gauge().DataPoints(), mClone.Gauge().DataPoints(), metricID)
+					default:
+						aggregateDataPoints(m.Gauge().DataPoints(), mClone.Gauge().DataPoints(), metricID, p.numberLookup)
+					}
 					return true
 				case pmetric.MetricTypeSum:
 					// Check if we care about this value
@@ -211,6 +219,10 @@ func (p *intervalProcessor) exportMetrics() {
 		out := p.md
 		p.md = pmetric.NewMetrics()
 
+		if len(p.config.Aggregation.DropAttributes) > 0 {
+			p.reaggregate(out)
+		}
+
 		// Clear all the lookup references
 		clear(p.rmLookup)
 		clear(p.smLookup)
@@ -219,6 +231,7 @@ func (p *intervalProcessor) exportMetrics() {
 		clear(p.histogramLookup)
 		clear(p.expHistogramLookup)
 		clear(p.summaryLookup)
+		clear(p.gaugeCounts)
 
 		return out
 	}()
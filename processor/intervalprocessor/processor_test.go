@@ -25,6 +25,7 @@ func TestAggregation(t *testing.T) {
 	testCases := []struct {
 		name        string
 		passThrough bool
+		aggregation Aggregation
 	}{
 		{name: "basic_aggregation"},
 		{name: "histograms_are_aggregated"},
@@ -35,6 +36,9 @@ func TestAggregation(t *testing.T) {
 		{name: "non_monotonic_sums_are_passed_through"}, // Non-monotonic sums are passed through even when aggregation is enabled
 		{name: "gauges_are_passed_through", passThrough: true},
 		{name: "summaries_are_passed_through", passThrough: true},
+		{name: "gauges_are_aggregated_with_min", aggregation: Aggregation{Gauge: GaugeAggregationMin}},
+		{name: "gauges_are_aggregated_with_avg", aggregation: Aggregation{Gauge: GaugeAggregationAvg}},
+		{name: "attributes_are_dropped", aggregation: Aggregation{Gauge: GaugeAggregationLast, DropAttributes: []string{"host"}}},
 	}
 
 	ctx, cancel := context.WithCancel(t.Context())
@@ -42,7 +46,7 @@ func TestAggregation(t *testing.T) {
 
 	var config *Config
 	for _, tc := range testCases {
-		config = &Config{Interval: time.Second, PassThrough: PassThrough{Gauge: tc.passThrough, Summary: tc.passThrough}}
+		config = &Config{Interval: time.Second, PassThrough: PassThrough{Gauge: tc.passThrough, Summary: tc.passThrough}, Aggregation: tc.aggregation}
 
 		t.Run(tc.name, func(t *testing.T) {
 			// next stores the results of the filter metric processor
@@ -80,6 +84,7 @@ func TestAggregation(t *testing.T) {
 			require.Empty(t, processor.histogramLookup)
 			require.Empty(t, processor.expHistogramLookup)
 			require.Empty(t, processor.summaryLookup)
+			require.Empty(t, processor.gaugeCounts)
 
 			// Exporting again should return nothing
 			processor.exportMetrics()
@@ -34,6 +34,7 @@ type fakeClient struct {
 	DaemonSets         map[string]*kube.DaemonSet
 	ReplicaSets        map[string]*kube.ReplicaSet
 	Jobs               map[string]*kube.Job
+	Owners             map[string]*kube.Owner
 	StopCh             chan struct{}
 	stopOnce           sync.Once
 	stopWg             sync.WaitGroup
@@ -104,6 +105,11 @@ func (f *fakeClient) GetJob(jobUID string) (*kube.Job, bool) {
 	return j, ok
 }
 
+func (f *fakeClient) GetOwner(ownerUID string) (*kube.Owner, bool) {
+	o, ok := f.Owners[ownerUID]
+	return o, ok
+}
+
 // Start is a noop for FakeClient.
 func (f *fakeClient) Start() error {
 	startInformer := func(informer cache.SharedInformer) {
@@ -65,9 +65,13 @@ func (cfg *Config) Validate() error {
 		}
 
 		switch f.From {
-		case "", kube.MetadataFromPod, kube.MetadataFromNamespace, kube.MetadataFromNode, kube.MetadataFromDeployment, kube.MetadataFromStatefulSet, kube.MetadataFromDaemonSet, kube.MetadataFromJob:
+		case "", kube.MetadataFromPod, kube.MetadataFromNamespace, kube.MetadataFromNode, kube.MetadataFromDeployment, kube.MetadataFromStatefulSet, kube.MetadataFromDaemonSet, kube.MetadataFromJob, kube.MetadataFromOwner:
 		default:
-			return fmt.Errorf("%s is not a valid choice for From. Must be one of: pod, namespace, deployment, statefulset, daemonset, job, node", f.From)
+			return fmt.Errorf("%s is not a valid choice for From. Must be one of: pod, namespace, deployment, statefulset, daemonset, job, node, owner", f.From)
+		}
+
+		if f.OwnerKind != "" && f.From != kube.MetadataFromOwner {
+			return fmt.Errorf("owner_kind %s requires From to be owner", f.OwnerKind)
 		}
 
 		if f.KeyRegex != "" {
@@ -99,6 +103,12 @@ func (cfg *Config) Validate() error {
 		}
 	}
 
+	for _, r := range cfg.Extract.OwnerResources {
+		if r.Version == "" || r.Resource == "" || r.Kind == "" {
+			return fmt.Errorf("owner resource %q requires a version, a resource and a kind", r.Group+"/"+r.Version+"/"+r.Resource)
+		}
+	}
+
 	for _, f := range cfg.Filter.Labels {
 		switch f.Op {
 		case "", filterOPEquals, filterOPNotEquals, filterOPExists, filterOPDoesNotExist:
@@ -168,6 +178,27 @@ type ExtractConfig struct {
 	// DeploymentNameFromReplicaSet allows extracting deployment name from replicaset name by trimming pod template hash.
 	// This will disable watching for replicaset resources.
 	DeploymentNameFromReplicaSet bool `mapstructure:"deployment_name_from_replicaset"`
+
+	// OwnerResources lists the custom resources owning pods, such as Argo Rollouts or Knative Services,
+	// that are walked through along with the built-in workloads when extracting labels and annotations
+	// with "from: owner". Only their metadata is watched.
+	OwnerResources []OwnerResourceConfig `mapstructure:"owner_resources"`
+}
+
+// OwnerResourceConfig identifies a resource in the owner chain of pods by its group, version, resource and kind.
+type OwnerResourceConfig struct {
+	// Group is the API group of the resource, e.g. argoproj.io. Empty for the core group.
+	Group string `mapstructure:"group"`
+	// Version is the API version of the resource, e.g. v1alpha1.
+	Version string `mapstructure:"version"`
+	// Resource is the plural name of the resource, e.g. rollouts.
+	Resource string `mapstructure:"resource"`
+	// Kind is the kind of the resource, e.g. Rollout. It is required as only the metadata
+	// of the resource is watched, which does not include its kind.
+	Kind string `mapstructure:"kind"`
+
+	// prevent unkeyed literal initialization
+	_ struct{}
 }
 
 // FieldExtractConfig allows specifying an extraction rule to extract a resource attribute from pod (or namespace)
@@ -213,8 +244,17 @@ type FieldExtractConfig struct {
 	KeyRegex string `mapstructure:"key_regex"`
 
 	// From represents the source of the labels/annotations.
-	// Allowed values are "pod", "namespace", and "node". The default is pod.
+	// Allowed values are "pod", "namespace", "node", "deployment", "statefulset", "daemonset", "job"
+	// and "owner". The default is pod.
+	// "owner" extracts from the owners of the pod, walking its owner chain through the built-in
+	// workloads and the custom resources listed in owner_resources. The closest owner takes precedence.
+	// When tag_name is not specified, the attribute name is of the format k8s.<kind>.label.<key>
+	// (or k8s.<kind>.annotation.<key>), e.g. k8s.rollout.label.team.
 	From string `mapstructure:"from"`
+
+	// OwnerKind restricts the extraction from owners to the owners of the given kind, e.g. Rollout.
+	// It requires From to be "owner".
+	OwnerKind string `mapstructure:"owner_kind"`
 }
 
 // FilterConfig section allows specifying filters to filter
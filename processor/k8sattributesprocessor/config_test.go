@@ -347,6 +347,29 @@ func TestLoadConfig(t *testing.T) {
 		{
 			id: component.NewIDWithName(metadata.Type, "bad_metadata_field"),
 		},
+		{
+			id: component.NewIDWithName(metadata.Type, "extract_from_owner"),
+			expected: &Config{
+				APIConfig: k8sconfig.APIConfig{AuthType: k8sconfig.AuthTypeServiceAccount},
+				Extract: ExtractConfig{
+					Metadata: enabledAttributes(),
+					Labels: []FieldExtractConfig{
+						{Key: "team", From: "owner", OwnerKind: "Rollout"},
+					},
+					OwnerResources: []OwnerResourceConfig{
+						{Group: "argoproj.io", Version: "v1alpha1", Resource: "rollouts", Kind: "Rollout"},
+					},
+				},
+				Exclude:                defaultExcludes,
+				WaitForMetadataTimeout: 10 * time.Second,
+			},
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "bad_owner_kind"),
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "bad_owner_resource"),
+		},
 	}
 
 	for _, tt := range tests {
@@ -203,6 +203,7 @@ func createProcessorOpts(cfg component.Config) []option {
 		withExtractAnnotations(oCfg.Extract.Annotations...),
 		withOtelAnnotations(oCfg.Extract.OtelAnnotations),
 		withDeploymentNameFromReplicaSet(oCfg.Extract.DeploymentNameFromReplicaSet),
+		withOwnerResources(oCfg.Extract.OwnerResources...),
 		// filters
 		withFilterNode(oCfg.Filter.Node, oCfg.Filter.NodeFromEnvVar),
 		withFilterNamespace(oCfg.Filter.Namespace),
@@ -5,6 +5,7 @@ package kube // import "github.com/open-telemetry/opentelemetry-collector-contri
 
 import (
 	"fmt"
+	"strings"
 
 	"go.opentelemetry.io/otel/attribute"
 )
@@ -48,3 +49,19 @@ func K8SNamespaceLabels(key, val string) attribute.KeyValue {
 func K8SNamespaceAnnotations(key, val string) attribute.KeyValue {
 	return attribute.String(fmt.Sprintf("k8s.namespace.annotations.%s", key), val)
 }
+
+// K8SOwnerLabel returns the AttributesFunction for the labels of owners of the given kind,
+// e.g. k8s.rollout.label.<key> for Argo Rollouts.
+func K8SOwnerLabel(kind string) AttributesFunction {
+	return func(key, val string) attribute.KeyValue {
+		return attribute.String(fmt.Sprintf("k8s.%s.label.%s", strings.ToLower(kind), key), val)
+	}
+}
+
+// K8SOwnerAnnotation returns the AttributesFunction for the annotations of owners of the given kind,
+// e.g. k8s.rollout.annotation.<key> for Argo Rollouts.
+func K8SOwnerAnnotation(kind string) AttributesFunction {
+	return func(key, val string) attribute.KeyValue {
+		return attribute.String(fmt.Sprintf("k8s.%s.annotation.%s", strings.ToLower(kind), key), val)
+	}
+}
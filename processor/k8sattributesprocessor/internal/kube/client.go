@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"maps"
 	"regexp"
+	"slices"
 	"strings"
 	"sync"
 	"time"
@@ -24,6 +25,7 @@ import (
 	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/selection"
 	"k8s.io/client-go/kubernetes"
 	clientmeta "k8s.io/client-go/metadata"
@@ -49,6 +51,7 @@ type WatchClient struct {
 	daemonsetInformer      cache.SharedInformer
 	jobInformer            cache.SharedInformer
 	replicasetInformer     cache.SharedInformer
+	ownerInformers         []ownerInformer
 	replicasetRegex        *regexp.Regexp
 	cronJobRegex           *regexp.Regexp
 	deleteQueue            []deleteRequest
@@ -92,6 +95,10 @@ type WatchClient struct {
 	// Key is replicaset uid
 	ReplicaSets map[string]*ReplicaSet
 
+	// A map containing the owners of pods, built-in workloads or custom resources, used to walk their owner chain.
+	// Key is owner uid
+	Owners map[string]*Owner
+
 	telemetryBuilder *metadata.TelemetryBuilder
 }
 
@@ -109,10 +116,29 @@ var deploymentHashSuffixPattern = regexp.MustCompile(`^[a-z0-9]{10}$`)
 
 var errCannotRetrieveImage = errors.New("cannot retrieve image name")
 
+// defaultOwnerResources are the built-in workloads always walked through in the owner chain of pods.
+var defaultOwnerResources = []OwnerResource{
+	{GroupVersionResource: replicaSetResource, Kind: "ReplicaSet"},
+	{GroupVersionResource: schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"}, Kind: "Deployment"},
+	{GroupVersionResource: schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "statefulsets"}, Kind: "StatefulSet"},
+	{GroupVersionResource: schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "daemonsets"}, Kind: "DaemonSet"},
+	{GroupVersionResource: schema.GroupVersionResource{Group: "batch", Version: "v1", Resource: "jobs"}, Kind: "Job"},
+	{GroupVersionResource: schema.GroupVersionResource{Group: "batch", Version: "v1", Resource: "cronjobs"}, Kind: "CronJob"},
+}
+
+var replicaSetResource = schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "replicasets"}
+
+// ownerInformer is an informer watching the metadata of the owners of the given kind.
+type ownerInformer struct {
+	informer cache.SharedInformer
+	kind     string
+}
+
 type InformersFactoryList struct {
 	newInformer           InformerProvider
 	newNamespaceInformer  InformerProviderNamespace
 	newReplicaSetInformer InformerProviderWorkload
+	newOwnerInformer      InformerProviderOwner
 }
 
 // New initializes a new k8s Client.
@@ -155,6 +181,7 @@ func New(
 	c.StatefulSets = map[string]*StatefulSet{}
 	c.DaemonSets = map[string]*DaemonSet{}
 	c.Jobs = map[string]*Job{}
+	c.Owners = map[string]*Owner{}
 
 	if newClientSet == nil {
 		newClientSet = k8sconfig.MakeClientBundle
@@ -241,6 +268,36 @@ func New(
 	if c.extractJobLabelsAnnotations() || rules.CronJobUID {
 		c.jobInformer = newJobSharedInformer(c.kc, c.Filters.Namespace)
 	}
+
+	if c.extractOwnerLabelsAnnotations() {
+		if informersFactory.newOwnerInformer == nil {
+			informersFactory.newOwnerInformer = newOwnerSharedInformer
+		}
+
+		for _, resource := range ownerResources(rules) {
+			// the replica sets are already watched to get the deployments of pods
+			if resource.GroupVersionResource == replicaSetResource && c.replicasetInformer != nil {
+				c.ownerInformers = append(c.ownerInformers, ownerInformer{informer: c.replicasetInformer, kind: resource.Kind})
+				continue
+			}
+
+			informer := informersFactory.newOwnerInformer(c.mc, resource.GroupVersionResource, c.Filters.Namespace)
+			err = informer.SetTransform(
+				func(object any) (any, error) {
+					owner, success := object.(*meta_v1.PartialObjectMetadata)
+					if !success { // means this is a cache.DeletedFinalStateUnknown, in which case we do nothing
+						return object, nil
+					}
+
+					return removeUnnecessaryOwnerData(owner), nil
+				},
+			)
+			if err != nil {
+				return nil, err
+			}
+			c.ownerInformers = append(c.ownerInformers, ownerInformer{informer: informer, kind: resource.Kind})
+		}
+	}
 	return c, err
 }
 
@@ -250,6 +307,7 @@ func (c *WatchClient) Start() error {
 	go c.deleteLoop(time.Second*30, defaultPodDeleteGracePeriod)
 
 	synced := make([]cache.InformerSynced, 0)
+	replicasetInformerStarted := false
 	// start the replicaSet informer first, as the replica sets need to be
 	// present at the time the pods are handled, to correctly establish the connection between pods and deployments
 	// The replicaset informer is needed to get the deployment UID.
@@ -265,6 +323,25 @@ func (c *WatchClient) Start() error {
 		}
 		synced = append(synced, reg.HasSynced)
 		go c.replicasetInformer.Run(c.stopCh)
+		replicasetInformerStarted = true
+	}
+
+	// the owner informers are also needed when the pods are handled, to walk their owner chain
+	for _, owners := range c.ownerInformers {
+		kind := owners.kind
+		reg, err := owners.informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+			AddFunc:    func(obj any) { c.handleOwnerAdd(kind, obj) },
+			UpdateFunc: func(oldObj, newObj any) { c.handleOwnerUpdate(kind, oldObj, newObj) },
+			DeleteFunc: c.handleOwnerDelete,
+		})
+		if err != nil {
+			return err
+		}
+		synced = append(synced, reg.HasSynced)
+		if owners.informer == c.replicasetInformer && replicasetInformerStarted {
+			continue
+		}
+		go owners.informer.Run(c.stopCh)
 	}
 
 	reg, err := c.namespaceInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
@@ -858,6 +935,17 @@ func (c *WatchClient) GetJob(jobUID string) (*Job, bool) {
 	return nil, false
 }
 
+// GetOwner takes an owner UID and returns the owner object, a built-in workload or a custom resource.
+func (c *WatchClient) GetOwner(ownerUID string) (*Owner, bool) {
+	c.m.RLock()
+	owner, ok := c.Owners[ownerUID]
+	c.m.RUnlock()
+	if ok {
+		return owner, ok
+	}
+	return nil, false
+}
+
 func (c *WatchClient) extractPodAttributes(pod *api_v1.Pod) map[string]string {
 	tags := map[string]string{}
 	if c.Rules.PodName {
@@ -1373,6 +1461,20 @@ func (c *WatchClient) extractJobAttributes(d *batch_v1.Job) map[string]string {
 	return tags
 }
 
+func (c *WatchClient) extractOwnerAttributes(kind string, owner *meta_v1.PartialObjectMetadata) map[string]string {
+	tags := map[string]string{}
+
+	for _, r := range c.Rules.Labels {
+		r.extractFromOwnerMetadata(kind, owner.GetLabels(), tags, K8SOwnerLabel(kind))
+	}
+
+	for _, r := range c.Rules.Annotations {
+		r.extractFromOwnerMetadata(kind, owner.GetAnnotations(), tags, K8SOwnerAnnotation(kind))
+	}
+
+	return tags
+}
+
 func (c *WatchClient) podFromAPI(pod *api_v1.Pod) *Pod {
 	newPod := &Pod{
 		Name:           pod.Name,
@@ -1386,6 +1488,7 @@ func (c *WatchClient) podFromAPI(pod *api_v1.Pod) *Pod {
 		HostNetwork:    pod.Spec.HostNetwork,
 		PodUID:         string(pod.UID),
 		StartTime:      pod.Status.StartTime,
+		OwnerUID:       controllerUID(pod.OwnerReferences),
 	}
 
 	if replicaset, ok := c.GetReplicaSet(getPodReplicaSetUID(pod)); ok {
@@ -1454,6 +1557,16 @@ func getPodJobUID(pod *api_v1.Pod) string {
 	return ""
 }
 
+// controllerUID returns the UID of the controller among the given owner references, if any.
+func controllerUID(ownerReferences []meta_v1.OwnerReference) string {
+	for _, ref := range ownerReferences {
+		if ref.Controller != nil && *ref.Controller {
+			return string(ref.UID)
+		}
+	}
+	return ""
+}
+
 // getIdentifiersFromAssoc returns list of PodIdentifiers for given pod
 func (c *WatchClient) getIdentifiersFromAssoc(pod *Pod) []PodIdentifier {
 	var ids []PodIdentifier
@@ -1753,6 +1866,22 @@ func (c *WatchClient) extractJobLabelsAnnotations() bool {
 	return false
 }
 
+func (c *WatchClient) extractOwnerLabelsAnnotations() bool {
+	for _, r := range c.Rules.Labels {
+		if r.From == MetadataFromOwner {
+			return true
+		}
+	}
+
+	for _, r := range c.Rules.Annotations {
+		if r.From == MetadataFromOwner {
+			return true
+		}
+	}
+
+	return false
+}
+
 func (c *WatchClient) extractNodeLabelsAnnotations() bool {
 	for _, r := range c.Rules.Labels {
 		if r.From == MetadataFromNode {
@@ -1944,6 +2073,77 @@ func (c *WatchClient) addOrUpdateReplicaSet(replicaSet *meta_v1.PartialObjectMet
 	c.m.Unlock()
 }
 
+func (c *WatchClient) handleOwnerAdd(kind string, obj any) {
+	if owner, ok := obj.(*meta_v1.PartialObjectMetadata); ok {
+		c.addOrUpdateOwner(kind, owner)
+	} else {
+		c.logger.Error("object received was not PartialObjectMetadata for owner add", zap.Any("received", obj))
+	}
+}
+
+func (c *WatchClient) handleOwnerUpdate(kind string, _, newOwner any) {
+	if owner, ok := newOwner.(*meta_v1.PartialObjectMetadata); ok {
+		c.addOrUpdateOwner(kind, owner)
+	} else {
+		c.logger.Error("object received was not PartialObjectMetadata for owner update", zap.Any("received", newOwner))
+	}
+}
+
+func (c *WatchClient) handleOwnerDelete(obj any) {
+	if owner, ok := ignoreDeletedFinalStateUnknown(obj).(*meta_v1.PartialObjectMetadata); ok {
+		c.m.Lock()
+		delete(c.Owners, string(owner.UID))
+		c.m.Unlock()
+	} else {
+		c.logger.Error("object received was not PartialObjectMetadata for owner delete", zap.Any("received", obj))
+	}
+}
+
+func (c *WatchClient) addOrUpdateOwner(kind string, owner *meta_v1.PartialObjectMetadata) {
+	newOwner := &Owner{
+		Kind:     kind,
+		Name:     owner.Name,
+		UID:      string(owner.UID),
+		OwnerUID: controllerUID(owner.OwnerReferences),
+	}
+	newOwner.Attributes = c.extractOwnerAttributes(kind, owner)
+
+	c.m.Lock()
+	if owner.UID != "" {
+		c.Owners[string(owner.UID)] = newOwner
+	}
+	c.m.Unlock()
+}
+
+// ownerResources returns the resources watched to walk the owner chain of pods, the built-in
+// workloads followed by the configured custom resources.
+func ownerResources(rules ExtractionRules) []OwnerResource {
+	resources := slices.Clone(defaultOwnerResources)
+	for _, r := range rules.OwnerResources {
+		if !slices.ContainsFunc(resources, func(other OwnerResource) bool {
+			return other.GroupVersionResource == r.GroupVersionResource
+		}) {
+			resources = append(resources, r)
+		}
+	}
+	return resources
+}
+
+// removeUnnecessaryOwnerData keeps only the metadata of owners needed to walk the owner chain
+// and to extract labels and annotations.
+func removeUnnecessaryOwnerData(owner *meta_v1.PartialObjectMetadata) *meta_v1.PartialObjectMetadata {
+	return &meta_v1.PartialObjectMetadata{
+		ObjectMeta: meta_v1.ObjectMeta{
+			Name:            owner.Name,
+			Namespace:       owner.Namespace,
+			UID:             owner.UID,
+			Labels:          owner.Labels,
+			Annotations:     owner.Annotations,
+			OwnerReferences: owner.OwnerReferences,
+		},
+	}
+}
+
 // runInformerWithDependencies starts the given informer. The second argument is a list of other informers that should complete
 // before the informer is started. This is necessary e.g. for the pod informer which requires the replica set informer
 // to be finished to correctly establish the connection to the replicaset/deployment it belongs to.
@@ -25,6 +25,7 @@ import (
 	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/selection"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes"
@@ -3610,6 +3611,7 @@ func newTestClientWithRulesAndFilters(t *testing.T, f Filters) (*WatchClient, *o
 		newInformer:           NewFakeInformer,
 		newNamespaceInformer:  NewFakeNamespaceInformer,
 		newReplicaSetInformer: NewFakeReplicaSetInformer,
+		newOwnerInformer:      NewFakeOwnerInformer,
 	}
 
 	c, err := New(set, k8sconfig.APIConfig{}, ExtractionRules{}, f, associations, exclude, newFakeAPIClientset, factory, false, 10*time.Second)
@@ -4685,3 +4687,309 @@ func TestMetadataNewForConfigFailure(t *testing.T) {
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "metadata.NewForConfig failed")
 }
+
+func newOwner(apiVersion, kind, name, uid string, labels map[string]string, controllerUID string) *meta_v1.PartialObjectMetadata {
+	owner := &meta_v1.PartialObjectMetadata{
+		TypeMeta: meta_v1.TypeMeta{APIVersion: apiVersion, Kind: kind},
+		ObjectMeta: meta_v1.ObjectMeta{
+			Name:      name,
+			Namespace: "default",
+			UID:       types.UID(uid),
+			Labels:    labels,
+		},
+	}
+	if controllerUID != "" {
+		isController := true
+		owner.OwnerReferences = []meta_v1.OwnerReference{
+			{Kind: "Rollout", Name: "owner", UID: types.UID(controllerUID), Controller: &isController},
+		}
+	}
+	return owner
+}
+
+func TestOwnerExtractionRules(t *testing.T) {
+	c, _ := newTestClientWithRulesAndFilters(t, Filters{})
+
+	rollout := newOwner("argoproj.io/v1alpha1", "Rollout", "checkout", "rollout-uid", map[string]string{"team": "payments"}, "")
+	rollout.Annotations = map[string]string{"owner": "alice"}
+
+	testCases := []struct {
+		name       string
+		rules      ExtractionRules
+		attributes map[string]string
+	}{
+		{
+			name:       "no-rules",
+			rules:      ExtractionRules{},
+			attributes: map[string]string{},
+		},
+		{
+			name: "labels and annotations",
+			rules: ExtractionRules{
+				Labels: []FieldExtractionRule{
+					{
+						Name: "team",
+						Key:  "team",
+						From: MetadataFromOwner,
+					},
+				},
+				Annotations: []FieldExtractionRule{
+					{
+						Key:  "owner",
+						From: MetadataFromOwner,
+					},
+				},
+			},
+			attributes: map[string]string{
+				"team":                         "payments",
+				"k8s.rollout.annotation.owner": "alice",
+			},
+		},
+		{
+			name: "owner kind",
+			rules: ExtractionRules{
+				Labels: []FieldExtractionRule{
+					{
+						Key:       "team",
+						From:      MetadataFromOwner,
+						OwnerKind: "Rollout",
+					},
+					{
+						Name:      "service-team",
+						Key:       "team",
+						From:      MetadataFromOwner,
+						OwnerKind: "Service",
+					},
+				},
+			},
+			attributes: map[string]string{
+				"k8s.rollout.label.team": "payments",
+			},
+		},
+		{
+			name: "other sources",
+			rules: ExtractionRules{
+				Labels: []FieldExtractionRule{
+					{
+						Key:  "team",
+						From: MetadataFromDeployment,
+					},
+				},
+			},
+			attributes: map[string]string{},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			c.Rules = tc.rules
+			c.handleOwnerAdd("Rollout", rollout)
+			o, ok := c.GetOwner("rollout-uid")
+			require.True(t, ok)
+			assert.Equal(t, "Rollout", o.Kind)
+			assert.Equal(t, "checkout", o.Name)
+			assert.Equal(t, tc.attributes, o.Attributes)
+		})
+	}
+}
+
+func TestHandleOwnerUpdateAndDelete(t *testing.T) {
+	c, _ := newTestClientWithRulesAndFilters(t, Filters{})
+
+	replicaSet := newOwner("apps/v1", "ReplicaSet", "checkout-5d8f7", "replicaset-uid", nil, "rollout-uid")
+	c.handleOwnerAdd("ReplicaSet", replicaSet)
+	o, ok := c.GetOwner("replicaset-uid")
+	require.True(t, ok)
+	assert.Equal(t, "ReplicaSet", o.Kind)
+	assert.Equal(t, "rollout-uid", o.OwnerUID)
+
+	updatedReplicaSet := newOwner("apps/v1", "ReplicaSet", "checkout-5d8f7", "replicaset-uid", nil, "")
+	c.handleOwnerUpdate("ReplicaSet", replicaSet, updatedReplicaSet)
+	o, ok = c.GetOwner("replicaset-uid")
+	require.True(t, ok)
+	assert.Empty(t, o.OwnerUID)
+
+	c.handleOwnerDelete(cache.DeletedFinalStateUnknown{Obj: updatedReplicaSet})
+	_, ok = c.GetOwner("replicaset-uid")
+	assert.False(t, ok)
+
+	// objects without UID are not tracked
+	c.handleOwnerAdd("ReplicaSet", newOwner("apps/v1", "ReplicaSet", "checkout-5d8f7", "", nil, ""))
+	assert.Empty(t, c.Owners)
+}
+
+func TestPodOwnerUID(t *testing.T) {
+	c, _ := newTestClientWithRulesAndFilters(t, Filters{})
+	c.Rules = ExtractionRules{
+		Labels: []FieldExtractionRule{{Key: "team", From: MetadataFromOwner}},
+	}
+
+	isController := true
+	pod := &api_v1.Pod{
+		ObjectMeta: meta_v1.ObjectMeta{
+			Name: "checkout-5d8f7-abcde",
+			UID:  "pod-uid",
+			OwnerReferences: []meta_v1.OwnerReference{
+				{Kind: "ConfigMap", Name: "not-a-controller", UID: "configmap-uid"},
+				{Kind: "ReplicaSet", Name: "checkout-5d8f7", UID: "replicaset-uid", Controller: &isController},
+			},
+		},
+		Status: api_v1.PodStatus{PodIP: "1.1.1.1"},
+	}
+
+	transformedPod := removeUnnecessaryPodData(pod, c.Rules)
+	assert.Equal(t, pod.OwnerReferences, transformedPod.OwnerReferences)
+
+	c.handlePodAdd(transformedPod)
+	got, ok := c.GetPod(newPodIdentifier("connection", "k8s.pod.ip", "1.1.1.1"))
+	require.True(t, ok)
+	assert.Equal(t, "replicaset-uid", got.OwnerUID)
+}
+
+func TestOwnerInformers(t *testing.T) {
+	rollouts := OwnerResource{
+		GroupVersionResource: schema.GroupVersionResource{Group: "argoproj.io", Version: "v1alpha1", Resource: "rollouts"},
+		Kind:                 "Rollout",
+	}
+	ownerRules := []FieldExtractionRule{{Key: "team", From: MetadataFromOwner}}
+	tests := []struct {
+		name              string
+		rules             ExtractionRules
+		resources         []schema.GroupVersionResource
+		kinds             []string
+		sharedReplicaSets bool
+	}{
+		{
+			name: "no owner rules",
+			rules: ExtractionRules{
+				OwnerResources: []OwnerResource{rollouts},
+			},
+		},
+		{
+			name: "owner rules",
+			rules: ExtractionRules{
+				Labels: ownerRules,
+				OwnerResources: []OwnerResource{
+					rollouts,
+					{GroupVersionResource: replicaSetResource, Kind: "ReplicaSet"},
+				},
+			},
+			resources: []schema.GroupVersionResource{
+				replicaSetResource,
+				{Group: "apps", Version: "v1", Resource: "deployments"},
+				{Group: "apps", Version: "v1", Resource: "statefulsets"},
+				{Group: "apps", Version: "v1", Resource: "daemonsets"},
+				{Group: "batch", Version: "v1", Resource: "jobs"},
+				{Group: "batch", Version: "v1", Resource: "cronjobs"},
+				rollouts.GroupVersionResource,
+			},
+			kinds: []string{"ReplicaSet", "Deployment", "StatefulSet", "DaemonSet", "Job", "CronJob", "Rollout"},
+		},
+		{
+			name: "owner rules with replicaset informer",
+			rules: ExtractionRules{
+				Labels:         ownerRules,
+				DeploymentName: true,
+				OwnerResources: []OwnerResource{rollouts},
+			},
+			resources: []schema.GroupVersionResource{
+				{Group: "apps", Version: "v1", Resource: "deployments"},
+				{Group: "apps", Version: "v1", Resource: "statefulsets"},
+				{Group: "apps", Version: "v1", Resource: "daemonsets"},
+				{Group: "batch", Version: "v1", Resource: "jobs"},
+				{Group: "batch", Version: "v1", Resource: "cronjobs"},
+				rollouts.GroupVersionResource,
+			},
+			kinds:             []string{"ReplicaSet", "Deployment", "StatefulSet", "DaemonSet", "Job", "CronJob", "Rollout"},
+			sharedReplicaSets: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var resources []schema.GroupVersionResource
+			factory := InformersFactoryList{
+				newInformer:           NewFakeInformer,
+				newNamespaceInformer:  NewFakeNamespaceInformer,
+				newReplicaSetInformer: NewFakeReplicaSetInformer,
+				newOwnerInformer: func(client clientmeta.Interface, gvr schema.GroupVersionResource, namespace string) cache.SharedInformer {
+					resources = append(resources, gvr)
+					return NewFakeOwnerInformer(client, gvr, namespace)
+				},
+			}
+
+			c, err := New(componenttest.NewNopTelemetrySettings(), k8sconfig.APIConfig{}, tt.rules, Filters{}, []Association{}, Excludes{}, newFakeAPIClientset, factory, false, 10*time.Second)
+			require.NoError(t, err)
+			assert.Equal(t, tt.resources, resources)
+
+			wc := c.(*WatchClient)
+			var kinds []string
+			for _, owners := range wc.ownerInformers {
+				kinds = append(kinds, owners.kind)
+			}
+			assert.Equal(t, tt.kinds, kinds)
+			if tt.sharedReplicaSets {
+				assert.Same(t, wc.replicasetInformer, wc.ownerInformers[0].informer)
+			}
+		})
+	}
+}
+
+func TestOwnerSharedInformer(t *testing.T) {
+	rollouts := schema.GroupVersionResource{Group: "argoproj.io", Version: "v1alpha1", Resource: "rollouts"}
+	rollout := newOwner("argoproj.io/v1alpha1", "Rollout", "checkout", "rollout-uid", map[string]string{"team": "payments"}, "")
+	rollout.ManagedFields = []meta_v1.ManagedFieldsEntry{{Manager: "argo-rollouts"}}
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, meta_v1.AddMetaToScheme(scheme))
+	mc := clientmetafake.NewSimpleMetadataClient(scheme, rollout)
+
+	c, _ := newTestClientWithRulesAndFilters(t, Filters{})
+	c.Rules = ExtractionRules{
+		Labels: []FieldExtractionRule{{Key: "team", From: MetadataFromOwner}},
+	}
+
+	informer := newOwnerSharedInformer(mc, rollouts, "default")
+	require.NoError(t, informer.SetTransform(func(object any) (any, error) {
+		return removeUnnecessaryOwnerData(object.(*meta_v1.PartialObjectMetadata)), nil
+	}))
+	_, err := informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    func(obj any) { c.handleOwnerAdd("Rollout", obj) },
+		UpdateFunc: func(oldObj, newObj any) { c.handleOwnerUpdate("Rollout", oldObj, newObj) },
+		DeleteFunc: c.handleOwnerDelete,
+	})
+	require.NoError(t, err)
+
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	go informer.Run(stopCh)
+	require.True(t, cache.WaitForCacheSync(stopCh, informer.HasSynced))
+
+	o, ok := c.GetOwner("rollout-uid")
+	require.True(t, ok)
+	assert.Equal(t, "Rollout", o.Kind)
+	assert.Equal(t, map[string]string{"k8s.rollout.label.team": "payments"}, o.Attributes)
+
+	// only the metadata needed to walk the owner chain is kept in the informer store
+	stored := informer.GetStore().List()
+	require.Len(t, stored, 1)
+	assert.Empty(t, stored[0].(*meta_v1.PartialObjectMetadata).ManagedFields)
+}
+
+func TestRemoveUnnecessaryOwnerData(t *testing.T) {
+	owner := newOwner("apps/v1", "ReplicaSet", "checkout-5d8f7", "replicaset-uid", map[string]string{"team": "payments"}, "rollout-uid")
+	owner.Annotations = map[string]string{"owner": "alice"}
+	owner.ResourceVersion = "42"
+	owner.ManagedFields = []meta_v1.ManagedFieldsEntry{{Manager: "kube-controller-manager"}}
+
+	transformedOwner := removeUnnecessaryOwnerData(owner)
+	assert.Equal(t, &meta_v1.PartialObjectMetadata{
+		ObjectMeta: meta_v1.ObjectMeta{
+			Name:            "checkout-5d8f7",
+			Namespace:       "default",
+			UID:             "replicaset-uid",
+			Labels:          map[string]string{"team": "payments"},
+			Annotations:     map[string]string{"owner": "alice"},
+			OwnerReferences: owner.OwnerReferences,
+		},
+	}, transformedOwner)
+}
@@ -10,6 +10,7 @@ import (
 
 	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/metadata"
 	"k8s.io/client-go/tools/cache"
@@ -124,6 +125,16 @@ func (f *FakeReplicaSetInformer) GetController() cache.Controller {
 	return f.FakeController
 }
 
+func NewFakeOwnerInformer(
+	_ metadata.Interface,
+	_ schema.GroupVersionResource,
+	_ string,
+) cache.SharedInformer {
+	return &FakeInformer{
+		FakeController: &FakeController{},
+	}
+}
+
 type FakeController struct {
 	sync.Mutex
 	stopped bool
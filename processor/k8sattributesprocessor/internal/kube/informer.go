@@ -51,6 +51,15 @@ type InformerProviderWorkload func(
 	namespace string,
 ) cache.SharedInformer
 
+// InformerProviderOwner defines a function type that returns a new SharedInformer. It is used to
+// allow passing custom shared informers to the watch client.
+// It's used for the resources of the owner chain of pods, including custom resources.
+type InformerProviderOwner func(
+	client metadata.Interface,
+	gvr schema.GroupVersionResource,
+	namespace string,
+) cache.SharedInformer
+
 func newSharedInformer(
 	client kubernetes.Interface,
 	namespace string,
@@ -262,3 +271,31 @@ func daemonsetWatchFuncWithSelectors(client kubernetes.Interface, namespace stri
 		return client.AppsV1().DaemonSets(namespace).Watch(ctx, opts)
 	}
 }
+
+func newOwnerSharedInformer(
+	client metadata.Interface,
+	gvr schema.GroupVersionResource,
+	namespace string,
+) cache.SharedInformer {
+	informer := cache.NewSharedInformer(
+		&cache.ListWatch{
+			ListWithContextFunc:  ownerListFuncWithSelectors(client, gvr, namespace),
+			WatchFuncWithContext: ownerWatchFuncWithSelectors(client, gvr, namespace),
+		},
+		&metav1.PartialObjectMetadata{},
+		watchSyncPeriod,
+	)
+	return informer
+}
+
+func ownerListFuncWithSelectors(mc metadata.Interface, gvr schema.GroupVersionResource, namespace string) cache.ListWithContextFunc {
+	return func(ctx context.Context, opts metav1.ListOptions) (runtime.Object, error) {
+		return mc.Resource(gvr).Namespace(namespace).List(ctx, opts)
+	}
+}
+
+func ownerWatchFuncWithSelectors(mc metadata.Interface, gvr schema.GroupVersionResource, namespace string) cache.WatchFuncWithContext {
+	return func(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error) {
+		return mc.Resource(gvr).Namespace(namespace).Watch(ctx, opts)
+	}
+}
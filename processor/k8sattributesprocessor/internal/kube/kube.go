@@ -9,6 +9,7 @@ import (
 
 	"go.opentelemetry.io/collector/component"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/selection"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/k8sconfig"
@@ -30,7 +31,10 @@ const (
 	// MetadataFromDaemonSet  is used to specify to extract metadata/labels/annotations from daemonset
 	MetadataFromDaemonSet = "daemonset"
 	// MetadataFromJob  is used to specify to extract metadata/labels/annotations from job
-	MetadataFromJob        = "job"
+	MetadataFromJob = "job"
+	// MetadataFromOwner is used to specify to extract metadata/labels/annotations from the owners of pods,
+	// walking the owner chain through built-in workloads and custom resources
+	MetadataFromOwner      = "owner"
 	PodIdentifierMaxLength = 4
 
 	ResourceSource   = "resource_attribute"
@@ -96,6 +100,7 @@ type Client interface {
 	GetStatefulSet(string) (*StatefulSet, bool)
 	GetDaemonSet(string) (*DaemonSet, bool)
 	GetJob(string) (*Job, bool)
+	GetOwner(string) (*Owner, bool)
 	Start() error
 	Stop()
 }
@@ -122,6 +127,8 @@ type Pod struct {
 	DaemonSetUID   string
 	JobUID         string
 	HostNetwork    bool
+	// OwnerUID is the UID of the controller of the pod, the first one in its owner chain.
+	OwnerUID string
 
 	// Containers specifies all containers in this pod.
 	Containers PodContainers
@@ -260,6 +267,10 @@ type ExtractionRules struct {
 	Annotations                  []FieldExtractionRule
 	Labels                       []FieldExtractionRule
 	DeploymentNameFromReplicaSet bool
+
+	// OwnerResources are the custom resources walked through, along with the built-in
+	// workloads, when extracting labels and annotations from the owners of pods.
+	OwnerResources []OwnerResource
 }
 
 // IncludesOwnerMetadata determines whether the ExtractionRules include metadata about Pod Owners
@@ -283,6 +294,11 @@ func (rules *ExtractionRules) IncludesOwnerMetadata() bool {
 			return true
 		}
 	}
+	for _, r := range append(rules.Labels, rules.Annotations...) {
+		if r.From == MetadataFromOwner {
+			return true
+		}
+	}
 	return rules.ServiceName
 }
 
@@ -308,7 +324,11 @@ type FieldExtractionRule struct {
 	//  - statefulset
 	//  - daemonset
 	//  - job
+	//  - owner
 	From string
+	// OwnerKind restricts the extraction from owners to the owners of the given kind, e.g. Rollout.
+	// Metadata is extracted from owners of any kind when empty.
+	OwnerKind string
 }
 
 func (r *FieldExtractionRule) extractFromPodMetadata(metadata, tags map[string]string, attrFunc AttributesFunction) {
@@ -354,6 +374,12 @@ func (r *FieldExtractionRule) extractFromJobMetadata(metadata, tags map[string]s
 	}
 }
 
+func (r *FieldExtractionRule) extractFromOwnerMetadata(kind string, metadata, tags map[string]string, attrFunc AttributesFunction) {
+	if r.From == MetadataFromOwner && (r.OwnerKind == "" || r.OwnerKind == kind) {
+		r.extractFromMetadata(metadata, tags, attrFunc)
+	}
+}
+
 func (r *FieldExtractionRule) extractFromMetadata(metadata, tags map[string]string, attrFunc AttributesFunction) {
 	if r.KeyRegex != nil {
 		for k, v := range metadata {
@@ -463,6 +489,24 @@ type CronJob struct {
 	Attributes map[string]string
 }
 
+// OwnerResource identifies a resource in the owner chain of pods. Owners are watched with the
+// metadata client, which does not return their kind, so the kind is given along with the resource.
+type OwnerResource struct {
+	schema.GroupVersionResource
+	Kind string
+}
+
+// Owner represents a kubernetes object in the owner chain of pods, either a built-in
+// workload or a custom resource.
+type Owner struct {
+	Kind       string
+	Name       string
+	UID        string
+	Attributes map[string]string
+	// OwnerUID is the UID of the controller of this owner, the next one in the owner chain.
+	OwnerUID string
+}
+
 func OtelAnnotations() FieldExtractionRule {
 	return FieldExtractionRule{
 		Name:                 "$1",
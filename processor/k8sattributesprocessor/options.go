@@ -9,6 +9,7 @@ import (
 	"time"
 
 	conventions "go.opentelemetry.io/otel/semconv/v1.40.0"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/selection"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/k8sconfig"
@@ -237,6 +238,24 @@ func withDeploymentNameFromReplicaSet(enabled bool) option {
 	}
 }
 
+// withOwnerResources allows specifying the custom resources walked through in the owner chain of pods.
+func withOwnerResources(resources ...OwnerResourceConfig) option {
+	return func(p *kubernetesprocessor) error {
+		p.rules.OwnerResources = nil
+		for _, r := range resources {
+			p.rules.OwnerResources = append(p.rules.OwnerResources, kube.OwnerResource{
+				GroupVersionResource: schema.GroupVersionResource{
+					Group:    r.Group,
+					Version:  r.Version,
+					Resource: r.Resource,
+				},
+				Kind: r.Kind,
+			})
+		}
+		return nil
+	}
+}
+
 // withExtractLabels allows specifying options to control extraction of pod labels.
 func withExtractLabels(labels ...FieldExtractConfig) option {
 	return func(p *kubernetesprocessor) error {
@@ -285,7 +304,7 @@ func extractFieldRules(fields ...FieldExtractConfig) ([]kube.FieldExtractionRule
 		}
 
 		rules = append(rules, kube.FieldExtractionRule{
-			Name: name, Key: a.Key, KeyRegex: keyRegex, HasKeyRegexReference: hasKeyRegexReference, From: a.From,
+			Name: name, Key: a.Key, KeyRegex: keyRegex, HasKeyRegexReference: hasKeyRegexReference, From: a.From, OwnerKind: a.OwnerKind,
 		})
 	}
 	return rules, nil
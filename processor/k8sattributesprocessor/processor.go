@@ -30,6 +30,8 @@ import (
 
 const (
 	clientIPLabelName string = "ip"
+	// maxOwnerChainDepth bounds the walk of the owner chain of pods, protecting against owner reference cycles.
+	maxOwnerChainDepth = 10
 )
 
 type kubernetesprocessor struct {
@@ -271,6 +273,10 @@ func (kp *kubernetesprocessor) processResource(ctx context.Context, resource pco
 			setResourceAttribute(resource.Attributes(), key, val)
 		}
 	}
+
+	if pod != nil {
+		kp.addOwnerChainAttributes(resource.Attributes(), pod)
+	}
 }
 
 func setResourceAttribute(attributes pcommon.Map, key, val string) {
@@ -454,6 +460,22 @@ func (kp *kubernetesprocessor) getAttributesForPodsJob(jobUID string) map[string
 	return j.Attributes
 }
 
+// addOwnerChainAttributes walks the owner chain of the pod, adding the attributes of each owner.
+// The attributes of the closest owners take precedence.
+func (kp *kubernetesprocessor) addOwnerChainAttributes(attrs pcommon.Map, pod *kube.Pod) {
+	ownerUID := pod.OwnerUID
+	for depth := 0; ownerUID != "" && depth < maxOwnerChainDepth; depth++ {
+		owner, ok := kp.kc.GetOwner(ownerUID)
+		if !ok {
+			return
+		}
+		for key, val := range owner.Attributes {
+			setResourceAttribute(attrs, key, val)
+		}
+		ownerUID = owner.OwnerUID
+	}
+}
+
 func (kp *kubernetesprocessor) getUIDForPodsNode(nodeName string) string {
 	node, ok := kp.kc.GetNode(nodeName)
 	if !ok {
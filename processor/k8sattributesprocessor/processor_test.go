@@ -2276,3 +2276,54 @@ func TestGetAttributesForPodsJob(t *testing.T) {
 	attrs = p.getAttributesForPodsJob("non-existent")
 	assert.Nil(t, attrs)
 }
+
+func TestAddOwnerChainAttributes(t *testing.T) {
+	kc := &fakeClient{
+		Owners: map[string]*kube.Owner{
+			"replicaset-123": {
+				Kind:     "ReplicaSet",
+				Name:     "test-rollout-5d8f7",
+				UID:      "replicaset-123",
+				OwnerUID: "rollout-123",
+			},
+			"rollout-123": {
+				Kind: "Rollout",
+				Name: "test-rollout",
+				UID:  "rollout-123",
+				Attributes: map[string]string{
+					"team":                  "rollout-team",
+					"k8s.rollout.label.app": "checkout",
+				},
+				OwnerUID: "cycle-123",
+			},
+			// owner reference cycles must not loop forever
+			"cycle-123": {
+				Kind: "Operator",
+				Name: "test-operator",
+				UID:  "cycle-123",
+				Attributes: map[string]string{
+					"team":     "operator-team",
+					"operator": "test-operator",
+				},
+				OwnerUID: "rollout-123",
+			},
+		},
+	}
+
+	p := &kubernetesprocessor{
+		kc: kc,
+	}
+
+	attrs := pcommon.NewMap()
+	p.addOwnerChainAttributes(attrs, &kube.Pod{OwnerUID: "replicaset-123"})
+	assert.Equal(t, map[string]any{
+		"team":                  "rollout-team",
+		"k8s.rollout.label.app": "checkout",
+		"operator":              "test-operator",
+	}, attrs.AsRaw())
+
+	// the chain stops at unknown owners
+	attrs = pcommon.NewMap()
+	p.addOwnerChainAttributes(attrs, &kube.Pod{OwnerUID: "non-existent"})
+	assert.Equal(t, 0, attrs.Len())
+}
@@ -42,6 +42,9 @@ const (
 
 	// submatchCaseFieldName is the mapstructure field name for submatchCase field
 	submatchCaseFieldName = "submatch_case"
+
+	// conditionsFieldName is the mapstructure field name for Conditions field
+	conditionsFieldName = "conditions"
 )
 
 // Config defines configuration for Resource processor.
@@ -102,6 +105,11 @@ type filterConfig struct {
 	// This field is optional.
 	MatchLabels map[string]string `mapstructure:"experimental_match_labels"`
 
+	// Conditions is a list of OTTL conditions evaluated against the data points of the metric(s) to operate on.
+	// Only the data points matching at least one of the conditions are selected.
+	// This field is optional.
+	Conditions []string `mapstructure:"conditions"`
+
 	// prevent unkeyed literal initialization
 	_ struct{}
 }
@@ -143,6 +143,31 @@ func TestLoadConfig(t *testing.T) {
 				},
 			},
 		},
+		{
+			configFile: "config_full.yaml",
+			id:         component.NewIDWithName(metadata.Type, "conditions"),
+			expected: &Config{
+				Transforms: []transform{
+					{
+						MetricIncludeFilter: filterConfig{
+							Include: "http.server.duration",
+							Conditions: []string{
+								`attributes["http.route"] == "/health"`,
+								`resource.attributes["service.name"] == "frontend"`,
+							},
+						},
+						Action: "update",
+						Operations: []operation{
+							{
+								Action:          "aggregate_labels",
+								LabelSet:        []string{"http.route"},
+								AggregationType: "sum",
+							},
+						},
+					},
+				},
+			},
+		},
 	}
 
 	for _, tt := range tests {
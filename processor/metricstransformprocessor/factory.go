@@ -15,6 +15,8 @@ import (
 	"go.opentelemetry.io/collector/processor/processorhelper"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/aggregateutil"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter/filterottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/metricstransformprocessor/internal/metadata"
 )
 
@@ -43,7 +45,7 @@ func createMetricsProcessor(
 		return nil, err
 	}
 
-	hCfg, err := buildHelperConfig(oCfg, set.BuildInfo.Version)
+	hCfg, err := buildHelperConfig(oCfg, set.BuildInfo.Version, set.TelemetrySettings)
 	if err != nil {
 		return nil, err
 	}
@@ -126,7 +128,7 @@ func validateConfiguration(config *Config) error {
 }
 
 // buildHelperConfig constructs the maps that will be useful for the operations
-func buildHelperConfig(config *Config, version string) ([]internalTransform, error) {
+func buildHelperConfig(config *Config, version string, settings component.TelemetrySettings) ([]internalTransform, error) {
 	helperDataTransforms := make([]internalTransform, len(config.Transforms))
 	for i := range config.Transforms {
 		t := &config.Transforms[i]
@@ -134,7 +136,7 @@ func buildHelperConfig(config *Config, version string) ([]internalTransform, err
 			t.MetricIncludeFilter.MatchType = strictMatchType
 		}
 
-		filter, err := createFilter(t.MetricIncludeFilter)
+		filter, err := createFilter(t.MetricIncludeFilter, settings)
 		if err != nil {
 			return nil, err
 		}
@@ -171,20 +173,29 @@ func buildHelperConfig(config *Config, version string) ([]internalTransform, err
 	return helperDataTransforms, nil
 }
 
-func createFilter(filterConfig filterConfig) (internalFilter, error) {
+func createFilter(filterConfig filterConfig, settings component.TelemetrySettings) (internalFilter, error) {
+	var conditions dataPointConditions
+	if len(filterConfig.Conditions) > 0 {
+		boolExpr, err := filterottl.NewBoolExprForDataPoint(filterConfig.Conditions, filterottl.StandardDataPointFuncs(), ottl.IgnoreError, settings)
+		if err != nil {
+			return nil, fmt.Errorf("%q, %w", conditionsFieldName, err)
+		}
+		conditions.conditions = boolExpr
+	}
+
 	switch filterConfig.MatchType {
 	case strictMatchType:
 		matchers, err := getMatcherMap(filterConfig.MatchLabels, func(str string) (StringMatcher, error) { return strictMatcher(str), nil })
 		if err != nil {
 			return nil, err
 		}
-		return internalFilterStrict{include: filterConfig.Include, attrMatchers: matchers}, nil
+		return internalFilterStrict{include: filterConfig.Include, attrMatchers: matchers, conditions: conditions}, nil
 	case regexpMatchType:
 		matchers, err := getMatcherMap(filterConfig.MatchLabels, func(str string) (StringMatcher, error) { return regexp.Compile(str) })
 		if err != nil {
 			return nil, err
 		}
-		return internalFilterRegexp{include: regexp.MustCompile(filterConfig.Include), attrMatchers: matchers}, nil
+		return internalFilterRegexp{include: regexp.MustCompile(filterConfig.Include), attrMatchers: matchers, conditions: conditions}, nil
 	}
 
 	return nil, fmt.Errorf("invalid match type: %v", filterConfig.MatchType)
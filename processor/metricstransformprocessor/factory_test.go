@@ -145,6 +145,21 @@ func TestCreateProcessors(t *testing.T) {
 	}
 }
 
+func TestCreateProcessorsInvalidConditions(t *testing.T) {
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config_invalid_conditions.yaml"))
+	require.NoError(t, err)
+
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig()
+	sub, err := cm.Sub(metadata.Type.String())
+	require.NoError(t, err)
+	require.NoError(t, sub.Unmarshal(cfg))
+
+	mp, err := factory.CreateMetrics(t.Context(), processortest.NewNopSettings(metadata.Type), cfg, consumertest.NewNop())
+	assert.ErrorContains(t, err, fmt.Sprintf("%q", conditionsFieldName))
+	assert.Nil(t, mp)
+}
+
 func TestFactory_validateConfiguration(t *testing.T) {
 	v1 := Config{
 		Transforms: []transform{
@@ -289,7 +304,7 @@ func TestCreateProcessorsFilledData(t *testing.T) {
 		},
 	}
 
-	internalTransforms, err := buildHelperConfig(oCfg, "v0.0.1")
+	internalTransforms, err := buildHelperConfig(oCfg, "v0.0.1", componenttest.NewNopTelemetrySettings())
 	assert.NoError(t, err)
 
 	for i, expTr := range expData {
@@ -4,14 +4,16 @@
 package metricstransformprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/metricstransformprocessor"
 
 import (
+	"context"
 	"regexp"
 	"strings"
 
-	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.uber.org/zap"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/aggregateutil"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottldatapoint"
 )
 
 type metricsTransformProcessor struct {
@@ -43,7 +45,10 @@ type internalFilter interface {
 	extractMatchedMetric(pmetric.Metric) pmetric.Metric
 	expand(string, string) string
 	submatches(pmetric.Metric) []int
-	matchAttrs(pcommon.Map) bool
+	matchDataPoint(pmetric.Metric, any) bool
+	// withScope returns a copy of the filter evaluating its OTTL conditions, if any, against the data points
+	// of the given resource and scope metrics.
+	withScope(context.Context, pmetric.ResourceMetrics, pmetric.ScopeMetrics) internalFilter
 }
 
 type StringMatcher interface {
@@ -56,9 +61,37 @@ func (s strictMatcher) MatchString(cmp string) bool {
 	return string(s) == cmp
 }
 
+// dataPointConditions evaluates OTTL conditions against the data points of the resource and scope metrics
+// it was bound to.
+type dataPointConditions struct {
+	conditions      *ottl.ConditionSequence[*ottldatapoint.TransformContext]
+	ctx             context.Context
+	resourceMetrics pmetric.ResourceMetrics
+	scopeMetrics    pmetric.ScopeMetrics
+}
+
+func (c dataPointConditions) withScope(ctx context.Context, rm pmetric.ResourceMetrics, sm pmetric.ScopeMetrics) dataPointConditions {
+	c.ctx = ctx
+	c.resourceMetrics = rm
+	c.scopeMetrics = sm
+	return c
+}
+
+// match returns true if there are no conditions or if the data point matches at least one of them.
+func (c dataPointConditions) match(metric pmetric.Metric, dp any) bool {
+	if c.conditions == nil {
+		return true
+	}
+	tCtx := ottldatapoint.NewTransformContextPtr(c.resourceMetrics, c.scopeMetrics, metric, dp)
+	defer tCtx.Close()
+	match, err := c.conditions.Eval(c.ctx, tCtx)
+	return err == nil && match
+}
+
 type internalFilterStrict struct {
 	include      string
 	attrMatchers map[string]StringMatcher
+	conditions   dataPointConditions
 }
 
 func (internalFilterStrict) getSubexpNames() []string {
@@ -68,6 +101,7 @@ func (internalFilterStrict) getSubexpNames() []string {
 type internalFilterRegexp struct {
 	include      *regexp.Regexp
 	attrMatchers map[string]StringMatcher
+	conditions   dataPointConditions
 }
 
 func (f internalFilterRegexp) getSubexpNames() []string {
@@ -65,8 +65,13 @@ func (internalFilterStrict) expand(string, string) string {
 	return ""
 }
 
-func (f internalFilterStrict) matchAttrs(attrs pcommon.Map) bool {
-	return matchAttrs(f.attrMatchers, attrs)
+func (f internalFilterStrict) matchDataPoint(metric pmetric.Metric, dp any) bool {
+	return matchAttrs(f.attrMatchers, dataPointAttributes(dp)) && f.conditions.match(metric, dp)
+}
+
+func (f internalFilterStrict) withScope(ctx context.Context, rm pmetric.ResourceMetrics, sm pmetric.ScopeMetrics) internalFilter {
+	f.conditions = f.conditions.withScope(ctx, rm, sm)
+	return f
 }
 
 // extractMatchedMetric returns a metric matching the filter.
@@ -98,15 +103,20 @@ func (f internalFilterRegexp) expand(metricTemplate, metricName string) string {
 	return ""
 }
 
-func (f internalFilterRegexp) matchAttrs(attrs pcommon.Map) bool {
-	return matchAttrs(f.attrMatchers, attrs)
+func (f internalFilterRegexp) matchDataPoint(metric pmetric.Metric, dp any) bool {
+	return matchAttrs(f.attrMatchers, dataPointAttributes(dp)) && f.conditions.match(metric, dp)
+}
+
+func (f internalFilterRegexp) withScope(ctx context.Context, rm pmetric.ResourceMetrics, sm pmetric.ScopeMetrics) internalFilter {
+	f.conditions = f.conditions.withScope(ctx, rm, sm)
+	return f
 }
 
 // matchAnyDps checks whether any metric data points match the filter, returns true if metric has no data points.
 func matchAnyDps(metric pmetric.Metric, f internalFilter) bool {
 	match := true
-	rangeDataPointAttributes(metric, func(attrs pcommon.Map) bool {
-		if f.matchAttrs(attrs) {
+	rangeDataPoints(metric, func(dp any) bool {
+		if f.matchDataPoint(metric, dp) {
 			match = true
 			return false
 		}
@@ -119,8 +129,8 @@ func matchAnyDps(metric pmetric.Metric, f internalFilter) bool {
 // matchAllDps checks whether all metric data points match the filter, returns true if metric has no data points.
 func matchAllDps(metric pmetric.Metric, f internalFilter) bool {
 	match := true
-	rangeDataPointAttributes(metric, func(attrs pcommon.Map) bool {
-		if !f.matchAttrs(attrs) {
+	rangeDataPoints(metric, func(dp any) bool {
+		if !f.matchDataPoint(metric, dp) {
 			match = false
 			return false
 		}
@@ -135,8 +145,8 @@ func matchAllDps(metric pmetric.Metric, f internalFilter) bool {
 // ([]bool{true, false, true}, 2).
 func matchDps(metric pmetric.Metric, f internalFilter) (matchedDps []bool, matchedDpsCount int) {
 	matchedDps = []bool{}
-	rangeDataPointAttributes(metric, func(attrs pcommon.Map) bool {
-		match := f.matchAttrs(attrs)
+	rangeDataPoints(metric, func(dp any) bool {
+		match := f.matchDataPoint(metric, dp)
 		if match {
 			matchedDpsCount++
 		}
@@ -227,7 +237,7 @@ func matchAttrs(attrMatchers map[string]StringMatcher, attrs pcommon.Map) bool {
 	return true
 }
 
-func (mtp *metricsTransformProcessor) processMetrics(_ context.Context, md pmetric.Metrics) (pmetric.Metrics, error) {
+func (mtp *metricsTransformProcessor) processMetrics(ctx context.Context, md pmetric.Metrics) (pmetric.Metrics, error) {
 	rms := md.ResourceMetrics()
 	groupedRMs := pmetric.NewResourceMetricsSlice()
 
@@ -236,6 +246,7 @@ func (mtp *metricsTransformProcessor) processMetrics(_ context.Context, md pmetr
 			metrics := sm.Metrics()
 
 			for _, transform := range mtp.transforms {
+				transform.MetricIncludeFilter = transform.MetricIncludeFilter.withScope(ctx, rm, sm)
 				switch transform.Action {
 				case Group:
 					groupedRM := groupedRMs.AppendEmpty()
@@ -513,6 +524,59 @@ func rangeDataPointAttributes(metric pmetric.Metric, f func(pcommon.Map) bool) {
 	}
 }
 
+// rangeDataPoints calls f sequentially on every metric data point.
+// The iteration terminates if f returns false.
+func rangeDataPoints(metric pmetric.Metric, f func(any) bool) {
+	//exhaustive:enforce
+	switch metric.Type() {
+	case pmetric.MetricTypeGauge:
+		for i := 0; i < metric.Gauge().DataPoints().Len(); i++ {
+			if !f(metric.Gauge().DataPoints().At(i)) {
+				return
+			}
+		}
+	case pmetric.MetricTypeSum:
+		for i := 0; i < metric.Sum().DataPoints().Len(); i++ {
+			if !f(metric.Sum().DataPoints().At(i)) {
+				return
+			}
+		}
+	case pmetric.MetricTypeHistogram:
+		for i := 0; i < metric.Histogram().DataPoints().Len(); i++ {
+			if !f(metric.Histogram().DataPoints().At(i)) {
+				return
+			}
+		}
+	case pmetric.MetricTypeExponentialHistogram:
+		for i := 0; i < metric.ExponentialHistogram().DataPoints().Len(); i++ {
+			if !f(metric.ExponentialHistogram().DataPoints().At(i)) {
+				return
+			}
+		}
+	case pmetric.MetricTypeSummary:
+		for i := 0; i < metric.Summary().DataPoints().Len(); i++ {
+			if !f(metric.Summary().DataPoints().At(i)) {
+				return
+			}
+		}
+	}
+}
+
+// dataPointAttributes returns the attributes of a data point provided by rangeDataPoints.
+func dataPointAttributes(dp any) pcommon.Map {
+	switch dp := dp.(type) {
+	case pmetric.NumberDataPoint:
+		return dp.Attributes()
+	case pmetric.HistogramDataPoint:
+		return dp.Attributes()
+	case pmetric.ExponentialHistogramDataPoint:
+		return dp.Attributes()
+	case pmetric.SummaryDataPoint:
+		return dp.Attributes()
+	}
+	return pcommon.NewMap()
+}
+
 func countDataPoints(metric pmetric.Metric) int {
 	//exhaustive:enforce
 	switch metric.Type() {
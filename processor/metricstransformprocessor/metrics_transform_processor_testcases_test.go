@@ -6,9 +6,12 @@ package metricstransformprocessor
 import (
 	"regexp"
 
+	"go.opentelemetry.io/collector/component/componenttest"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/aggregateutil"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter/filterottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
 )
 
 type metricsTransformTest struct {
@@ -2063,4 +2066,165 @@ var standardTests = []metricsTransformTest{
 		},
 		out: []pmetric.Metric{},
 	},
+	{
+		name: "metric_experimental_scale_with_conditions",
+		transforms: []internalTransform{
+			{
+				MetricIncludeFilter: internalFilterStrict{
+					include:    "metric1",
+					conditions: newTestConditions(`attributes["label1"] == "value1"`, `value_int > 2`),
+				},
+				Action: Update,
+				Operations: []internalOperation{
+					{
+						configOperation: &operation{
+							Action: scaleValue,
+							Scale:  10,
+						},
+					},
+				},
+			},
+		},
+		in: []pmetric.Metric{
+			metricBuilder(pmetric.MetricTypeSum, "metric1", "label1").
+				addIntDatapoint(1, 1, 1, "value1").
+				addIntDatapoint(1, 1, 3, "value2").
+				addIntDatapoint(1, 1, 2, "value3").build(),
+		},
+		out: []pmetric.Metric{
+			metricBuilder(pmetric.MetricTypeSum, "metric1", "label1").
+				addIntDatapoint(1, 1, 10, "value1").
+				addIntDatapoint(1, 1, 30, "value2").
+				addIntDatapoint(1, 1, 2, "value3").build(),
+		},
+	},
+	{
+		name: "metric_label_aggregation_insert_with_conditions",
+		transforms: []internalTransform{
+			{
+				MetricIncludeFilter: internalFilterStrict{
+					include:    "metric1",
+					conditions: newTestConditions(`attributes["label0"] == "label0-value1"`),
+				},
+				Action:  Insert,
+				NewName: "new/metric1",
+				Operations: []internalOperation{
+					{
+						configOperation: &operation{
+							Action:          aggregateLabels,
+							AggregationType: aggregateutil.Sum,
+							LabelSet:        []string{"label1"},
+						},
+						labelSetMap: map[string]bool{"label1": true},
+					},
+				},
+			},
+		},
+		in: []pmetric.Metric{
+			metricBuilder(pmetric.MetricTypeGauge, "metric1", "label0", "label1").
+				addDoubleDatapoint(1, 2, 3, "label0-value1", "label1-value1").
+				addDoubleDatapoint(1, 2, 1, "label0-value1", "label1-value1").
+				addDoubleDatapoint(1, 2, 1, "label0-value2", "label1-value1").
+				build(),
+		},
+		out: []pmetric.Metric{
+			metricBuilder(pmetric.MetricTypeGauge, "metric1", "label0", "label1").
+				addDoubleDatapoint(1, 2, 3, "label0-value1", "label1-value1").
+				addDoubleDatapoint(1, 2, 1, "label0-value1", "label1-value1").
+				addDoubleDatapoint(1, 2, 1, "label0-value2", "label1-value1").
+				build(),
+			metricBuilder(pmetric.MetricTypeGauge, "new/metric1", "label1").
+				addDoubleDatapoint(1, 2, 4, "label1-value1").build(),
+		},
+	},
+	{
+		name: "metric_label_aggregation_histogram_with_different_bounds",
+		transforms: []internalTransform{
+			{
+				MetricIncludeFilter: internalFilterStrict{include: "metric1"},
+				Action:              Update,
+				Operations: []internalOperation{
+					{
+						configOperation: &operation{
+							Action:          aggregateLabels,
+							AggregationType: aggregateutil.Sum,
+							LabelSet:        []string{"label1"},
+						},
+						labelSetMap: map[string]bool{"label1": true},
+					},
+				},
+			},
+		},
+		in: []pmetric.Metric{
+			metricBuilder(pmetric.MetricTypeHistogram, "metric1", "label1", "label2").
+				addHistogramDatapoint(1, 2, 6, 10, []float64{1, 5, 10}, []uint64{1, 2, 3, 0}, "label1-value1", "label2-value1").
+				addHistogramDatapoint(1, 2, 6, 20, []float64{5, 10}, []uint64{4, 0, 2}, "label1-value1", "label2-value2").
+				build(),
+		},
+		out: []pmetric.Metric{
+			metricBuilder(pmetric.MetricTypeHistogram, "metric1", "label1").
+				addHistogramDatapoint(1, 2, 12, 30, []float64{5, 10}, []uint64{7, 3, 2}, "label1-value1").
+				build(),
+		},
+	},
+	{
+		name: "metric_label_aggregation_exp_histogram_with_different_scales",
+		transforms: []internalTransform{
+			{
+				MetricIncludeFilter: internalFilterStrict{include: "metric1"},
+				Action:              Update,
+				Operations: []internalOperation{
+					{
+						configOperation: &operation{
+							Action:          aggregateLabels,
+							AggregationType: aggregateutil.Sum,
+							LabelSet:        []string{},
+						},
+						labelSetMap: map[string]bool{},
+					},
+				},
+			},
+		},
+		in: []pmetric.Metric{
+			metricBuilder(pmetric.MetricTypeExponentialHistogram, "metric1").
+				addExpHistogramDatapoint(expHistogramConfig{
+					count:          10,
+					sum:            10,
+					min:            1,
+					max:            5,
+					scale:          1,
+					positiveOffset: -1,
+					positiveCount:  []uint64{1, 2, 3, 4},
+				}).
+				addExpHistogramDatapoint(expHistogramConfig{
+					count:          5,
+					sum:            5,
+					min:            0.5,
+					max:            4,
+					scale:          0,
+					positiveOffset: 0,
+					positiveCount:  []uint64{5},
+				}).build(),
+		},
+		out: []pmetric.Metric{
+			metricBuilder(pmetric.MetricTypeExponentialHistogram, "metric1").
+				addExpHistogramDatapoint(expHistogramConfig{
+					count:          15,
+					sum:            15,
+					min:            0.5,
+					max:            5,
+					scale:          0,
+					positiveOffset: -1,
+					positiveCount:  []uint64{1, 10, 4},
+				}).build(),
+		},
+	},
+}
+
+func newTestConditions(conditions ...string) dataPointConditions {
+	boolExpr, err := filterottl.NewBoolExprForDataPoint(conditions, filterottl.StandardDataPointFuncs(), ottl.IgnoreError, componenttest.NewNopTelemetrySettings())
+	if err != nil {
+		panic(err)
+	}
+	return dataPointConditions{conditions: boolExpr}
 }
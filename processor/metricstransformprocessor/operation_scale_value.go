@@ -30,7 +30,7 @@ func scaleValueOp(metric pmetric.Metric, op *internalOperation, f internalFilter
 
 	for i := 0; i < dps.Len(); i++ {
 		dp := dps.At(i)
-		if !f.matchAttrs(dp.Attributes()) {
+		if !f.matchDataPoint(metric, dp) {
 			continue
 		}
 		switch dp.ValueType() {
@@ -47,7 +47,7 @@ func scaleHistogramOp(metric pmetric.Metric, op *internalOperation, f internalFi
 
 	for i := 0; i < dps.Len(); i++ {
 		dp := dps.At(i)
-		if !f.matchAttrs(dp.Attributes()) {
+		if !f.matchDataPoint(metric, dp) {
 			continue
 		}
 
@@ -73,7 +73,7 @@ func scaleExpHistogramOp(metric pmetric.Metric, op *internalOperation, f interna
 	dps := metric.ExponentialHistogram().DataPoints()
 	for i := 0; i < dps.Len(); i++ {
 		dp := dps.At(i)
-		if !f.matchAttrs(dp.Attributes()) {
+		if !f.matchDataPoint(metric, dp) {
 			continue
 		}
 
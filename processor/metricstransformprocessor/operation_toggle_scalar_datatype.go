@@ -22,7 +22,7 @@ func toggleScalarDataTypeOp(metric pmetric.Metric, f internalFilter) {
 
 	for i := 0; i < dps.Len(); i++ {
 		dp := dps.At(i)
-		if !f.matchAttrs(dp.Attributes()) {
+		if !f.matchDataPoint(metric, dp) {
 			continue
 		}
 
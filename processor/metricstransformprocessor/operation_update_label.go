@@ -4,18 +4,18 @@
 package metricstransformprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/metricstransformprocessor"
 
 import (
-	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 )
 
 // updateLabelOp updates labels and label values in metric based on given operation
 func updateLabelOp(metric pmetric.Metric, mtpOp *internalOperation, f internalFilter) {
 	op := mtpOp.configOperation
-	rangeDataPointAttributes(metric, func(attrs pcommon.Map) bool {
-		if !f.matchAttrs(attrs) {
+	rangeDataPoints(metric, func(dp any) bool {
+		if !f.matchDataPoint(metric, dp) {
 			return true
 		}
 
+		attrs := dataPointAttributes(dp)
 		attrKey := op.Label
 		attrVal, ok := attrs.Get(attrKey)
 		if !ok {
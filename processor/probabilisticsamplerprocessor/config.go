@@ -93,6 +93,11 @@ type Config struct {
 
 	// SamplingPriority (logs only) enables using a log record attribute as the sampling priority of the log record.
 	SamplingPriority string `mapstructure:"sampling_priority"`
+
+	// TracestateAttribute (logs only) The optional name of a log record attribute holding the W3C tracestate of the
+	// span that produced the log record.  In the equalizing and proportional modes, log records without their own
+	// sampling threshold inherit the OpenTelemetry sampling threshold and randomness of the span.
+	TracestateAttribute string `mapstructure:"tracestate_attribute"`
 }
 
 var _ component.Config = (*Config)(nil)
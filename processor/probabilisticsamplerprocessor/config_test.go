@@ -36,14 +36,15 @@ func TestLoadConfig(t *testing.T) {
 		{
 			id: component.NewIDWithName(metadata.Type, "logs"),
 			expected: &Config{
-				SamplingPercentage: 15.3,
-				SamplingPrecision:  defaultPrecision,
-				HashSeed:           22,
-				Mode:               "",
-				AttributeSource:    "record",
-				FromAttribute:      "foo",
-				SamplingPriority:   "bar",
-				FailClosed:         true,
+				SamplingPercentage:  15.3,
+				SamplingPrecision:   defaultPrecision,
+				HashSeed:            22,
+				Mode:                "",
+				AttributeSource:     "record",
+				FromAttribute:       "foo",
+				SamplingPriority:    "bar",
+				TracestateAttribute: "baz",
+				FailClosed:          true,
 			},
 		},
 	}
@@ -17,6 +17,13 @@ func computeHash(b []byte, seed uint32) uint32 {
 	return hash.Sum32()
 }
 
+// computeHash64 creates a 64-bit hash using the FNV-1a algorithm
+func computeHash64(b []byte) uint64 {
+	hash := fnv.New64a()
+	_, _ = hash.Write(b)
+	return hash.Sum64()
+}
+
 // i32tob converts a seed to a byte array to be used as part of fnv.Write()
 func i32tob(val uint32) []byte {
 	r := make([]byte, 4)
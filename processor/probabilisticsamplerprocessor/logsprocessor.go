@@ -50,7 +50,13 @@ func (rc *recordCarrier) get(key string) string {
 	return val.Str()
 }
 
-func newLogRecordCarrier(l plog.LogRecord) (samplingCarrier, error) {
+// newLogRecordCarrier parses the sampling.threshold and
+// sampling.randomness attributes of the log record.  When
+// tracestateAttribute is set and names an attribute holding the W3C
+// tracestate of the span that produced the log record, the OpenTelemetry
+// threshold and randomness values of the span are used in place of the
+// missing attributes.
+func newLogRecordCarrier(l plog.LogRecord, tracestateAttribute string) (samplingCarrier, error) {
 	var ret error
 	carrier := &recordCarrier{
 		record: l,
@@ -73,6 +79,24 @@ func newLogRecordCarrier(l plog.LogRecord) (samplingCarrier, error) {
 			carrier.parsed.randomness = rnd
 		}
 	}
+	if tracestateAttribute == "" {
+		return carrier, ret
+	}
+	if raw := carrier.get(tracestateAttribute); raw != "" {
+		w3c, err := sampling.NewW3CTraceState(raw)
+		if err != nil {
+			return carrier, errors.Join(err, ret)
+		}
+		otts := w3c.OTelValue()
+		if th, has := otts.TValueThreshold(); has && carrier.parsed.tvalue == "" {
+			carrier.parsed.tvalue = otts.TValue()
+			carrier.parsed.threshold = th
+		}
+		if rnd, has := otts.RValueRandomness(); has && carrier.parsed.rvalue == "" {
+			carrier.parsed.rvalue = otts.RValue()
+			carrier.parsed.randomness = rnd
+		}
+	}
 	return carrier, ret
 }
 
@@ -115,7 +139,7 @@ func (*recordCarrier) reserialize() error {
 func (*neverSampler) randomnessFromLogRecord(logRec plog.LogRecord) (randomnessNamer, samplingCarrier, error) {
 	// We return a fake randomness value, since it will not be used.
 	// This avoids a consistency check error for missing randomness.
-	lrc, err := newLogRecordCarrier(logRec)
+	lrc, err := newLogRecordCarrier(logRec, "")
 	return newSamplingPriorityMethod(sampling.AllProbabilitiesRandomness), lrc, err
 }
 
@@ -123,7 +147,7 @@ func (*neverSampler) randomnessFromLogRecord(logRec plog.LogRecord) (randomnessN
 // the TraceID or logs attribute source.
 func (th *hashingSampler) randomnessFromLogRecord(logRec plog.LogRecord) (randomnessNamer, samplingCarrier, error) {
 	rnd := newMissingRandomnessMethod()
-	lrc, err := newLogRecordCarrier(logRec)
+	lrc, err := newLogRecordCarrier(logRec, "")
 
 	if th.logsTraceIDEnabled {
 		value := logRec.TraceID()
@@ -165,24 +189,52 @@ func (th *hashingSampler) randomnessFromLogRecord(logRec plog.LogRecord) (random
 	return rnd, lrc, err
 }
 
-// randomnessFromLogRecord (hashingSampler) uses OTEP 235 semantic
-// conventions basing its decision only on the TraceID.
-func (*consistentTracestateCommon) randomnessFromLogRecord(logRec plog.LogRecord) (randomnessNamer, samplingCarrier, error) {
-	lrc, err := newLogRecordCarrier(logRec)
+// randomnessFromLogRecord (consistentTracestateCommon) uses OTEP 235
+// semantic conventions.  Randomness is taken from the record's explicit
+// randomness, including the randomness of the span's tracestate when
+// inherited, then from the TraceID, then from the logs attribute
+// source.  Randomness derived from the attribute source is recorded in
+// the log record, so that subsequent sampling stages make consistent
+// decisions.
+func (tc *consistentTracestateCommon) randomnessFromLogRecord(logRec plog.LogRecord) (randomnessNamer, samplingCarrier, error) {
+	lrc, err := newLogRecordCarrier(logRec, tc.logsTracestateAttribute)
 	rnd := newMissingRandomnessMethod()
 
 	if err != nil {
-		// Parse error in sampling.randomness or sampling.threshold
+		// Parse error in sampling.randomness, sampling.threshold,
+		// or the inherited tracestate.
 		lrc = nil
 	} else if rv, hasRnd := lrc.explicitRandomness(); hasRnd {
 		rnd = rv
-	} else if tid := logRec.TraceID(); !tid.IsEmpty() {
+	} else if tid := logRec.TraceID(); tc.logsTraceIDEnabled && !tid.IsEmpty() {
+		rnd = newTraceIDW3CSpecMethod(sampling.TraceIDToRandomness(tid))
+	} else if keyRnd, hasKey := tc.randomnessFromKeyAttribute(logRec); hasKey {
+		rnd = keyRnd
+		lrc.setExplicitRandomness(rnd)
+	} else if !tid.IsEmpty() {
 		rnd = newTraceIDW3CSpecMethod(sampling.TraceIDToRandomness(tid))
 	}
 
 	return rnd, lrc, err
 }
 
+// randomnessFromKeyAttribute hashes the value of the logs attribute
+// source, when it is configured and present in the log record.
+func (tc *consistentTracestateCommon) randomnessFromKeyAttribute(logRec plog.LogRecord) (randomnessNamer, bool) {
+	if tc.logsRandomnessSourceAttribute == "" {
+		return newMissingRandomnessMethod(), false
+	}
+	value, ok := logRec.Attributes().Get(tc.logsRandomnessSourceAttribute)
+	if !ok {
+		return newMissingRandomnessMethod(), false
+	}
+	by := getBytesFromValue(value)
+	if len(by) == 0 {
+		return newMissingRandomnessMethod(), false
+	}
+	return newAttributeHashingMethod(tc.logsRandomnessSourceAttribute, randomnessFromKey(by)), true
+}
+
 // newLogsProcessor returns a processor.LogsProcessor that will perform head sampling according to the given
 // configuration.
 func newLogsProcessor(ctx context.Context, set processor.Settings, nextConsumer consumer.Logs, cfg *Config) (processor.Logs, error) {
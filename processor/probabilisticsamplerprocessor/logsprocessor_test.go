@@ -416,6 +416,100 @@ func TestLogsSamplingState(t *testing.T) {
 			},
 			log: "cannot raise existing sampling probability",
 		},
+		{
+			name: "25 percent inherited from tracestate equalizing",
+			cfg: &Config{
+				SamplingPercentage:  50,
+				AttributeSource:     traceIDAttributeSource,
+				Mode:                Equalizing,
+				TracestateAttribute: "span.tracestate",
+			},
+			tid: mustParseTID("fefefefefefefefefee0000000000000"),
+			attrs: map[string]any{
+				"span.tracestate": "ot=th:c", // Corresponds with 25%
+			},
+			sampled:  true,
+			adjCount: 4,
+			expect: map[string]any{
+				"span.tracestate":    "ot=th:c",
+				"sampling.threshold": "c",
+			},
+		},
+		{
+			name: "50 percent inherited from tracestate proportional",
+			cfg: &Config{
+				SamplingPercentage:  50,
+				AttributeSource:     traceIDAttributeSource,
+				Mode:                Proportional,
+				TracestateAttribute: "span.tracestate",
+			},
+			attrs: map[string]any{
+				// Without a TraceID, the randomness of the span is used.
+				"span.tracestate": "ot=th:8;rv:f0000000000000,vendor=value",
+			},
+			sampled:  true,
+			adjCount: 4,
+			expect: map[string]any{
+				"span.tracestate":    "ot=th:8;rv:f0000000000000,vendor=value",
+				"sampling.threshold": "c",
+			},
+		},
+		{
+			name: "25 percent inherited from tracestate inconsistent",
+			cfg: &Config{
+				SamplingPercentage:  50,
+				AttributeSource:     traceIDAttributeSource,
+				Mode:                Equalizing,
+				FailClosed:          true,
+				TracestateAttribute: "span.tracestate",
+			},
+			tid: defaultTID,
+			attrs: map[string]any{
+				"span.tracestate": "ot=th:c",
+			},
+			log:     "inconsistent arriving threshold",
+			sampled: false,
+		},
+		{
+			name: "sampling threshold attribute preferred over tracestate",
+			cfg: &Config{
+				SamplingPercentage:  100,
+				AttributeSource:     traceIDAttributeSource,
+				Mode:                Equalizing,
+				TracestateAttribute: "span.tracestate",
+			},
+			tid: mustParseTID("fefefefefefefefefee0000000000000"),
+			attrs: map[string]any{
+				"sampling.threshold": "8",
+				"span.tracestate":    "ot=th:c",
+			},
+			sampled:  true,
+			adjCount: 2,
+			expect: map[string]any{
+				"sampling.threshold": "8",
+				"span.tracestate":    "ot=th:c",
+			},
+		},
+		{
+			name: "100 percent attribute proportional",
+			cfg: &Config{
+				SamplingPercentage: 100,
+				AttributeSource:    recordAttributeSource,
+				FromAttribute:      "log.id",
+				Mode:               Proportional,
+			},
+			tid: defaultTID,
+			attrs: map[string]any{
+				"log.id": "abc",
+			},
+			sampled:  true,
+			adjCount: 1,
+			expect: map[string]any{
+				"log.id":              "abc",
+				"sampling.randomness": randomnessFromKey([]byte("abc")).RValue(),
+				"sampling.threshold":  "0",
+			},
+		},
 		{
 			name: "hash_seed with spec randomness",
 			cfg: &Config{
@@ -246,8 +246,18 @@ func (th *hashingSampler) decide(_ samplingCarrier) sampling.Threshold {
 
 // consistentTracestateCommon contains the common aspects of the
 // Proportional and Equalizing sampler modes.  These samplers sample
-// using the TraceID and do not support use of logs source attribute.
-type consistentTracestateCommon struct{}
+// using the TraceID or, for logs, a consistent hash of the logs
+// source attribute.
+type consistentTracestateCommon struct {
+	// Logs only: name of attribute to obtain randomness
+	logsRandomnessSourceAttribute string
+
+	// Logs only: whether traceID is preferred over the attribute
+	logsTraceIDEnabled bool
+
+	// Logs only: name of attribute holding the span's tracestate
+	logsTracestateAttribute string
+}
 
 // neverSampler always decides false.
 type neverSampler struct{}
@@ -347,6 +357,15 @@ func randomnessFromBytes(b []byte, hashSeed uint32) sampling.Randomness {
 	return rnd
 }
 
+// randomnessFromKey computes 56 bits of randomness from an arbitrary
+// key using the 64-bit FNV-1a hash function.  Unlike randomnessFromBytes,
+// all 56 bits are used, so that the key can be used with the
+// consistent sampler modes at any precision.
+func randomnessFromKey(b []byte) sampling.Randomness {
+	rnd, _ := sampling.UnsignedToRandomness(computeHash64(b) & (sampling.MaxAdjustedCount - 1))
+	return rnd
+}
+
 func consistencyCheck(rnd randomnessNamer, carrier samplingCarrier) error {
 	// Without randomness, do not check the threshold.
 	if isMissing(rnd) {
@@ -408,6 +427,12 @@ func makeSampler(cfg *Config, isLogs bool) dataSampler {
 		ratio = sampling.MinSamplingProbability
 	}
 
+	common := consistentTracestateCommon{
+		logsRandomnessSourceAttribute: cfg.FromAttribute,
+		logsTraceIDEnabled:            cfg.AttributeSource != recordAttributeSource,
+		logsTracestateAttribute:       cfg.TracestateAttribute,
+	}
+
 	switch mode {
 	case Equalizing:
 		// The error case below is ignored, we have rounded the probability so
@@ -415,13 +440,15 @@ func makeSampler(cfg *Config, isLogs bool) dataSampler {
 		threshold, _ := sampling.ProbabilityToThresholdWithPrecision(ratio, cfg.SamplingPrecision)
 
 		return &equalizingSampler{
-			tvalueThreshold: threshold,
+			tvalueThreshold:            threshold,
+			consistentTracestateCommon: common,
 		}
 
 	case Proportional:
 		return &proportionalSampler{
-			ratio:     ratio,
-			precision: cfg.SamplingPrecision,
+			ratio:                      ratio,
+			precision:                  cfg.SamplingPrecision,
+			consistentTracestateCommon: common,
 		}
 
 	default: // i.e., HashSeed
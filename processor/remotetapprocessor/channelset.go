@@ -5,47 +5,51 @@ package remotetapprocessor // import "github.com/open-telemetry/opentelemetry-co
 
 import "sync"
 
-// channelSet is a collection of byte channels where adding, removing, and writing to
-// the channels is synchronized.
+// channelSet is a collection of client sessions where adding, removing, and writing to
+// the sessions' channels is synchronized.
 type channelSet struct {
 	i       int
 	mu      sync.RWMutex
-	chanmap map[int]chan []byte
+	chanmap map[int]*session
 }
 
 func newChannelSet() *channelSet {
 	return &channelSet{
-		chanmap: map[int]chan []byte{},
+		chanmap: map[int]*session{},
 	}
 }
 
-// add adds the channel to the channelSet and returns a key (just an int) used to
-// remove the channel later.
-func (c *channelSet) add(ch chan []byte) int {
+// add adds the session to the channelSet and returns a key (just an int) used to
+// remove the session later.
+func (c *channelSet) add(s *session) int {
 	c.mu.Lock()
 	idx := c.i
-	c.chanmap[idx] = ch
+	c.chanmap[idx] = s
 	c.i++
 	c.mu.Unlock()
 	return idx
 }
 
-// writeBytes writes the passed in bytes to all of the channels in the
-// channelSet.
-func (c *channelSet) writeBytes(bytes []byte) {
+// write writes the bytes returned by payload for each session to that session.
+// Sessions for which payload returns no bytes are skipped.
+func (c *channelSet) write(payload func(s *session) []byte) {
 	c.mu.RLock()
-	for _, ch := range c.chanmap {
-		ch <- bytes
+	for _, s := range c.chanmap {
+		if bytes := payload(s); len(bytes) > 0 {
+			s.send(bytes)
+		}
 	}
 	c.mu.RUnlock()
 }
 
-// closeAndRemove closes then removes the channel associated with the passed in
-// key. Panics if an invalid key is passed in.
+// closeAndRemove closes then removes the session associated with the passed in
+// key. Keys that were already removed, e.g. by shutdown, are ignored.
 func (c *channelSet) closeAndRemove(key int) {
 	c.mu.Lock()
-	close(c.chanmap[key])
-	delete(c.chanmap, key)
+	if s, ok := c.chanmap[key]; ok {
+		close(s.ch)
+		delete(c.chanmap, key)
+	}
 	c.mu.Unlock()
 }
 
@@ -62,8 +66,8 @@ func (c *channelSet) shutdown() {
 		i++
 	}
 
-	for key := range keys {
-		close(c.chanmap[key])
+	for _, key := range keys {
+		close(c.chanmap[key].ch)
 		delete(c.chanmap, key)
 	}
 }
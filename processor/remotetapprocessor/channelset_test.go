@@ -8,17 +8,45 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"golang.org/x/time/rate"
 )
 
 func TestChannelset(t *testing.T) {
 	cs := newChannelSet()
 	ch := make(chan []byte)
-	key := cs.add(ch)
+	key := cs.add(newSession(ch))
 	go func() {
-		cs.writeBytes([]byte("hello"))
+		cs.write(func(*session) []byte { return []byte("hello") })
 	}()
 	assert.Eventually(t, func() bool {
 		return assert.Equal(t, []byte("hello"), <-ch)
 	}, time.Second, time.Millisecond*10)
 	cs.closeAndRemove(key)
 }
+
+func TestChannelsetPerSessionPayload(t *testing.T) {
+	cs := newChannelSet()
+	chA := make(chan []byte, 1)
+	chB := make(chan []byte, 1)
+	a := newSession(chA)
+	b := newSession(chB)
+	cs.add(a)
+	cs.add(b)
+
+	cs.write(func(s *session) []byte {
+		if s == a {
+			return []byte("a")
+		}
+		return nil
+	})
+	assert.Equal(t, []byte("a"), <-chA)
+	assert.Empty(t, chB)
+
+	// A payload larger than the session's budget is dropped.
+	b.limiter = rate.NewLimiter(4, 4)
+	cs.write(func(*session) []byte { return []byte("hello") })
+	assert.Equal(t, []byte("hello"), <-chA)
+	assert.Empty(t, chB)
+
+	cs.shutdown()
+}
@@ -4,13 +4,18 @@
 package remotetapprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/remotetapprocessor"
 
 import (
+	"errors"
+
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config/confighttp"
 	"go.opentelemetry.io/collector/config/confignet"
 	"golang.org/x/time/rate"
 )
 
-const defaultEndpoint = "localhost:12001"
+const (
+	defaultEndpoint          = "localhost:12001"
+	defaultMaxBytesPerSecond = 1024 * 1024
+)
 
 type Config struct {
 	confighttp.ServerConfig `mapstructure:",squash"` // squash ensures fields are correctly decoded in embedded struct
@@ -19,6 +24,11 @@ type Config struct {
 	// through the websocket by this processor in messages per second. Defaults to 1.
 	Limit rate.Limit `mapstructure:"limit"`
 
+	// MaxBytesPerSecond is the maximum number of bytes sent to each client per second.
+	// Data that would exceed it is dropped for that client. Clients may request a lower
+	// limit. Zero means no limit. Defaults to 1 MiB.
+	MaxBytesPerSecond int `mapstructure:"max_bytes_per_second"`
+
 	// prevent unkeyed literal initialization
 	_ struct{}
 }
@@ -28,7 +38,15 @@ func createDefaultConfig() component.Config {
 	netAddr.Transport = confignet.TransportTypeTCP
 	netAddr.Endpoint = defaultEndpoint
 	return &Config{
-		ServerConfig: confighttp.ServerConfig{NetAddr: netAddr},
-		Limit:        1,
+		ServerConfig:      confighttp.ServerConfig{NetAddr: netAddr},
+		Limit:             1,
+		MaxBytesPerSecond: defaultMaxBytesPerSecond,
+	}
+}
+
+func (cfg *Config) Validate() error {
+	if cfg.MaxBytesPerSecond < 0 {
+		return errors.New("max_bytes_per_second must not be negative")
 	}
+	return nil
 }
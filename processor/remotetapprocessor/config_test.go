@@ -13,4 +13,12 @@ func TestCreateDefaultConfig(t *testing.T) {
 	cfg := createDefaultConfig().(*Config)
 	assert.Equal(t, "localhost:12001", cfg.NetAddr.Endpoint)
 	assert.EqualValues(t, 1, cfg.Limit)
+	assert.Equal(t, 1024*1024, cfg.MaxBytesPerSecond)
+}
+
+func TestConfigValidate(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	assert.NoError(t, cfg.Validate())
+	cfg.MaxBytesPerSecond = -1
+	assert.EqualError(t, cfg.Validate(), "max_bytes_per_second must not be negative")
 }
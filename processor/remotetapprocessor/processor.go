@@ -23,6 +23,8 @@ import (
 	"golang.org/x/time/rate"
 )
 
+const sseEndpoint = "/sse"
+
 type wsprocessor struct {
 	config            *Config
 	telemetrySettings component.TelemetrySettings
@@ -30,6 +32,10 @@ type wsprocessor struct {
 	shutdownWG        sync.WaitGroup
 	cs                *channelSet
 	limiter           *rate.Limiter
+	// stopCh is closed on shutdown to end streaming responses, which the server
+	// would otherwise wait for.
+	stopCh   chan struct{}
+	stopOnce sync.Once
 }
 
 var (
@@ -44,6 +50,7 @@ func newProcessor(settings processor.Settings, config *Config) *wsprocessor {
 		telemetrySettings: settings.TelemetrySettings,
 		cs:                newChannelSet(),
 		limiter:           rate.NewLimiter(config.Limit, int(config.Limit)),
+		stopCh:            make(chan struct{}),
 	}
 }
 
@@ -54,7 +61,10 @@ func (w *wsprocessor) Start(ctx context.Context, host component.Host) error {
 	if err != nil {
 		return fmt.Errorf("failed to bind to address %s: %w", w.config.NetAddr.Endpoint, err)
 	}
-	w.server, err = w.config.ToServer(ctx, host.GetExtensions(), w.telemetrySettings, websocket.Server{Handler: w.handleConn})
+	mux := http.NewServeMux()
+	mux.HandleFunc(sseEndpoint, w.handleSSE)
+	mux.Handle("/", websocket.Server{Handler: w.handleConn})
+	w.server, err = w.config.ToServer(ctx, host.GetExtensions(), w.telemetrySettings, mux)
 	if err != nil {
 		return err
 	}
@@ -73,20 +83,82 @@ func (w *wsprocessor) handleConn(conn *websocket.Conn) {
 		return
 	}
 	ch := make(chan []byte)
-	idx := w.cs.add(ch)
+	s, err := newSessionFromQuery(ch, conn.Request().URL.Query(), w.config.MaxBytesPerSecond, w.telemetrySettings)
+	if err != nil {
+		w.telemetrySettings.Logger.Debug("Invalid session parameters", zap.Error(err))
+		_, _ = conn.Write([]byte(err.Error()))
+		return
+	}
+	idx := w.cs.add(s)
 	for bytes := range ch {
 		_, err := conn.Write(bytes)
 		if err != nil {
 			w.telemetrySettings.Logger.Debug("websocket write error: %w", zap.Error(err))
-			w.cs.closeAndRemove(idx)
+			w.removeSession(idx, ch)
 			break
 		}
 	}
 }
 
+// handleSSE streams the data of a session as server-sent events.
+func (w *wsprocessor) handleSSE(rw http.ResponseWriter, req *http.Request) {
+	flusher, ok := rw.(http.Flusher)
+	if !ok {
+		http.Error(rw, "streaming unsupported", http.StatusInternalServerError)
+		return
+	}
+	ch := make(chan []byte)
+	s, err := newSessionFromQuery(ch, req.URL.Query(), w.config.MaxBytesPerSecond, w.telemetrySettings)
+	if err != nil {
+		http.Error(rw, err.Error(), http.StatusBadRequest)
+		return
+	}
+	rw.Header().Set("Content-Type", "text/event-stream")
+	rw.Header().Set("Cache-Control", "no-cache")
+	rw.Header().Set("Connection", "keep-alive")
+	rw.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	idx := w.cs.add(s)
+	for {
+		select {
+		case bytes, ok := <-ch:
+			if !ok {
+				return
+			}
+			if _, err := fmt.Fprintf(rw, "data: %s\n\n", bytes); err != nil {
+				w.telemetrySettings.Logger.Debug("sse write error", zap.Error(err))
+				w.removeSession(idx, ch)
+				return
+			}
+			flusher.Flush()
+		case <-req.Context().Done():
+			w.removeSession(idx, ch)
+			return
+		case <-w.stopCh:
+			w.removeSession(idx, ch)
+			return
+		}
+	}
+}
+
+// removeSession removes the session while draining its channel, so that a write in
+// progress to the session does not block the removal.
+func (w *wsprocessor) removeSession(idx int, ch chan []byte) {
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for range ch { // drain until closed
+		}
+	}()
+	w.cs.closeAndRemove(idx)
+	<-done
+}
+
 func (w *wsprocessor) Shutdown(ctx context.Context) error {
 	var err error
 
+	w.stopOnce.Do(func() { close(w.stopCh) })
 	if w.server != nil {
 		err = w.server.Shutdown(ctx)
 		w.shutdownWG.Wait()
@@ -101,41 +173,70 @@ func (w *wsprocessor) Shutdown(ctx context.Context) error {
 	return err
 }
 
-func (w *wsprocessor) ConsumeMetrics(_ context.Context, md pmetric.Metrics) (pmetric.Metrics, error) {
+func (w *wsprocessor) ConsumeMetrics(ctx context.Context, md pmetric.Metrics) (pmetric.Metrics, error) {
 	if w.limiter.Allow() {
-		b, err := metricMarshaler.MarshalMetrics(md)
-		if err != nil {
-			w.telemetrySettings.Logger.Debug("Error serializing to JSON", zap.Error(err))
-		} else {
-			w.cs.writeBytes(b)
-		}
+		all := sync.OnceValue(func() []byte {
+			return w.marshal(metricMarshaler.MarshalMetrics(md))
+		})
+		w.cs.write(func(s *session) []byte {
+			if !s.filtering() {
+				return all()
+			}
+			filtered := s.filterMetrics(ctx, md)
+			if filtered.MetricCount() == 0 {
+				return nil
+			}
+			return w.marshal(metricMarshaler.MarshalMetrics(filtered))
+		})
 	}
 
 	return md, nil
 }
 
-func (w *wsprocessor) ConsumeLogs(_ context.Context, ld plog.Logs) (plog.Logs, error) {
+func (w *wsprocessor) ConsumeLogs(ctx context.Context, ld plog.Logs) (plog.Logs, error) {
 	if w.limiter.Allow() {
-		b, err := logMarshaler.MarshalLogs(ld)
-		if err != nil {
-			w.telemetrySettings.Logger.Debug("Error serializing to JSON", zap.Error(err))
-		} else {
-			w.cs.writeBytes(b)
-		}
+		all := sync.OnceValue(func() []byte {
+			return w.marshal(logMarshaler.MarshalLogs(ld))
+		})
+		w.cs.write(func(s *session) []byte {
+			if !s.filtering() {
+				return all()
+			}
+			filtered := s.filterLogs(ctx, ld)
+			if filtered.LogRecordCount() == 0 {
+				return nil
+			}
+			return w.marshal(logMarshaler.MarshalLogs(filtered))
+		})
 	}
 
 	return ld, nil
 }
 
-func (w *wsprocessor) ConsumeTraces(_ context.Context, td ptrace.Traces) (ptrace.Traces, error) {
+func (w *wsprocessor) ConsumeTraces(ctx context.Context, td ptrace.Traces) (ptrace.Traces, error) {
 	if w.limiter.Allow() {
-		b, err := traceMarshaler.MarshalTraces(td)
-		if err != nil {
-			w.telemetrySettings.Logger.Debug("Error serializing to JSON", zap.Error(err))
-		} else {
-			w.cs.writeBytes(b)
-		}
+		all := sync.OnceValue(func() []byte {
+			return w.marshal(traceMarshaler.MarshalTraces(td))
+		})
+		w.cs.write(func(s *session) []byte {
+			if !s.filtering() {
+				return all()
+			}
+			filtered := s.filterTraces(ctx, td)
+			if filtered.SpanCount() == 0 {
+				return nil
+			}
+			return w.marshal(traceMarshaler.MarshalTraces(filtered))
+		})
 	}
 
 	return td, nil
 }
+
+func (w *wsprocessor) marshal(b []byte, err error) []byte {
+	if err != nil {
+		w.telemetrySettings.Logger.Debug("Error serializing to JSON", zap.Error(err))
+		return nil
+	}
+	return b
+}
@@ -44,7 +44,7 @@ func TestConsumeMetrics(t *testing.T) {
 			processor := newProcessor(processortest.NewNopSettings(metadata.Type), conf)
 
 			ch := make(chan []byte)
-			idx := processor.cs.add(ch)
+			idx := processor.cs.add(newSession(ch))
 			receiveNum := 0
 			wg := &sync.WaitGroup{}
 			wg.Go(func() {
@@ -93,7 +93,7 @@ func TestConsumeLogs(t *testing.T) {
 			processor := newProcessor(processortest.NewNopSettings(metadata.Type), conf)
 
 			ch := make(chan []byte)
-			idx := processor.cs.add(ch)
+			idx := processor.cs.add(newSession(ch))
 			receiveNum := 0
 			wg := &sync.WaitGroup{}
 			wg.Go(func() {
@@ -144,7 +144,7 @@ func TestConsumeTraces(t *testing.T) {
 			processor := newProcessor(processortest.NewNopSettings(metadata.Type), conf)
 
 			ch := make(chan []byte)
-			idx := processor.cs.add(ch)
+			idx := processor.cs.add(newSession(ch))
 			receiveNum := 0
 			wg := &sync.WaitGroup{}
 			wg.Go(func() {
@@ -166,3 +166,9 @@ func TestConsumeTraces(t *testing.T) {
 		})
 	}
 }
+
+func TestShutdownTwice(t *testing.T) {
+	processor := newProcessor(processortest.NewNopSettings(metadata.Type), createDefaultConfig().(*Config))
+	assert.NoError(t, processor.Shutdown(t.Context()))
+	assert.NoError(t, processor.Shutdown(t.Context()))
+}
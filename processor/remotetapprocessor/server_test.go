@@ -4,7 +4,11 @@
 package remotetapprocessor
 
 import (
+	"bufio"
 	"net"
+	"net/http"
+	"net/url"
+	"strings"
 	"testing"
 	"time"
 
@@ -169,3 +173,75 @@ func getWsProcessorUnderTesting(t *testing.T, cfg *Config) *wsprocessor {
 	require.NotNil(t, wp)
 	return wp.Unwrap().(*wsprocessor)
 }
+
+func TestSSEConnectionWithCondition(t *testing.T) {
+	cfg := &Config{
+		ServerConfig: confighttp.ServerConfig{
+			NetAddr: confignet.AddrConfig{
+				Transport: "tcp",
+				Endpoint:  "localhost:12004",
+			},
+		},
+		Limit: 10,
+	}
+	logSink := &consumertest.LogsSink{}
+	processor, err := NewFactory().CreateLogs(t.Context(), processortest.NewNopSettings(metadata.Type), cfg,
+		logSink)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		errProcessorShutdown := processor.Shutdown(t.Context())
+		require.NoError(t, errProcessorShutdown)
+	})
+	err = processor.Start(t.Context(), componenttest.NewNopHost())
+	require.NoError(t, err)
+
+	query := url.Values{}
+	query.Set("condition", `body == "bar"`)
+	//nolint:noctx // the request is bound to the processor lifetime
+	resp, err := http.Get("http://localhost:12004/sse?" + query.Encode())
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, resp.Body.Close())
+	})
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+
+	requireClientWaitingForData(t, cfg)
+	log := plog.NewLogs()
+	logRecords := log.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords()
+	logRecords.AppendEmpty().Body().SetStr("foo")
+	logRecords.AppendEmpty().Body().SetStr("bar")
+	err = processor.ConsumeLogs(t.Context(), log)
+	require.NoError(t, err)
+
+	line, err := bufio.NewReader(resp.Body).ReadString('\n')
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(line, "data: "))
+	require.JSONEq(t, `{"resourceLogs":[{"resource":{},"scopeLogs":[{"scope":{},"logRecords":[{"body":{"stringValue":"bar"}}]}]}]}`, strings.TrimSpace(strings.TrimPrefix(line, "data: ")))
+	assert.Equal(t, 2, logSink.LogRecordCount())
+}
+
+func TestSSEConnectionInvalidCondition(t *testing.T) {
+	cfg := &Config{
+		ServerConfig: confighttp.ServerConfig{
+			NetAddr: confignet.AddrConfig{
+				Transport: "tcp",
+				Endpoint:  "localhost:12005",
+			},
+		},
+		Limit: 1,
+	}
+	processor, err := NewFactory().CreateLogs(t.Context(), processortest.NewNopSettings(metadata.Type), cfg,
+		&consumertest.LogsSink{})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, processor.Shutdown(t.Context()))
+	})
+	require.NoError(t, processor.Start(t.Context(), componenttest.NewNopHost()))
+
+	//nolint:noctx // the request is bound to the processor lifetime
+	resp, err := http.Get("http://localhost:12005/sse?condition=" + url.QueryEscape("name =="))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
@@ -0,0 +1,206 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package remotetapprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/remotetapprocessor"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"net/url"
+	"strconv"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"golang.org/x/time/rate"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter/filterottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottllog"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlmetric"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlspan"
+)
+
+// Query parameters a client uses to configure its session.
+const (
+	conditionParam         = "condition"
+	sampleRateParam        = "sample_rate"
+	maxBytesPerSecondParam = "max_bytes_per_second"
+)
+
+// session is a single connected client. A session only receives the log records,
+// spans and metrics matching any of its OTTL conditions, sampled at its sample rate,
+// and never more bytes per second than its limiter allows.
+type session struct {
+	ch chan []byte
+
+	logCondition    *ottl.ConditionSequence[*ottllog.TransformContext]
+	spanCondition   *ottl.ConditionSequence[*ottlspan.TransformContext]
+	metricCondition *ottl.ConditionSequence[*ottlmetric.TransformContext]
+	hasConditions   bool
+	sampleRate      float64
+	limiter         *rate.Limiter
+}
+
+// newSession returns a session receiving all data written to the processor.
+func newSession(ch chan []byte) *session {
+	return &session{ch: ch, sampleRate: 1}
+}
+
+// newSessionFromQuery returns a session configured from the query parameters of the
+// client request. maxBytesPerSecond is the server limit, clients may only lower it.
+func newSessionFromQuery(ch chan []byte, query url.Values, maxBytesPerSecond int, set component.TelemetrySettings) (*session, error) {
+	s := newSession(ch)
+
+	if conditions := query[conditionParam]; len(conditions) > 0 {
+		// A condition may only be valid for some signals, e.g. one using severity_number
+		// only applies to logs. Signals the conditions cannot be parsed for receive nothing.
+		var errs error
+		var err error
+		if s.logCondition, err = filterottl.NewBoolExprForLog(conditions, filterottl.StandardLogFuncs(), ottl.IgnoreError, set); err != nil {
+			errs = errors.Join(errs, fmt.Errorf("logs: %w", err))
+		}
+		if s.spanCondition, err = filterottl.NewBoolExprForSpan(conditions, filterottl.StandardSpanFuncs(), ottl.IgnoreError, set); err != nil {
+			errs = errors.Join(errs, fmt.Errorf("traces: %w", err))
+		}
+		if s.metricCondition, err = filterottl.NewBoolExprForMetric(conditions, filterottl.StandardMetricFuncs(), ottl.IgnoreError, set); err != nil {
+			errs = errors.Join(errs, fmt.Errorf("metrics: %w", err))
+		}
+		if s.logCondition == nil && s.spanCondition == nil && s.metricCondition == nil {
+			return nil, fmt.Errorf("invalid %s: %w", conditionParam, errs)
+		}
+		s.hasConditions = true
+	}
+
+	if v := query.Get(sampleRateParam); v != "" {
+		sampleRate, err := strconv.ParseFloat(v, 64)
+		if err != nil || sampleRate <= 0 || sampleRate > 1 {
+			return nil, fmt.Errorf("invalid %s %q: must be a number in (0, 1]", sampleRateParam, v)
+		}
+		s.sampleRate = sampleRate
+	}
+
+	limit := maxBytesPerSecond
+	if v := query.Get(maxBytesPerSecondParam); v != "" {
+		requested, err := strconv.Atoi(v)
+		if err != nil || requested <= 0 {
+			return nil, fmt.Errorf("invalid %s %q: must be a positive integer", maxBytesPerSecondParam, v)
+		}
+		if limit == 0 || requested < limit {
+			limit = requested
+		}
+	}
+	if limit > 0 {
+		s.limiter = rate.NewLimiter(rate.Limit(limit), limit)
+	}
+	return s, nil
+}
+
+// filtering reports whether the session receives a subset of the data.
+func (s *session) filtering() bool {
+	return s.hasConditions || s.sampleRate < 1
+}
+
+// send writes b to the session unless it would exceed the session's bytes per second.
+// It reports whether b was sent. A message larger than the limiter's burst, i.e. than
+// the bytes per second, could never be allowed: it is sent only once the limiter is
+// full and consumes all its tokens, so that at most one such message is sent per second.
+func (s *session) send(b []byte) bool {
+	if s.limiter != nil && !s.limiter.AllowN(time.Now(), min(len(b), s.limiter.Burst())) {
+		return false
+	}
+	s.ch <- b
+	return true
+}
+
+func (s *session) sampled() bool {
+	return s.sampleRate >= 1 || rand.Float64() < s.sampleRate
+}
+
+// filterLogs returns a copy of ld holding only the log records selected by the session.
+func (s *session) filterLogs(ctx context.Context, ld plog.Logs) plog.Logs {
+	filtered := plog.NewLogs()
+	if s.hasConditions && s.logCondition == nil {
+		return filtered
+	}
+	ld.CopyTo(filtered)
+	filtered.ResourceLogs().RemoveIf(func(rl plog.ResourceLogs) bool {
+		rl.ScopeLogs().RemoveIf(func(sl plog.ScopeLogs) bool {
+			sl.LogRecords().RemoveIf(func(lr plog.LogRecord) bool {
+				if !s.sampled() {
+					return true
+				}
+				if !s.hasConditions {
+					return false
+				}
+				tCtx := ottllog.NewTransformContextPtr(rl, sl, lr)
+				matched, _ := s.logCondition.Eval(ctx, tCtx)
+				tCtx.Close()
+				return !matched
+			})
+			return sl.LogRecords().Len() == 0
+		})
+		return rl.ScopeLogs().Len() == 0
+	})
+	return filtered
+}
+
+// filterTraces returns a copy of td holding only the spans selected by the session.
+func (s *session) filterTraces(ctx context.Context, td ptrace.Traces) ptrace.Traces {
+	filtered := ptrace.NewTraces()
+	if s.hasConditions && s.spanCondition == nil {
+		return filtered
+	}
+	td.CopyTo(filtered)
+	filtered.ResourceSpans().RemoveIf(func(rs ptrace.ResourceSpans) bool {
+		rs.ScopeSpans().RemoveIf(func(ss ptrace.ScopeSpans) bool {
+			ss.Spans().RemoveIf(func(span ptrace.Span) bool {
+				if !s.sampled() {
+					return true
+				}
+				if !s.hasConditions {
+					return false
+				}
+				tCtx := ottlspan.NewTransformContextPtr(rs, ss, span)
+				matched, _ := s.spanCondition.Eval(ctx, tCtx)
+				tCtx.Close()
+				return !matched
+			})
+			return ss.Spans().Len() == 0
+		})
+		return rs.ScopeSpans().Len() == 0
+	})
+	return filtered
+}
+
+// filterMetrics returns a copy of md holding only the metrics selected by the session.
+func (s *session) filterMetrics(ctx context.Context, md pmetric.Metrics) pmetric.Metrics {
+	filtered := pmetric.NewMetrics()
+	if s.hasConditions && s.metricCondition == nil {
+		return filtered
+	}
+	md.CopyTo(filtered)
+	filtered.ResourceMetrics().RemoveIf(func(rm pmetric.ResourceMetrics) bool {
+		rm.ScopeMetrics().RemoveIf(func(sm pmetric.ScopeMetrics) bool {
+			sm.Metrics().RemoveIf(func(metric pmetric.Metric) bool {
+				if !s.sampled() {
+					return true
+				}
+				if !s.hasConditions {
+					return false
+				}
+				tCtx := ottlmetric.NewTransformContextPtr(rm, sm, metric)
+				matched, _ := s.metricCondition.Eval(ctx, tCtx)
+				tCtx.Close()
+				return !matched
+			})
+			return sm.Metrics().Len() == 0
+		})
+		return rm.ScopeMetrics().Len() == 0
+	})
+	return filtered
+}
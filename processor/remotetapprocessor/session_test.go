@@ -0,0 +1,183 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package remotetapprocessor
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"golang.org/x/time/rate"
+)
+
+func TestNewSessionFromQuery(t *testing.T) {
+	tests := []struct {
+		name              string
+		query             url.Values
+		maxBytesPerSecond int
+		wantErr           string
+		wantLimit         rate.Limit
+		wantSampleRate    float64
+		wantFiltering     bool
+	}{
+		{
+			name:           "no parameters",
+			query:          url.Values{},
+			wantSampleRate: 1,
+		},
+		{
+			name:              "server limit",
+			query:             url.Values{},
+			maxBytesPerSecond: 100,
+			wantSampleRate:    1,
+			wantLimit:         100,
+		},
+		{
+			name:              "client lowers limit",
+			query:             url.Values{maxBytesPerSecondParam: {"10"}},
+			maxBytesPerSecond: 100,
+			wantSampleRate:    1,
+			wantLimit:         10,
+		},
+		{
+			name:              "client cannot raise limit",
+			query:             url.Values{maxBytesPerSecondParam: {"1000"}},
+			maxBytesPerSecond: 100,
+			wantSampleRate:    1,
+			wantLimit:         100,
+		},
+		{
+			name:           "sample rate",
+			query:          url.Values{sampleRateParam: {"0.25"}},
+			wantSampleRate: 0.25,
+			wantFiltering:  true,
+		},
+		{
+			name:           "condition",
+			query:          url.Values{conditionParam: {`resource.attributes["service.name"] == "checkout"`}},
+			wantSampleRate: 1,
+			wantFiltering:  true,
+		},
+		{
+			name:    "invalid condition",
+			query:   url.Values{conditionParam: {"name =="}},
+			wantErr: "invalid condition",
+		},
+		{
+			name:    "invalid sample rate",
+			query:   url.Values{sampleRateParam: {"2"}},
+			wantErr: `invalid sample_rate "2"`,
+		},
+		{
+			name:    "invalid max bytes per second",
+			query:   url.Values{maxBytesPerSecondParam: {"-1"}},
+			wantErr: `invalid max_bytes_per_second "-1"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, err := newSessionFromQuery(make(chan []byte), tt.query, tt.maxBytesPerSecond, componenttest.NewNopTelemetrySettings())
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantSampleRate, s.sampleRate)
+			assert.Equal(t, tt.wantFiltering, s.filtering())
+			if tt.wantLimit == 0 {
+				assert.Nil(t, s.limiter)
+			} else {
+				assert.Equal(t, tt.wantLimit, s.limiter.Limit())
+			}
+		})
+	}
+}
+
+func TestSessionConditionForSomeSignals(t *testing.T) {
+	s, err := newSessionFromQuery(make(chan []byte), url.Values{conditionParam: {"severity_number >= SEVERITY_NUMBER_ERROR"}}, 0, componenttest.NewNopTelemetrySettings())
+	require.NoError(t, err)
+	assert.NotNil(t, s.logCondition)
+	assert.Nil(t, s.spanCondition)
+	assert.Nil(t, s.metricCondition)
+
+	td := ptrace.NewTraces()
+	td.ResourceSpans().AppendEmpty().ScopeSpans().AppendEmpty().Spans().AppendEmpty().SetName("span")
+	assert.Equal(t, 0, s.filterTraces(t.Context(), td).SpanCount())
+}
+
+func TestSessionFilterLogs(t *testing.T) {
+	s, err := newSessionFromQuery(make(chan []byte), url.Values{conditionParam: {`attributes["keep"] == true`}}, 0, componenttest.NewNopTelemetrySettings())
+	require.NoError(t, err)
+
+	ld := plog.NewLogs()
+	lrs := ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords()
+	lrs.AppendEmpty().Attributes().PutBool("keep", true)
+	lrs.AppendEmpty().Attributes().PutBool("keep", false)
+	ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords().AppendEmpty()
+
+	filtered := s.filterLogs(t.Context(), ld)
+	assert.Equal(t, 1, filtered.LogRecordCount())
+	assert.Equal(t, 1, filtered.ResourceLogs().Len())
+	assert.Equal(t, 3, ld.LogRecordCount(), "input must not be modified")
+}
+
+func TestSessionFilterTraces(t *testing.T) {
+	s, err := newSessionFromQuery(make(chan []byte), url.Values{conditionParam: {`name == "a"`, `name == "b"`}}, 0, componenttest.NewNopTelemetrySettings())
+	require.NoError(t, err)
+
+	td := ptrace.NewTraces()
+	spans := td.ResourceSpans().AppendEmpty().ScopeSpans().AppendEmpty().Spans()
+	spans.AppendEmpty().SetName("a")
+	spans.AppendEmpty().SetName("b")
+	spans.AppendEmpty().SetName("c")
+
+	assert.Equal(t, 2, s.filterTraces(t.Context(), td).SpanCount())
+}
+
+func TestSessionFilterMetrics(t *testing.T) {
+	s, err := newSessionFromQuery(make(chan []byte), url.Values{conditionParam: {`name == "keep"`}}, 0, componenttest.NewNopTelemetrySettings())
+	require.NoError(t, err)
+
+	md := pmetric.NewMetrics()
+	metrics := md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics()
+	metrics.AppendEmpty().SetName("keep")
+	metrics.AppendEmpty().SetName("drop")
+
+	filtered := s.filterMetrics(t.Context(), md)
+	require.Equal(t, 1, filtered.MetricCount())
+	assert.Equal(t, "keep", filtered.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Name())
+}
+
+func TestSessionSampleRate(t *testing.T) {
+	s := newSession(make(chan []byte))
+	s.sampleRate = 0.5
+
+	ld := plog.NewLogs()
+	lrs := ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords()
+	for range 1000 {
+		lrs.AppendEmpty()
+	}
+	count := s.filterLogs(t.Context(), ld).LogRecordCount()
+	assert.Greater(t, count, 350)
+	assert.Less(t, count, 650)
+}
+
+func TestSessionSendLargerThanLimit(t *testing.T) {
+	ch := make(chan []byte, 3)
+	s := newSession(ch)
+	s.limiter = rate.NewLimiter(10, 10)
+
+	large := make([]byte, 100)
+	// A message larger than the limit is sent while the limiter is full, and then
+	// dropped until the limiter is refilled.
+	assert.True(t, s.send(large))
+	assert.False(t, s.send(large))
+	assert.False(t, s.send([]byte("a")))
+	assert.Len(t, ch, 1)
+}
@@ -23,6 +23,7 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlprofile"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlspan"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlspanevent"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottltrace"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/transformprocessor/internal/common"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/transformprocessor/internal/logs"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/transformprocessor/internal/metadata"
@@ -39,12 +40,14 @@ type transformProcessorFactory struct {
 	metricFunctions                     map[string]ottl.Factory[*ottlmetric.TransformContext]
 	spanEventFunctions                  map[string]ottl.Factory[*ottlspanevent.TransformContext]
 	spanFunctions                       map[string]ottl.Factory[*ottlspan.TransformContext]
+	traceFunctions                      map[string]ottl.Factory[*ottltrace.TransformContext]
 	profileFunctions                    map[string]ottl.Factory[*ottlprofile.TransformContext]
 	defaultDataPointFunctionsOverridden bool
 	defaultLogFunctionsOverridden       bool
 	defaultMetricFunctionsOverridden    bool
 	defaultSpanEventFunctionsOverridden bool
 	defaultSpanFunctionsOverridden      bool
+	defaultTraceFunctionsOverridden     bool
 	defaultProfileFunctionsOverridden   bool
 }
 
@@ -156,6 +159,18 @@ func WithSpanFunctionsNew(spanFunctions []ottl.Factory[*ottlspan.TransformContex
 	}
 }
 
+// WithTraceFunctions will override the default OTTL trace context functions with the provided traceFunctions in the resulting processor.
+// Subsequent uses of WithTraceFunctions will merge the provided traceFunctions with the previously registered functions.
+func WithTraceFunctions(traceFunctions []ottl.Factory[*ottltrace.TransformContext]) FactoryOption {
+	return func(factory *transformProcessorFactory) {
+		if !factory.defaultTraceFunctionsOverridden {
+			factory.traceFunctions = map[string]ottl.Factory[*ottltrace.TransformContext]{}
+			factory.defaultTraceFunctionsOverridden = true
+		}
+		factory.traceFunctions = mergeFunctionsToMap(factory.traceFunctions, traceFunctions)
+	}
+}
+
 // Deprecated: [v0.145.0] use WithProfileFunctionsNew.
 func WithProfileFunctions(profileFunctions []ottl.Factory[ottlprofile.TransformContext]) FactoryOption {
 	newProfileFunctions := make([]ottl.Factory[*ottlprofile.TransformContext], 0, len(profileFunctions))
@@ -189,6 +204,7 @@ func NewFactoryWithOptions(options ...FactoryOption) processor.Factory {
 		metricFunctions:    defaultMetricFunctionsMap(),
 		spanEventFunctions: defaultSpanEventFunctionsMap(),
 		spanFunctions:      defaultSpanFunctionsMap(),
+		traceFunctions:     defaultTraceFunctionsMap(),
 		profileFunctions:   defaultProfileFunctionsMap(),
 	}
 	for _, o := range options {
@@ -217,6 +233,7 @@ func (f *transformProcessorFactory) createDefaultConfig() component.Config {
 		metricFunctions:    f.metricFunctions,
 		spanEventFunctions: f.spanEventFunctions,
 		spanFunctions:      f.spanFunctions,
+		traceFunctions:     f.traceFunctions,
 		profileFunctions:   f.profileFunctions,
 	}
 }
@@ -231,7 +248,11 @@ func (f *transformProcessorFactory) createLogsProcessor(
 	if f.defaultLogFunctionsOverridden {
 		set.Logger.Debug("non-default OTTL log functions have been registered in the \"transform\" processor", zap.Bool("log", f.defaultLogFunctionsOverridden))
 	}
-	proc, err := logs.NewProcessor(oCfg.LogStatements, oCfg.ErrorMode, oCfg.FlattenData, set.TelemetrySettings, f.logFunctions)
+	observer, err := newStatementObserver(set, oCfg)
+	if err != nil {
+		return nil, err
+	}
+	proc, err := logs.NewProcessor(oCfg.LogStatements, oCfg.ErrorMode, oCfg.FlattenData, set.TelemetrySettings, f.logFunctions, common.WithLogStatementObserver(observer))
 	if err != nil {
 		return nil, fmt.Errorf("invalid config for \"transform\" processor %w", err)
 	}
@@ -251,13 +272,18 @@ func (f *transformProcessorFactory) createTracesProcessor(
 	nextConsumer consumer.Traces,
 ) (processor.Traces, error) {
 	oCfg := cfg.(*Config)
-	if f.defaultSpanEventFunctionsOverridden || f.defaultSpanFunctionsOverridden {
+	if f.defaultSpanEventFunctionsOverridden || f.defaultSpanFunctionsOverridden || f.defaultTraceFunctionsOverridden {
 		set.Logger.Debug("non-default OTTL trace functions have been registered in the \"transform\" processor",
 			zap.Bool("span", f.defaultSpanFunctionsOverridden),
 			zap.Bool("spanevent", f.defaultSpanEventFunctionsOverridden),
+			zap.Bool("trace", f.defaultTraceFunctionsOverridden),
 		)
 	}
-	proc, err := traces.NewProcessor(oCfg.TraceStatements, oCfg.ErrorMode, set.TelemetrySettings, f.spanFunctions, f.spanEventFunctions)
+	observer, err := newStatementObserver(set, oCfg)
+	if err != nil {
+		return nil, err
+	}
+	proc, err := traces.NewProcessor(oCfg.TraceStatements, oCfg.ErrorMode, set.TelemetrySettings, f.spanFunctions, f.spanEventFunctions, f.traceFunctions, common.WithTraceStatementObserver(observer))
 	if err != nil {
 		return nil, fmt.Errorf("invalid config for \"transform\" processor %w", err)
 	}
@@ -284,7 +310,11 @@ func (f *transformProcessorFactory) createMetricsProcessor(
 			zap.Bool("metric", f.defaultMetricFunctionsOverridden),
 		)
 	}
-	proc, err := metrics.NewProcessor(oCfg.MetricStatements, oCfg.ErrorMode, set.TelemetrySettings, f.metricFunctions, f.dataPointFunctions)
+	observer, err := newStatementObserver(set, oCfg)
+	if err != nil {
+		return nil, err
+	}
+	proc, err := metrics.NewProcessor(oCfg.MetricStatements, oCfg.ErrorMode, set.TelemetrySettings, f.metricFunctions, f.dataPointFunctions, common.WithMetricStatementObserver(observer))
 	if err != nil {
 		return nil, fmt.Errorf("invalid config for \"transform\" processor %w", err)
 	}
@@ -309,7 +339,11 @@ func (f *transformProcessorFactory) createProfilesProcessor(
 	if f.defaultProfileFunctionsOverridden {
 		set.Logger.Debug("non-default OTTL profile functions have been registered in the \"transform\" processor", zap.Bool("profile", f.defaultProfileFunctionsOverridden))
 	}
-	proc, err := profiles.NewProcessor(oCfg.ProfileStatements, oCfg.ErrorMode, set.TelemetrySettings, f.profileFunctions)
+	observer, err := newStatementObserver(set, oCfg)
+	if err != nil {
+		return nil, err
+	}
+	proc, err := profiles.NewProcessor(oCfg.ProfileStatements, oCfg.ErrorMode, set.TelemetrySettings, f.profileFunctions, common.WithProfileStatementObserver(observer))
 	if err != nil {
 		return nil, fmt.Errorf("invalid config for \"transform\" processor %w", err)
 	}
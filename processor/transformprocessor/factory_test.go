@@ -49,6 +49,9 @@ func assertConfigContainsDefaultFunctions(t *testing.T, config Config) {
 	for _, f := range DefaultSpanEventFunctionsNew() {
 		assert.Contains(t, config.spanEventFunctions, f.Name(), "missing span event function %v", f.Name())
 	}
+	for _, f := range DefaultTraceFunctions() {
+		assert.Contains(t, config.traceFunctions, f.Name(), "missing trace function %v", f.Name())
+	}
 	for _, f := range DefaultProfileFunctions() {
 		assert.Contains(t, config.profileFunctions, f.Name(), "missing profile function %v", f.Name())
 	}
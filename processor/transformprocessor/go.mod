@@ -16,8 +16,8 @@ require (
 	go.opentelemetry.io/collector/featuregate v1.53.1-0.20260309153054-85fc1918516c
 	go.opentelemetry.io/collector/pdata v1.53.1-0.20260309153054-85fc1918516c
 	go.opentelemetry.io/collector/processor v1.53.1-0.20260309153054-85fc1918516c
-	go.opentelemetry.io/otel/metric v1.42.0 // indirect
-	go.opentelemetry.io/otel/trace v1.42.0 // indirect
+	go.opentelemetry.io/otel/metric v1.42.0
+	go.opentelemetry.io/otel/trace v1.42.0
 	go.uber.org/goleak v1.3.0
 	go.uber.org/multierr v1.11.0
 	go.uber.org/zap v1.27.1
@@ -34,6 +34,7 @@ require (
 	go.opentelemetry.io/collector/processor/processortest v0.147.1-0.20260309153054-85fc1918516c
 	go.opentelemetry.io/collector/processor/xprocessor v0.147.1-0.20260309153054-85fc1918516c
 	go.opentelemetry.io/otel v1.42.0
+	go.opentelemetry.io/otel/sdk/metric v1.41.0
 	golang.org/x/exp v0.0.0-20240506185415-9bf2ced13842
 )
 
@@ -80,7 +81,6 @@ require (
 	go.opentelemetry.io/collector/pdata/xpdata v0.147.1-0.20260309153054-85fc1918516c // indirect
 	go.opentelemetry.io/collector/pipeline v1.53.1-0.20260309153054-85fc1918516c // indirect
 	go.opentelemetry.io/otel/sdk v1.41.0 // indirect
-	go.opentelemetry.io/otel/sdk/metric v1.41.0 // indirect
 	go.yaml.in/yaml/v3 v3.0.4 // indirect
 	golang.org/x/net v0.51.0 // indirect
 	golang.org/x/sys v0.41.0 // indirect
@@ -262,7 +262,7 @@ func parseResourceContextStatements[R any](
 	if errGlobalBoolExpr != nil {
 		return *new(R), errGlobalBoolExpr
 	}
-	rStatements := ottlresource.NewStatementSequence(parsedStatements, pc.Settings, ottlresource.WithStatementSequenceErrorMode(errorMode))
+	rStatements := ottlresource.NewStatementSequence(parsedStatements, pc.Settings, ottlresource.WithStatementSequenceErrorMode(errorMode), ottlresource.WithStatementSequenceObserver(pc.StatementObserver))
 	result := baseContext(resourceStatements{rStatements, globalExpr})
 	return result.(R), nil
 }
@@ -288,7 +288,7 @@ func parseScopeContextStatements[R any](
 	if errGlobalBoolExpr != nil {
 		return *new(R), errGlobalBoolExpr
 	}
-	sStatements := ottlscope.NewStatementSequence(parsedStatements, pc.Settings, ottlscope.WithStatementSequenceErrorMode(errorMode))
+	sStatements := ottlscope.NewStatementSequence(parsedStatements, pc.Settings, ottlscope.WithStatementSequenceErrorMode(errorMode), ottlscope.WithStatementSequenceObserver(pc.StatementObserver))
 	result := baseContext(scopeStatements{sStatements, globalExpr})
 	return result.(R), nil
 }
@@ -72,6 +72,10 @@ func WithProfileErrorMode(errorMode ottl.ErrorMode) ProfileParserCollectionOptio
 	return ProfileParserCollectionOption(ottl.WithParserCollectionErrorMode[ProfilesConsumer](errorMode))
 }
 
+func WithProfileStatementObserver(observer ottl.StatementObserver) ProfileParserCollectionOption {
+	return ProfileParserCollectionOption(ottl.WithParserCollectionStatementObserver[ProfilesConsumer](observer))
+}
+
 func NewProfileParserCollection(settings component.TelemetrySettings, options ...ProfileParserCollectionOption) (*ProfileParserCollection, error) {
 	pcOptions := []ottl.ParserCollectionOption[ProfilesConsumer]{
 		withCommonContextParsers[ProfilesConsumer](),
@@ -108,7 +112,7 @@ func convertProfileStatements(pc *ottl.ParserCollection[ProfilesConsumer], state
 	if errGlobalBoolExpr != nil {
 		return nil, errGlobalBoolExpr
 	}
-	lStatements := ottlprofile.NewStatementSequence(parsedStatements, pc.Settings, ottlprofile.WithStatementSequenceErrorMode(errorMode))
+	lStatements := ottlprofile.NewStatementSequence(parsedStatements, pc.Settings, ottlprofile.WithStatementSequenceErrorMode(errorMode), ottlprofile.WithStatementSequenceObserver(pc.StatementObserver))
 	return profileStatements{lStatements, globalExpr}, nil
 }
 
@@ -14,6 +14,7 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlspan"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlspanevent"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottltrace"
 )
 
 type TracesConsumer interface {
@@ -97,6 +98,37 @@ func (s spanEventStatements) ConsumeTraces(ctx context.Context, td ptrace.Traces
 	return nil
 }
 
+type traceContextStatements struct {
+	ottl.StatementSequence[*ottltrace.TransformContext]
+	expr.BoolExpr[*ottltrace.TransformContext]
+}
+
+func (traceContextStatements) Context() ContextID {
+	return Trace
+}
+
+// ConsumeTraces executes the statements once for each group of spans sharing a trace ID.
+// Traces are only complete when the spans were grouped beforehand, e.g. by the groupbytrace processor.
+func (t traceContextStatements) ConsumeTraces(ctx context.Context, td ptrace.Traces) error {
+	for _, spans := range ottltrace.GroupSpansByTrace(td) {
+		tCtx := ottltrace.NewTransformContextPtr(spans)
+		condition, err := t.Eval(ctx, tCtx)
+		if err != nil {
+			tCtx.Close()
+			return err
+		}
+		if condition {
+			err = t.Execute(ctx, tCtx)
+			if err != nil {
+				tCtx.Close()
+				return err
+			}
+		}
+		tCtx.Close()
+	}
+	return nil
+}
+
 type TraceParserCollection ottl.ParserCollection[TracesConsumer]
 
 type TraceParserCollectionOption ottl.ParserCollectionOption[TracesConsumer]
@@ -121,10 +153,24 @@ func WithSpanEventParser(functions map[string]ottl.Factory[*ottlspanevent.Transf
 	}
 }
 
+func WithTraceParser(functions map[string]ottl.Factory[*ottltrace.TransformContext]) TraceParserCollectionOption {
+	return func(pc *ottl.ParserCollection[TracesConsumer]) error {
+		parser, err := ottltrace.NewParser(functions, pc.Settings, ottltrace.EnablePathContextNames())
+		if err != nil {
+			return err
+		}
+		return ottl.WithParserCollectionContext(ottltrace.ContextName, &parser, ottl.WithStatementConverter(convertTraceStatements))(pc)
+	}
+}
+
 func WithTraceErrorMode(errorMode ottl.ErrorMode) TraceParserCollectionOption {
 	return TraceParserCollectionOption(ottl.WithParserCollectionErrorMode[TracesConsumer](errorMode))
 }
 
+func WithTraceStatementObserver(observer ottl.StatementObserver) TraceParserCollectionOption {
+	return TraceParserCollectionOption(ottl.WithParserCollectionStatementObserver[TracesConsumer](observer))
+}
+
 func NewTraceParserCollection(settings component.TelemetrySettings, options ...TraceParserCollectionOption) (*TraceParserCollection, error) {
 	pcOptions := []ottl.ParserCollectionOption[TracesConsumer]{
 		withCommonContextParsers[TracesConsumer](),
@@ -161,7 +207,7 @@ func convertSpanStatements(pc *ottl.ParserCollection[TracesConsumer], statements
 	if errGlobalBoolExpr != nil {
 		return nil, errGlobalBoolExpr
 	}
-	sStatements := ottlspan.NewStatementSequence(parsedStatements, pc.Settings, ottlspan.WithStatementSequenceErrorMode(errorMode))
+	sStatements := ottlspan.NewStatementSequence(parsedStatements, pc.Settings, ottlspan.WithStatementSequenceErrorMode(errorMode), ottlspan.WithStatementSequenceObserver(pc.StatementObserver))
 	return traceStatements{sStatements, globalExpr}, nil
 }
 
@@ -182,10 +228,31 @@ func convertSpanEventStatements(pc *ottl.ParserCollection[TracesConsumer], state
 	if errGlobalBoolExpr != nil {
 		return nil, errGlobalBoolExpr
 	}
-	seStatements := ottlspanevent.NewStatementSequence(parsedStatements, pc.Settings, ottlspanevent.WithStatementSequenceErrorMode(errorMode))
+	seStatements := ottlspanevent.NewStatementSequence(parsedStatements, pc.Settings, ottlspanevent.WithStatementSequenceErrorMode(errorMode), ottlspanevent.WithStatementSequenceObserver(pc.StatementObserver))
 	return spanEventStatements{seStatements, globalExpr}, nil
 }
 
+func convertTraceStatements(pc *ottl.ParserCollection[TracesConsumer], statements ottl.StatementsGetter, parsedStatements []*ottl.Statement[*ottltrace.TransformContext]) (TracesConsumer, error) {
+	contextStatements, err := toContextStatements(statements)
+	if err != nil {
+		return nil, err
+	}
+	errorMode := pc.ErrorMode
+	if contextStatements.ErrorMode != "" {
+		errorMode = contextStatements.ErrorMode
+	}
+	var parserOptions []ottl.Option[*ottltrace.TransformContext]
+	if contextStatements.Context == "" {
+		parserOptions = append(parserOptions, ottltrace.EnablePathContextNames())
+	}
+	globalExpr, errGlobalBoolExpr := parseGlobalExpr(filterottl.NewBoolExprForTraceWithOptions, contextStatements.Conditions, errorMode, pc.Settings, filterottl.StandardTraceFuncs(), parserOptions)
+	if errGlobalBoolExpr != nil {
+		return nil, errGlobalBoolExpr
+	}
+	tStatements := ottltrace.NewStatementSequence(parsedStatements, pc.Settings, ottltrace.WithStatementSequenceErrorMode(errorMode), ottltrace.WithStatementSequenceObserver(pc.StatementObserver))
+	return traceContextStatements{tStatements, globalExpr}, nil
+}
+
 func (tpc *TraceParserCollection) ParseContextStatements(contextStatements ContextStatements) (TracesConsumer, error) {
 	pc := ottl.ParserCollection[TracesConsumer](*tpc)
 	if contextStatements.Context != "" {
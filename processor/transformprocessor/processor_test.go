@@ -4,18 +4,26 @@
 package transformprocessor
 
 import (
+	"context"
 	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
 	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/plog"
 	"go.opentelemetry.io/collector/processor/processortest"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata/metricdatatest"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/golden"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatatest/plogtest"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/transformprocessor/internal/common"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/transformprocessor/internal/metadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/transformprocessor/internal/metadatatest"
 )
 
 func TestFlattenDataDisabledByDefault(t *testing.T) {
@@ -145,3 +153,48 @@ func BenchmarkLogsWithFlatten(b *testing.B) {
 		require.NoError(b, p.ConsumeLogs(b.Context(), input))
 	}
 }
+
+func TestStatementTelemetry(t *testing.T) {
+	tel := componenttest.NewTelemetry()
+	t.Cleanup(func() { require.NoError(t, tel.Shutdown(context.Background())) })
+
+	factory := NewFactory()
+	oCfg := factory.CreateDefaultConfig().(*Config)
+	assert.False(t, oCfg.StatementTelemetry)
+	oCfg.StatementTelemetry = true
+	oCfg.ErrorMode = ottl.IgnoreError
+	oCfg.LogStatements = []common.ContextStatements{
+		{
+			Context: "log",
+			Statements: []string{
+				`set(attributes["matched"], true) where body == "match"`,
+				`merge_maps(attributes, body, "upsert")`,
+			},
+		},
+	}
+	set := metadatatest.NewSettings(tel)
+	p, err := factory.CreateLogs(t.Context(), set, oCfg, new(consumertest.LogsSink))
+	require.NoError(t, err)
+
+	ld := plog.NewLogs()
+	records := ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords()
+	records.AppendEmpty().Body().SetStr("match")
+	records.AppendEmpty().Body().SetStr("other")
+	require.NoError(t, p.ConsumeLogs(t.Context(), ld))
+
+	id := attribute.String(metadata.Type.String(), set.ID.String())
+	setStatement := attribute.String("statement", `set(log.attributes["matched"], true) where log.body == "match"`)
+	mergeStatement := attribute.String("statement", `merge_maps(log.attributes, log.body, "upsert")`)
+	metadatatest.AssertEqualProcessorTransformStatementExecutions(t, tel, []metricdata.DataPoint[int64]{
+		{Attributes: attribute.NewSet(id, setStatement, attribute.Bool("condition_matched", true)), Value: 1},
+		{Attributes: attribute.NewSet(id, setStatement, attribute.Bool("condition_matched", false)), Value: 1},
+		{Attributes: attribute.NewSet(id, mergeStatement, attribute.Bool("condition_matched", true)), Value: 2},
+	}, metricdatatest.IgnoreTimestamp())
+	metadatatest.AssertEqualProcessorTransformStatementErrors(t, tel, []metricdata.DataPoint[int64]{
+		{Attributes: attribute.NewSet(id, mergeStatement), Value: 2},
+	}, metricdatatest.IgnoreTimestamp())
+	metadatatest.AssertEqualProcessorTransformStatementDuration(t, tel, []metricdata.HistogramDataPoint[float64]{
+		{Attributes: attribute.NewSet(id, setStatement)},
+		{Attributes: attribute.NewSet(id, mergeStatement)},
+	}, metricdatatest.IgnoreTimestamp(), metricdatatest.IgnoreValue())
+}
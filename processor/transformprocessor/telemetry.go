@@ -0,0 +1,74 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package transformprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/transformprocessor"
+
+import (
+	"context"
+	"sync"
+
+	"go.opentelemetry.io/collector/processor"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/transformprocessor/internal/metadata"
+)
+
+// statementTelemetry records the executions of the processor's statements.
+type statementTelemetry struct {
+	telemetryBuilder *metadata.TelemetryBuilder
+	id               attribute.KeyValue
+	// attributes caches the measurement attributes of each statement, so they are not
+	// built for every execution.
+	attributes sync.Map
+}
+
+type statementAttributes struct {
+	statement  metric.MeasurementOption
+	matched    metric.MeasurementOption
+	notMatched metric.MeasurementOption
+}
+
+// newStatementObserver returns the observer recording the statements executions, or nil if
+// the statement telemetry is disabled.
+func newStatementObserver(set processor.Settings, cfg *Config) (ottl.StatementObserver, error) {
+	if !cfg.StatementTelemetry {
+		return nil, nil
+	}
+	telemetryBuilder, err := metadata.NewTelemetryBuilder(set.TelemetrySettings)
+	if err != nil {
+		return nil, err
+	}
+	st := &statementTelemetry{
+		telemetryBuilder: telemetryBuilder,
+		id:               attribute.String(metadata.Type.String(), set.ID.String()),
+	}
+	return st.observe, nil
+}
+
+func (st *statementTelemetry) observe(ctx context.Context, execution ottl.StatementExecution) {
+	attrs := st.statementAttributes(execution.Statement)
+	if execution.ConditionMatched {
+		st.telemetryBuilder.ProcessorTransformStatementExecutions.Add(ctx, 1, attrs.matched)
+	} else {
+		st.telemetryBuilder.ProcessorTransformStatementExecutions.Add(ctx, 1, attrs.notMatched)
+	}
+	st.telemetryBuilder.ProcessorTransformStatementDuration.Record(ctx, execution.Duration.Seconds(), attrs.statement)
+	if execution.Err != nil {
+		st.telemetryBuilder.ProcessorTransformStatementErrors.Add(ctx, 1, attrs.statement)
+	}
+}
+
+func (st *statementTelemetry) statementAttributes(statement string) *statementAttributes {
+	if attrs, ok := st.attributes.Load(statement); ok {
+		return attrs.(*statementAttributes)
+	}
+	statementAttr := attribute.String("statement", statement)
+	attrs, _ := st.attributes.LoadOrStore(statement, &statementAttributes{
+		statement:  metric.WithAttributeSet(attribute.NewSet(st.id, statementAttr)),
+		matched:    metric.WithAttributeSet(attribute.NewSet(st.id, statementAttr, attribute.Bool("condition_matched", true))),
+		notMatched: metric.WithAttributeSet(attribute.NewSet(st.id, statementAttr, attribute.Bool("condition_matched", false))),
+	})
+	return attrs.(*statementAttributes)
+}
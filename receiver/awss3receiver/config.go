@@ -52,6 +52,22 @@ type Notifications struct {
 	_ struct{}
 }
 
+// ReplayConfig configures replaying objects of the configured time range with bounded
+// concurrency and rate limiting, checkpointing progress so a restarted replay resumes
+// where it stopped.
+type ReplayConfig struct {
+	// MaxConcurrency is the maximum number of objects retrieved and processed in parallel.
+	// Default is 4.
+	MaxConcurrency int `mapstructure:"max_concurrency"`
+	// ObjectsPerSecond limits the rate at which objects are retrieved. Zero means no limit.
+	ObjectsPerSecond float64 `mapstructure:"objects_per_second"`
+	// StorageID is the optional storage extension used to checkpoint the replay progress.
+	StorageID *component.ID `mapstructure:"storage"`
+
+	// prevent unkeyed literal initialization
+	_ struct{}
+}
+
 // Encoding defines the encoding configuration for the file receiver.
 type Encoding struct {
 	Extension component.ID `mapstructure:"extension"`
@@ -70,10 +86,13 @@ type Config struct {
 	Notifications Notifications      `mapstructure:"notifications"`
 	// SQS configures receiving S3 object change notifications via an SQS queue.
 	SQS *SQSConfig `mapstructure:"sqs"`
+	// Replay enables replay mode for the starttime/endtime range.
+	Replay *ReplayConfig `mapstructure:"replay"`
 }
 
 const (
-	s3PartitionFormatDefault = "year=%Y/month=%m/day=%d/hour=%H/minute=%M"
+	s3PartitionFormatDefault    = "year=%Y/month=%m/day=%d/hour=%H/minute=%M"
+	replayMaxConcurrencyDefault = 4
 )
 
 func createDefaultConfig() component.Config {
@@ -115,6 +134,19 @@ func (c Config) Validate() error {
 		errs = multierr.Append(errs, errors.New("starttime/endtime and sqs configuration cannot be used together"))
 	}
 
+	// Replay operates on a time range
+	if c.Replay != nil {
+		if !hasStartTime || !hasEndTime {
+			errs = multierr.Append(errs, errors.New("replay requires starttime and endtime"))
+		}
+		if c.Replay.MaxConcurrency < 0 {
+			errs = multierr.Append(errs, errors.New("replay.max_concurrency must not be negative"))
+		}
+		if c.Replay.ObjectsPerSecond < 0 {
+			errs = multierr.Append(errs, errors.New("replay.objects_per_second must not be negative"))
+		}
+	}
+
 	// Validate StartTime format if specified
 	if hasStartTime {
 		if _, err := parseTime(c.StartTime, "starttime"); err != nil {
@@ -71,6 +71,7 @@ func TestLoadConfig(t *testing.T) {
 	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
 	require.NoError(t, err)
 	opampExtension := component.NewIDWithName(component.MustNewType("opamp"), "bar")
+	storageExtension := component.MustNewID("file_storage")
 	tests := []struct {
 		id           component.ID
 		expected     component.Config
@@ -158,6 +159,29 @@ func TestLoadConfig(t *testing.T) {
 				},
 			},
 		},
+		{
+			id: component.NewIDWithName(metadata.Type, "6"),
+			expected: &Config{
+				S3Downloader: S3DownloaderConfig{
+					Region:                         "us-east-1",
+					S3Bucket:                       "abucket",
+					S3PartitionFormat:              "year=%Y/month=%m/day=%d/hour=%H/minute=%M",
+					FilePrefixIncludeTelemetryType: true,
+					EndpointPartitionID:            "aws",
+				},
+				StartTime: "2024-01-31 15:00",
+				EndTime:   "2024-02-03",
+				Replay: &ReplayConfig{
+					MaxConcurrency:   8,
+					ObjectsPerSecond: 20,
+					StorageID:        &storageExtension,
+				},
+			},
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "7"),
+			errorMessage: "replay requires starttime and endtime; replay.max_concurrency must not be negative",
+		},
 	}
 
 	for _, tt := range tests {
@@ -11,6 +11,7 @@ import (
 	"fmt"
 	"io"
 	"strings"
+	"sync"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/consumer"
@@ -29,6 +30,13 @@ type encodingExtension struct {
 
 type encodingExtensions []encodingExtension
 
+// s3ReaderLifecycle is implemented by readers that need access to the host, such as
+// readers that checkpoint their progress in a storage extension.
+type s3ReaderLifecycle interface {
+	start(ctx context.Context, host component.Host) error
+	shutdown(ctx context.Context) error
+}
+
 type receiverProcessor interface {
 	processReceivedData(ctx context.Context, receiver *awss3Receiver, key string, data []byte) error
 }
@@ -37,6 +45,7 @@ type awss3Receiver struct {
 	reader          s3Reader
 	logger          *zap.Logger
 	cancel          context.CancelFunc
+	readerDone      sync.WaitGroup
 	obsrecv         *receiverhelper.ObsReport
 	encodingsConfig []Encoding
 	telemetryType   string
@@ -52,6 +61,11 @@ func newAWSS3Receiver(ctx context.Context, cfg *Config, telemetryType string, se
 
 	// Create the appropriate reader based on configuration
 	switch {
+	case cfg.Replay != nil:
+		reader, err = newS3ReplayReader(ctx, notifier, settings.Logger, settings.ID, cfg)
+		if err != nil {
+			return nil, err
+		}
 	case cfg.StartTime != "" && cfg.EndTime != "":
 		reader, err = newS3TimeBasedReader(ctx, notifier, settings.Logger, cfg)
 		if err != nil {
@@ -100,11 +114,17 @@ func (r *awss3Receiver) Start(ctx context.Context, host component.Host) error {
 		return err
 	}
 
+	if lifecycle, ok := r.reader.(s3ReaderLifecycle); ok {
+		if err = lifecycle.start(ctx, host); err != nil {
+			return err
+		}
+	}
+
 	var cancelCtx context.Context
 	cancelCtx, r.cancel = context.WithCancel(context.Background())
-	go func() {
+	r.readerDone.Go(func() {
 		_ = r.reader.readAll(cancelCtx, r.telemetryType, r.receiveBytes)
-	}()
+	})
 	return nil
 }
 
@@ -117,6 +137,10 @@ func (r *awss3Receiver) Shutdown(ctx context.Context) error {
 	if r.cancel != nil {
 		r.cancel()
 	}
+	r.readerDone.Wait()
+	if lifecycle, ok := r.reader.(s3ReaderLifecycle); ok {
+		return lifecycle.shutdown(ctx)
+	}
 	return nil
 }
 
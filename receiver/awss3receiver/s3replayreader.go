@@ -0,0 +1,314 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package awss3receiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/awss3receiver"
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/extension/xextension/storage"
+	"go.uber.org/zap"
+	"golang.org/x/time/rate"
+)
+
+const (
+	replayCheckpointKeyFormat = "replay/%s"
+
+	// replayCheckpointTimeout bounds the time spent persisting a checkpoint. Checkpoints are
+	// persisted with a context detached from the replay so that the progress of objects
+	// completed right before shutdown is not lost.
+	replayCheckpointTimeout = 5 * time.Second
+)
+
+// replayCheckpoint records how far a replay has progressed. Partition is the start of the
+// partition being replayed and Completed lists the keys of that partition that have
+// already been processed.
+type replayCheckpoint struct {
+	StartTime time.Time `json:"start_time"`
+	EndTime   time.Time `json:"end_time"`
+	Partition time.Time `json:"partition"`
+	Completed []string  `json:"completed,omitempty"`
+}
+
+// s3ReplayReader replays the objects of a time range partition by partition. Objects of a
+// partition are retrieved concurrently and rate limited, and progress is checkpointed in
+// a storage extension so that a restarted replay resumes where it stopped.
+type s3ReplayReader struct {
+	*s3TimeBasedReader
+
+	componentID    component.ID
+	storageID      *component.ID
+	storageClient  storage.Client
+	maxConcurrency int
+	limiter        *rate.Limiter
+
+	checkpointMu sync.Mutex
+	checkpoint   replayCheckpoint
+}
+
+func newS3ReplayReader(ctx context.Context, notifier statusNotifier, logger *zap.Logger, componentID component.ID, cfg *Config) (*s3ReplayReader, error) {
+	timeBasedReader, err := newS3TimeBasedReader(ctx, notifier, logger, cfg)
+	if err != nil {
+		return nil, err
+	}
+	maxConcurrency := cfg.Replay.MaxConcurrency
+	if maxConcurrency == 0 {
+		maxConcurrency = replayMaxConcurrencyDefault
+	}
+	var limiter *rate.Limiter
+	if cfg.Replay.ObjectsPerSecond > 0 {
+		limiter = rate.NewLimiter(rate.Limit(cfg.Replay.ObjectsPerSecond), 1)
+	}
+	return &s3ReplayReader{
+		s3TimeBasedReader: timeBasedReader,
+		componentID:       componentID,
+		storageID:         cfg.Replay.StorageID,
+		storageClient:     storage.NewNopClient(),
+		maxConcurrency:    maxConcurrency,
+		limiter:           limiter,
+	}, nil
+}
+
+// start connects the reader to the configured storage extension.
+func (r *s3ReplayReader) start(ctx context.Context, host component.Host) error {
+	if r.storageID == nil {
+		return nil
+	}
+	extension, ok := host.GetExtensions()[*r.storageID]
+	if !ok {
+		return fmt.Errorf("storage extension '%s' not found", r.storageID)
+	}
+	storageExtension, ok := extension.(storage.Extension)
+	if !ok {
+		return fmt.Errorf("non-storage extension '%s' found", r.storageID)
+	}
+	client, err := storageExtension.GetClient(ctx, component.KindReceiver, r.componentID, "")
+	if err != nil {
+		return fmt.Errorf("failed to get storage client: %w", err)
+	}
+	r.storageClient = client
+	return nil
+}
+
+func (r *s3ReplayReader) shutdown(ctx context.Context) error {
+	return r.storageClient.Close(ctx)
+}
+
+// readAll implements the s3Reader interface
+func (r *s3ReplayReader) readAll(ctx context.Context, telemetryType string, dataCallback s3ObjectCallback) error {
+	timeStep, err := determineTimestep(r.s3PartitionFormat)
+	if err != nil {
+		return err
+	}
+
+	partition := r.startTime
+	completed := map[string]struct{}{}
+	if checkpoint, ok := r.loadCheckpoint(ctx, telemetryType); ok {
+		partition = checkpoint.Partition
+		for _, key := range checkpoint.Completed {
+			completed[key] = struct{}{}
+		}
+		r.logger.Info("Resuming replay from checkpoint", zap.Time("partition", partition), zap.Int("completed_objects", len(completed)))
+	}
+
+	r.logger.Info("Start replaying telemetry", zap.Time("start_time", r.startTime), zap.Time("end_time", r.endTime))
+	for ; partition.Before(r.endTime); partition = partition.Add(timeStep) {
+		r.sendStatus(ctx, statusNotification{
+			TelemetryType: telemetryType,
+			IngestStatus:  IngestStatusIngesting,
+			StartTime:     r.startTime,
+			EndTime:       r.endTime,
+			IngestTime:    partition,
+		})
+		if err := r.replayPartition(ctx, partition, completed, telemetryType, dataCallback); err != nil {
+			r.sendStatus(ctx, statusNotification{
+				TelemetryType:  telemetryType,
+				IngestStatus:   IngestStatusFailed,
+				StartTime:      r.startTime,
+				EndTime:        r.endTime,
+				IngestTime:     partition,
+				FailureMessage: err.Error(),
+			})
+			r.logger.Error("Error replaying telemetry", zap.Error(err), zap.Time("time", partition))
+			return err
+		}
+		completed = map[string]struct{}{}
+		r.saveCheckpoint(ctx, telemetryType, partition.Add(timeStep), nil)
+	}
+	r.sendStatus(ctx, statusNotification{
+		TelemetryType: telemetryType,
+		IngestStatus:  IngestStatusCompleted,
+		StartTime:     r.startTime,
+		EndTime:       r.endTime,
+		IngestTime:    r.endTime,
+	})
+	r.logger.Info("Finished replaying telemetry", zap.Time("start_time", r.startTime), zap.Time("end_time", r.endTime))
+	return nil
+}
+
+// replayPartition retrieves and processes all objects of the partition starting at the given
+// time that are not already completed, using up to maxConcurrency workers.
+func (r *s3ReplayReader) replayPartition(ctx context.Context, partition time.Time, completed map[string]struct{}, telemetryType string, dataCallback s3ObjectCallback) error {
+	keys, err := r.listKeys(ctx, partition, telemetryType)
+	if err != nil {
+		return err
+	}
+	done := make([]string, 0, len(completed))
+	for key := range completed {
+		done = append(done, key)
+	}
+	r.setCheckpoint(partition, done)
+
+	ctx, cancel := context.WithCancelCause(ctx)
+	defer cancel(nil)
+
+	keyCh := make(chan string)
+	var wg sync.WaitGroup
+	for range r.maxConcurrency {
+		wg.Go(func() {
+			for key := range keyCh {
+				if err := r.replayObject(ctx, key, dataCallback); err != nil {
+					cancel(err)
+					return
+				}
+				r.completeObject(ctx, telemetryType, key)
+			}
+		})
+	}
+
+sendLoop:
+	for _, key := range keys {
+		if _, ok := completed[key]; ok {
+			continue
+		}
+		select {
+		case keyCh <- key:
+		case <-ctx.Done():
+			break sendLoop
+		}
+	}
+	close(keyCh)
+	wg.Wait()
+
+	if ctx.Err() != nil {
+		return context.Cause(ctx)
+	}
+	return nil
+}
+
+func (r *s3ReplayReader) replayObject(ctx context.Context, key string, dataCallback s3ObjectCallback) error {
+	if r.limiter != nil {
+		if err := r.limiter.Wait(ctx); err != nil {
+			return err
+		}
+	} else if err := ctx.Err(); err != nil {
+		return err
+	}
+	data, err := retrieveS3Object(ctx, r.getObjectClient, r.s3Bucket, key)
+	if err != nil {
+		return err
+	}
+	r.logger.Debug("Retrieved telemetry", zap.String("key", key))
+	return dataCallback(ctx, key, data)
+}
+
+func (r *s3ReplayReader) listKeys(ctx context.Context, partition time.Time, telemetryType string) ([]string, error) {
+	prefix := r.getObjectPrefixForTime(partition, telemetryType)
+	r.logger.Debug("Finding telemetry with prefix", zap.String("prefix", prefix))
+	p := r.listObjectsClient.NewListObjectsV2Paginator(&s3.ListObjectsV2Input{
+		Bucket: &r.s3Bucket,
+		Prefix: &prefix,
+	})
+
+	var keys []string
+	for p.HasMorePages() {
+		page, err := p.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, obj := range page.Contents {
+			keys = append(keys, *obj.Key)
+		}
+	}
+	if len(keys) == 0 {
+		r.logger.Info("No telemetry found for time", zap.String("prefix", prefix), zap.Time("time", partition))
+	}
+	return keys, nil
+}
+
+func (*s3ReplayReader) checkpointKey(telemetryType string) string {
+	return fmt.Sprintf(replayCheckpointKeyFormat, telemetryType)
+}
+
+// loadCheckpoint returns the stored checkpoint if there is one for the configured time range.
+func (r *s3ReplayReader) loadCheckpoint(ctx context.Context, telemetryType string) (replayCheckpoint, bool) {
+	var checkpoint replayCheckpoint
+	data, err := r.storageClient.Get(ctx, r.checkpointKey(telemetryType))
+	if err != nil {
+		r.logger.Warn("Error retrieving replay checkpoint, starting from the beginning", zap.Error(err))
+		return checkpoint, false
+	}
+	if len(data) == 0 {
+		return checkpoint, false
+	}
+	if err := json.Unmarshal(data, &checkpoint); err != nil {
+		r.logger.Warn("Invalid replay checkpoint, starting from the beginning", zap.Error(err))
+		return checkpoint, false
+	}
+	if !checkpoint.StartTime.Equal(r.startTime) || !checkpoint.EndTime.Equal(r.endTime) {
+		r.logger.Info("Replay checkpoint is for a different time range, starting from the beginning",
+			zap.Time("checkpoint_start_time", checkpoint.StartTime),
+			zap.Time("checkpoint_end_time", checkpoint.EndTime))
+		return replayCheckpoint{}, false
+	}
+	return checkpoint, true
+}
+
+func (r *s3ReplayReader) setCheckpoint(partition time.Time, completed []string) {
+	r.checkpointMu.Lock()
+	defer r.checkpointMu.Unlock()
+	r.setCheckpointLocked(partition, completed)
+}
+
+func (r *s3ReplayReader) saveCheckpoint(ctx context.Context, telemetryType string, partition time.Time, completed []string) {
+	r.checkpointMu.Lock()
+	defer r.checkpointMu.Unlock()
+	r.setCheckpointLocked(partition, completed)
+	r.persistCheckpointLocked(ctx, telemetryType)
+}
+
+func (r *s3ReplayReader) setCheckpointLocked(partition time.Time, completed []string) {
+	r.checkpoint = replayCheckpoint{
+		StartTime: r.startTime,
+		EndTime:   r.endTime,
+		Partition: partition,
+		Completed: completed,
+	}
+}
+
+// completeObject records key as processed and persists the checkpoint.
+func (r *s3ReplayReader) completeObject(ctx context.Context, telemetryType, key string) {
+	r.checkpointMu.Lock()
+	defer r.checkpointMu.Unlock()
+	r.checkpoint.Completed = append(r.checkpoint.Completed, key)
+	r.persistCheckpointLocked(ctx, telemetryType)
+}
+
+func (r *s3ReplayReader) persistCheckpointLocked(ctx context.Context, telemetryType string) {
+	data, err := json.Marshal(r.checkpoint)
+	if err != nil {
+		r.logger.Warn("Failed to marshal replay checkpoint", zap.Error(err))
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), replayCheckpointTimeout)
+	defer cancel()
+	if err := r.storageClient.Set(ctx, r.checkpointKey(telemetryType), data); err != nil {
+		r.logger.Warn("Failed to store replay checkpoint", zap.Error(err))
+	}
+}
@@ -0,0 +1,268 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package awss3receiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/awss3receiver"
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/extension/xextension/storage"
+	"go.uber.org/zap"
+	"golang.org/x/time/rate"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/storage/storagetest"
+)
+
+// fakeS3 is an in-process stand-in for an S3 bucket that supports listing by prefix
+// with pagination and retrieving objects.
+type fakeS3 struct {
+	mu       sync.Mutex
+	objects  map[string][]byte
+	pageSize int
+	gets     map[string]int
+	failKey  string
+}
+
+func newFakeS3(objects map[string][]byte) *fakeS3 {
+	return &fakeS3{objects: objects, pageSize: 2, gets: map[string]int{}}
+}
+
+func (f *fakeS3) NewListObjectsV2Paginator(params *s3.ListObjectsV2Input) ListObjectsV2Pager {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	var keys []string
+	for key := range f.objects {
+		if strings.HasPrefix(key, *params.Prefix) {
+			keys = append(keys, key)
+		}
+	}
+	slices.Sort(keys)
+	pager := &mockListObjectsV2Pager{}
+	for chunk := range slices.Chunk(keys, f.pageSize) {
+		page := &s3.ListObjectsV2Output{}
+		for _, key := range chunk {
+			page.Contents = append(page.Contents, types.Object{Key: &key})
+		}
+		pager.Pages = append(pager.Pages, page)
+	}
+	return pager
+}
+
+func (f *fakeS3) GetObject(_ context.Context, params *s3.GetObjectInput, _ ...func(*s3.Options)) (*s3.GetObjectOutput, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if *params.Key == f.failKey {
+		return nil, errors.New("get object failed")
+	}
+	data, ok := f.objects[*params.Key]
+	if !ok {
+		return nil, errors.New("no such key")
+	}
+	f.gets[*params.Key]++
+	return &s3.GetObjectOutput{Body: io.NopCloser(bytes.NewReader(data))}, nil
+}
+
+// contextCheckingStorageClient fails to store values when the context is done, as
+// storage extensions backed by a database do.
+type contextCheckingStorageClient struct {
+	*storagetest.TestClient
+}
+
+func (c contextCheckingStorageClient) Set(ctx context.Context, key string, value []byte) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	return c.TestClient.Set(ctx, key, value)
+}
+
+func newTestStorageClient() *storagetest.TestClient {
+	return storagetest.NewInMemoryClient(component.KindReceiver, component.MustNewID("awss3"), "")
+}
+
+func newTestReplayReader(bucket *fakeS3, client storage.Client, maxConcurrency int) *s3ReplayReader {
+	return &s3ReplayReader{
+		s3TimeBasedReader: &s3TimeBasedReader{
+			logger:                         zap.NewNop(),
+			listObjectsClient:              bucket,
+			getObjectClient:                bucket,
+			s3Bucket:                       "bucket",
+			s3PartitionFormat:              s3PartitionFormatDefault,
+			S3PartitionTimeLocation:        time.UTC,
+			filePrefixIncludeTelemetryType: true,
+			startTime:                      testTime,
+			endTime:                        testTime.Add(3 * time.Minute),
+		},
+		storageClient:  client,
+		maxConcurrency: maxConcurrency,
+	}
+}
+
+func replayTestObjects() map[string][]byte {
+	return map[string][]byte{
+		"year=2021/month=02/day=01/hour=17/minute=32/traces_1": []byte("1"),
+		"year=2021/month=02/day=01/hour=17/minute=32/traces_2": []byte("2"),
+		"year=2021/month=02/day=01/hour=17/minute=32/traces_3": []byte("3"),
+		"year=2021/month=02/day=01/hour=17/minute=33/traces_4": []byte("4"),
+		"year=2021/month=02/day=01/hour=17/minute=34/traces_5": []byte("5"),
+		"year=2021/month=02/day=01/hour=17/minute=34/traces_6": []byte("6"),
+		"year=2021/month=02/day=01/hour=17/minute=35/traces_7": []byte("7"),
+		"year=2021/month=02/day=01/hour=17/minute=32/logs_1":   []byte("l"),
+	}
+}
+
+type collectingCallback struct {
+	mu   sync.Mutex
+	keys map[string]int
+}
+
+func (c *collectingCallback) callback(_ context.Context, key string, _ []byte) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.keys == nil {
+		c.keys = map[string]int{}
+	}
+	c.keys[key]++
+	return nil
+}
+
+func Test_s3ReplayReader_readAll(t *testing.T) {
+	bucket := newFakeS3(replayTestObjects())
+	client := newTestStorageClient()
+	reader := newTestReplayReader(bucket, client, 3)
+
+	collected := &collectingCallback{}
+	require.NoError(t, reader.readAll(t.Context(), "traces", collected.callback))
+
+	keys := slices.Sorted(maps.Keys(collected.keys))
+	require.Equal(t, []string{
+		"year=2021/month=02/day=01/hour=17/minute=32/traces_1",
+		"year=2021/month=02/day=01/hour=17/minute=32/traces_2",
+		"year=2021/month=02/day=01/hour=17/minute=32/traces_3",
+		"year=2021/month=02/day=01/hour=17/minute=33/traces_4",
+		"year=2021/month=02/day=01/hour=17/minute=34/traces_5",
+		"year=2021/month=02/day=01/hour=17/minute=34/traces_6",
+	}, keys)
+	for key, count := range collected.keys {
+		assert.Equal(t, 1, count, key)
+	}
+
+	checkpoint, ok := reader.loadCheckpoint(t.Context(), "traces")
+	require.True(t, ok)
+	assert.Equal(t, testTime.Add(3*time.Minute), checkpoint.Partition)
+	assert.Empty(t, checkpoint.Completed)
+
+	// A completed replay is not repeated.
+	again := &collectingCallback{}
+	require.NoError(t, reader.readAll(t.Context(), "traces", again.callback))
+	assert.Empty(t, again.keys)
+}
+
+func Test_s3ReplayReader_resume(t *testing.T) {
+	bucket := newFakeS3(replayTestObjects())
+	bucket.failKey = "year=2021/month=02/day=01/hour=17/minute=34/traces_6"
+	client := newTestStorageClient()
+
+	first := &collectingCallback{}
+	reader := newTestReplayReader(bucket, client, 1)
+	require.ErrorContains(t, reader.readAll(t.Context(), "traces", first.callback), "get object failed")
+	assert.Contains(t, first.keys, "year=2021/month=02/day=01/hour=17/minute=34/traces_5")
+
+	checkpoint, ok := reader.loadCheckpoint(t.Context(), "traces")
+	require.True(t, ok)
+	assert.Equal(t, testTime.Add(2*time.Minute), checkpoint.Partition)
+	assert.Equal(t, []string{"year=2021/month=02/day=01/hour=17/minute=34/traces_5"}, checkpoint.Completed)
+
+	// After a restart only the remaining objects are replayed.
+	bucket.failKey = ""
+	second := &collectingCallback{}
+	reader = newTestReplayReader(bucket, client, 1)
+	require.NoError(t, reader.readAll(t.Context(), "traces", second.callback))
+	assert.Equal(t, map[string]int{"year=2021/month=02/day=01/hour=17/minute=34/traces_6": 1}, second.keys)
+}
+
+func Test_s3ReplayReader_checkpointForOtherRange(t *testing.T) {
+	bucket := newFakeS3(replayTestObjects())
+	client := newTestStorageClient()
+	reader := newTestReplayReader(bucket, client, 2)
+	require.NoError(t, reader.readAll(t.Context(), "traces", (&collectingCallback{}).callback))
+
+	reader = newTestReplayReader(bucket, client, 2)
+	reader.endTime = testTime.Add(4 * time.Minute)
+	collected := &collectingCallback{}
+	require.NoError(t, reader.readAll(t.Context(), "traces", collected.callback))
+	assert.Len(t, collected.keys, 7)
+}
+
+func Test_s3ReplayReader_callbackError(t *testing.T) {
+	bucket := newFakeS3(replayTestObjects())
+	reader := newTestReplayReader(bucket, newTestStorageClient(), 2)
+	err := reader.readAll(t.Context(), "traces", func(context.Context, string, []byte) error {
+		return errors.New("consumer failed")
+	})
+	require.ErrorContains(t, err, "consumer failed")
+}
+
+func Test_s3ReplayReader_rateLimit(t *testing.T) {
+	bucket := newFakeS3(replayTestObjects())
+	reader := newTestReplayReader(bucket, newTestStorageClient(), 4)
+	reader.limiter = rate.NewLimiter(100, 1)
+
+	start := time.Now()
+	require.NoError(t, reader.readAll(t.Context(), "traces", (&collectingCallback{}).callback))
+	assert.GreaterOrEqual(t, time.Since(start), 50*time.Millisecond)
+}
+
+func Test_s3ReplayReader_completeObjectAfterCancel(t *testing.T) {
+	client := contextCheckingStorageClient{TestClient: newTestStorageClient()}
+	reader := newTestReplayReader(newFakeS3(nil), client, 1)
+	reader.setCheckpoint(testTime, nil)
+
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+	reader.completeObject(ctx, "traces", "year=2021/month=02/day=01/hour=17/minute=32/traces_1")
+
+	checkpoint, ok := reader.loadCheckpoint(t.Context(), "traces")
+	require.True(t, ok)
+	assert.Equal(t, []string{"year=2021/month=02/day=01/hour=17/minute=32/traces_1"}, checkpoint.Completed)
+}
+
+func Test_s3ReplayReader_cancelled(t *testing.T) {
+	bucket := newFakeS3(replayTestObjects())
+	reader := newTestReplayReader(bucket, newTestStorageClient(), 1)
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+	require.ErrorIs(t, reader.readAll(ctx, "traces", (&collectingCallback{}).callback), context.Canceled)
+}
+
+func Test_s3ReplayReader_start(t *testing.T) {
+	storageID := storagetest.NewStorageID("replay")
+
+	reader := newTestReplayReader(newFakeS3(nil), storage.NewNopClient(), 1)
+	reader.storageID = &storageID
+	require.ErrorContains(t, reader.start(t.Context(), storagetest.NewStorageHost()), "storage extension 'test_storage/replay' not found")
+
+	nonStorageID := storagetest.NewNonStorageID("replay")
+	reader.storageID = &nonStorageID
+	require.ErrorContains(t, reader.start(t.Context(), storagetest.NewStorageHost().WithNonStorageExtension("replay")), "non-storage extension 'non_storage/replay' found")
+
+	reader.storageID = &storageID
+	require.NoError(t, reader.start(t.Context(), storagetest.NewStorageHost().WithInMemoryStorageExtension("replay")))
+	creatorID, err := storagetest.CreatorID(t.Context(), reader.storageClient)
+	require.NoError(t, err)
+	assert.Equal(t, storageID, creatorID)
+	require.NoError(t, reader.shutdown(t.Context()))
+}
@@ -16,6 +16,7 @@ import (
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter/filterset"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/metadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/cgroupscraper"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/cpuscraper"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/diskscraper"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/filesystemscraper"
@@ -24,6 +25,7 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/networkscraper"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/nfsscraper"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/pagingscraper"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/pressurescraper"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/processesscraper"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/processscraper"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/systemscraper"
@@ -61,6 +63,15 @@ func TestLoadConfig(t *testing.T) {
 					InitialDelay:       time.Second,
 				},
 				Scrapers: map[component.Type]component.Config{
+					component.MustNewType("cgroup"): (func() component.Config {
+						cfg := cgroupscraper.NewFactory().CreateDefaultConfig()
+						cfg.(*cgroupscraper.Config).MaxDepth = 3
+						cfg.(*cgroupscraper.Config).Exclude = cgroupscraper.MatchConfig{
+							Paths:  []string{"/init.scope"},
+							Config: filterset.Config{MatchType: "strict"},
+						}
+						return cfg
+					})(),
 					component.MustNewType("cpu"):  cpuscraper.NewFactory().CreateDefaultConfig(),
 					component.MustNewType("disk"): diskscraper.NewFactory().CreateDefaultConfig(),
 					component.MustNewType("load"): (func() component.Config {
@@ -81,6 +92,7 @@ func TestLoadConfig(t *testing.T) {
 					component.MustNewType("nfs"):       nfsscraper.NewFactory().CreateDefaultConfig(),
 					component.MustNewType("processes"): processesscraper.NewFactory().CreateDefaultConfig(),
 					component.MustNewType("paging"):    pagingscraper.NewFactory().CreateDefaultConfig(),
+					component.MustNewType("pressure"):  pressurescraper.NewFactory().CreateDefaultConfig(),
 					component.MustNewType("process"): (func() component.Config {
 						cfg := processscraper.NewFactory().CreateDefaultConfig()
 						cfg.(*processscraper.Config).Include = processscraper.MatchConfig{
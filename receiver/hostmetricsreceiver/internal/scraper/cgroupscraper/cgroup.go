@@ -0,0 +1,186 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package cgroupscraper // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/cgroupscraper"
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// cgroupStats holds the values read from the interface files of a single
+// cgroup v2 directory. A field is nil when the corresponding file does not
+// exist, e.g. because the controller is not enabled for the cgroup.
+type cgroupStats struct {
+	cpu         *cpuStats
+	memoryUsage *uint64
+	// memoryLimit is nil when memory.max is set to "max".
+	memoryLimit *uint64
+	oomKills    *uint64
+	io          *ioStats
+}
+
+// cpuStats holds the cpu.stat values, in microseconds.
+type cpuStats struct {
+	userUsec      uint64
+	systemUsec    uint64
+	throttledUsec *uint64
+}
+
+// ioStats holds the io.stat values summed over all devices.
+type ioStats struct {
+	readBytes  uint64
+	writeBytes uint64
+	readOps    uint64
+	writeOps   uint64
+}
+
+func readCgroupStats(dir string) (*cgroupStats, error) {
+	var stats cgroupStats
+	var errs []error
+
+	cpuStat, err := readFlatKeyed(filepath.Join(dir, "cpu.stat"))
+	switch {
+	case err == nil:
+		stats.cpu = &cpuStats{
+			userUsec:   cpuStat["user_usec"],
+			systemUsec: cpuStat["system_usec"],
+		}
+		// throttled_usec is only reported when the cpu controller is enabled.
+		if throttled, ok := cpuStat["throttled_usec"]; ok {
+			stats.cpu.throttledUsec = &throttled
+		}
+	case !errors.Is(err, fs.ErrNotExist):
+		errs = append(errs, err)
+	}
+
+	if stats.memoryUsage, err = readSingleValue(filepath.Join(dir, "memory.current")); err != nil {
+		errs = append(errs, err)
+	}
+	if stats.memoryLimit, err = readSingleValue(filepath.Join(dir, "memory.max")); err != nil {
+		errs = append(errs, err)
+	}
+
+	memoryEvents, err := readFlatKeyed(filepath.Join(dir, "memory.events"))
+	switch {
+	case err == nil:
+		if oomKills, ok := memoryEvents["oom_kill"]; ok {
+			stats.oomKills = &oomKills
+		}
+	case !errors.Is(err, fs.ErrNotExist):
+		errs = append(errs, err)
+	}
+
+	ioStat, err := readIOStat(filepath.Join(dir, "io.stat"))
+	switch {
+	case err == nil:
+		stats.io = ioStat
+	case !errors.Is(err, fs.ErrNotExist):
+		errs = append(errs, err)
+	}
+
+	return &stats, errors.Join(errs...)
+}
+
+// readFlatKeyed parses a flat keyed file such as cpu.stat:
+//
+//	usage_usec 1234
+//	user_usec 1000
+func readFlatKeyed(path string) (map[string]uint64, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	values := make(map[string]uint64)
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		key, value, ok := strings.Cut(scanner.Text(), " ")
+		if !ok {
+			continue
+		}
+		v, err := strconv.ParseUint(strings.TrimSpace(value), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for %s in %s: %w", key, path, err)
+		}
+		values[key] = v
+	}
+	return values, scanner.Err()
+}
+
+// readSingleValue parses a single value file such as memory.current. It
+// returns nil if the file does not exist or if its value is "max".
+func readSingleValue(path string) (*uint64, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	value := strings.TrimSpace(string(content))
+	if value == "max" {
+		return nil, nil
+	}
+	v, err := strconv.ParseUint(value, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid value in %s: %w", path, err)
+	}
+	return &v, nil
+}
+
+// readIOStat parses a nested keyed io.stat file and sums the values of all
+// devices:
+//
+//	8:0 rbytes=1024 wbytes=2048 rios=1 wios=2 dbytes=0 dios=0
+func readIOStat(path string) (*ioStats, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var stats ioStats
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 2 {
+			continue
+		}
+		for _, field := range fields[1:] {
+			key, value, ok := strings.Cut(field, "=")
+			if !ok {
+				continue
+			}
+			var dst *uint64
+			switch key {
+			case "rbytes":
+				dst = &stats.readBytes
+			case "wbytes":
+				dst = &stats.writeBytes
+			case "rios":
+				dst = &stats.readOps
+			case "wios":
+				dst = &stats.writeOps
+			default:
+				continue
+			}
+			v, err := strconv.ParseUint(value, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for %s of device %s in %s: %w", key, fields[0], path, err)
+			}
+			*dst += v
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return &stats, nil
+}
@@ -0,0 +1,150 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package cgroupscraper // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/cgroupscraper"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/shirou/gopsutil/v4/common"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/scraper"
+	"go.opentelemetry.io/collector/scraper/scrapererror"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter/filterset"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/gopsutilenv"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/cgroupscraper/internal/metadata"
+)
+
+const cgroupMetricsLen = 7
+
+// scraper for Cgroup Metrics
+type cgroupScraper struct {
+	settings scraper.Settings
+	config   *Config
+	mb       *metadata.MetricsBuilder
+
+	includeFS filterset.FilterSet
+	excludeFS filterset.FilterSet
+}
+
+// newCgroupScraper creates a Cgroup Scraper
+func newCgroupScraper(settings scraper.Settings, cfg *Config) (*cgroupScraper, error) {
+	scraper := &cgroupScraper{settings: settings, config: cfg}
+
+	var err error
+
+	if len(cfg.Include.Paths) > 0 {
+		scraper.includeFS, err = filterset.CreateFilterSet(cfg.Include.Paths, &cfg.Include.Config)
+		if err != nil {
+			return nil, fmt.Errorf("error creating cgroup include filters: %w", err)
+		}
+	}
+
+	if len(cfg.Exclude.Paths) > 0 {
+		scraper.excludeFS, err = filterset.CreateFilterSet(cfg.Exclude.Paths, &cfg.Exclude.Config)
+		if err != nil {
+			return nil, fmt.Errorf("error creating cgroup exclude filters: %w", err)
+		}
+	}
+
+	return scraper, nil
+}
+
+func (s *cgroupScraper) start(context.Context, component.Host) error {
+	s.mb = metadata.NewMetricsBuilder(s.config.MetricsBuilderConfig, s.settings)
+	return nil
+}
+
+func (s *cgroupScraper) scrape(ctx context.Context) (pmetric.Metrics, error) {
+	root := gopsutilenv.GetEnvWithContext(ctx, string(common.HostSysEnvKey), "/sys", "fs", "cgroup")
+	// Only the unified (v2) hierarchy is supported, its root always contains cgroup.controllers.
+	if _, err := os.Stat(filepath.Join(root, "cgroup.controllers")); err != nil {
+		return pmetric.NewMetrics(), fmt.Errorf("cgroup v2 hierarchy not found at %s: %w", root, err)
+	}
+
+	var errs scrapererror.ScrapeErrors
+	now := pcommon.NewTimestampFromTime(time.Now())
+
+	walkErr := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			// cgroups can be removed while walking the hierarchy.
+			if errors.Is(err, fs.ErrNotExist) {
+				return nil
+			}
+			return err
+		}
+		if !d.IsDir() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(root, path)
+		if err != nil {
+			return err
+		}
+		cgroupPath, depth := "/", 0
+		if rel != "." {
+			cgroupPath = "/" + filepath.ToSlash(rel)
+			depth = strings.Count(cgroupPath, "/")
+		}
+		if depth > s.config.MaxDepth {
+			return fs.SkipDir
+		}
+
+		if (s.includeFS != nil && !s.includeFS.Matches(cgroupPath)) ||
+			(s.excludeFS != nil && s.excludeFS.Matches(cgroupPath)) {
+			return nil
+		}
+
+		stats, err := readCgroupStats(path)
+		if err != nil {
+			errs.AddPartial(cgroupMetricsLen, fmt.Errorf("error reading stats for cgroup %q: %w", cgroupPath, err))
+		}
+		s.recordCgroupMetrics(now, stats)
+
+		rb := s.mb.NewResourceBuilder()
+		rb.SetCgroupPath(cgroupPath)
+		s.mb.EmitForResource(metadata.WithResource(rb.Emit()))
+		return nil
+	})
+	if walkErr != nil {
+		errs.AddPartial(0, fmt.Errorf("error walking cgroup hierarchy: %w", walkErr))
+	}
+
+	return s.mb.Emit(), errs.Combine()
+}
+
+func (s *cgroupScraper) recordCgroupMetrics(now pcommon.Timestamp, stats *cgroupStats) {
+	// cpu.stat values are reported in microseconds.
+	if stats.cpu != nil {
+		s.mb.RecordCgroupCPUTimeDataPoint(now, float64(stats.cpu.userUsec)/1e6, metadata.AttributeStateUser)
+		s.mb.RecordCgroupCPUTimeDataPoint(now, float64(stats.cpu.systemUsec)/1e6, metadata.AttributeStateSystem)
+		if stats.cpu.throttledUsec != nil {
+			s.mb.RecordCgroupCPUThrottledTimeDataPoint(now, float64(*stats.cpu.throttledUsec)/1e6)
+		}
+	}
+	if stats.memoryUsage != nil {
+		s.mb.RecordCgroupMemoryUsageDataPoint(now, int64(*stats.memoryUsage))
+	}
+	if stats.memoryLimit != nil {
+		s.mb.RecordCgroupMemoryLimitDataPoint(now, int64(*stats.memoryLimit))
+	}
+	if stats.oomKills != nil {
+		s.mb.RecordCgroupMemoryOomKillsDataPoint(now, int64(*stats.oomKills))
+	}
+	if stats.io != nil {
+		s.mb.RecordCgroupIoBytesDataPoint(now, int64(stats.io.readBytes), metadata.AttributeDirectionRead)
+		s.mb.RecordCgroupIoBytesDataPoint(now, int64(stats.io.writeBytes), metadata.AttributeDirectionWrite)
+		s.mb.RecordCgroupIoOperationsDataPoint(now, int64(stats.io.readOps), metadata.AttributeDirectionRead)
+		s.mb.RecordCgroupIoOperationsDataPoint(now, int64(stats.io.writeOps), metadata.AttributeDirectionWrite)
+	}
+}
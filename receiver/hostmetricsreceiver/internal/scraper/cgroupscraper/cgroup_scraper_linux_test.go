@@ -0,0 +1,170 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build linux
+
+package cgroupscraper
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/shirou/gopsutil/v4/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/scraper/scrapertest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter/filterset"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/cgroupscraper/internal/metadata"
+)
+
+func testContext(t *testing.T, sysDir string) context.Context {
+	return context.WithValue(
+		t.Context(),
+		common.EnvKey,
+		common.EnvMap{common.HostSysEnvKey: sysDir},
+	)
+}
+
+func scrapeCgroups(ctx context.Context, t *testing.T, cfg *Config) (pmetric.Metrics, error) {
+	t.Helper()
+	s, err := newCgroupScraper(scrapertest.NewNopSettings(metadata.Type), cfg)
+	require.NoError(t, err)
+	require.NoError(t, s.start(ctx, componenttest.NewNopHost()))
+	return s.scrape(ctx)
+}
+
+func cgroupPaths(metrics pmetric.Metrics) []string {
+	var paths []string
+	rms := metrics.ResourceMetrics()
+	for i := 0; i < rms.Len(); i++ {
+		if path, ok := rms.At(i).Resource().Attributes().Get("cgroup.path"); ok {
+			paths = append(paths, path.Str())
+		}
+	}
+	return paths
+}
+
+func findResourceMetrics(t *testing.T, metrics pmetric.Metrics, cgroupPath string) pmetric.MetricSlice {
+	t.Helper()
+	rms := metrics.ResourceMetrics()
+	for i := 0; i < rms.Len(); i++ {
+		if path, ok := rms.At(i).Resource().Attributes().Get("cgroup.path"); ok && path.Str() == cgroupPath {
+			return rms.At(i).ScopeMetrics().At(0).Metrics()
+		}
+	}
+	require.Failf(t, "cgroup not found", "no resource metrics for cgroup %s", cgroupPath)
+	return pmetric.NewMetricSlice()
+}
+
+func findMetric(t *testing.T, ms pmetric.MetricSlice, name string) pmetric.Metric {
+	t.Helper()
+	for i := 0; i < ms.Len(); i++ {
+		if ms.At(i).Name() == name {
+			return ms.At(i)
+		}
+	}
+	require.Failf(t, "metric not found", "metric %s not found", name)
+	return pmetric.NewMetric()
+}
+
+func TestScrape(t *testing.T) {
+	ctx := testContext(t, filepath.Join("testdata", "sys"))
+	cfg := createDefaultConfig().(*Config)
+	cfg.Metrics.CgroupMemoryOomKills.Enabled = true
+
+	metrics, err := scrapeCgroups(ctx, t, cfg)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"/", "/system.slice", "/system.slice/docker.service", "/user.slice"}, cgroupPaths(metrics))
+
+	ms := findResourceMetrics(t, metrics, "/system.slice/docker.service")
+
+	cpuTime := findMetric(t, ms, "cgroup.cpu.time").Sum().DataPoints()
+	require.Equal(t, 2, cpuTime.Len())
+	for i := 0; i < cpuTime.Len(); i++ {
+		dp := cpuTime.At(i)
+		state, ok := dp.Attributes().Get("state")
+		require.True(t, ok)
+		expected := map[string]float64{"user": 1, "system": 0.5}[state.Str()]
+		assert.InDelta(t, expected, dp.DoubleValue(), 1e-9, state.Str())
+	}
+	assert.InDelta(t, 0.125, findMetric(t, ms, "cgroup.cpu.throttled_time").Sum().DataPoints().At(0).DoubleValue(), 1e-9)
+	assert.Equal(t, int64(52428800), findMetric(t, ms, "cgroup.memory.usage").Sum().DataPoints().At(0).IntValue())
+	assert.Equal(t, int64(536870912), findMetric(t, ms, "cgroup.memory.limit").Sum().DataPoints().At(0).IntValue())
+	assert.Equal(t, int64(2), findMetric(t, ms, "cgroup.memory.oom_kills").Sum().DataPoints().At(0).IntValue())
+
+	// /system.slice has no memory limit and reads from two devices.
+	ms = findResourceMetrics(t, metrics, "/system.slice")
+	for i := 0; i < ms.Len(); i++ {
+		assert.NotEqual(t, "cgroup.memory.limit", ms.At(i).Name())
+	}
+	ioBytes := findMetric(t, ms, "cgroup.io.bytes").Sum().DataPoints()
+	require.Equal(t, 2, ioBytes.Len())
+	for i := 0; i < ioBytes.Len(); i++ {
+		dp := ioBytes.At(i)
+		direction, ok := dp.Attributes().Get("direction")
+		require.True(t, ok)
+		expected := map[string]int64{"read": 8192, "write": 8192}[direction.Str()]
+		assert.Equal(t, expected, dp.IntValue(), direction.Str())
+	}
+}
+
+func TestScrapeFiltering(t *testing.T) {
+	tests := []struct {
+		name          string
+		mutateConfig  func(cfg *Config)
+		expectedPaths []string
+	}{
+		{
+			name:          "max depth",
+			mutateConfig:  func(cfg *Config) { cfg.MaxDepth = 1 },
+			expectedPaths: []string{"/", "/system.slice", "/user.slice"},
+		},
+		{
+			name:          "root only",
+			mutateConfig:  func(cfg *Config) { cfg.MaxDepth = 0 },
+			expectedPaths: []string{"/"},
+		},
+		{
+			name:          "deeper max depth",
+			mutateConfig:  func(cfg *Config) { cfg.MaxDepth = 10 },
+			expectedPaths: []string{"/", "/system.slice", "/system.slice/docker.service", "/system.slice/docker.service/nested", "/user.slice"},
+		},
+		{
+			name: "include",
+			mutateConfig: func(cfg *Config) {
+				cfg.Include = MatchConfig{Config: filterset.Config{MatchType: filterset.Strict}, Paths: []string{"/system.slice/docker.service"}}
+			},
+			expectedPaths: []string{"/system.slice/docker.service"},
+		},
+		{
+			name: "exclude",
+			mutateConfig: func(cfg *Config) {
+				cfg.Exclude = MatchConfig{Config: filterset.Config{MatchType: filterset.Regexp}, Paths: []string{"^/system\\.slice"}}
+			},
+			expectedPaths: []string{"/", "/user.slice"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := testContext(t, filepath.Join("testdata", "sys"))
+			cfg := createDefaultConfig().(*Config)
+			tt.mutateConfig(cfg)
+
+			metrics, err := scrapeCgroups(ctx, t, cfg)
+			require.NoError(t, err)
+			assert.ElementsMatch(t, tt.expectedPaths, cgroupPaths(metrics))
+		})
+	}
+}
+
+func TestScrapeNoCgroupV2Hierarchy(t *testing.T) {
+	ctx := testContext(t, t.TempDir())
+
+	metrics, err := scrapeCgroups(ctx, t, createDefaultConfig().(*Config))
+	assert.ErrorContains(t, err, "cgroup v2 hierarchy not found")
+	assert.Equal(t, 0, metrics.ResourceMetrics().Len())
+}
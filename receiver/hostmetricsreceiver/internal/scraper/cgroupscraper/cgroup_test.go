@@ -0,0 +1,91 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package cgroupscraper
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeCgroupFiles(t *testing.T, files map[string]string) string {
+	t.Helper()
+	dir := t.TempDir()
+	for name, content := range files {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0o600))
+	}
+	return dir
+}
+
+func uint64Ptr(v uint64) *uint64 {
+	return &v
+}
+
+func TestReadCgroupStats(t *testing.T) {
+	tests := []struct {
+		name        string
+		files       map[string]string
+		expected    *cgroupStats
+		expectedErr string
+	}{
+		{
+			name: "all files",
+			files: map[string]string{
+				"cpu.stat":       "usage_usec 300\nuser_usec 200\nsystem_usec 100\nthrottled_usec 50\n",
+				"memory.current": "1024\n",
+				"memory.max":     "2048\n",
+				"memory.events":  "low 0\nhigh 0\nmax 1\noom 1\noom_kill 1\n",
+				"io.stat":        "8:0 rbytes=10 wbytes=20 rios=1 wios=2 dbytes=0 dios=0\n8:16 rbytes=5 wbytes=5 rios=1 wios=1 dbytes=0 dios=0\n",
+			},
+			expected: &cgroupStats{
+				cpu:         &cpuStats{userUsec: 200, systemUsec: 100, throttledUsec: uint64Ptr(50)},
+				memoryUsage: uint64Ptr(1024),
+				memoryLimit: uint64Ptr(2048),
+				oomKills:    uint64Ptr(1),
+				io:          &ioStats{readBytes: 15, writeBytes: 25, readOps: 2, writeOps: 3},
+			},
+		},
+		{
+			name: "no memory limit",
+			files: map[string]string{
+				"memory.current": "1024\n",
+				"memory.max":     "max\n",
+			},
+			expected: &cgroupStats{memoryUsage: uint64Ptr(1024)},
+		},
+		{
+			name:     "no files",
+			files:    map[string]string{},
+			expected: &cgroupStats{},
+		},
+		{
+			name: "invalid cpu.stat",
+			files: map[string]string{
+				"cpu.stat": "user_usec abc\n",
+			},
+			expectedErr: "invalid value for user_usec",
+		},
+		{
+			name: "invalid io.stat",
+			files: map[string]string{
+				"io.stat": "8:0 rbytes=abc\n",
+			},
+			expectedErr: "invalid value for rbytes of device 8:0",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stats, err := readCgroupStats(writeCgroupFiles(t, tt.files))
+			if tt.expectedErr != "" {
+				assert.ErrorContains(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, stats)
+		})
+	}
+}
@@ -0,0 +1,40 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package cgroupscraper // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/cgroupscraper"
+
+import (
+	"errors"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter/filterset"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/cgroupscraper/internal/metadata"
+)
+
+// Config relating to Cgroup Metric Scraper.
+type Config struct {
+	// MetricsBuilderConfig allows to customize scraped metrics/attributes representation.
+	metadata.MetricsBuilderConfig `mapstructure:",squash"`
+	// Include specifies a filter on the cgroup paths that should be included from the generated metrics.
+	// Exclude specifies a filter on the cgroup paths that should be excluded from the generated metrics.
+	// If neither `include` or `exclude` are set, metrics will be generated for all cgroups up to MaxDepth.
+	Include MatchConfig `mapstructure:"include"`
+	Exclude MatchConfig `mapstructure:"exclude"`
+
+	// MaxDepth is the maximum depth below the root of the cgroup hierarchy that is scraped.
+	// The root cgroup has a depth of 0, /system.slice has a depth of 1 and so on.
+	MaxDepth int `mapstructure:"max_depth"`
+}
+
+type MatchConfig struct {
+	filterset.Config `mapstructure:",squash"`
+
+	Paths []string `mapstructure:"paths"`
+}
+
+// Validate checks the scraper configuration is valid
+func (cfg *Config) Validate() error {
+	if cfg.MaxDepth < 0 {
+		return errors.New("max_depth must not be negative")
+	}
+	return nil
+}
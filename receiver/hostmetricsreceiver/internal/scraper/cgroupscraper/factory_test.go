@@ -0,0 +1,55 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package cgroupscraper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/collector/scraper/scrapertest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter/filterset"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/cgroupscraper/internal/metadata"
+)
+
+func TestCreateDefaultConfig(t *testing.T) {
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig()
+	assert.IsType(t, &Config{}, cfg)
+	assert.Equal(t, defaultMaxDepth, cfg.(*Config).MaxDepth)
+	assert.NoError(t, cfg.(*Config).Validate())
+}
+
+func TestCreateCgroupScraper(t *testing.T) {
+	factory := NewFactory()
+	cfg := &Config{}
+
+	scraper, err := factory.CreateMetrics(t.Context(), scrapertest.NewNopSettings(metadata.Type), cfg)
+
+	if supportedOS {
+		assert.NoError(t, err)
+		assert.NotNil(t, scraper)
+	} else {
+		assert.ErrorIs(t, err, errUnsupportedOS)
+		assert.Nil(t, scraper)
+	}
+}
+
+func TestCreateCgroupScraperInvalidFilter(t *testing.T) {
+	if !supportedOS {
+		t.Skip()
+	}
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig().(*Config)
+	cfg.Include = MatchConfig{Paths: []string{"["}, Config: filterset.Config{MatchType: filterset.Regexp}}
+
+	_, err := factory.CreateMetrics(t.Context(), scrapertest.NewNopSettings(metadata.Type), cfg)
+	assert.ErrorContains(t, err, "error creating cgroup include filters")
+}
+
+func TestValidate(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.MaxDepth = -1
+	assert.EqualError(t, cfg.Validate(), "max_depth must not be negative")
+}
@@ -0,0 +1,69 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package pressurescraper // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/pressurescraper"
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// pressureTotals holds the cumulative stall times of a single resource in
+// microseconds, keyed by the line type of the PSI file ("some" or "full").
+type pressureTotals map[string]uint64
+
+// readPressureTotals reads a PSI file such as /proc/pressure/cpu.
+func readPressureTotals(path string) (pressureTotals, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	totals, err := parsePressureTotals(f)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+	return totals, nil
+}
+
+// parsePressureTotals parses the content of a PSI file. The format is:
+//
+//	some avg10=0.00 avg60=0.00 avg300=0.00 total=12345
+//	full avg10=0.00 avg60=0.00 avg300=0.00 total=6789
+//
+// The "full" line is missing for the system wide CPU pressure on kernels
+// older than 5.13.
+func parsePressureTotals(r io.Reader) (pressureTotals, error) {
+	totals := pressureTotals{}
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 {
+			continue
+		}
+		for _, field := range fields[1:] {
+			value, ok := strings.CutPrefix(field, "total=")
+			if !ok {
+				continue
+			}
+			total, err := strconv.ParseUint(value, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid %s total %q: %w", fields[0], value, err)
+			}
+			totals[fields[0]] = total
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if len(totals) == 0 {
+		return nil, errors.New("no pressure totals found")
+	}
+	return totals, nil
+}
@@ -0,0 +1,97 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package pressurescraper // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/pressurescraper"
+
+import (
+	"context"
+	"time"
+
+	"github.com/shirou/gopsutil/v4/common"
+	"github.com/shirou/gopsutil/v4/host"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/scraper"
+	"go.opentelemetry.io/collector/scraper/scrapererror"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/gopsutilenv"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/pressurescraper/internal/metadata"
+)
+
+// Each resource file under /proc/pressure backs a single metric.
+const resourceMetricsLen = 1
+
+// stallTypes lists the PSI line types in the order they are recorded.
+var stallTypes = []string{"some", "full"}
+
+// scraper for Pressure Stall Information Metrics
+type pressureScraper struct {
+	settings scraper.Settings
+	config   *Config
+	mb       *metadata.MetricsBuilder
+
+	// for mocking
+	bootTime func(context.Context) (uint64, error)
+}
+
+// newPressureScraper creates a scraper for the /proc/pressure metrics
+func newPressureScraper(_ context.Context, settings scraper.Settings, cfg *Config) *pressureScraper {
+	return &pressureScraper{settings: settings, config: cfg, bootTime: host.BootTimeWithContext}
+}
+
+func (s *pressureScraper) start(ctx context.Context, _ component.Host) error {
+	// PSI totals are accumulated by the kernel since boot.
+	bootTime, err := s.bootTime(ctx)
+	if err != nil {
+		return err
+	}
+	s.mb = metadata.NewMetricsBuilder(s.config.MetricsBuilderConfig, s.settings, metadata.WithStartTime(pcommon.Timestamp(bootTime*1e9)))
+	return nil
+}
+
+func (s *pressureScraper) scrape(ctx context.Context) (pmetric.Metrics, error) {
+	var errs scrapererror.ScrapeErrors
+	now := pcommon.NewTimestampFromTime(time.Now())
+
+	if s.config.Metrics.SystemCPUPressureStallTime.Enabled {
+		if err := s.recordResourcePressure(ctx, now, "cpu", s.mb.RecordSystemCPUPressureStallTimeDataPoint); err != nil {
+			errs.AddPartial(resourceMetricsLen, err)
+		}
+	}
+	if s.config.Metrics.SystemMemoryPressureStallTime.Enabled {
+		if err := s.recordResourcePressure(ctx, now, "memory", s.mb.RecordSystemMemoryPressureStallTimeDataPoint); err != nil {
+			errs.AddPartial(resourceMetricsLen, err)
+		}
+	}
+	if s.config.Metrics.SystemIoPressureStallTime.Enabled {
+		if err := s.recordResourcePressure(ctx, now, "io", s.mb.RecordSystemIoPressureStallTimeDataPoint); err != nil {
+			errs.AddPartial(resourceMetricsLen, err)
+		}
+	}
+
+	return s.mb.Emit(), errs.Combine()
+}
+
+func (s *pressureScraper) recordResourcePressure(
+	ctx context.Context,
+	now pcommon.Timestamp,
+	resource string,
+	record func(pcommon.Timestamp, float64, metadata.AttributeStallType),
+) error {
+	path := gopsutilenv.GetEnvWithContext(ctx, string(common.HostProcEnvKey), "/proc", "pressure", resource)
+	totals, err := readPressureTotals(path)
+	if err != nil {
+		return err
+	}
+
+	for _, stallType := range stallTypes {
+		total, ok := totals[stallType]
+		if !ok {
+			continue
+		}
+		// The kernel reports totals in microseconds.
+		record(now, float64(total)/1e6, metadata.MapAttributeStallType[stallType])
+	}
+	return nil
+}
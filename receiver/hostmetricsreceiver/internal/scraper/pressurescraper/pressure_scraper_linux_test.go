@@ -0,0 +1,89 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build linux
+
+package pressurescraper
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/shirou/gopsutil/v4/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/scraper/scrapererror"
+	"go.opentelemetry.io/collector/scraper/scrapertest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/pressurescraper/internal/metadata"
+)
+
+func newTestPressureScraper(ctx context.Context, t *testing.T, bootTime time.Time) *pressureScraper {
+	t.Helper()
+	s := newPressureScraper(ctx, scrapertest.NewNopSettings(metadata.Type), &Config{
+		MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig(),
+	})
+	s.bootTime = func(context.Context) (uint64, error) {
+		return uint64(bootTime.Unix()), nil
+	}
+	require.NoError(t, s.start(ctx, componenttest.NewNopHost()))
+	return s
+}
+
+func TestScrape(t *testing.T) {
+	ctx := context.WithValue(
+		t.Context(),
+		common.EnvKey,
+		common.EnvMap{common.HostProcEnvKey: filepath.Join("testdata", "proc")},
+	)
+	bootTime := time.Date(2006, 0o1, 0o2, 0o3, 0o4, 0o5, 0, time.UTC)
+	s := newTestPressureScraper(ctx, t, bootTime)
+
+	metrics, err := s.scrape(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 3, metrics.MetricCount())
+	assert.Equal(t, 6, metrics.DataPointCount())
+
+	expected := map[string]map[string]float64{
+		metadata.MetricsInfo.SystemCPUPressureStallTime.Name:    {"some": 1.5, "full": 0},
+		metadata.MetricsInfo.SystemMemoryPressureStallTime.Name: {"some": 2.5, "full": 0.75},
+		metadata.MetricsInfo.SystemIoPressureStallTime.Name:     {"some": 4, "full": 3},
+	}
+	ms := metrics.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	for i := 0; i < ms.Len(); i++ {
+		m := ms.At(i)
+		want, ok := expected[m.Name()]
+		require.Truef(t, ok, "unexpected metric %s", m.Name())
+		require.Equal(t, pmetric.MetricTypeSum, m.Type())
+		dps := m.Sum().DataPoints()
+		require.Equal(t, len(want), dps.Len())
+		for j := 0; j < dps.Len(); j++ {
+			dp := dps.At(j)
+			stallType, ok := dp.Attributes().Get("stall.type")
+			require.True(t, ok)
+			assert.InDelta(t, want[stallType.Str()], dp.DoubleValue(), 1e-9, "%s %s", m.Name(), stallType.Str())
+			assert.Equal(t, bootTime, dp.StartTimestamp().AsTime())
+		}
+	}
+}
+
+func TestScrapeMissingPressureFiles(t *testing.T) {
+	ctx := context.WithValue(
+		t.Context(),
+		common.EnvKey,
+		common.EnvMap{common.HostProcEnvKey: t.TempDir()},
+	)
+	s := newTestPressureScraper(ctx, t, time.Now())
+
+	metrics, err := s.scrape(ctx)
+	require.Error(t, err)
+	require.True(t, scrapererror.IsPartialScrapeError(err))
+	var partialErr scrapererror.PartialScrapeError
+	require.ErrorAs(t, err, &partialErr)
+	assert.Equal(t, 3, partialErr.Failed)
+	assert.Equal(t, 0, metrics.MetricCount())
+}
@@ -0,0 +1,53 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package pressurescraper
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePressureTotals(t *testing.T) {
+	tests := []struct {
+		name        string
+		content     string
+		expected    pressureTotals
+		expectedErr string
+	}{
+		{
+			name:     "some and full",
+			content:  "some avg10=0.00 avg60=0.00 avg300=0.00 total=12345\nfull avg10=0.00 avg60=0.00 avg300=0.00 total=6789\n",
+			expected: pressureTotals{"some": 12345, "full": 6789},
+		},
+		{
+			name:     "some only",
+			content:  "some avg10=2.04 avg60=0.75 avg300=0.40 total=157622356\n",
+			expected: pressureTotals{"some": 157622356},
+		},
+		{
+			name:        "invalid total",
+			content:     "some avg10=0.00 avg60=0.00 avg300=0.00 total=abc\n",
+			expectedErr: `invalid some total "abc"`,
+		},
+		{
+			name:        "empty",
+			content:     "",
+			expectedErr: "no pressure totals found",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			totals, err := parsePressureTotals(strings.NewReader(tt.content))
+			if tt.expectedErr != "" {
+				assert.ErrorContains(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, totals)
+		})
+	}
+}
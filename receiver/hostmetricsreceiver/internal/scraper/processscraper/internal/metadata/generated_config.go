@@ -38,6 +38,8 @@ type MetricsConfig struct {
 	ProcessMemoryUsage         MetricConfig `mapstructure:"process.memory.usage"`
 	ProcessMemoryUtilization   MetricConfig `mapstructure:"process.memory.utilization"`
 	ProcessMemoryVirtual       MetricConfig `mapstructure:"process.memory.virtual"`
+	ProcessNetworkConnections  MetricConfig `mapstructure:"process.network.connections"`
+	ProcessNetworkSockets      MetricConfig `mapstructure:"process.network.sockets"`
 	ProcessOpenFileDescriptors MetricConfig `mapstructure:"process.open_file_descriptors"`
 	ProcessPagingFaults        MetricConfig `mapstructure:"process.paging.faults"`
 	ProcessSignalsPending      MetricConfig `mapstructure:"process.signals_pending"`
@@ -74,6 +76,12 @@ func DefaultMetricsConfig() MetricsConfig {
 		ProcessMemoryVirtual: MetricConfig{
 			Enabled: true,
 		},
+		ProcessNetworkConnections: MetricConfig{
+			Enabled: false,
+		},
+		ProcessNetworkSockets: MetricConfig{
+			Enabled: false,
+		},
 		ProcessOpenFileDescriptors: MetricConfig{
 			Enabled: false,
 		},
@@ -53,6 +53,12 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					ProcessMemoryVirtual: MetricConfig{
 						Enabled: true,
 					},
+					ProcessNetworkConnections: MetricConfig{
+						Enabled: true,
+					},
+					ProcessNetworkSockets: MetricConfig{
+						Enabled: true,
+					},
 					ProcessOpenFileDescriptors: MetricConfig{
 						Enabled: true,
 					},
@@ -112,6 +118,12 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					ProcessMemoryVirtual: MetricConfig{
 						Enabled: false,
 					},
+					ProcessNetworkConnections: MetricConfig{
+						Enabled: false,
+					},
+					ProcessNetworkSockets: MetricConfig{
+						Enabled: false,
+					},
 					ProcessOpenFileDescriptors: MetricConfig{
 						Enabled: false,
 					},
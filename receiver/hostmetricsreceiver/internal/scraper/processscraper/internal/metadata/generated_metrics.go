@@ -91,6 +91,32 @@ var MapAttributePagingFaultType = map[string]AttributePagingFaultType{
 	"minor": AttributePagingFaultTypeMinor,
 }
 
+// AttributeProtocol specifies the value protocol attribute.
+type AttributeProtocol int
+
+const (
+	_ AttributeProtocol = iota
+	AttributeProtocolTcp
+	AttributeProtocolUdp
+)
+
+// String returns the string representation of the AttributeProtocol.
+func (av AttributeProtocol) String() string {
+	switch av {
+	case AttributeProtocolTcp:
+		return "tcp"
+	case AttributeProtocolUdp:
+		return "udp"
+	}
+	return ""
+}
+
+// MapAttributeProtocol is a helper map of string to AttributeProtocol attribute value.
+var MapAttributeProtocol = map[string]AttributeProtocol{
+	"tcp": AttributeProtocolTcp,
+	"udp": AttributeProtocolUdp,
+}
+
 // AttributeState specifies the value state attribute.
 type AttributeState int
 
@@ -149,6 +175,12 @@ var MetricsInfo = metricsInfo{
 	ProcessMemoryVirtual: metricInfo{
 		Name: "process.memory.virtual",
 	},
+	ProcessNetworkConnections: metricInfo{
+		Name: "process.network.connections",
+	},
+	ProcessNetworkSockets: metricInfo{
+		Name: "process.network.sockets",
+	},
 	ProcessOpenFileDescriptors: metricInfo{
 		Name: "process.open_file_descriptors",
 	},
@@ -176,6 +208,8 @@ type metricsInfo struct {
 	ProcessMemoryUsage         metricInfo
 	ProcessMemoryUtilization   metricInfo
 	ProcessMemoryVirtual       metricInfo
+	ProcessNetworkConnections  metricInfo
+	ProcessNetworkSockets      metricInfo
 	ProcessOpenFileDescriptors metricInfo
 	ProcessPagingFaults        metricInfo
 	ProcessSignalsPending      metricInfo
@@ -661,6 +695,115 @@ func newMetricProcessMemoryVirtual(cfg MetricConfig) metricProcessMemoryVirtual
 	return m
 }
 
+type metricProcessNetworkConnections struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills process.network.connections metric with initial data.
+func (m *metricProcessNetworkConnections) init() {
+	m.data.SetName("process.network.connections")
+	m.data.SetDescription("Number of TCP connections held by the process, broken down by connection state.")
+	m.data.SetUnit("{connections}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(false)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricProcessNetworkConnections) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, protocolAttributeValue string, connectionStateAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("protocol", protocolAttributeValue)
+	dp.Attributes().PutStr("state", connectionStateAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricProcessNetworkConnections) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricProcessNetworkConnections) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricProcessNetworkConnections(cfg MetricConfig) metricProcessNetworkConnections {
+	m := metricProcessNetworkConnections{config: cfg}
+
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricProcessNetworkSockets struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills process.network.sockets metric with initial data.
+func (m *metricProcessNetworkSockets) init() {
+	m.data.SetName("process.network.sockets")
+	m.data.SetDescription("Number of open internet sockets held by the process.")
+	m.data.SetUnit("{sockets}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(false)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricProcessNetworkSockets) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, protocolAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("protocol", protocolAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricProcessNetworkSockets) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricProcessNetworkSockets) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricProcessNetworkSockets(cfg MetricConfig) metricProcessNetworkSockets {
+	m := metricProcessNetworkSockets{config: cfg}
+
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricProcessOpenFileDescriptors struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
@@ -940,6 +1083,8 @@ type MetricsBuilder struct {
 	metricProcessMemoryUsage         metricProcessMemoryUsage
 	metricProcessMemoryUtilization   metricProcessMemoryUtilization
 	metricProcessMemoryVirtual       metricProcessMemoryVirtual
+	metricProcessNetworkConnections  metricProcessNetworkConnections
+	metricProcessNetworkSockets      metricProcessNetworkSockets
 	metricProcessOpenFileDescriptors metricProcessOpenFileDescriptors
 	metricProcessPagingFaults        metricProcessPagingFaults
 	metricProcessSignalsPending      metricProcessSignalsPending
@@ -979,6 +1124,8 @@ func NewMetricsBuilder(mbc MetricsBuilderConfig, settings scraper.Settings, opti
 		metricProcessMemoryUsage:         newMetricProcessMemoryUsage(mbc.Metrics.ProcessMemoryUsage),
 		metricProcessMemoryUtilization:   newMetricProcessMemoryUtilization(mbc.Metrics.ProcessMemoryUtilization),
 		metricProcessMemoryVirtual:       newMetricProcessMemoryVirtual(mbc.Metrics.ProcessMemoryVirtual),
+		metricProcessNetworkConnections:  newMetricProcessNetworkConnections(mbc.Metrics.ProcessNetworkConnections),
+		metricProcessNetworkSockets:      newMetricProcessNetworkSockets(mbc.Metrics.ProcessNetworkSockets),
 		metricProcessOpenFileDescriptors: newMetricProcessOpenFileDescriptors(mbc.Metrics.ProcessOpenFileDescriptors),
 		metricProcessPagingFaults:        newMetricProcessPagingFaults(mbc.Metrics.ProcessPagingFaults),
 		metricProcessSignalsPending:      newMetricProcessSignalsPending(mbc.Metrics.ProcessSignalsPending),
@@ -1114,6 +1261,8 @@ func (mb *MetricsBuilder) EmitForResource(options ...ResourceMetricsOption) {
 	mb.metricProcessMemoryUsage.emit(ils.Metrics())
 	mb.metricProcessMemoryUtilization.emit(ils.Metrics())
 	mb.metricProcessMemoryVirtual.emit(ils.Metrics())
+	mb.metricProcessNetworkConnections.emit(ils.Metrics())
+	mb.metricProcessNetworkSockets.emit(ils.Metrics())
 	mb.metricProcessOpenFileDescriptors.emit(ils.Metrics())
 	mb.metricProcessPagingFaults.emit(ils.Metrics())
 	mb.metricProcessSignalsPending.emit(ils.Metrics())
@@ -1195,6 +1344,16 @@ func (mb *MetricsBuilder) RecordProcessMemoryVirtualDataPoint(ts pcommon.Timesta
 	mb.metricProcessMemoryVirtual.recordDataPoint(mb.startTime, ts, val)
 }
 
+// RecordProcessNetworkConnectionsDataPoint adds a data point to process.network.connections metric.
+func (mb *MetricsBuilder) RecordProcessNetworkConnectionsDataPoint(ts pcommon.Timestamp, val int64, protocolAttributeValue AttributeProtocol, connectionStateAttributeValue string) {
+	mb.metricProcessNetworkConnections.recordDataPoint(mb.startTime, ts, val, protocolAttributeValue.String(), connectionStateAttributeValue)
+}
+
+// RecordProcessNetworkSocketsDataPoint adds a data point to process.network.sockets metric.
+func (mb *MetricsBuilder) RecordProcessNetworkSocketsDataPoint(ts pcommon.Timestamp, val int64, protocolAttributeValue AttributeProtocol) {
+	mb.metricProcessNetworkSockets.recordDataPoint(mb.startTime, ts, val, protocolAttributeValue.String())
+}
+
 // RecordProcessOpenFileDescriptorsDataPoint adds a data point to process.open_file_descriptors metric.
 func (mb *MetricsBuilder) RecordProcessOpenFileDescriptorsDataPoint(ts pcommon.Timestamp, val int64) {
 	mb.metricProcessOpenFileDescriptors.recordDataPoint(mb.startTime, ts, val)
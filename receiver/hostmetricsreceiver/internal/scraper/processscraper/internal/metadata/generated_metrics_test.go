@@ -98,6 +98,12 @@ func TestMetricsBuilder(t *testing.T) {
 			allMetricsCount++
 			mb.RecordProcessMemoryVirtualDataPoint(ts, 1)
 
+			allMetricsCount++
+			mb.RecordProcessNetworkConnectionsDataPoint(ts, 1, AttributeProtocolTcp, "state-val")
+
+			allMetricsCount++
+			mb.RecordProcessNetworkSocketsDataPoint(ts, 1, AttributeProtocolTcp)
+
 			allMetricsCount++
 			mb.RecordProcessOpenFileDescriptorsDataPoint(ts, 1)
 
@@ -281,6 +287,43 @@ func TestMetricsBuilder(t *testing.T) {
 					assert.Equal(t, ts, dp.Timestamp())
 					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
 					assert.Equal(t, int64(1), dp.IntValue())
+				case "process.network.connections":
+					assert.False(t, validatedMetrics["process.network.connections"], "Found a duplicate in the metrics slice: process.network.connections")
+					validatedMetrics["process.network.connections"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "Number of TCP connections held by the process, broken down by connection state.", ms.At(i).Description())
+					assert.Equal(t, "{connections}", ms.At(i).Unit())
+					assert.False(t, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("protocol")
+					assert.True(t, ok)
+					assert.Equal(t, "tcp", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("state")
+					assert.True(t, ok)
+					assert.Equal(t, "state-val", attrVal.Str())
+				case "process.network.sockets":
+					assert.False(t, validatedMetrics["process.network.sockets"], "Found a duplicate in the metrics slice: process.network.sockets")
+					validatedMetrics["process.network.sockets"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "Number of open internet sockets held by the process.", ms.At(i).Description())
+					assert.Equal(t, "{sockets}", ms.At(i).Unit())
+					assert.False(t, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("protocol")
+					assert.True(t, ok)
+					assert.Equal(t, "tcp", attrVal.Str())
 				case "process.open_file_descriptors":
 					assert.False(t, validatedMetrics["process.open_file_descriptors"], "Found a duplicate in the metrics slice: process.open_file_descriptors")
 					validatedMetrics["process.open_file_descriptors"] = true
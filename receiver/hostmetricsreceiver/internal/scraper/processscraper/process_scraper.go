@@ -37,6 +37,7 @@ const (
 	handleMetricsLen            = 1
 	signalMetricsLen            = 1
 	uptimeMetricsLen            = 1
+	networkMetricsLen           = 2
 
 	metricsLen = cpuMetricsLen + memoryMetricsLen + diskMetricsLen + memoryUtilizationMetricsLen + pagingMetricsLen + threadMetricsLen + contextSwitchMetricsLen + fileDescriptorMetricsLen + signalMetricsLen + handleCountMetricsLen + uptimeMetricsLen
 )
@@ -176,6 +177,10 @@ func (s *processScraper) scrape(ctx context.Context) (pmetric.Metrics, error) {
 			errs.AddPartial(uptimeMetricsLen, fmt.Errorf("error calculating uptime for process %q (pid %v): %w", md.executable.name, md.pid, err))
 		}
 
+		if err = s.scrapeAndAppendNetworkMetrics(ctx, now, md.pid); err != nil {
+			errs.AddPartial(networkMetricsLen, fmt.Errorf("error reading network connections for process %q (pid %v): %w", md.executable.name, md.pid, err))
+		}
+
 		s.mb.EmitForResource(metadata.WithResource(md.buildResource(s.mb.NewResourceBuilder())),
 			metadata.WithStartTimeOverride(pcommon.Timestamp(md.createTime*1e6)))
 	}
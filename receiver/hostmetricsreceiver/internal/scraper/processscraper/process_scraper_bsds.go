@@ -61,3 +61,7 @@ func getProcessCommand(ctx context.Context, proc processHandle) (*commandMetadat
 	command := &commandMetadata{command: cmdline, commandLine: cmdline}
 	return command, nil
 }
+
+func (*processScraper) scrapeAndAppendNetworkMetrics(context.Context, pcommon.Timestamp, int32) error {
+	return nil
+}
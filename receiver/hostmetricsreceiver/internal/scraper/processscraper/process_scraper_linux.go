@@ -7,8 +7,10 @@ package processscraper // import "github.com/open-telemetry/opentelemetry-collec
 
 import (
 	"context"
+	"syscall"
 
 	"github.com/shirou/gopsutil/v4/cpu"
+	"github.com/shirou/gopsutil/v4/net"
 	"go.opentelemetry.io/collector/pdata/pcommon"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/processscraper/internal/metadata"
@@ -68,3 +70,35 @@ func getProcessCommand(ctx context.Context, proc processHandle) (*commandMetadat
 	command := &commandMetadata{command: cmd, commandLineSlice: cmdline}
 	return command, nil
 }
+
+func (s *processScraper) scrapeAndAppendNetworkMetrics(ctx context.Context, now pcommon.Timestamp, pid int32) error {
+	if !s.config.Metrics.ProcessNetworkSockets.Enabled && !s.config.Metrics.ProcessNetworkConnections.Enabled {
+		return nil
+	}
+
+	// Skipping the uids avoids reading the status file of every process owning a socket.
+	connections, err := net.ConnectionsPidWithoutUidsWithContext(ctx, "inet", pid)
+	if err != nil {
+		return err
+	}
+
+	var tcpSockets, udpSockets int64
+	tcpStateCounts := make(map[string]int64)
+	for _, connection := range connections {
+		switch connection.Type {
+		case syscall.SOCK_STREAM:
+			tcpSockets++
+			tcpStateCounts[connection.Status]++
+		case syscall.SOCK_DGRAM:
+			udpSockets++
+		}
+	}
+
+	s.mb.RecordProcessNetworkSocketsDataPoint(now, tcpSockets, metadata.AttributeProtocolTcp)
+	s.mb.RecordProcessNetworkSocketsDataPoint(now, udpSockets, metadata.AttributeProtocolUdp)
+	for state, count := range tcpStateCounts {
+		s.mb.RecordProcessNetworkConnectionsDataPoint(now, count, metadata.AttributeProtocolTcp, state)
+	}
+
+	return nil
+}
@@ -28,7 +28,8 @@ import (
 // testdata/procfs folder. New processes must be added here to be usable
 // within tests.
 var testdataProcesses = map[string]int32{
-	"context switches": 828531,
+	"context switches":    828531,
+	"network connections": 828600,
 }
 
 // testdataProcessHandles will create a list of processHandles from the list of valid
@@ -103,12 +104,11 @@ func TestProcessScraper_Procfs(t *testing.T) {
 		common.EnvMap{common.HostProcEnvKey: filepath.Join(wd, "testdata", "procfs")},
 	)
 
-	scraper := newTestProcessScraper(ctx, t)
-
 	handles, err := testdataProcessHandles(ctx)
 	require.NoError(t, err)
 
-	runTestdataProcfsTest(ctx, t, scraper, handles, "context switches", testContextSwitches)
+	runTestdataProcfsTest(ctx, t, handles, "context switches", testContextSwitches)
+	runTestdataProcfsTest(ctx, t, handles, "network connections", testNetworkConnections)
 }
 
 type testdataProcfsTestFunc func(context.Context, *testing.T, *processScraper, processHandles)
@@ -116,14 +116,14 @@ type testdataProcfsTestFunc func(context.Context, *testing.T, *processScraper, p
 func runTestdataProcfsTest(
 	ctx context.Context,
 	t *testing.T,
-	scraper *processScraper,
 	handles processHandles,
 	name string,
 	testFunc testdataProcfsTestFunc,
 ) {
 	t.Run(name, func(t *testing.T) {
 		t.Parallel()
-		testFunc(ctx, t, scraper, handles)
+		// Each test gets its own scraper so that emitted metrics don't mix.
+		testFunc(ctx, t, newTestProcessScraper(ctx, t), handles)
 	})
 }
 
@@ -181,6 +181,44 @@ func testContextSwitches(ctx context.Context, t *testing.T, scraper *processScra
 	require.True(t, foundInvoluntarySwitches && foundVoluntarySwitches)
 }
 
+// testNetworkConnections tests process 828600. The process owns 3 TCP sockets
+// (1 listening, 2 established) and 1 UDP socket. The net/tcp file also
+// contains a TIME_WAIT connection without an owner and an established
+// connection owned by another process, neither should be counted.
+func testNetworkConnections(ctx context.Context, t *testing.T, scraper *processScraper, _ processHandles) {
+	require.NoError(t, scraper.scrapeAndAppendNetworkMetrics(ctx, pcommon.NewTimestampFromTime(time.Now()), testdataProcesses["network connections"]))
+
+	metrics := scraper.mb.Emit()
+	require.Equal(t, 1, metrics.ResourceMetrics().Len())
+	ms := metrics.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	require.Equal(t, 2, ms.Len())
+
+	sockets := map[string]int64{}
+	connections := map[string]int64{}
+	for i := range ms.Len() {
+		m := ms.At(i)
+		dps := m.Sum().DataPoints()
+		for k := range dps.Len() {
+			dp := dps.At(k)
+			protocol, ok := dp.Attributes().Get("protocol")
+			require.True(t, ok)
+			switch m.Name() {
+			case metadata.MetricsInfo.ProcessNetworkSockets.Name:
+				sockets[protocol.Str()] = dp.IntValue()
+			case metadata.MetricsInfo.ProcessNetworkConnections.Name:
+				require.Equal(t, metadata.AttributeProtocolTcp.String(), protocol.Str())
+				state, ok := dp.Attributes().Get("state")
+				require.True(t, ok)
+				connections[state.Str()] = dp.IntValue()
+			default:
+				require.Failf(t, "unexpected metric", "metric %s", m.Name())
+			}
+		}
+	}
+	require.Equal(t, map[string]int64{"tcp": 3, "udp": 1}, sockets)
+	require.Equal(t, map[string]int64{"LISTEN": 1, "ESTABLISHED": 2}, connections)
+}
+
 // metricsConfigAllEnabled uses reflection to mark all metrics in
 // metadata.MetricsConfig as Enabled. This should be resilient to
 // new metrics being added.
@@ -0,0 +1,201 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package k8sauditreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sauditreceiver"
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	conventions "go.opentelemetry.io/otel/semconv/v1.38.0"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sauditreceiver/internal/metadata"
+)
+
+const (
+	auditAPIVersion = "audit.k8s.io/v1"
+	eventListKind   = "EventList"
+	eventKind       = "Event"
+)
+
+var errNotAuditEvent = errors.New("not an audit.k8s.io/v1 Event")
+
+// eventList mirrors the audit.k8s.io/v1 EventList posted by the webhook backend.
+// The events are kept raw so that each of them can be decoded both into an event
+// and into the body of its log record.
+type eventList struct {
+	metav1.TypeMeta `json:",inline"`
+	Items           []json.RawMessage `json:"items"`
+}
+
+// event mirrors the fields of the audit.k8s.io/v1 Event mapped to log record attributes.
+// The k8s.io/apiserver module defining it is not imported to keep the dependencies small.
+type event struct {
+	metav1.TypeMeta          `json:",inline"`
+	Level                    string                     `json:"level"`
+	AuditID                  types.UID                  `json:"auditID"`
+	Stage                    string                     `json:"stage"`
+	RequestURI               string                     `json:"requestURI"`
+	Verb                     string                     `json:"verb"`
+	User                     authenticationv1.UserInfo  `json:"user"`
+	ImpersonatedUser         *authenticationv1.UserInfo `json:"impersonatedUser,omitempty"`
+	SourceIPs                []string                   `json:"sourceIPs,omitempty"`
+	UserAgent                string                     `json:"userAgent,omitempty"`
+	ObjectRef                *objectReference           `json:"objectRef,omitempty"`
+	ResponseStatus           *metav1.Status             `json:"responseStatus,omitempty"`
+	RequestReceivedTimestamp metav1.MicroTime           `json:"requestReceivedTimestamp"`
+	StageTimestamp           metav1.MicroTime           `json:"stageTimestamp"`
+	Annotations              map[string]string          `json:"annotations,omitempty"`
+}
+
+// objectReference mirrors the audit.k8s.io/v1 ObjectReference.
+type objectReference struct {
+	Resource        string    `json:"resource,omitempty"`
+	Namespace       string    `json:"namespace,omitempty"`
+	Name            string    `json:"name,omitempty"`
+	UID             types.UID `json:"uid,omitempty"`
+	APIGroup        string    `json:"apiGroup,omitempty"`
+	APIVersion      string    `json:"apiVersion,omitempty"`
+	ResourceVersion string    `json:"resourceVersion,omitempty"`
+	Subresource     string    `json:"subresource,omitempty"`
+}
+
+// decodeEventList decodes the events of an audit.k8s.io/v1 EventList.
+func decodeEventList(data []byte) ([]json.RawMessage, error) {
+	var list eventList
+	if err := json.Unmarshal(data, &list); err != nil {
+		return nil, err
+	}
+	if list.APIVersion != auditAPIVersion || list.Kind != eventListKind {
+		return nil, fmt.Errorf("unexpected %s %s, expected %s %s", list.APIVersion, list.Kind, auditAPIVersion, eventListKind)
+	}
+	return list.Items, nil
+}
+
+// decodeEvent decodes an audit.k8s.io/v1 Event, returning it along with its raw fields.
+func decodeEvent(data []byte) (*event, map[string]any, error) {
+	ev := &event{}
+	if err := json.Unmarshal(data, ev); err != nil {
+		return nil, nil, err
+	}
+	if ev.APIVersion != auditAPIVersion || ev.Kind != eventKind {
+		return nil, nil, errNotAuditEvent
+	}
+	raw := map[string]any{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, nil, err
+	}
+	return ev, raw, nil
+}
+
+// newLogs creates the logs the audit events are appended to.
+func newLogs(version string) (plog.Logs, plog.LogRecordSlice) {
+	ld := plog.NewLogs()
+	sl := ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty()
+	sl.Scope().SetName(metadata.ScopeName)
+	sl.Scope().SetVersion(version)
+	return ld, sl.LogRecords()
+}
+
+// eventToLogRecord maps an audit event to a log record. The whole event is kept as the body,
+// while the user, verb, object, response code and latency are added as attributes.
+func eventToLogRecord(ev *event, raw map[string]any, lr plog.LogRecord) error {
+	if err := lr.Body().SetEmptyMap().FromRaw(raw); err != nil {
+		return err
+	}
+
+	timestamp := ev.StageTimestamp.Time
+	if timestamp.IsZero() {
+		timestamp = ev.RequestReceivedTimestamp.Time
+	}
+	lr.SetTimestamp(pcommon.NewTimestampFromTime(timestamp))
+	lr.SetObservedTimestamp(pcommon.NewTimestampFromTime(time.Now()))
+
+	attrs := lr.Attributes()
+	attrs.PutStr("k8s.audit.id", string(ev.AuditID))
+	attrs.PutStr("k8s.audit.level", ev.Level)
+	attrs.PutStr("k8s.audit.stage", ev.Stage)
+	attrs.PutStr("k8s.audit.verb", ev.Verb)
+	attrs.PutStr("k8s.audit.request_uri", ev.RequestURI)
+
+	attrs.PutStr(string(conventions.UserNameKey), ev.User.Username)
+	if ev.User.UID != "" {
+		attrs.PutStr(string(conventions.UserIDKey), ev.User.UID)
+	}
+	putStrSlice(attrs, "k8s.audit.user.groups", ev.User.Groups)
+	if ev.ImpersonatedUser != nil {
+		attrs.PutStr("k8s.audit.impersonated_user.name", ev.ImpersonatedUser.Username)
+		putStrSlice(attrs, "k8s.audit.impersonated_user.groups", ev.ImpersonatedUser.Groups)
+	}
+
+	if len(ev.SourceIPs) > 0 {
+		attrs.PutStr(string(conventions.ClientAddressKey), ev.SourceIPs[0])
+		putStrSlice(attrs, "k8s.audit.source_ips", ev.SourceIPs)
+	}
+	if ev.UserAgent != "" {
+		attrs.PutStr(string(conventions.UserAgentOriginalKey), ev.UserAgent)
+	}
+
+	if ref := ev.ObjectRef; ref != nil {
+		putNonEmptyStr(attrs, "k8s.object.resource", ref.Resource)
+		putNonEmptyStr(attrs, "k8s.object.subresource", ref.Subresource)
+		putNonEmptyStr(attrs, "k8s.object.api_group", ref.APIGroup)
+		putNonEmptyStr(attrs, "k8s.object.api_version", ref.APIVersion)
+		putNonEmptyStr(attrs, "k8s.object.name", ref.Name)
+		putNonEmptyStr(attrs, "k8s.object.uid", string(ref.UID))
+		putNonEmptyStr(attrs, string(conventions.K8SNamespaceNameKey), ref.Namespace)
+	}
+
+	if status := ev.ResponseStatus; status != nil {
+		attrs.PutInt(string(conventions.HTTPResponseStatusCodeKey), int64(status.Code))
+		putNonEmptyStr(attrs, "k8s.audit.response.reason", string(status.Reason))
+		lr.SetSeverityNumber(severityFromCode(status.Code))
+	} else {
+		lr.SetSeverityNumber(plog.SeverityNumberInfo)
+	}
+
+	if !ev.RequestReceivedTimestamp.IsZero() && !ev.StageTimestamp.IsZero() {
+		attrs.PutDouble("k8s.audit.latency", ev.StageTimestamp.Sub(ev.RequestReceivedTimestamp.Time).Seconds())
+	}
+
+	for k, v := range ev.Annotations {
+		attrs.PutStr("k8s.audit.annotation."+k, v)
+	}
+	return nil
+}
+
+// severityFromCode maps the response code of a request to the severity of its audit event.
+func severityFromCode(code int32) plog.SeverityNumber {
+	switch {
+	case code >= 500:
+		return plog.SeverityNumberError
+	case code >= 400:
+		return plog.SeverityNumberWarn
+	default:
+		return plog.SeverityNumberInfo
+	}
+}
+
+func putNonEmptyStr(attrs pcommon.Map, key, value string) {
+	if value != "" {
+		attrs.PutStr(key, value)
+	}
+}
+
+func putStrSlice(attrs pcommon.Map, key string, values []string) {
+	if len(values) == 0 {
+		return
+	}
+	s := attrs.PutEmptySlice(key)
+	s.EnsureCapacity(len(values))
+	for _, v := range values {
+		s.AppendEmpty().SetStr(v)
+	}
+}
@@ -0,0 +1,112 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package k8sauditreceiver
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+)
+
+func TestDecodeEventList(t *testing.T) {
+	data, err := os.ReadFile(filepath.Join("testdata", "eventlist.json"))
+	require.NoError(t, err)
+
+	items, err := decodeEventList(data)
+	require.NoError(t, err)
+	assert.Len(t, items, 2)
+
+	_, err = decodeEventList([]byte(`{"kind":"EventList","apiVersion":"audit.k8s.io/v1beta1","items":[]}`))
+	assert.Error(t, err)
+
+	_, err = decodeEventList([]byte(`not json`))
+	assert.Error(t, err)
+}
+
+func TestDecodeEvent(t *testing.T) {
+	_, _, err := decodeEvent([]byte(`{"kind":"Pod","apiVersion":"v1"}`))
+	assert.ErrorIs(t, err, errNotAuditEvent)
+
+	_, _, err = decodeEvent([]byte(`{"kind":`))
+	assert.Error(t, err)
+}
+
+func TestEventToLogRecord(t *testing.T) {
+	data, err := os.ReadFile(filepath.Join("testdata", "eventlist.json"))
+	require.NoError(t, err)
+	items, err := decodeEventList(data)
+	require.NoError(t, err)
+
+	ev, raw, err := decodeEvent(items[0])
+	require.NoError(t, err)
+	lr := plog.NewLogRecord()
+	require.NoError(t, eventToLogRecord(ev, raw, lr))
+
+	assert.Equal(t, pcommon.NewTimestampFromTime(time.Date(2026, 10, 18, 10, 0, 0, 250000000, time.UTC)), lr.Timestamp())
+	assert.NotZero(t, lr.ObservedTimestamp())
+	assert.Equal(t, plog.SeverityNumberInfo, lr.SeverityNumber())
+	assert.Equal(t, raw, lr.Body().Map().AsRaw())
+
+	assert.Equal(t, map[string]any{
+		"k8s.audit.id":                       "0a4376d5-307a-4e16-a049-24e017ab1d8f",
+		"k8s.audit.level":                    "Metadata",
+		"k8s.audit.stage":                    "ResponseComplete",
+		"k8s.audit.verb":                     "patch",
+		"k8s.audit.request_uri":              "/apis/apps/v1/namespaces/default/deployments/checkout",
+		"user.name":                          "alice",
+		"user.id":                            "1c2ea7a8-6c52-4b1a-9fb4-3d4a7d1c8e3f",
+		"k8s.audit.user.groups":              []any{"developers", "system:authenticated"},
+		"k8s.audit.impersonated_user.name":   "system:serviceaccount:default:deployer",
+		"k8s.audit.impersonated_user.groups": []any{"system:serviceaccounts"},
+		"client.address":                     "10.0.0.12",
+		"k8s.audit.source_ips":               []any{"10.0.0.12", "192.168.1.4"},
+		"user_agent.original":                "kubectl/v1.35.0 (linux/amd64) kubernetes/abcdef",
+		"k8s.object.resource":                "deployments",
+		"k8s.object.api_group":               "apps",
+		"k8s.object.api_version":             "v1",
+		"k8s.object.name":                    "checkout",
+		"k8s.namespace.name":                 "default",
+		"http.response.status_code":          int64(200),
+		"k8s.audit.latency":                  0.25,
+		"k8s.audit.annotation.authorization.k8s.io/decision": "allow",
+		"k8s.audit.annotation.authorization.k8s.io/reason":   "RBAC: allowed by RoleBinding \"deployers/default\"",
+	}, lr.Attributes().AsRaw())
+
+	ev, raw, err = decodeEvent(items[1])
+	require.NoError(t, err)
+	lr = plog.NewLogRecord()
+	require.NoError(t, eventToLogRecord(ev, raw, lr))
+
+	assert.Equal(t, plog.SeverityNumberWarn, lr.SeverityNumber())
+	reason, ok := lr.Attributes().Get("k8s.audit.response.reason")
+	require.True(t, ok)
+	assert.Equal(t, "Forbidden", reason.Str())
+	_, ok = lr.Attributes().Get("k8s.object.name")
+	assert.False(t, ok)
+	_, ok = lr.Attributes().Get("user_agent.original")
+	assert.False(t, ok)
+}
+
+func TestSeverityFromCode(t *testing.T) {
+	tests := []struct {
+		code     int32
+		expected plog.SeverityNumber
+	}{
+		{code: 200, expected: plog.SeverityNumberInfo},
+		{code: 201, expected: plog.SeverityNumberInfo},
+		{code: 404, expected: plog.SeverityNumberWarn},
+		{code: 429, expected: plog.SeverityNumberWarn},
+		{code: 500, expected: plog.SeverityNumberError},
+		{code: 503, expected: plog.SeverityNumberError},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.expected, severityFromCode(tt.code))
+	}
+}
@@ -0,0 +1,107 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package k8sauditreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sauditreceiver"
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/config/configoptional"
+	clientmeta "k8s.io/client-go/metadata"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/k8sconfig"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer"
+)
+
+var (
+	errNoSource        = errors.New("at least one of webhook or file must be configured")
+	errInvalidPath     = errors.New("webhook path must start with /")
+	errInvalidCacheTTL = errors.New("cache_ttl must be positive")
+)
+
+// Config defines configuration for the Kubernetes audit receiver.
+type Config struct {
+	// Webhook receives the audit events sent by the webhook backend of the API server.
+	Webhook configoptional.Optional[WebhookConfig] `mapstructure:"webhook"`
+
+	// File tails the audit log files written by the log backend of the API server.
+	File configoptional.Optional[FileConfig] `mapstructure:"file"`
+
+	// ResolveObjects looks up the objects referenced by the audit events to add their labels
+	// and controller to the log records.
+	ResolveObjects ResolveObjectsConfig `mapstructure:"resolve_objects"`
+
+	// prevent unkeyed literal initialization
+	_ struct{}
+}
+
+// WebhookConfig defines the server receiving the audit.k8s.io/v1 EventList sent by the webhook backend.
+type WebhookConfig struct {
+	confighttp.ServerConfig `mapstructure:",squash"`
+
+	// Path the audit events are posted to. Default is /audit.
+	Path string `mapstructure:"path"`
+
+	// prevent unkeyed literal initialization
+	_ struct{}
+}
+
+// FileConfig defines the audit log files to tail, holding one JSON audit.k8s.io/v1 Event per line.
+type FileConfig struct {
+	fileconsumer.Config `mapstructure:",squash"`
+
+	// StorageID is the storage extension used to persist the offsets of the files.
+	StorageID *component.ID `mapstructure:"storage"`
+
+	// prevent unkeyed literal initialization
+	_ struct{}
+}
+
+// ResolveObjectsConfig defines how the objects referenced by the audit events are looked up.
+type ResolveObjectsConfig struct {
+	k8sconfig.APIConfig `mapstructure:",squash"`
+
+	// Enabled turns on the lookup of the referenced objects.
+	Enabled bool `mapstructure:"enabled"`
+
+	// CacheTTL is how long the looked up objects are cached. Default is 1m.
+	CacheTTL time.Duration `mapstructure:"cache_ttl"`
+
+	// For mocking
+	makeMetadataClient func(apiConf k8sconfig.APIConfig) (clientmeta.Interface, error)
+}
+
+func (cfg *Config) Validate() error {
+	if !cfg.Webhook.HasValue() && !cfg.File.HasValue() {
+		return errNoSource
+	}
+	return nil
+}
+
+func (cfg *WebhookConfig) Validate() error {
+	if !strings.HasPrefix(cfg.Path, "/") {
+		return errInvalidPath
+	}
+	return nil
+}
+
+func (cfg *ResolveObjectsConfig) Validate() error {
+	if !cfg.Enabled {
+		return nil
+	}
+	if cfg.CacheTTL <= 0 {
+		return errInvalidCacheTTL
+	}
+	return cfg.APIConfig.Validate()
+}
+
+func (cfg *ResolveObjectsConfig) getMetadataClient() (clientmeta.Interface, error) {
+	if cfg.makeMetadataClient == nil {
+		cfg.makeMetadataClient = k8sconfig.MakeMetadataClient
+	}
+	return cfg.makeMetadataClient(cfg.APIConfig)
+}
@@ -0,0 +1,132 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package k8sauditreceiver
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/config/confignet"
+	"go.opentelemetry.io/collector/config/configoptional"
+	"go.opentelemetry.io/collector/config/configtls"
+	"go.opentelemetry.io/collector/confmap/confmaptest"
+	"go.opentelemetry.io/collector/confmap/xconfmap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/k8sconfig"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sauditreceiver/internal/metadata"
+)
+
+func TestLoadConfig(t *testing.T) {
+	t.Parallel()
+
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+	require.NoError(t, err)
+
+	storageID := component.MustNewID("file_storage")
+	fileConfig := fileconsumer.NewConfig()
+	fileConfig.Include = []string{"/var/log/kubernetes/audit/audit.log"}
+	fileConfig.StartAt = "beginning"
+
+	tests := []struct {
+		id          component.ID
+		expected    component.Config
+		expectedErr error
+	}{
+		{
+			id:          component.NewIDWithName(metadata.Type, ""),
+			expectedErr: errNoSource,
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "webhook"),
+			expected: &Config{
+				Webhook: configoptional.Some(WebhookConfig{
+					ServerConfig: confighttp.ServerConfig{
+						NetAddr: confignet.AddrConfig{
+							Endpoint:  "0.0.0.0:8443",
+							Transport: confignet.TransportTypeTCP,
+						},
+						TLS: configoptional.Some(configtls.ServerConfig{
+							Config: configtls.Config{
+								CertFile: "/etc/otelcol/tls/tls.crt",
+								KeyFile:  "/etc/otelcol/tls/tls.key",
+							},
+						}),
+					},
+					Path: "/events",
+				}),
+				File: configoptional.Default(FileConfig{
+					Config: *fileconsumer.NewConfig(),
+				}),
+				ResolveObjects: ResolveObjectsConfig{
+					APIConfig: k8sconfig.APIConfig{
+						AuthType: k8sconfig.AuthTypeServiceAccount,
+					},
+					CacheTTL: time.Minute,
+				},
+			},
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "file"),
+			expected: &Config{
+				Webhook: createDefaultConfig().(*Config).Webhook,
+				File: configoptional.Some(FileConfig{
+					Config:    *fileConfig,
+					StorageID: &storageID,
+				}),
+				ResolveObjects: ResolveObjectsConfig{
+					APIConfig: k8sconfig.APIConfig{
+						AuthType: k8sconfig.AuthTypeServiceAccount,
+					},
+					CacheTTL: time.Minute,
+				},
+			},
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "resolve_objects"),
+			expected: &Config{
+				Webhook: configoptional.Some(*createDefaultConfig().(*Config).Webhook.Get()),
+				File:    createDefaultConfig().(*Config).File,
+				ResolveObjects: ResolveObjectsConfig{
+					APIConfig: k8sconfig.APIConfig{
+						AuthType: k8sconfig.AuthTypeKubeConfig,
+					},
+					Enabled:  true,
+					CacheTTL: 5 * time.Minute,
+				},
+			},
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "invalid_path"),
+			expectedErr: errInvalidPath,
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "invalid_cache_ttl"),
+			expectedErr: errInvalidCacheTTL,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.id.String(), func(t *testing.T) {
+			factory := NewFactory()
+			cfg := factory.CreateDefaultConfig()
+
+			sub, err := cm.Sub(tt.id.String())
+			require.NoError(t, err)
+			require.NoError(t, sub.Unmarshal(cfg))
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, xconfmap.Validate(cfg), tt.expectedErr)
+				return
+			}
+			assert.NoError(t, xconfmap.Validate(cfg))
+			assert.Equal(t, tt.expected, cfg)
+		})
+	}
+}
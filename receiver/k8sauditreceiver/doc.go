@@ -0,0 +1,6 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:generate make mdatagen
+
+package k8sauditreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sauditreceiver"
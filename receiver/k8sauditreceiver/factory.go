@@ -0,0 +1,68 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package k8sauditreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sauditreceiver"
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/config/confignet"
+	"go.opentelemetry.io/collector/config/configoptional"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/receiver"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/k8sconfig"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sauditreceiver/internal/metadata"
+)
+
+const (
+	defaultEndpoint = "localhost:8443"
+	defaultPath     = "/audit"
+	defaultCacheTTL = time.Minute
+)
+
+// NewFactory creates a factory for the Kubernetes audit receiver.
+func NewFactory() receiver.Factory {
+	return receiver.NewFactory(
+		metadata.Type,
+		createDefaultConfig,
+		receiver.WithLogs(createLogsReceiver, metadata.LogsStability))
+}
+
+func createDefaultConfig() component.Config {
+	return &Config{
+		Webhook: configoptional.Default(WebhookConfig{
+			ServerConfig: confighttp.ServerConfig{
+				NetAddr: confignet.AddrConfig{
+					Endpoint:  defaultEndpoint,
+					Transport: confignet.TransportTypeTCP,
+				},
+			},
+			Path: defaultPath,
+		}),
+		File: configoptional.Default(FileConfig{
+			Config: *fileconsumer.NewConfig(),
+		}),
+		ResolveObjects: ResolveObjectsConfig{
+			APIConfig: k8sconfig.APIConfig{
+				AuthType: k8sconfig.AuthTypeServiceAccount,
+			},
+			CacheTTL: defaultCacheTTL,
+		},
+	}
+}
+
+func createLogsReceiver(
+	_ context.Context,
+	params receiver.Settings,
+	cfg component.Config,
+	consumer consumer.Logs,
+) (receiver.Logs, error) {
+	rCfg := cfg.(*Config)
+
+	return newReceiver(params, rCfg, consumer)
+}
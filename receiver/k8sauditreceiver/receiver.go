@@ -0,0 +1,249 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package k8sauditreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sauditreceiver"
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componentstatus"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/receiver"
+	"go.opentelemetry.io/collector/receiver/receiverhelper"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/adapter"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sauditreceiver/internal/metadata"
+)
+
+type k8sauditReceiver struct {
+	config       *Config
+	settings     receiver.Settings
+	logsConsumer consumer.Logs
+
+	webhookObsrecv *receiverhelper.ObsReport
+	server         *http.Server
+	shutdownWG     sync.WaitGroup
+
+	fileObsrecv *receiverhelper.ObsReport
+	input       *fileconsumer.Manager
+
+	resolver *objectResolver
+}
+
+// newReceiver creates the Kubernetes audit receiver with the given configuration.
+func newReceiver(
+	set receiver.Settings,
+	config *Config,
+	consumer consumer.Logs,
+) (receiver.Logs, error) {
+	kr := &k8sauditReceiver{
+		settings:     set,
+		config:       config,
+		logsConsumer: consumer,
+	}
+
+	if config.Webhook.HasValue() {
+		transport := "http"
+		if config.Webhook.Get().TLS.HasValue() {
+			transport = "https"
+		}
+		obsrecv, err := receiverhelper.NewObsReport(receiverhelper.ObsReportSettings{
+			ReceiverID:             set.ID,
+			Transport:              transport,
+			ReceiverCreateSettings: set,
+		})
+		if err != nil {
+			return nil, err
+		}
+		kr.webhookObsrecv = obsrecv
+	}
+
+	if config.File.HasValue() {
+		obsrecv, err := receiverhelper.NewObsReport(receiverhelper.ObsReportSettings{
+			ReceiverID:             set.ID,
+			Transport:              "file",
+			ReceiverCreateSettings: set,
+		})
+		if err != nil {
+			return nil, err
+		}
+		kr.fileObsrecv = obsrecv
+
+		input, err := config.File.Get().Build(set.TelemetrySettings, kr.consumeFileTokens)
+		if err != nil {
+			return nil, err
+		}
+		kr.input = input
+	}
+
+	return kr, nil
+}
+
+func (kr *k8sauditReceiver) Start(ctx context.Context, host component.Host) error {
+	if kr.config.ResolveObjects.Enabled {
+		client, err := kr.config.ResolveObjects.getMetadataClient()
+		if err != nil {
+			return err
+		}
+		kr.resolver = newObjectResolver(client, kr.settings.Logger, kr.config.ResolveObjects.CacheTTL)
+	}
+
+	if kr.config.Webhook.HasValue() {
+		if err := kr.startWebhook(ctx, host); err != nil {
+			return err
+		}
+	}
+
+	if kr.input != nil {
+		storageClient, err := adapter.GetStorageClient(ctx, host, kr.config.File.Get().StorageID, kr.settings.ID)
+		if err != nil {
+			return err
+		}
+		if err := kr.input.Start(storageClient); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (kr *k8sauditReceiver) startWebhook(ctx context.Context, host component.Host) error {
+	cfg := kr.config.Webhook.Get()
+
+	ln, err := cfg.ToListener(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to bind to address %s: %w", cfg.NetAddr.Endpoint, err)
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(cfg.Path, kr.handleWebhook)
+
+	kr.server, err = cfg.ToServer(ctx, host.GetExtensions(), kr.settings.TelemetrySettings, mux)
+	if err != nil {
+		return err
+	}
+
+	kr.settings.Logger.Info("starting to receive audit events", zap.String("endpoint", ln.Addr().String()))
+	kr.shutdownWG.Go(func() {
+		if errHTTP := kr.server.Serve(ln); !errors.Is(errHTTP, http.ErrServerClosed) && errHTTP != nil {
+			componentstatus.ReportStatus(host, componentstatus.NewFatalErrorEvent(errHTTP))
+		}
+	})
+	return nil
+}
+
+func (kr *k8sauditReceiver) Shutdown(context.Context) error {
+	var errs error
+	if kr.server != nil {
+		errs = kr.server.Close()
+		kr.shutdownWG.Wait()
+	}
+	if kr.input != nil {
+		errs = errors.Join(errs, kr.input.Stop())
+	}
+	return errs
+}
+
+// handleWebhook handles the audit.k8s.io/v1 EventList posted by the webhook backend of the API server.
+// The API server retries the batch when the response is not successful.
+func (kr *k8sauditReceiver) handleWebhook(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "only POST is supported", http.StatusMethodNotAllowed)
+		return
+	}
+
+	ctx := kr.webhookObsrecv.StartLogsOp(r.Context())
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		kr.webhookObsrecv.EndLogsOp(ctx, metadata.Type.String(), 0, err)
+		return
+	}
+
+	items, err := decodeEventList(body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		kr.webhookObsrecv.EndLogsOp(ctx, metadata.Type.String(), 0, err)
+		return
+	}
+
+	// events that cannot be decoded are skipped, as the API server would post them again on failure
+	ld, lrs := newLogs(kr.settings.BuildInfo.Version)
+	var errs error
+	for _, item := range items {
+		if err = kr.appendEvent(ctx, lrs, item, nil); err != nil {
+			errs = errors.Join(errs, err)
+		}
+	}
+	if errs != nil {
+		kr.settings.Logger.Warn("failed to decode audit events", zap.Error(errs))
+	}
+
+	if lrs.Len() > 0 {
+		err = kr.logsConsumer.ConsumeLogs(ctx, ld)
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusServiceUnavailable)
+	} else {
+		w.WriteHeader(http.StatusOK)
+	}
+	kr.webhookObsrecv.EndLogsOp(ctx, metadata.Type.String(), lrs.Len(), errors.Join(errs, err))
+}
+
+// consumeFileTokens handles the lines read from the audit log files, each holding an audit.k8s.io/v1 Event.
+func (kr *k8sauditReceiver) consumeFileTokens(ctx context.Context, tokens [][]byte, attributes map[string]any, _ int64, _ []int64) error {
+	ctx = kr.fileObsrecv.StartLogsOp(ctx)
+
+	ld, lrs := newLogs(kr.settings.BuildInfo.Version)
+	var errs error
+	for _, token := range tokens {
+		if err := kr.appendEvent(ctx, lrs, token, attributes); err != nil {
+			errs = errors.Join(errs, err)
+		}
+	}
+	if errs != nil {
+		kr.settings.Logger.Warn("failed to decode audit events", zap.Error(errs))
+	}
+
+	var err error
+	if lrs.Len() > 0 {
+		err = kr.logsConsumer.ConsumeLogs(ctx, ld)
+	}
+	kr.fileObsrecv.EndLogsOp(ctx, metadata.Type.String(), lrs.Len(), errors.Join(errs, err))
+	return err
+}
+
+// appendEvent decodes an audit event and appends it to lrs, along with the given attributes.
+func (kr *k8sauditReceiver) appendEvent(ctx context.Context, lrs plog.LogRecordSlice, data json.RawMessage, attributes map[string]any) error {
+	ev, raw, err := decodeEvent(data)
+	if err != nil {
+		return err
+	}
+
+	lr := plog.NewLogRecord()
+	if err = eventToLogRecord(ev, raw, lr); err != nil {
+		return err
+	}
+	for k, v := range attributes {
+		if err = lr.Attributes().PutEmpty(k).FromRaw(v); err != nil {
+			return err
+		}
+	}
+	if kr.resolver != nil {
+		if object := kr.resolver.resolve(ctx, ev.ObjectRef); object != nil {
+			addObjectAttributes(lr.Attributes(), object)
+		}
+	}
+	lr.MoveTo(lrs.AppendEmpty())
+	return nil
+}
@@ -0,0 +1,207 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package k8sauditreceiver
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/config/configoptional"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clientmeta "k8s.io/client-go/metadata"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/k8sconfig"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sauditreceiver/internal/metadata"
+)
+
+func newWebhookReceiver(t *testing.T, next consumer.Logs) *k8sauditReceiver {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Webhook = configoptional.Some(*cfg.Webhook.Get())
+	r, err := newReceiver(receivertest.NewNopSettings(metadata.Type), cfg, next)
+	require.NoError(t, err)
+	return r.(*k8sauditReceiver)
+}
+
+// invalidEventList returns the given EventList with an undecodable event inserted before its events.
+func invalidEventList(t *testing.T, data []byte) []byte {
+	var list map[string]any
+	require.NoError(t, json.Unmarshal(data, &list))
+	list["items"] = append([]any{map[string]any{"kind": "Pod", "apiVersion": "v1"}}, list["items"].([]any)...)
+	out, err := json.Marshal(list)
+	require.NoError(t, err)
+	return out
+}
+
+func TestHandleWebhook(t *testing.T) {
+	data, err := os.ReadFile(filepath.Join("testdata", "eventlist.json"))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name         string
+		method       string
+		body         []byte
+		consumer     consumer.Logs
+		expectedCode int
+		expectedLogs int
+	}{
+		{
+			name:         "event list",
+			method:       http.MethodPost,
+			body:         data,
+			consumer:     new(consumertest.LogsSink),
+			expectedCode: http.StatusOK,
+			expectedLogs: 2,
+		},
+		{
+			name:         "empty event list",
+			method:       http.MethodPost,
+			body:         []byte(`{"kind":"EventList","apiVersion":"audit.k8s.io/v1","items":[]}`),
+			consumer:     new(consumertest.LogsSink),
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:         "invalid method",
+			method:       http.MethodGet,
+			consumer:     new(consumertest.LogsSink),
+			expectedCode: http.StatusMethodNotAllowed,
+		},
+		{
+			name:         "invalid body",
+			method:       http.MethodPost,
+			body:         []byte(`{"kind":"Pod","apiVersion":"v1"}`),
+			consumer:     new(consumertest.LogsSink),
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name:         "invalid event",
+			method:       http.MethodPost,
+			body:         []byte(`{"kind":"EventList","apiVersion":"audit.k8s.io/v1","items":[{"kind":"Pod","apiVersion":"v1"}]}`),
+			consumer:     new(consumertest.LogsSink),
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:         "invalid event among valid events",
+			method:       http.MethodPost,
+			body:         invalidEventList(t, data),
+			consumer:     new(consumertest.LogsSink),
+			expectedCode: http.StatusOK,
+			expectedLogs: 2,
+		},
+		{
+			name:         "consumer error",
+			method:       http.MethodPost,
+			body:         data,
+			consumer:     consumertest.NewErr(errors.New("consumer error")),
+			expectedCode: http.StatusServiceUnavailable,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := newWebhookReceiver(t, tt.consumer)
+
+			req := httptest.NewRequest(tt.method, "/audit", bytes.NewReader(tt.body))
+			w := httptest.NewRecorder()
+			r.handleWebhook(w, req)
+
+			assert.Equal(t, tt.expectedCode, w.Code)
+			if sink, ok := tt.consumer.(*consumertest.LogsSink); ok {
+				assert.Equal(t, tt.expectedLogs, sink.LogRecordCount())
+			}
+		})
+	}
+}
+
+func TestWebhookResolveObjects(t *testing.T) {
+	data, err := os.ReadFile(filepath.Join("testdata", "eventlist.json"))
+	require.NoError(t, err)
+
+	sink := new(consumertest.LogsSink)
+	cfg := createDefaultConfig().(*Config)
+	cfg.Webhook = configoptional.Some(*cfg.Webhook.Get())
+	cfg.Webhook.Get().NetAddr.Endpoint = "localhost:0"
+	cfg.ResolveObjects.Enabled = true
+	cfg.ResolveObjects.makeMetadataClient = func(k8sconfig.APIConfig) (clientmeta.Interface, error) {
+		return newFakeMetadataClient(t, &metav1.PartialObjectMetadata{
+			TypeMeta: metav1.TypeMeta{APIVersion: "apps/v1", Kind: "Deployment"},
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "default",
+				Name:      "checkout",
+				UID:       "deployment-uid",
+				Labels:    map[string]string{"app": "checkout"},
+			},
+		}), nil
+	}
+
+	r, err := newReceiver(receivertest.NewNopSettings(metadata.Type), cfg, sink)
+	require.NoError(t, err)
+	require.NoError(t, r.Start(context.Background(), componenttest.NewNopHost()))
+	defer func() {
+		require.NoError(t, r.Shutdown(context.Background()))
+	}()
+
+	w := httptest.NewRecorder()
+	r.(*k8sauditReceiver).handleWebhook(w, httptest.NewRequest(http.MethodPost, "/audit", bytes.NewReader(data)))
+	require.Equal(t, http.StatusOK, w.Code)
+
+	require.Equal(t, 2, sink.LogRecordCount())
+	attrs := sink.AllLogs()[0].ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Attributes()
+	uid, ok := attrs.Get("k8s.object.uid")
+	require.True(t, ok)
+	assert.Equal(t, "deployment-uid", uid.Str())
+	label, ok := attrs.Get("k8s.object.label.app")
+	require.True(t, ok)
+	assert.Equal(t, "checkout", label.Str())
+}
+
+func TestFileReceiver(t *testing.T) {
+	data, err := os.ReadFile(filepath.Join("testdata", "audit.log"))
+	require.NoError(t, err)
+	tempFolder := t.TempDir()
+	logFile := filepath.Join(tempFolder, "audit.log")
+	// the first line is not an audit event and is skipped
+	require.NoError(t, os.WriteFile(logFile, append([]byte("{\"kind\":\"Pod\",\"apiVersion\":\"v1\"}\n"), data...), 0o600))
+
+	fileConfig := fileconsumer.NewConfig()
+	fileConfig.Include = []string{filepath.Join(tempFolder, "*")}
+	fileConfig.StartAt = "beginning"
+	cfg := &Config{
+		File: configoptional.Some(FileConfig{Config: *fileConfig}),
+	}
+
+	sink := new(consumertest.LogsSink)
+	r, err := newReceiver(receivertest.NewNopSettings(metadata.Type), cfg, sink)
+	require.NoError(t, err)
+	require.NoError(t, r.Start(context.Background(), componenttest.NewNopHost()))
+	defer func() {
+		require.NoError(t, r.Shutdown(context.Background()))
+	}()
+
+	require.Eventually(t, func() bool {
+		return sink.LogRecordCount() == 2
+	}, 5*time.Second, 10*time.Millisecond)
+
+	lr := sink.AllLogs()[0].ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0)
+	fileName, ok := lr.Attributes().Get("log.file.name")
+	require.True(t, ok)
+	assert.Equal(t, "audit.log", fileName.Str())
+	verb, ok := lr.Attributes().Get("k8s.audit.verb")
+	require.True(t, ok)
+	assert.Equal(t, "patch", verb.Str())
+}
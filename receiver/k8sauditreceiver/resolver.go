@@ -0,0 +1,127 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package k8sauditreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sauditreceiver"
+
+import (
+	"context"
+	"time"
+
+	lru "github.com/hashicorp/golang-lru/v2"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.uber.org/zap"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	clientmeta "k8s.io/client-go/metadata"
+)
+
+const (
+	// maxCachedObjects bounds the cache of resolved objects, the least recently used ones are evicted
+	// once it is reached.
+	maxCachedObjects = 10000
+	// failedLookupTTL is how long a failed lookup is cached, so that the API server is not queried for
+	// every event referencing an object while it cannot be looked up.
+	failedLookupTTL = 10 * time.Second
+)
+
+type objectKey struct {
+	gvr       schema.GroupVersionResource
+	namespace string
+	name      string
+}
+
+// resolvedObject holds the metadata of an object referenced by audit events.
+type resolvedObject struct {
+	uid       string
+	labels    map[string]string
+	ownerKind string
+	ownerName string
+}
+
+type cachedObject struct {
+	// object is nil when the object does not exist or could not be looked up.
+	object  *resolvedObject
+	expires time.Time
+}
+
+// objectResolver looks up the objects referenced by audit events with the metadata client, so that
+// the content of objects such as secrets is never read. The objects are cached so that the API server
+// is not queried for every event.
+type objectResolver struct {
+	client clientmeta.Interface
+	logger *zap.Logger
+	ttl    time.Duration
+	now    func() time.Time
+
+	cache *lru.Cache[objectKey, cachedObject]
+}
+
+func newObjectResolver(client clientmeta.Interface, logger *zap.Logger, ttl time.Duration) *objectResolver {
+	// lru.New only fails for a non-positive size
+	cache, _ := lru.New[objectKey, cachedObject](maxCachedObjects)
+	return &objectResolver{
+		client: client,
+		logger: logger,
+		ttl:    ttl,
+		now:    time.Now,
+		cache:  cache,
+	}
+}
+
+// resolve returns the object referenced by ref, or nil if it cannot be looked up.
+func (r *objectResolver) resolve(ctx context.Context, ref *objectReference) *resolvedObject {
+	if ref == nil || ref.Name == "" || ref.Resource == "" || ref.APIVersion == "" {
+		return nil
+	}
+	key := objectKey{
+		gvr: schema.GroupVersionResource{
+			Group:    ref.APIGroup,
+			Version:  ref.APIVersion,
+			Resource: ref.Resource,
+		},
+		namespace: ref.Namespace,
+		name:      ref.Name,
+	}
+
+	now := r.now()
+	cached, ok := r.cache.Get(key)
+	if ok && now.Before(cached.expires) {
+		return cached.object
+	}
+
+	obj, err := r.client.Resource(key.gvr).Namespace(key.namespace).Get(ctx, key.name, metav1.GetOptions{})
+	if err != nil {
+		ttl := r.ttl
+		if !apierrors.IsNotFound(err) {
+			// transient errors are cached briefly so that the lookup is retried soon
+			r.logger.Debug("failed to resolve object", zap.String("resource", key.gvr.String()), zap.String("name", key.name), zap.Error(err))
+			ttl = min(ttl, failedLookupTTL)
+		}
+		r.cache.Add(key, cachedObject{expires: now.Add(ttl)})
+		return nil
+	}
+
+	resolved := &resolvedObject{
+		uid:    string(obj.GetUID()),
+		labels: obj.GetLabels(),
+	}
+	if owner := metav1.GetControllerOfNoCopy(obj); owner != nil {
+		resolved.ownerKind = owner.Kind
+		resolved.ownerName = owner.Name
+	}
+	r.cache.Add(key, cachedObject{object: resolved, expires: now.Add(r.ttl)})
+	return resolved
+}
+
+// addObjectAttributes adds the attributes of a resolved object to a log record.
+func addObjectAttributes(attrs pcommon.Map, object *resolvedObject) {
+	if _, ok := attrs.Get("k8s.object.uid"); !ok {
+		putNonEmptyStr(attrs, "k8s.object.uid", object.uid)
+	}
+	for k, v := range object.labels {
+		attrs.PutStr("k8s.object.label."+k, v)
+	}
+	putNonEmptyStr(attrs, "k8s.object.owner.kind", object.ownerKind)
+	putNonEmptyStr(attrs, "k8s.object.owner.name", object.ownerName)
+}
@@ -0,0 +1,168 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package k8sauditreceiver
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	lru "github.com/hashicorp/golang-lru/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.uber.org/zap"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientmetafake "k8s.io/client-go/metadata/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func newReplicaSet(name string) *metav1.PartialObjectMetadata {
+	isController := true
+	return &metav1.PartialObjectMetadata{
+		TypeMeta: metav1.TypeMeta{APIVersion: "apps/v1", Kind: "ReplicaSet"},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      name,
+			UID:       types.UID(name + "-uid"),
+			Labels:    map[string]string{"app": "checkout"},
+			OwnerReferences: []metav1.OwnerReference{
+				{APIVersion: "apps/v1", Kind: "Deployment", Name: "checkout", UID: "deployment-uid", Controller: &isController},
+			},
+		},
+	}
+}
+
+func newFakeMetadataClient(t *testing.T, objects ...runtime.Object) *clientmetafake.FakeMetadataClient {
+	scheme := runtime.NewScheme()
+	require.NoError(t, metav1.AddMetaToScheme(scheme))
+	return clientmetafake.NewSimpleMetadataClient(scheme, objects...)
+}
+
+func TestObjectResolver(t *testing.T) {
+	client := newFakeMetadataClient(t, newReplicaSet("checkout-5d8f7"))
+	resolver := newObjectResolver(client, zap.NewNop(), time.Minute)
+
+	ref := &objectReference{
+		Resource:   "replicasets",
+		Namespace:  "default",
+		Name:       "checkout-5d8f7",
+		APIGroup:   "apps",
+		APIVersion: "v1",
+	}
+	object := resolver.resolve(context.Background(), ref)
+	require.NotNil(t, object)
+	assert.Equal(t, &resolvedObject{
+		uid:       "checkout-5d8f7-uid",
+		labels:    map[string]string{"app": "checkout"},
+		ownerKind: "Deployment",
+		ownerName: "checkout",
+	}, object)
+
+	// unknown objects resolve to nothing
+	missing := *ref
+	missing.Name = "payments-7c9d4"
+	assert.Nil(t, resolver.resolve(context.Background(), &missing))
+
+	// references without name, such as lists, are not resolved
+	list := *ref
+	list.Name = ""
+	assert.Nil(t, resolver.resolve(context.Background(), &list))
+	assert.Nil(t, resolver.resolve(context.Background(), nil))
+
+	assert.Len(t, client.Actions(), 2)
+}
+
+func TestObjectResolverCache(t *testing.T) {
+	client := newFakeMetadataClient(t, newReplicaSet("checkout-5d8f7"))
+	resolver := newObjectResolver(client, zap.NewNop(), time.Minute)
+	now := time.Now()
+	resolver.now = func() time.Time { return now }
+
+	ref := &objectReference{
+		Resource:   "replicasets",
+		Namespace:  "default",
+		Name:       "checkout-5d8f7",
+		APIGroup:   "apps",
+		APIVersion: "v1",
+	}
+	require.NotNil(t, resolver.resolve(context.Background(), ref))
+	require.NotNil(t, resolver.resolve(context.Background(), ref))
+	assert.Len(t, client.Actions(), 1)
+
+	now = now.Add(2 * time.Minute)
+	require.NotNil(t, resolver.resolve(context.Background(), ref))
+	assert.Len(t, client.Actions(), 2)
+}
+
+func TestObjectResolverCacheFailures(t *testing.T) {
+	client := newFakeMetadataClient(t, newReplicaSet("checkout-5d8f7"))
+	client.PrependReactor("get", "replicasets", func(k8stesting.Action) (bool, runtime.Object, error) {
+		return true, nil, errors.New("connection refused")
+	})
+	resolver := newObjectResolver(client, zap.NewNop(), time.Minute)
+	now := time.Now()
+	resolver.now = func() time.Time { return now }
+
+	ref := &objectReference{
+		Resource:   "replicasets",
+		Namespace:  "default",
+		Name:       "checkout-5d8f7",
+		APIGroup:   "apps",
+		APIVersion: "v1",
+	}
+	assert.Nil(t, resolver.resolve(context.Background(), ref))
+	assert.Nil(t, resolver.resolve(context.Background(), ref))
+	assert.Len(t, client.Actions(), 1)
+
+	// failed lookups are retried sooner than the cache TTL
+	now = now.Add(failedLookupTTL)
+	assert.Nil(t, resolver.resolve(context.Background(), ref))
+	assert.Len(t, client.Actions(), 2)
+}
+
+func TestObjectResolverCacheEviction(t *testing.T) {
+	client := newFakeMetadataClient(t, newReplicaSet("checkout-5d8f7"), newReplicaSet("payments-7c9d4"))
+	resolver := newObjectResolver(client, zap.NewNop(), time.Minute)
+	resolver.cache, _ = lru.New[objectKey, cachedObject](1)
+
+	newRef := func(name string) *objectReference {
+		return &objectReference{
+			Resource:   "replicasets",
+			Namespace:  "default",
+			Name:       name,
+			APIGroup:   "apps",
+			APIVersion: "v1",
+		}
+	}
+	require.NotNil(t, resolver.resolve(context.Background(), newRef("checkout-5d8f7")))
+	require.NotNil(t, resolver.resolve(context.Background(), newRef("payments-7c9d4")))
+	assert.Equal(t, 1, resolver.cache.Len())
+
+	// the least recently used object was evicted to cache the new one
+	require.NotNil(t, resolver.resolve(context.Background(), newRef("payments-7c9d4")))
+	assert.Len(t, client.Actions(), 2)
+	require.NotNil(t, resolver.resolve(context.Background(), newRef("checkout-5d8f7")))
+	assert.Len(t, client.Actions(), 3)
+}
+
+func TestAddObjectAttributes(t *testing.T) {
+	attrs := pcommon.NewMap()
+	attrs.PutStr("k8s.object.uid", "audit-uid")
+	addObjectAttributes(attrs, &resolvedObject{
+		uid:       "checkout-5d8f7-uid",
+		labels:    map[string]string{"app": "checkout"},
+		ownerKind: "Deployment",
+		ownerName: "checkout",
+	})
+	assert.Equal(t, map[string]any{
+		"k8s.object.uid":        "audit-uid",
+		"k8s.object.label.app":  "checkout",
+		"k8s.object.owner.kind": "Deployment",
+		"k8s.object.owner.name": "checkout",
+	}, attrs.AsRaw())
+}
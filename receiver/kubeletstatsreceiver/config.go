@@ -59,6 +59,13 @@ type Config struct {
 	// NetworkCollectAllInterfaces allows to enable collecting metrics from all network interfaces instead of default one
 	// Can be set separately for Pod and Node network metrics
 	NetworkCollectAllInterfaces NetworkInterfacesEnablerConfig `mapstructure:"collect_all_network_interfaces"`
+
+	// CgroupRootPath is the path where the node's cgroup v2 filesystem is mounted
+	// in the collector container, e.g. /hostfs/sys/fs/cgroup. When set, pressure stall
+	// information and OOM kill counts of pods and containers are read from the
+	// cgroup filesystem. It requires the receiver to run on the node it scrapes,
+	// typically as a DaemonSet.
+	CgroupRootPath string `mapstructure:"cgroup_root_path"`
 }
 
 type NetworkInterfacesEnablerConfig struct {
@@ -98,6 +105,7 @@ func (cfg *Config) getReceiverOptions() (*scraperOptions, error) {
 		metricGroupsToCollect: mgs,
 		allNetworkInterfaces:  ifaces,
 		k8sAPIClient:          k8sAPIClient,
+		cgroupRootPath:        cfg.CgroupRootPath,
 	}, nil
 }
 
@@ -147,5 +155,13 @@ func (cfg *Config) Validate() error {
 			return errors.New("for k8s.pod.memory.node.utilization node setting is required. Check the readme on how to set the required setting")
 		}
 	}
+	if cfg.CgroupRootPath == "" {
+		switch {
+		case cfg.Metrics.ContainerMemoryOomKills.Enabled:
+			return errors.New("for container.memory.oom_kills cgroup_root_path setting is required. Check the readme on how to set the required setting")
+		case cfg.Metrics.K8sPodMemoryOomKills.Enabled:
+			return errors.New("for k8s.pod.memory.oom_kills cgroup_root_path setting is required. Check the readme on how to set the required setting")
+		}
+	}
 	return nil
 }
@@ -286,6 +286,36 @@ func TestLoadConfig(t *testing.T) {
 			},
 			expectedValidationErr: "for k8s.pod.memory.node.utilization node setting is required. Check the readme on how to set the required setting",
 		},
+		{
+			id: component.NewIDWithName(metadata.Type, "cgroup"),
+			expected: func() *Config {
+				mbc := metadata.DefaultMetricsBuilderConfig()
+				mbc.Metrics.K8sPodMemoryOomKills.Enabled = true
+				mbc.Metrics.K8sPodMemoryPressureStallTime.Enabled = true
+				return &Config{
+					ControllerConfig: scraperhelper.ControllerConfig{
+						CollectionInterval: duration,
+						InitialDelay:       time.Second,
+					},
+					ClientConfig: kube.ClientConfig{
+						APIConfig: k8sconfig.APIConfig{
+							AuthType: "serviceAccount",
+						},
+					},
+					MetricGroupsToCollect: []kubelet.MetricGroup{
+						kubelet.ContainerMetricGroup,
+						kubelet.PodMetricGroup,
+						kubelet.NodeMetricGroup,
+					},
+					CgroupRootPath:       "/hostfs/sys/fs/cgroup",
+					MetricsBuilderConfig: mbc,
+				}
+			}(),
+		},
+		{
+			id:                    component.NewIDWithName(metadata.Type, "oom_kills_without_cgroup_root_path"),
+			expectedValidationErr: "for container.memory.oom_kills cgroup_root_path setting is required. Check the readme on how to set the required setting",
+		},
 	}
 
 	for _, tt := range tests {
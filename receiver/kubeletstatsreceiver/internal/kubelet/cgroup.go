@@ -0,0 +1,226 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package kubelet // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/kubeletstatsreceiver/internal/kubelet"
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	stats "k8s.io/kubelet/pkg/apis/stats/v1alpha1"
+)
+
+// kubepodsDirs are the top level directories the kubelet creates for pod cgroups
+// with the systemd and cgroupfs cgroup drivers respectively.
+var kubepodsDirs = []string{"kubepods.slice", "kubepods"}
+
+var (
+	// systemd driver: kubepods-burstable-pod0f1c2d3e_4f5a_6b7c_8d9e_0f1a2b3c4d5e.slice
+	// cgroupfs driver: pod0f1c2d3e-4f5a-6b7c-8d9e-0f1a2b3c4d5e
+	podCgroupRegexp = regexp.MustCompile(`^(?:kubepods(?:-[a-z]+)?-)?pod([0-9a-f]{8}[-_][0-9a-f]{4}[-_][0-9a-f]{4}[-_][0-9a-f]{4}[-_][0-9a-f]{12})(?:\.slice)?$`)
+	// systemd driver: cri-containerd-<id>.scope, crio-<id>.scope, docker-<id>.scope
+	// cgroupfs driver: <id>
+	containerCgroupRegexp = regexp.MustCompile(`^(?:cri-containerd-|crio-|docker-)?([0-9a-f]{64})(?:\.scope)?$`)
+)
+
+// CgroupResourceStats holds the cgroup v2 pressure stall information and OOM
+// kill counter of a single pod or container cgroup.
+type CgroupResourceStats struct {
+	CPUPressure    *stats.PSIStats
+	MemoryPressure *stats.PSIStats
+	IOPressure     *stats.PSIStats
+	OOMKills       *uint64
+}
+
+// CgroupStats holds the stats of all pod and container cgroups found on the node.
+type CgroupStats struct {
+	// Pods is keyed by pod UID.
+	Pods map[string]CgroupResourceStats
+	// Containers is keyed by container ID, without the runtime scheme.
+	Containers map[string]CgroupResourceStats
+}
+
+// CgroupProvider reads pod and container stats directly from a cgroup v2
+// hierarchy. It is meant to be used when the collector runs as a DaemonSet
+// with the node's cgroup filesystem mounted.
+type CgroupProvider struct {
+	root string
+}
+
+func NewCgroupProvider(root string) *CgroupProvider {
+	return &CgroupProvider{root: root}
+}
+
+// CgroupStats walks the kubepods hierarchy under the cgroup root and collects
+// the stats of every pod cgroup and its direct container cgroups.
+func (p *CgroupProvider) CgroupStats() (*CgroupStats, error) {
+	out := &CgroupStats{
+		Pods:       make(map[string]CgroupResourceStats),
+		Containers: make(map[string]CgroupResourceStats),
+	}
+
+	found := false
+	for _, dir := range kubepodsDirs {
+		kubepods := filepath.Join(p.root, dir)
+		if _, err := os.Stat(kubepods); err != nil {
+			continue
+		}
+		found = true
+		err := filepath.WalkDir(kubepods, func(path string, d fs.DirEntry, err error) error {
+			if err != nil {
+				// Pods can be removed while walking the hierarchy.
+				if errors.Is(err, fs.ErrNotExist) {
+					return nil
+				}
+				return err
+			}
+			if !d.IsDir() {
+				return nil
+			}
+			match := podCgroupRegexp.FindStringSubmatch(d.Name())
+			if match == nil {
+				return nil
+			}
+			out.Pods[strings.ReplaceAll(match[1], "_", "-")] = readCgroupResourceStats(path)
+			readContainerCgroups(path, out.Containers)
+			return filepath.SkipDir
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if !found {
+		return nil, fmt.Errorf("no kubepods cgroup found under %q", p.root)
+	}
+	return out, nil
+}
+
+func readContainerCgroups(podPath string, containers map[string]CgroupResourceStats) {
+	entries, err := os.ReadDir(podPath)
+	if err != nil {
+		return
+	}
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		match := containerCgroupRegexp.FindStringSubmatch(entry.Name())
+		if match == nil {
+			continue
+		}
+		containers[match[1]] = readCgroupResourceStats(filepath.Join(podPath, entry.Name()))
+	}
+}
+
+func readCgroupResourceStats(path string) CgroupResourceStats {
+	return CgroupResourceStats{
+		CPUPressure:    readPressureFile(filepath.Join(path, "cpu.pressure")),
+		MemoryPressure: readPressureFile(filepath.Join(path, "memory.pressure")),
+		IOPressure:     readPressureFile(filepath.Join(path, "io.pressure")),
+		OOMKills:       readMemoryEventsOOMKills(filepath.Join(path, "memory.events")),
+	}
+}
+
+// readPressureFile parses a cgroup v2 *.pressure file, returning nil if the
+// file does not exist or cannot be parsed. The file format is:
+//
+//	some avg10=0.00 avg60=0.00 avg300=0.00 total=12345
+//	full avg10=0.00 avg60=0.00 avg300=0.00 total=6789
+//
+// Totals are reported by the kernel in microseconds and are converted to
+// nanoseconds to match the kubelet stats API.
+func readPressureFile(path string) *stats.PSIStats {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil
+	}
+	defer f.Close()
+
+	psi, err := parsePressure(bufio.NewScanner(f))
+	if err != nil {
+		return nil
+	}
+	return psi
+}
+
+func parsePressure(scanner *bufio.Scanner) (*stats.PSIStats, error) {
+	var psi stats.PSIStats
+	found := false
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 {
+			continue
+		}
+		var data *stats.PSIData
+		switch fields[0] {
+		case "some":
+			data = &psi.Some
+		case "full":
+			data = &psi.Full
+		default:
+			continue
+		}
+		for _, field := range fields[1:] {
+			key, value, ok := strings.Cut(field, "=")
+			if !ok {
+				return nil, fmt.Errorf("invalid pressure field %q", field)
+			}
+			var err error
+			switch key {
+			case "avg10":
+				data.Avg10, err = strconv.ParseFloat(value, 64)
+			case "avg60":
+				data.Avg60, err = strconv.ParseFloat(value, 64)
+			case "avg300":
+				data.Avg300, err = strconv.ParseFloat(value, 64)
+			case "total":
+				var total uint64
+				total, err = strconv.ParseUint(value, 10, 64)
+				data.Total = total * 1000
+			}
+			if err != nil {
+				return nil, fmt.Errorf("invalid pressure field %q: %w", field, err)
+			}
+		}
+		found = true
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, errors.New("no pressure data found")
+	}
+	return &psi, nil
+}
+
+// readMemoryEventsOOMKills returns the oom_kill counter from a cgroup v2
+// memory.events file, or nil if it is not available.
+func readMemoryEventsOOMKills(path string) *uint64 {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		key, value, ok := strings.Cut(scanner.Text(), " ")
+		if !ok || key != "oom_kill" {
+			continue
+		}
+		kills, err := strconv.ParseUint(strings.TrimSpace(value), 10, 64)
+		if err != nil {
+			return nil
+		}
+		return &kills
+	}
+	return nil
+}
@@ -0,0 +1,128 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package kubelet
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testContainerID = "0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"
+	testPressure    = "some avg10=0.00 avg60=0.00 avg300=0.00 total=1500\nfull avg10=0.00 avg60=0.00 avg300=0.00 total=500\n"
+)
+
+func writeCgroupFiles(t *testing.T, dir string, files map[string]string) {
+	require.NoError(t, os.MkdirAll(dir, 0o755))
+	for name, content := range files {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0o600))
+	}
+}
+
+func TestCgroupStats(t *testing.T) {
+	tests := []struct {
+		name          string
+		podDir        string
+		containerDir  string
+		expectedPodID string
+	}{
+		{
+			name:          "systemd driver",
+			podDir:        "kubepods.slice/kubepods-burstable.slice/kubepods-burstable-pod0f1c2d3e_4f5a_6b7c_8d9e_0f1a2b3c4d5e.slice",
+			containerDir:  "cri-containerd-" + testContainerID + ".scope",
+			expectedPodID: "0f1c2d3e-4f5a-6b7c-8d9e-0f1a2b3c4d5e",
+		},
+		{
+			name:          "cgroupfs driver",
+			podDir:        "kubepods/pod0f1c2d3e-4f5a-6b7c-8d9e-0f1a2b3c4d5e",
+			containerDir:  testContainerID,
+			expectedPodID: "0f1c2d3e-4f5a-6b7c-8d9e-0f1a2b3c4d5e",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			root := t.TempDir()
+			podPath := filepath.Join(root, tt.podDir)
+			writeCgroupFiles(t, podPath, map[string]string{
+				"cpu.pressure":  testPressure,
+				"memory.events": "low 0\nhigh 0\nmax 4\noom 2\noom_kill 2\noom_group_kill 0\n",
+			})
+			writeCgroupFiles(t, filepath.Join(podPath, tt.containerDir), map[string]string{
+				"io.pressure":   testPressure,
+				"memory.events": "oom_kill 1\n",
+			})
+			// conmon cgroups must not be mistaken for the container.
+			writeCgroupFiles(t, filepath.Join(podPath, "crio-conmon-"+testContainerID+".scope"), map[string]string{
+				"memory.events": "oom_kill 7\n",
+			})
+
+			cs, err := NewCgroupProvider(root).CgroupStats()
+			require.NoError(t, err)
+
+			require.Contains(t, cs.Pods, tt.expectedPodID)
+			pod := cs.Pods[tt.expectedPodID]
+			require.NotNil(t, pod.CPUPressure)
+			assert.Equal(t, uint64(1_500_000), pod.CPUPressure.Some.Total)
+			assert.Equal(t, uint64(500_000), pod.CPUPressure.Full.Total)
+			assert.Nil(t, pod.MemoryPressure)
+			require.NotNil(t, pod.OOMKills)
+			assert.Equal(t, uint64(2), *pod.OOMKills)
+
+			require.Len(t, cs.Containers, 1)
+			container := cs.Containers[testContainerID]
+			require.NotNil(t, container.IOPressure)
+			assert.Equal(t, uint64(1_500_000), container.IOPressure.Some.Total)
+			require.NotNil(t, container.OOMKills)
+			assert.Equal(t, uint64(1), *container.OOMKills)
+		})
+	}
+}
+
+func TestCgroupStatsNoKubepods(t *testing.T) {
+	_, err := NewCgroupProvider(t.TempDir()).CgroupStats()
+	assert.ErrorContains(t, err, "no kubepods cgroup found")
+}
+
+func TestReadPressureFile(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		wantNil bool
+	}{
+		{
+			name:    "cpu pressure without full line",
+			content: "some avg10=1.50 avg60=0.75 avg300=0.25 total=42\n",
+		},
+		{
+			name:    "invalid total",
+			content: "some avg10=0.00 avg60=0.00 avg300=0.00 total=abc\n",
+			wantNil: true,
+		},
+		{
+			name:    "empty file",
+			content: "",
+			wantNil: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "cpu.pressure")
+			require.NoError(t, os.WriteFile(path, []byte(tt.content), 0o600))
+			psi := readPressureFile(path)
+			if tt.wantNil {
+				assert.Nil(t, psi)
+				return
+			}
+			require.NotNil(t, psi)
+			assert.InDelta(t, 1.5, psi.Some.Avg10, 0.001)
+			assert.Equal(t, uint64(42_000), psi.Some.Total)
+			assert.Zero(t, psi.Full.Total)
+		})
+	}
+	assert.Nil(t, readPressureFile(filepath.Join(t.TempDir(), "missing.pressure")))
+}
@@ -50,6 +50,7 @@ type Metadata struct {
 	Labels                    map[MetadataLabel]bool
 	PodsMetadata              *v1.PodList
 	DetailedPVCResourceSetter func(rb *metadata.ResourceBuilder, volCacheID, volumeClaim, namespace string) error
+	CgroupStats               *CgroupStats
 	podResources              map[string]resources
 	containerResources        map[string]resources
 	nodeInfo                  NodeInfo
@@ -226,6 +227,37 @@ func stripContainerID(id string) string {
 	return containerSchemeRegexp.ReplaceAllString(id, "")
 }
 
+// getPodCgroupStats returns the cgroup stats of the given pod, or nil if the
+// cgroup filesystem is not read or the pod cgroup was not found.
+func (m *Metadata) getPodCgroupStats(podUID string) *CgroupResourceStats {
+	if m.CgroupStats == nil {
+		return nil
+	}
+	s, ok := m.CgroupStats.Pods[podUID]
+	if !ok {
+		return nil
+	}
+	return &s
+}
+
+// getContainerCgroupStats returns the cgroup stats of the given container, or nil
+// if the cgroup filesystem is not read or the container cgroup was not found.
+// Container cgroups are named after the container ID, so pods metadata is required.
+func (m *Metadata) getContainerCgroupStats(podUID, containerName string) *CgroupResourceStats {
+	if m.CgroupStats == nil || m.PodsMetadata == nil {
+		return nil
+	}
+	containerID, err := m.getContainerID(podUID, containerName)
+	if err != nil {
+		return nil
+	}
+	s, ok := m.CgroupStats.Containers[containerID]
+	if !ok {
+		return nil
+	}
+	return &s
+}
+
 func (m *Metadata) getPodVolume(podUID, volumeName string) (*v1.Volume, error) {
 	for i := range m.PodsMetadata.Items {
 		pod := &m.PodsMetadata.Items[i]
@@ -12,6 +12,9 @@ import (
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/receiver/receivertest"
 	"go.uber.org/zap"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	stats "k8s.io/kubelet/pkg/apis/stats/v1alpha1"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/kubeletstatsreceiver/internal/metadata"
 )
@@ -188,6 +191,87 @@ func TestEmitMetrics(t *testing.T) {
 	}
 }
 
+func TestCgroupMetrics(t *testing.T) {
+	oomKills := uint64(3)
+	summary := &stats.Summary{
+		Pods: []stats.PodStats{
+			{
+				PodRef: stats.PodReference{UID: "pod-uid-123", Name: "pod1", Namespace: "ns"},
+				CPU: &stats.CPUStats{
+					PSI: &stats.PSIStats{
+						Some: stats.PSIData{Total: 2_000_000_000},
+						Full: stats.PSIData{Total: 1_000_000_000},
+					},
+				},
+				Containers: []stats.ContainerStats{
+					{Name: "container1"},
+				},
+			},
+		},
+	}
+	md := NewMetadata(nil, &v1.PodList{
+		Items: []v1.Pod{
+			{
+				ObjectMeta: metav1.ObjectMeta{UID: "pod-uid-123"},
+				Status: v1.PodStatus{
+					ContainerStatuses: []v1.ContainerStatus{
+						{Name: "container1", ContainerID: "containerd://abc"},
+					},
+				},
+			},
+		},
+	}, NodeInfo{}, nil)
+	md.CgroupStats = &CgroupStats{
+		Pods: map[string]CgroupResourceStats{
+			"pod-uid-123": {
+				MemoryPressure: &stats.PSIStats{Some: stats.PSIData{Total: 500_000_000}},
+				OOMKills:       &oomKills,
+			},
+		},
+		Containers: map[string]CgroupResourceStats{
+			"abc": {OOMKills: &oomKills},
+		},
+	}
+
+	cfg := metadata.DefaultMetricsBuilderConfig()
+	cfg.Metrics.K8sPodCPUPressureStallTime.Enabled = true
+	cfg.Metrics.K8sPodMemoryPressureStallTime.Enabled = true
+	cfg.Metrics.K8sPodIoPressureStallTime.Enabled = true
+	cfg.Metrics.K8sPodMemoryOomKills.Enabled = true
+	cfg.Metrics.ContainerMemoryOomKills.Enabled = true
+	mbs := &metadata.MetricsBuilders{
+		NodeMetricsBuilder:      metadata.NewMetricsBuilder(cfg, receivertest.NewNopSettings(metadata.Type)),
+		PodMetricsBuilder:       metadata.NewMetricsBuilder(cfg, receivertest.NewNopSettings(metadata.Type)),
+		ContainerMetricsBuilder: metadata.NewMetricsBuilder(cfg, receivertest.NewNopSettings(metadata.Type)),
+	}
+	mgs := map[MetricGroup]bool{
+		ContainerMetricGroup: true,
+		PodMetricGroup:       true,
+	}
+
+	metrics := indexedFakeMetrics(MetricsData(zap.NewNop(), summary, md, mgs, map[MetricGroup]bool{}, mbs))
+
+	// CPU pressure comes from /stats/summary.
+	requireContains(t, metrics, "k8s.pod.cpu.pressure.stall_time")
+	dps := metrics["k8s.pod.cpu.pressure.stall_time"][0].Sum().DataPoints()
+	require.Equal(t, 2, dps.Len())
+	require.InDelta(t, 2.0, dps.At(0).DoubleValue(), 0.001)
+	stallType, _ := dps.At(0).Attributes().Get("stall.type")
+	require.Equal(t, "some", stallType.Str())
+	require.InDelta(t, 1.0, dps.At(1).DoubleValue(), 0.001)
+
+	// Memory pressure and OOM kills come from the cgroup filesystem.
+	requireContains(t, metrics, "k8s.pod.memory.pressure.stall_time")
+	require.InDelta(t, 0.5, metrics["k8s.pod.memory.pressure.stall_time"][0].Sum().DataPoints().At(0).DoubleValue(), 0.001)
+	requireContains(t, metrics, "k8s.pod.memory.oom_kills")
+	require.Equal(t, int64(3), metrics["k8s.pod.memory.oom_kills"][0].Sum().DataPoints().At(0).IntValue())
+	requireContains(t, metrics, "container.memory.oom_kills")
+	require.Equal(t, int64(3), metrics["container.memory.oom_kills"][0].Sum().DataPoints().At(0).IntValue())
+
+	_, found := metrics["k8s.pod.io.pressure.stall_time"]
+	require.False(t, found)
+}
+
 func requireContains(t *testing.T, metrics map[string][]pmetric.Metric, metricName string) {
 	_, found := metrics[metricName]
 	require.True(t, found)
@@ -0,0 +1,72 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package kubelet // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/kubeletstatsreceiver/internal/kubelet"
+
+import (
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	stats "k8s.io/kubelet/pkg/apis/stats/v1alpha1"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/kubeletstatsreceiver/internal/metadata"
+)
+
+// getCgroupResourceStats returns the pressure stall information reported by the
+// kubelet in /stats/summary, overridden by the stats read from the cgroup
+// filesystem when available. OOM kills are only available from the latter.
+func getCgroupResourceStats(
+	cpu *stats.CPUStats,
+	memory *stats.MemoryStats,
+	io *stats.IOStats,
+	fromCgroup *CgroupResourceStats,
+) CgroupResourceStats {
+	var out CgroupResourceStats
+	if cpu != nil {
+		out.CPUPressure = cpu.PSI
+	}
+	if memory != nil {
+		out.MemoryPressure = memory.PSI
+	}
+	if io != nil {
+		out.IOPressure = io.PSI
+	}
+	if fromCgroup == nil {
+		return out
+	}
+
+	if fromCgroup.CPUPressure != nil {
+		out.CPUPressure = fromCgroup.CPUPressure
+	}
+	if fromCgroup.MemoryPressure != nil {
+		out.MemoryPressure = fromCgroup.MemoryPressure
+	}
+	if fromCgroup.IOPressure != nil {
+		out.IOPressure = fromCgroup.IOPressure
+	}
+	out.OOMKills = fromCgroup.OOMKills
+	return out
+}
+
+func addCgroupMetrics(
+	mb *metadata.MetricsBuilder,
+	cgroupMetrics metadata.CgroupMetrics,
+	s CgroupResourceStats,
+	currentTime pcommon.Timestamp,
+) {
+	addPressureMetric(mb, cgroupMetrics.CPUPressure, s.CPUPressure, currentTime)
+	addPressureMetric(mb, cgroupMetrics.MemoryPressure, s.MemoryPressure, currentTime)
+	addPressureMetric(mb, cgroupMetrics.IOPressure, s.IOPressure, currentTime)
+	recordIntDataPoint(mb, cgroupMetrics.OOMKills, s.OOMKills, currentTime)
+}
+
+func addPressureMetric(
+	mb *metadata.MetricsBuilder,
+	recordDataPoint metadata.RecordDoubleDataPointWithStallTypeFunc,
+	s *stats.PSIStats,
+	currentTime pcommon.Timestamp,
+) {
+	if s == nil {
+		return
+	}
+	recordDataPoint(mb, currentTime, float64(s.Some.Total)/1_000_000_000, metadata.AttributeStallTypeSome)
+	recordDataPoint(mb, currentTime, float64(s.Full.Total)/1_000_000_000, metadata.AttributeStallTypeFull)
+}
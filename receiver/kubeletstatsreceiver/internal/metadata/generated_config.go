@@ -29,14 +29,18 @@ func (ms *MetricConfig) Unmarshal(parser *confmap.Conf) error {
 
 // MetricsConfig provides config for kubeletstats metrics.
 type MetricsConfig struct {
+	ContainerCPUPressureStallTime        MetricConfig `mapstructure:"container.cpu.pressure.stall_time"`
 	ContainerCPUTime                     MetricConfig `mapstructure:"container.cpu.time"`
 	ContainerCPUUsage                    MetricConfig `mapstructure:"container.cpu.usage"`
 	ContainerFilesystemAvailable         MetricConfig `mapstructure:"container.filesystem.available"`
 	ContainerFilesystemCapacity          MetricConfig `mapstructure:"container.filesystem.capacity"`
 	ContainerFilesystemUsage             MetricConfig `mapstructure:"container.filesystem.usage"`
+	ContainerIoPressureStallTime         MetricConfig `mapstructure:"container.io.pressure.stall_time"`
 	ContainerMemoryAvailable             MetricConfig `mapstructure:"container.memory.available"`
 	ContainerMemoryMajorPageFaults       MetricConfig `mapstructure:"container.memory.major_page_faults"`
+	ContainerMemoryOomKills              MetricConfig `mapstructure:"container.memory.oom_kills"`
 	ContainerMemoryPageFaults            MetricConfig `mapstructure:"container.memory.page_faults"`
+	ContainerMemoryPressureStallTime     MetricConfig `mapstructure:"container.memory.pressure.stall_time"`
 	ContainerMemoryRss                   MetricConfig `mapstructure:"container.memory.rss"`
 	ContainerMemoryUsage                 MetricConfig `mapstructure:"container.memory.usage"`
 	ContainerMemoryWorkingSet            MetricConfig `mapstructure:"container.memory.working_set"`
@@ -62,6 +66,7 @@ type MetricsConfig struct {
 	K8sNodeNetworkIo                     MetricConfig `mapstructure:"k8s.node.network.io"`
 	K8sNodeUptime                        MetricConfig `mapstructure:"k8s.node.uptime"`
 	K8sPodCPUNodeUtilization             MetricConfig `mapstructure:"k8s.pod.cpu.node.utilization"`
+	K8sPodCPUPressureStallTime           MetricConfig `mapstructure:"k8s.pod.cpu.pressure.stall_time"`
 	K8sPodCPUTime                        MetricConfig `mapstructure:"k8s.pod.cpu.time"`
 	K8sPodCPUUsage                       MetricConfig `mapstructure:"k8s.pod.cpu.usage"`
 	K8sPodCPULimitUtilization            MetricConfig `mapstructure:"k8s.pod.cpu_limit_utilization"`
@@ -69,10 +74,13 @@ type MetricsConfig struct {
 	K8sPodFilesystemAvailable            MetricConfig `mapstructure:"k8s.pod.filesystem.available"`
 	K8sPodFilesystemCapacity             MetricConfig `mapstructure:"k8s.pod.filesystem.capacity"`
 	K8sPodFilesystemUsage                MetricConfig `mapstructure:"k8s.pod.filesystem.usage"`
+	K8sPodIoPressureStallTime            MetricConfig `mapstructure:"k8s.pod.io.pressure.stall_time"`
 	K8sPodMemoryAvailable                MetricConfig `mapstructure:"k8s.pod.memory.available"`
 	K8sPodMemoryMajorPageFaults          MetricConfig `mapstructure:"k8s.pod.memory.major_page_faults"`
 	K8sPodMemoryNodeUtilization          MetricConfig `mapstructure:"k8s.pod.memory.node.utilization"`
+	K8sPodMemoryOomKills                 MetricConfig `mapstructure:"k8s.pod.memory.oom_kills"`
 	K8sPodMemoryPageFaults               MetricConfig `mapstructure:"k8s.pod.memory.page_faults"`
+	K8sPodMemoryPressureStallTime        MetricConfig `mapstructure:"k8s.pod.memory.pressure.stall_time"`
 	K8sPodMemoryRss                      MetricConfig `mapstructure:"k8s.pod.memory.rss"`
 	K8sPodMemoryUsage                    MetricConfig `mapstructure:"k8s.pod.memory.usage"`
 	K8sPodMemoryWorkingSet               MetricConfig `mapstructure:"k8s.pod.memory.working_set"`
@@ -91,6 +99,9 @@ type MetricsConfig struct {
 
 func DefaultMetricsConfig() MetricsConfig {
 	return MetricsConfig{
+		ContainerCPUPressureStallTime: MetricConfig{
+			Enabled: false,
+		},
 		ContainerCPUTime: MetricConfig{
 			Enabled: true,
 		},
@@ -106,15 +117,24 @@ func DefaultMetricsConfig() MetricsConfig {
 		ContainerFilesystemUsage: MetricConfig{
 			Enabled: true,
 		},
+		ContainerIoPressureStallTime: MetricConfig{
+			Enabled: false,
+		},
 		ContainerMemoryAvailable: MetricConfig{
 			Enabled: true,
 		},
 		ContainerMemoryMajorPageFaults: MetricConfig{
 			Enabled: true,
 		},
+		ContainerMemoryOomKills: MetricConfig{
+			Enabled: false,
+		},
 		ContainerMemoryPageFaults: MetricConfig{
 			Enabled: true,
 		},
+		ContainerMemoryPressureStallTime: MetricConfig{
+			Enabled: false,
+		},
 		ContainerMemoryRss: MetricConfig{
 			Enabled: true,
 		},
@@ -190,6 +210,9 @@ func DefaultMetricsConfig() MetricsConfig {
 		K8sPodCPUNodeUtilization: MetricConfig{
 			Enabled: false,
 		},
+		K8sPodCPUPressureStallTime: MetricConfig{
+			Enabled: false,
+		},
 		K8sPodCPUTime: MetricConfig{
 			Enabled: true,
 		},
@@ -211,6 +234,9 @@ func DefaultMetricsConfig() MetricsConfig {
 		K8sPodFilesystemUsage: MetricConfig{
 			Enabled: true,
 		},
+		K8sPodIoPressureStallTime: MetricConfig{
+			Enabled: false,
+		},
 		K8sPodMemoryAvailable: MetricConfig{
 			Enabled: true,
 		},
@@ -220,9 +246,15 @@ func DefaultMetricsConfig() MetricsConfig {
 		K8sPodMemoryNodeUtilization: MetricConfig{
 			Enabled: false,
 		},
+		K8sPodMemoryOomKills: MetricConfig{
+			Enabled: false,
+		},
 		K8sPodMemoryPageFaults: MetricConfig{
 			Enabled: true,
 		},
+		K8sPodMemoryPressureStallTime: MetricConfig{
+			Enabled: false,
+		},
 		K8sPodMemoryRss: MetricConfig{
 			Enabled: true,
 		},
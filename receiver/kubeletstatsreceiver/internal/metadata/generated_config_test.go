@@ -26,6 +26,9 @@ func TestMetricsBuilderConfig(t *testing.T) {
 			name: "all_set",
 			want: MetricsBuilderConfig{
 				Metrics: MetricsConfig{
+					ContainerCPUPressureStallTime: MetricConfig{
+						Enabled: true,
+					},
 					ContainerCPUTime: MetricConfig{
 						Enabled: true,
 					},
@@ -41,15 +44,24 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					ContainerFilesystemUsage: MetricConfig{
 						Enabled: true,
 					},
+					ContainerIoPressureStallTime: MetricConfig{
+						Enabled: true,
+					},
 					ContainerMemoryAvailable: MetricConfig{
 						Enabled: true,
 					},
 					ContainerMemoryMajorPageFaults: MetricConfig{
 						Enabled: true,
 					},
+					ContainerMemoryOomKills: MetricConfig{
+						Enabled: true,
+					},
 					ContainerMemoryPageFaults: MetricConfig{
 						Enabled: true,
 					},
+					ContainerMemoryPressureStallTime: MetricConfig{
+						Enabled: true,
+					},
 					ContainerMemoryRss: MetricConfig{
 						Enabled: true,
 					},
@@ -125,6 +137,9 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					K8sPodCPUNodeUtilization: MetricConfig{
 						Enabled: true,
 					},
+					K8sPodCPUPressureStallTime: MetricConfig{
+						Enabled: true,
+					},
 					K8sPodCPUTime: MetricConfig{
 						Enabled: true,
 					},
@@ -146,6 +161,9 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					K8sPodFilesystemUsage: MetricConfig{
 						Enabled: true,
 					},
+					K8sPodIoPressureStallTime: MetricConfig{
+						Enabled: true,
+					},
 					K8sPodMemoryAvailable: MetricConfig{
 						Enabled: true,
 					},
@@ -155,9 +173,15 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					K8sPodMemoryNodeUtilization: MetricConfig{
 						Enabled: true,
 					},
+					K8sPodMemoryOomKills: MetricConfig{
+						Enabled: true,
+					},
 					K8sPodMemoryPageFaults: MetricConfig{
 						Enabled: true,
 					},
+					K8sPodMemoryPressureStallTime: MetricConfig{
+						Enabled: true,
+					},
 					K8sPodMemoryRss: MetricConfig{
 						Enabled: true,
 					},
@@ -224,6 +248,9 @@ func TestMetricsBuilderConfig(t *testing.T) {
 			name: "none_set",
 			want: MetricsBuilderConfig{
 				Metrics: MetricsConfig{
+					ContainerCPUPressureStallTime: MetricConfig{
+						Enabled: false,
+					},
 					ContainerCPUTime: MetricConfig{
 						Enabled: false,
 					},
@@ -239,15 +266,24 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					ContainerFilesystemUsage: MetricConfig{
 						Enabled: false,
 					},
+					ContainerIoPressureStallTime: MetricConfig{
+						Enabled: false,
+					},
 					ContainerMemoryAvailable: MetricConfig{
 						Enabled: false,
 					},
 					ContainerMemoryMajorPageFaults: MetricConfig{
 						Enabled: false,
 					},
+					ContainerMemoryOomKills: MetricConfig{
+						Enabled: false,
+					},
 					ContainerMemoryPageFaults: MetricConfig{
 						Enabled: false,
 					},
+					ContainerMemoryPressureStallTime: MetricConfig{
+						Enabled: false,
+					},
 					ContainerMemoryRss: MetricConfig{
 						Enabled: false,
 					},
@@ -323,6 +359,9 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					K8sPodCPUNodeUtilization: MetricConfig{
 						Enabled: false,
 					},
+					K8sPodCPUPressureStallTime: MetricConfig{
+						Enabled: false,
+					},
 					K8sPodCPUTime: MetricConfig{
 						Enabled: false,
 					},
@@ -344,6 +383,9 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					K8sPodFilesystemUsage: MetricConfig{
 						Enabled: false,
 					},
+					K8sPodIoPressureStallTime: MetricConfig{
+						Enabled: false,
+					},
 					K8sPodMemoryAvailable: MetricConfig{
 						Enabled: false,
 					},
@@ -353,9 +395,15 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					K8sPodMemoryNodeUtilization: MetricConfig{
 						Enabled: false,
 					},
+					K8sPodMemoryOomKills: MetricConfig{
+						Enabled: false,
+					},
 					K8sPodMemoryPageFaults: MetricConfig{
 						Enabled: false,
 					},
+					K8sPodMemoryPressureStallTime: MetricConfig{
+						Enabled: false,
+					},
 					K8sPodMemoryRss: MetricConfig{
 						Enabled: false,
 					},
@@ -38,7 +38,36 @@ var MapAttributeDirection = map[string]AttributeDirection{
 	"transmit": AttributeDirectionTransmit,
 }
 
+// AttributeStallType specifies the value stall.type attribute.
+type AttributeStallType int
+
+const (
+	_ AttributeStallType = iota
+	AttributeStallTypeSome
+	AttributeStallTypeFull
+)
+
+// String returns the string representation of the AttributeStallType.
+func (av AttributeStallType) String() string {
+	switch av {
+	case AttributeStallTypeSome:
+		return "some"
+	case AttributeStallTypeFull:
+		return "full"
+	}
+	return ""
+}
+
+// MapAttributeStallType is a helper map of string to AttributeStallType attribute value.
+var MapAttributeStallType = map[string]AttributeStallType{
+	"some": AttributeStallTypeSome,
+	"full": AttributeStallTypeFull,
+}
+
 var MetricsInfo = metricsInfo{
+	ContainerCPUPressureStallTime: metricInfo{
+		Name: "container.cpu.pressure.stall_time",
+	},
 	ContainerCPUTime: metricInfo{
 		Name: "container.cpu.time",
 	},
@@ -54,15 +83,24 @@ var MetricsInfo = metricsInfo{
 	ContainerFilesystemUsage: metricInfo{
 		Name: "container.filesystem.usage",
 	},
+	ContainerIoPressureStallTime: metricInfo{
+		Name: "container.io.pressure.stall_time",
+	},
 	ContainerMemoryAvailable: metricInfo{
 		Name: "container.memory.available",
 	},
 	ContainerMemoryMajorPageFaults: metricInfo{
 		Name: "container.memory.major_page_faults",
 	},
+	ContainerMemoryOomKills: metricInfo{
+		Name: "container.memory.oom_kills",
+	},
 	ContainerMemoryPageFaults: metricInfo{
 		Name: "container.memory.page_faults",
 	},
+	ContainerMemoryPressureStallTime: metricInfo{
+		Name: "container.memory.pressure.stall_time",
+	},
 	ContainerMemoryRss: metricInfo{
 		Name: "container.memory.rss",
 	},
@@ -138,6 +176,9 @@ var MetricsInfo = metricsInfo{
 	K8sPodCPUNodeUtilization: metricInfo{
 		Name: "k8s.pod.cpu.node.utilization",
 	},
+	K8sPodCPUPressureStallTime: metricInfo{
+		Name: "k8s.pod.cpu.pressure.stall_time",
+	},
 	K8sPodCPUTime: metricInfo{
 		Name: "k8s.pod.cpu.time",
 	},
@@ -159,6 +200,9 @@ var MetricsInfo = metricsInfo{
 	K8sPodFilesystemUsage: metricInfo{
 		Name: "k8s.pod.filesystem.usage",
 	},
+	K8sPodIoPressureStallTime: metricInfo{
+		Name: "k8s.pod.io.pressure.stall_time",
+	},
 	K8sPodMemoryAvailable: metricInfo{
 		Name: "k8s.pod.memory.available",
 	},
@@ -168,9 +212,15 @@ var MetricsInfo = metricsInfo{
 	K8sPodMemoryNodeUtilization: metricInfo{
 		Name: "k8s.pod.memory.node.utilization",
 	},
+	K8sPodMemoryOomKills: metricInfo{
+		Name: "k8s.pod.memory.oom_kills",
+	},
 	K8sPodMemoryPageFaults: metricInfo{
 		Name: "k8s.pod.memory.page_faults",
 	},
+	K8sPodMemoryPressureStallTime: metricInfo{
+		Name: "k8s.pod.memory.pressure.stall_time",
+	},
 	K8sPodMemoryRss: metricInfo{
 		Name: "k8s.pod.memory.rss",
 	},
@@ -216,14 +266,18 @@ var MetricsInfo = metricsInfo{
 }
 
 type metricsInfo struct {
+	ContainerCPUPressureStallTime        metricInfo
 	ContainerCPUTime                     metricInfo
 	ContainerCPUUsage                    metricInfo
 	ContainerFilesystemAvailable         metricInfo
 	ContainerFilesystemCapacity          metricInfo
 	ContainerFilesystemUsage             metricInfo
+	ContainerIoPressureStallTime         metricInfo
 	ContainerMemoryAvailable             metricInfo
 	ContainerMemoryMajorPageFaults       metricInfo
+	ContainerMemoryOomKills              metricInfo
 	ContainerMemoryPageFaults            metricInfo
+	ContainerMemoryPressureStallTime     metricInfo
 	ContainerMemoryRss                   metricInfo
 	ContainerMemoryUsage                 metricInfo
 	ContainerMemoryWorkingSet            metricInfo
@@ -249,6 +303,7 @@ type metricsInfo struct {
 	K8sNodeNetworkIo                     metricInfo
 	K8sNodeUptime                        metricInfo
 	K8sPodCPUNodeUtilization             metricInfo
+	K8sPodCPUPressureStallTime           metricInfo
 	K8sPodCPUTime                        metricInfo
 	K8sPodCPUUsage                       metricInfo
 	K8sPodCPULimitUtilization            metricInfo
@@ -256,10 +311,13 @@ type metricsInfo struct {
 	K8sPodFilesystemAvailable            metricInfo
 	K8sPodFilesystemCapacity             metricInfo
 	K8sPodFilesystemUsage                metricInfo
+	K8sPodIoPressureStallTime            metricInfo
 	K8sPodMemoryAvailable                metricInfo
 	K8sPodMemoryMajorPageFaults          metricInfo
 	K8sPodMemoryNodeUtilization          metricInfo
+	K8sPodMemoryOomKills                 metricInfo
 	K8sPodMemoryPageFaults               metricInfo
+	K8sPodMemoryPressureStallTime        metricInfo
 	K8sPodMemoryRss                      metricInfo
 	K8sPodMemoryUsage                    metricInfo
 	K8sPodMemoryWorkingSet               metricInfo
@@ -280,6 +338,60 @@ type metricInfo struct {
 	Name string
 }
 
+type metricContainerCPUPressureStallTime struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills container.cpu.pressure.stall_time metric with initial data.
+func (m *metricContainerCPUPressureStallTime) init() {
+	m.data.SetName("container.cpu.pressure.stall_time")
+	m.data.SetDescription("Total time that some (stall.type=some) or all (stall.type=full) non-idle tasks of the container were stalled waiting for CPU, as reported by cgroup v2 pressure stall information")
+	m.data.SetUnit("s")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricContainerCPUPressureStallTime) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64, stallTypeAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+	dp.Attributes().PutStr("stall.type", stallTypeAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricContainerCPUPressureStallTime) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricContainerCPUPressureStallTime) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricContainerCPUPressureStallTime(cfg MetricConfig) metricContainerCPUPressureStallTime {
+	m := metricContainerCPUPressureStallTime{config: cfg}
+
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricContainerCPUTime struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
@@ -532,6 +644,60 @@ func newMetricContainerFilesystemUsage(cfg MetricConfig) metricContainerFilesyst
 	return m
 }
 
+type metricContainerIoPressureStallTime struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills container.io.pressure.stall_time metric with initial data.
+func (m *metricContainerIoPressureStallTime) init() {
+	m.data.SetName("container.io.pressure.stall_time")
+	m.data.SetDescription("Total time that some (stall.type=some) or all (stall.type=full) non-idle tasks of the container were stalled waiting for IO, as reported by cgroup v2 pressure stall information")
+	m.data.SetUnit("s")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricContainerIoPressureStallTime) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64, stallTypeAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+	dp.Attributes().PutStr("stall.type", stallTypeAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricContainerIoPressureStallTime) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricContainerIoPressureStallTime) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricContainerIoPressureStallTime(cfg MetricConfig) metricContainerIoPressureStallTime {
+	m := metricContainerIoPressureStallTime{config: cfg}
+
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricContainerMemoryAvailable struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
@@ -632,6 +798,58 @@ func newMetricContainerMemoryMajorPageFaults(cfg MetricConfig) metricContainerMe
 	return m
 }
 
+type metricContainerMemoryOomKills struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills container.memory.oom_kills metric with initial data.
+func (m *metricContainerMemoryOomKills) init() {
+	m.data.SetName("container.memory.oom_kills")
+	m.data.SetDescription("Number of processes in the container killed by the kernel OOM killer")
+	m.data.SetUnit("{kill}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+}
+
+func (m *metricContainerMemoryOomKills) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricContainerMemoryOomKills) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricContainerMemoryOomKills) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricContainerMemoryOomKills(cfg MetricConfig) metricContainerMemoryOomKills {
+	m := metricContainerMemoryOomKills{config: cfg}
+
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricContainerMemoryPageFaults struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
@@ -682,6 +900,60 @@ func newMetricContainerMemoryPageFaults(cfg MetricConfig) metricContainerMemoryP
 	return m
 }
 
+type metricContainerMemoryPressureStallTime struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills container.memory.pressure.stall_time metric with initial data.
+func (m *metricContainerMemoryPressureStallTime) init() {
+	m.data.SetName("container.memory.pressure.stall_time")
+	m.data.SetDescription("Total time that some (stall.type=some) or all (stall.type=full) non-idle tasks of the container were stalled waiting for memory, as reported by cgroup v2 pressure stall information")
+	m.data.SetUnit("s")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricContainerMemoryPressureStallTime) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64, stallTypeAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+	dp.Attributes().PutStr("stall.type", stallTypeAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricContainerMemoryPressureStallTime) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricContainerMemoryPressureStallTime) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricContainerMemoryPressureStallTime(cfg MetricConfig) metricContainerMemoryPressureStallTime {
+	m := metricContainerMemoryPressureStallTime{config: cfg}
+
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricContainerMemoryRss struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
@@ -1948,6 +2220,60 @@ func newMetricK8sPodCPUNodeUtilization(cfg MetricConfig) metricK8sPodCPUNodeUtil
 	return m
 }
 
+type metricK8sPodCPUPressureStallTime struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills k8s.pod.cpu.pressure.stall_time metric with initial data.
+func (m *metricK8sPodCPUPressureStallTime) init() {
+	m.data.SetName("k8s.pod.cpu.pressure.stall_time")
+	m.data.SetDescription("Total time that some (stall.type=some) or all (stall.type=full) non-idle tasks of the pod were stalled waiting for CPU, as reported by cgroup v2 pressure stall information")
+	m.data.SetUnit("s")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricK8sPodCPUPressureStallTime) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64, stallTypeAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+	dp.Attributes().PutStr("stall.type", stallTypeAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricK8sPodCPUPressureStallTime) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricK8sPodCPUPressureStallTime) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricK8sPodCPUPressureStallTime(cfg MetricConfig) metricK8sPodCPUPressureStallTime {
+	m := metricK8sPodCPUPressureStallTime{config: cfg}
+
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricK8sPodCPUTime struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
@@ -2300,6 +2626,60 @@ func newMetricK8sPodFilesystemUsage(cfg MetricConfig) metricK8sPodFilesystemUsag
 	return m
 }
 
+type metricK8sPodIoPressureStallTime struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills k8s.pod.io.pressure.stall_time metric with initial data.
+func (m *metricK8sPodIoPressureStallTime) init() {
+	m.data.SetName("k8s.pod.io.pressure.stall_time")
+	m.data.SetDescription("Total time that some (stall.type=some) or all (stall.type=full) non-idle tasks of the pod were stalled waiting for IO, as reported by cgroup v2 pressure stall information")
+	m.data.SetUnit("s")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricK8sPodIoPressureStallTime) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64, stallTypeAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+	dp.Attributes().PutStr("stall.type", stallTypeAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricK8sPodIoPressureStallTime) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricK8sPodIoPressureStallTime) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricK8sPodIoPressureStallTime(cfg MetricConfig) metricK8sPodIoPressureStallTime {
+	m := metricK8sPodIoPressureStallTime{config: cfg}
+
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricK8sPodMemoryAvailable struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
@@ -2450,6 +2830,58 @@ func newMetricK8sPodMemoryNodeUtilization(cfg MetricConfig) metricK8sPodMemoryNo
 	return m
 }
 
+type metricK8sPodMemoryOomKills struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills k8s.pod.memory.oom_kills metric with initial data.
+func (m *metricK8sPodMemoryOomKills) init() {
+	m.data.SetName("k8s.pod.memory.oom_kills")
+	m.data.SetDescription("Number of processes in the pod killed by the kernel OOM killer")
+	m.data.SetUnit("{kill}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+}
+
+func (m *metricK8sPodMemoryOomKills) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricK8sPodMemoryOomKills) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricK8sPodMemoryOomKills) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricK8sPodMemoryOomKills(cfg MetricConfig) metricK8sPodMemoryOomKills {
+	m := metricK8sPodMemoryOomKills{config: cfg}
+
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricK8sPodMemoryPageFaults struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
@@ -2500,6 +2932,60 @@ func newMetricK8sPodMemoryPageFaults(cfg MetricConfig) metricK8sPodMemoryPageFau
 	return m
 }
 
+type metricK8sPodMemoryPressureStallTime struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills k8s.pod.memory.pressure.stall_time metric with initial data.
+func (m *metricK8sPodMemoryPressureStallTime) init() {
+	m.data.SetName("k8s.pod.memory.pressure.stall_time")
+	m.data.SetDescription("Total time that some (stall.type=some) or all (stall.type=full) non-idle tasks of the pod were stalled waiting for memory, as reported by cgroup v2 pressure stall information")
+	m.data.SetUnit("s")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricK8sPodMemoryPressureStallTime) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64, stallTypeAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+	dp.Attributes().PutStr("stall.type", stallTypeAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricK8sPodMemoryPressureStallTime) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricK8sPodMemoryPressureStallTime) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricK8sPodMemoryPressureStallTime(cfg MetricConfig) metricK8sPodMemoryPressureStallTime {
+	m := metricK8sPodMemoryPressureStallTime{config: cfg}
+
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricK8sPodMemoryRss struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
@@ -3224,14 +3710,18 @@ type MetricsBuilder struct {
 	buildInfo                                  component.BuildInfo  // contains version information.
 	resourceAttributeIncludeFilter             map[string]filter.Filter
 	resourceAttributeExcludeFilter             map[string]filter.Filter
+	metricContainerCPUPressureStallTime        metricContainerCPUPressureStallTime
 	metricContainerCPUTime                     metricContainerCPUTime
 	metricContainerCPUUsage                    metricContainerCPUUsage
 	metricContainerFilesystemAvailable         metricContainerFilesystemAvailable
 	metricContainerFilesystemCapacity          metricContainerFilesystemCapacity
 	metricContainerFilesystemUsage             metricContainerFilesystemUsage
+	metricContainerIoPressureStallTime         metricContainerIoPressureStallTime
 	metricContainerMemoryAvailable             metricContainerMemoryAvailable
 	metricContainerMemoryMajorPageFaults       metricContainerMemoryMajorPageFaults
+	metricContainerMemoryOomKills              metricContainerMemoryOomKills
 	metricContainerMemoryPageFaults            metricContainerMemoryPageFaults
+	metricContainerMemoryPressureStallTime     metricContainerMemoryPressureStallTime
 	metricContainerMemoryRss                   metricContainerMemoryRss
 	metricContainerMemoryUsage                 metricContainerMemoryUsage
 	metricContainerMemoryWorkingSet            metricContainerMemoryWorkingSet
@@ -3257,6 +3747,7 @@ type MetricsBuilder struct {
 	metricK8sNodeNetworkIo                     metricK8sNodeNetworkIo
 	metricK8sNodeUptime                        metricK8sNodeUptime
 	metricK8sPodCPUNodeUtilization             metricK8sPodCPUNodeUtilization
+	metricK8sPodCPUPressureStallTime           metricK8sPodCPUPressureStallTime
 	metricK8sPodCPUTime                        metricK8sPodCPUTime
 	metricK8sPodCPUUsage                       metricK8sPodCPUUsage
 	metricK8sPodCPULimitUtilization            metricK8sPodCPULimitUtilization
@@ -3264,10 +3755,13 @@ type MetricsBuilder struct {
 	metricK8sPodFilesystemAvailable            metricK8sPodFilesystemAvailable
 	metricK8sPodFilesystemCapacity             metricK8sPodFilesystemCapacity
 	metricK8sPodFilesystemUsage                metricK8sPodFilesystemUsage
+	metricK8sPodIoPressureStallTime            metricK8sPodIoPressureStallTime
 	metricK8sPodMemoryAvailable                metricK8sPodMemoryAvailable
 	metricK8sPodMemoryMajorPageFaults          metricK8sPodMemoryMajorPageFaults
 	metricK8sPodMemoryNodeUtilization          metricK8sPodMemoryNodeUtilization
+	metricK8sPodMemoryOomKills                 metricK8sPodMemoryOomKills
 	metricK8sPodMemoryPageFaults               metricK8sPodMemoryPageFaults
+	metricK8sPodMemoryPressureStallTime        metricK8sPodMemoryPressureStallTime
 	metricK8sPodMemoryRss                      metricK8sPodMemoryRss
 	metricK8sPodMemoryUsage                    metricK8sPodMemoryUsage
 	metricK8sPodMemoryWorkingSet               metricK8sPodMemoryWorkingSet
@@ -3325,14 +3819,18 @@ func NewMetricsBuilder(mbc MetricsBuilderConfig, settings receiver.Settings, opt
 		startTime:                                  pcommon.NewTimestampFromTime(time.Now()),
 		metricsBuffer:                              pmetric.NewMetrics(),
 		buildInfo:                                  settings.BuildInfo,
+		metricContainerCPUPressureStallTime:        newMetricContainerCPUPressureStallTime(mbc.Metrics.ContainerCPUPressureStallTime),
 		metricContainerCPUTime:                     newMetricContainerCPUTime(mbc.Metrics.ContainerCPUTime),
 		metricContainerCPUUsage:                    newMetricContainerCPUUsage(mbc.Metrics.ContainerCPUUsage),
 		metricContainerFilesystemAvailable:         newMetricContainerFilesystemAvailable(mbc.Metrics.ContainerFilesystemAvailable),
 		metricContainerFilesystemCapacity:          newMetricContainerFilesystemCapacity(mbc.Metrics.ContainerFilesystemCapacity),
 		metricContainerFilesystemUsage:             newMetricContainerFilesystemUsage(mbc.Metrics.ContainerFilesystemUsage),
+		metricContainerIoPressureStallTime:         newMetricContainerIoPressureStallTime(mbc.Metrics.ContainerIoPressureStallTime),
 		metricContainerMemoryAvailable:             newMetricContainerMemoryAvailable(mbc.Metrics.ContainerMemoryAvailable),
 		metricContainerMemoryMajorPageFaults:       newMetricContainerMemoryMajorPageFaults(mbc.Metrics.ContainerMemoryMajorPageFaults),
+		metricContainerMemoryOomKills:              newMetricContainerMemoryOomKills(mbc.Metrics.ContainerMemoryOomKills),
 		metricContainerMemoryPageFaults:            newMetricContainerMemoryPageFaults(mbc.Metrics.ContainerMemoryPageFaults),
+		metricContainerMemoryPressureStallTime:     newMetricContainerMemoryPressureStallTime(mbc.Metrics.ContainerMemoryPressureStallTime),
 		metricContainerMemoryRss:                   newMetricContainerMemoryRss(mbc.Metrics.ContainerMemoryRss),
 		metricContainerMemoryUsage:                 newMetricContainerMemoryUsage(mbc.Metrics.ContainerMemoryUsage),
 		metricContainerMemoryWorkingSet:            newMetricContainerMemoryWorkingSet(mbc.Metrics.ContainerMemoryWorkingSet),
@@ -3358,6 +3856,7 @@ func NewMetricsBuilder(mbc MetricsBuilderConfig, settings receiver.Settings, opt
 		metricK8sNodeNetworkIo:                     newMetricK8sNodeNetworkIo(mbc.Metrics.K8sNodeNetworkIo),
 		metricK8sNodeUptime:                        newMetricK8sNodeUptime(mbc.Metrics.K8sNodeUptime),
 		metricK8sPodCPUNodeUtilization:             newMetricK8sPodCPUNodeUtilization(mbc.Metrics.K8sPodCPUNodeUtilization),
+		metricK8sPodCPUPressureStallTime:           newMetricK8sPodCPUPressureStallTime(mbc.Metrics.K8sPodCPUPressureStallTime),
 		metricK8sPodCPUTime:                        newMetricK8sPodCPUTime(mbc.Metrics.K8sPodCPUTime),
 		metricK8sPodCPUUsage:                       newMetricK8sPodCPUUsage(mbc.Metrics.K8sPodCPUUsage),
 		metricK8sPodCPULimitUtilization:            newMetricK8sPodCPULimitUtilization(mbc.Metrics.K8sPodCPULimitUtilization),
@@ -3365,10 +3864,13 @@ func NewMetricsBuilder(mbc MetricsBuilderConfig, settings receiver.Settings, opt
 		metricK8sPodFilesystemAvailable:            newMetricK8sPodFilesystemAvailable(mbc.Metrics.K8sPodFilesystemAvailable),
 		metricK8sPodFilesystemCapacity:             newMetricK8sPodFilesystemCapacity(mbc.Metrics.K8sPodFilesystemCapacity),
 		metricK8sPodFilesystemUsage:                newMetricK8sPodFilesystemUsage(mbc.Metrics.K8sPodFilesystemUsage),
+		metricK8sPodIoPressureStallTime:            newMetricK8sPodIoPressureStallTime(mbc.Metrics.K8sPodIoPressureStallTime),
 		metricK8sPodMemoryAvailable:                newMetricK8sPodMemoryAvailable(mbc.Metrics.K8sPodMemoryAvailable),
 		metricK8sPodMemoryMajorPageFaults:          newMetricK8sPodMemoryMajorPageFaults(mbc.Metrics.K8sPodMemoryMajorPageFaults),
 		metricK8sPodMemoryNodeUtilization:          newMetricK8sPodMemoryNodeUtilization(mbc.Metrics.K8sPodMemoryNodeUtilization),
+		metricK8sPodMemoryOomKills:                 newMetricK8sPodMemoryOomKills(mbc.Metrics.K8sPodMemoryOomKills),
 		metricK8sPodMemoryPageFaults:               newMetricK8sPodMemoryPageFaults(mbc.Metrics.K8sPodMemoryPageFaults),
+		metricK8sPodMemoryPressureStallTime:        newMetricK8sPodMemoryPressureStallTime(mbc.Metrics.K8sPodMemoryPressureStallTime),
 		metricK8sPodMemoryRss:                      newMetricK8sPodMemoryRss(mbc.Metrics.K8sPodMemoryRss),
 		metricK8sPodMemoryUsage:                    newMetricK8sPodMemoryUsage(mbc.Metrics.K8sPodMemoryUsage),
 		metricK8sPodMemoryWorkingSet:               newMetricK8sPodMemoryWorkingSet(mbc.Metrics.K8sPodMemoryWorkingSet),
@@ -3545,14 +4047,18 @@ func (mb *MetricsBuilder) EmitForResource(options ...ResourceMetricsOption) {
 	ils.Scope().SetName(ScopeName)
 	ils.Scope().SetVersion(mb.buildInfo.Version)
 	ils.Metrics().EnsureCapacity(mb.metricsCapacity)
+	mb.metricContainerCPUPressureStallTime.emit(ils.Metrics())
 	mb.metricContainerCPUTime.emit(ils.Metrics())
 	mb.metricContainerCPUUsage.emit(ils.Metrics())
 	mb.metricContainerFilesystemAvailable.emit(ils.Metrics())
 	mb.metricContainerFilesystemCapacity.emit(ils.Metrics())
 	mb.metricContainerFilesystemUsage.emit(ils.Metrics())
+	mb.metricContainerIoPressureStallTime.emit(ils.Metrics())
 	mb.metricContainerMemoryAvailable.emit(ils.Metrics())
 	mb.metricContainerMemoryMajorPageFaults.emit(ils.Metrics())
+	mb.metricContainerMemoryOomKills.emit(ils.Metrics())
 	mb.metricContainerMemoryPageFaults.emit(ils.Metrics())
+	mb.metricContainerMemoryPressureStallTime.emit(ils.Metrics())
 	mb.metricContainerMemoryRss.emit(ils.Metrics())
 	mb.metricContainerMemoryUsage.emit(ils.Metrics())
 	mb.metricContainerMemoryWorkingSet.emit(ils.Metrics())
@@ -3578,6 +4084,7 @@ func (mb *MetricsBuilder) EmitForResource(options ...ResourceMetricsOption) {
 	mb.metricK8sNodeNetworkIo.emit(ils.Metrics())
 	mb.metricK8sNodeUptime.emit(ils.Metrics())
 	mb.metricK8sPodCPUNodeUtilization.emit(ils.Metrics())
+	mb.metricK8sPodCPUPressureStallTime.emit(ils.Metrics())
 	mb.metricK8sPodCPUTime.emit(ils.Metrics())
 	mb.metricK8sPodCPUUsage.emit(ils.Metrics())
 	mb.metricK8sPodCPULimitUtilization.emit(ils.Metrics())
@@ -3585,10 +4092,13 @@ func (mb *MetricsBuilder) EmitForResource(options ...ResourceMetricsOption) {
 	mb.metricK8sPodFilesystemAvailable.emit(ils.Metrics())
 	mb.metricK8sPodFilesystemCapacity.emit(ils.Metrics())
 	mb.metricK8sPodFilesystemUsage.emit(ils.Metrics())
+	mb.metricK8sPodIoPressureStallTime.emit(ils.Metrics())
 	mb.metricK8sPodMemoryAvailable.emit(ils.Metrics())
 	mb.metricK8sPodMemoryMajorPageFaults.emit(ils.Metrics())
 	mb.metricK8sPodMemoryNodeUtilization.emit(ils.Metrics())
+	mb.metricK8sPodMemoryOomKills.emit(ils.Metrics())
 	mb.metricK8sPodMemoryPageFaults.emit(ils.Metrics())
+	mb.metricK8sPodMemoryPressureStallTime.emit(ils.Metrics())
 	mb.metricK8sPodMemoryRss.emit(ils.Metrics())
 	mb.metricK8sPodMemoryUsage.emit(ils.Metrics())
 	mb.metricK8sPodMemoryWorkingSet.emit(ils.Metrics())
@@ -3634,6 +4144,11 @@ func (mb *MetricsBuilder) Emit(options ...ResourceMetricsOption) pmetric.Metrics
 	return metrics
 }
 
+// RecordContainerCPUPressureStallTimeDataPoint adds a data point to container.cpu.pressure.stall_time metric.
+func (mb *MetricsBuilder) RecordContainerCPUPressureStallTimeDataPoint(ts pcommon.Timestamp, val float64, stallTypeAttributeValue AttributeStallType) {
+	mb.metricContainerCPUPressureStallTime.recordDataPoint(mb.startTime, ts, val, stallTypeAttributeValue.String())
+}
+
 // RecordContainerCPUTimeDataPoint adds a data point to container.cpu.time metric.
 func (mb *MetricsBuilder) RecordContainerCPUTimeDataPoint(ts pcommon.Timestamp, val float64) {
 	mb.metricContainerCPUTime.recordDataPoint(mb.startTime, ts, val)
@@ -3659,6 +4174,11 @@ func (mb *MetricsBuilder) RecordContainerFilesystemUsageDataPoint(ts pcommon.Tim
 	mb.metricContainerFilesystemUsage.recordDataPoint(mb.startTime, ts, val)
 }
 
+// RecordContainerIoPressureStallTimeDataPoint adds a data point to container.io.pressure.stall_time metric.
+func (mb *MetricsBuilder) RecordContainerIoPressureStallTimeDataPoint(ts pcommon.Timestamp, val float64, stallTypeAttributeValue AttributeStallType) {
+	mb.metricContainerIoPressureStallTime.recordDataPoint(mb.startTime, ts, val, stallTypeAttributeValue.String())
+}
+
 // RecordContainerMemoryAvailableDataPoint adds a data point to container.memory.available metric.
 func (mb *MetricsBuilder) RecordContainerMemoryAvailableDataPoint(ts pcommon.Timestamp, val int64) {
 	mb.metricContainerMemoryAvailable.recordDataPoint(mb.startTime, ts, val)
@@ -3669,11 +4189,21 @@ func (mb *MetricsBuilder) RecordContainerMemoryMajorPageFaultsDataPoint(ts pcomm
 	mb.metricContainerMemoryMajorPageFaults.recordDataPoint(mb.startTime, ts, val)
 }
 
+// RecordContainerMemoryOomKillsDataPoint adds a data point to container.memory.oom_kills metric.
+func (mb *MetricsBuilder) RecordContainerMemoryOomKillsDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricContainerMemoryOomKills.recordDataPoint(mb.startTime, ts, val)
+}
+
 // RecordContainerMemoryPageFaultsDataPoint adds a data point to container.memory.page_faults metric.
 func (mb *MetricsBuilder) RecordContainerMemoryPageFaultsDataPoint(ts pcommon.Timestamp, val int64) {
 	mb.metricContainerMemoryPageFaults.recordDataPoint(mb.startTime, ts, val)
 }
 
+// RecordContainerMemoryPressureStallTimeDataPoint adds a data point to container.memory.pressure.stall_time metric.
+func (mb *MetricsBuilder) RecordContainerMemoryPressureStallTimeDataPoint(ts pcommon.Timestamp, val float64, stallTypeAttributeValue AttributeStallType) {
+	mb.metricContainerMemoryPressureStallTime.recordDataPoint(mb.startTime, ts, val, stallTypeAttributeValue.String())
+}
+
 // RecordContainerMemoryRssDataPoint adds a data point to container.memory.rss metric.
 func (mb *MetricsBuilder) RecordContainerMemoryRssDataPoint(ts pcommon.Timestamp, val int64) {
 	mb.metricContainerMemoryRss.recordDataPoint(mb.startTime, ts, val)
@@ -3799,6 +4329,11 @@ func (mb *MetricsBuilder) RecordK8sPodCPUNodeUtilizationDataPoint(ts pcommon.Tim
 	mb.metricK8sPodCPUNodeUtilization.recordDataPoint(mb.startTime, ts, val)
 }
 
+// RecordK8sPodCPUPressureStallTimeDataPoint adds a data point to k8s.pod.cpu.pressure.stall_time metric.
+func (mb *MetricsBuilder) RecordK8sPodCPUPressureStallTimeDataPoint(ts pcommon.Timestamp, val float64, stallTypeAttributeValue AttributeStallType) {
+	mb.metricK8sPodCPUPressureStallTime.recordDataPoint(mb.startTime, ts, val, stallTypeAttributeValue.String())
+}
+
 // RecordK8sPodCPUTimeDataPoint adds a data point to k8s.pod.cpu.time metric.
 func (mb *MetricsBuilder) RecordK8sPodCPUTimeDataPoint(ts pcommon.Timestamp, val float64) {
 	mb.metricK8sPodCPUTime.recordDataPoint(mb.startTime, ts, val)
@@ -3834,6 +4369,11 @@ func (mb *MetricsBuilder) RecordK8sPodFilesystemUsageDataPoint(ts pcommon.Timest
 	mb.metricK8sPodFilesystemUsage.recordDataPoint(mb.startTime, ts, val)
 }
 
+// RecordK8sPodIoPressureStallTimeDataPoint adds a data point to k8s.pod.io.pressure.stall_time metric.
+func (mb *MetricsBuilder) RecordK8sPodIoPressureStallTimeDataPoint(ts pcommon.Timestamp, val float64, stallTypeAttributeValue AttributeStallType) {
+	mb.metricK8sPodIoPressureStallTime.recordDataPoint(mb.startTime, ts, val, stallTypeAttributeValue.String())
+}
+
 // RecordK8sPodMemoryAvailableDataPoint adds a data point to k8s.pod.memory.available metric.
 func (mb *MetricsBuilder) RecordK8sPodMemoryAvailableDataPoint(ts pcommon.Timestamp, val int64) {
 	mb.metricK8sPodMemoryAvailable.recordDataPoint(mb.startTime, ts, val)
@@ -3849,11 +4389,21 @@ func (mb *MetricsBuilder) RecordK8sPodMemoryNodeUtilizationDataPoint(ts pcommon.
 	mb.metricK8sPodMemoryNodeUtilization.recordDataPoint(mb.startTime, ts, val)
 }
 
+// RecordK8sPodMemoryOomKillsDataPoint adds a data point to k8s.pod.memory.oom_kills metric.
+func (mb *MetricsBuilder) RecordK8sPodMemoryOomKillsDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricK8sPodMemoryOomKills.recordDataPoint(mb.startTime, ts, val)
+}
+
 // RecordK8sPodMemoryPageFaultsDataPoint adds a data point to k8s.pod.memory.page_faults metric.
 func (mb *MetricsBuilder) RecordK8sPodMemoryPageFaultsDataPoint(ts pcommon.Timestamp, val int64) {
 	mb.metricK8sPodMemoryPageFaults.recordDataPoint(mb.startTime, ts, val)
 }
 
+// RecordK8sPodMemoryPressureStallTimeDataPoint adds a data point to k8s.pod.memory.pressure.stall_time metric.
+func (mb *MetricsBuilder) RecordK8sPodMemoryPressureStallTimeDataPoint(ts pcommon.Timestamp, val float64, stallTypeAttributeValue AttributeStallType) {
+	mb.metricK8sPodMemoryPressureStallTime.recordDataPoint(mb.startTime, ts, val, stallTypeAttributeValue.String())
+}
+
 // RecordK8sPodMemoryRssDataPoint adds a data point to k8s.pod.memory.rss metric.
 func (mb *MetricsBuilder) RecordK8sPodMemoryRssDataPoint(ts pcommon.Timestamp, val int64) {
 	mb.metricK8sPodMemoryRss.recordDataPoint(mb.startTime, ts, val)
@@ -11,6 +11,8 @@ type RecordIntDataPointFunc func(*MetricsBuilder, pcommon.Timestamp, int64)
 
 type RecordIntDataPointWithDirectionFunc func(*MetricsBuilder, pcommon.Timestamp, int64, string, AttributeDirection)
 
+type RecordDoubleDataPointWithStallTypeFunc func(*MetricsBuilder, pcommon.Timestamp, float64, AttributeStallType)
+
 type MetricsBuilders struct {
 	NodeMetricsBuilder      *MetricsBuilder
 	PodMetricsBuilder       *MetricsBuilder
@@ -149,6 +151,27 @@ var K8sVolumeMetrics = VolumeMetrics{
 	InodesUsed: (*MetricsBuilder).RecordK8sVolumeInodesUsedDataPoint,
 }
 
+type CgroupMetrics struct {
+	CPUPressure    RecordDoubleDataPointWithStallTypeFunc
+	MemoryPressure RecordDoubleDataPointWithStallTypeFunc
+	IOPressure     RecordDoubleDataPointWithStallTypeFunc
+	OOMKills       RecordIntDataPointFunc
+}
+
+var PodCgroupMetrics = CgroupMetrics{
+	CPUPressure:    (*MetricsBuilder).RecordK8sPodCPUPressureStallTimeDataPoint,
+	MemoryPressure: (*MetricsBuilder).RecordK8sPodMemoryPressureStallTimeDataPoint,
+	IOPressure:     (*MetricsBuilder).RecordK8sPodIoPressureStallTimeDataPoint,
+	OOMKills:       (*MetricsBuilder).RecordK8sPodMemoryOomKillsDataPoint,
+}
+
+var ContainerCgroupMetrics = CgroupMetrics{
+	CPUPressure:    (*MetricsBuilder).RecordContainerCPUPressureStallTimeDataPoint,
+	MemoryPressure: (*MetricsBuilder).RecordContainerMemoryPressureStallTimeDataPoint,
+	IOPressure:     (*MetricsBuilder).RecordContainerIoPressureStallTimeDataPoint,
+	OOMKills:       (*MetricsBuilder).RecordContainerMemoryOomKillsDataPoint,
+}
+
 type UptimeMetrics struct {
 	Uptime RecordIntDataPointFunc
 }
@@ -31,11 +31,13 @@ type scraperOptions struct {
 	metricGroupsToCollect map[kubelet.MetricGroup]bool
 	allNetworkInterfaces  map[kubelet.MetricGroup]bool
 	k8sAPIClient          kubernetes.Interface
+	cgroupRootPath        string
 }
 
 type kubeletScraper struct {
 	statsProvider         *kubelet.StatsProvider
 	metadataProvider      *kubelet.MetadataProvider
+	cgroupProvider        *kubelet.CgroupProvider
 	logger                *zap.Logger
 	extraMetadataLabels   []kubelet.MetadataLabel
 	metricGroupsToCollect map[kubelet.MetricGroup]bool
@@ -86,6 +88,10 @@ func newKubeletScraper(
 		nodeInfo: &kubelet.NodeInfo{},
 	}
 
+	if rOptions.cgroupRootPath != "" {
+		ks.cgroupProvider = kubelet.NewCgroupProvider(rOptions.cgroupRootPath)
+	}
+
 	if metricsConfig.Metrics.K8sContainerCPUNodeUtilization.Enabled ||
 		metricsConfig.Metrics.K8sPodCPUNodeUtilization.Enabled ||
 		metricsConfig.Metrics.K8sContainerMemoryNodeUtilization.Enabled ||
@@ -108,8 +114,9 @@ func (r *kubeletScraper) scrape(context.Context) (pmetric.Metrics, error) {
 	}
 
 	var podsMetadata *v1.PodList
-	// fetch metadata only when extra metadata labels are needed
-	if len(r.extraMetadataLabels) > 0 || r.needsResources {
+	// fetch metadata only when extra metadata labels are needed, or to map
+	// container cgroups, which are named after the container ID, to containers
+	if len(r.extraMetadataLabels) > 0 || r.needsResources || r.cgroupProvider != nil {
 		podsMetadata, err = r.metadataProvider.Pods()
 		if err != nil {
 			r.logger.Error("call to /pods endpoint failed", zap.Error(err))
@@ -123,6 +130,12 @@ func (r *kubeletScraper) scrape(context.Context) (pmetric.Metrics, error) {
 	}
 
 	metaD := kubelet.NewMetadata(r.extraMetadataLabels, podsMetadata, nodeInfo, r.detailedPVCLabelsSetter())
+	if r.cgroupProvider != nil {
+		metaD.CgroupStats, err = r.cgroupProvider.CgroupStats()
+		if err != nil {
+			r.logger.Warn("failed to read pod and container cgroup stats", zap.Error(err))
+		}
+	}
 
 	mds := kubelet.MetricsData(r.logger, summary, metaD, r.metricGroupsToCollect, r.allNetworkInterfaces, r.mbs)
 	md := pmetric.NewMetrics()
@@ -55,6 +55,11 @@ type metricGroup struct {
 	complexValue   []*dataPoint
 	exemplars      pmetric.ExemplarSlice
 	isNHCB         bool // true if this is a Native Histogram Custom Buckets (schema -53)
+	// counterReset is true if the native histogram carried a CounterReset hint.
+	counterReset bool
+	// skipNHCB is true if the group holds classic buckets and an NHCB converted
+	// from them was dropped. Exemplars added afterwards belong to the dropped NHCB.
+	skipNHCB bool
 }
 
 func newMetricFamily(metricName string, mc scrape.MetricMetadataStore, logger *zap.Logger, isNativeHistogram, isNHCB bool) *metricFamily {
@@ -180,8 +185,8 @@ func (mg *metricGroup) toDistributionPoint(dest pmetric.HistogramDataPointSlice)
 
 	// The timestamp MUST be in retrieved from milliseconds and converted to nanoseconds.
 	tsNanos := timestampFromMs(mg.ts)
-	if mg.createdSeconds != 0 {
-		point.SetStartTimestamp(timestampFromFloat64(mg.createdSeconds))
+	if startTs := mg.histogramStartTimestamp(tsNanos); startTs != 0 {
+		point.SetStartTimestamp(startTs)
 	}
 	point.SetTimestamp(tsNanos)
 	populateAttributes(pmetric.MetricTypeHistogram, mg.ls, point.Attributes())
@@ -255,14 +260,29 @@ func (mg *metricGroup) toExponentialHistogramDataPoints(dest pmetric.Exponential
 	}
 
 	tsNanos := timestampFromMs(mg.ts)
-	if mg.createdSeconds != 0 {
-		point.SetStartTimestamp(timestampFromFloat64(mg.createdSeconds))
+	if startTs := mg.histogramStartTimestamp(tsNanos); startTs != 0 {
+		point.SetStartTimestamp(startTs)
 	}
 	point.SetTimestamp(tsNanos)
 	populateAttributes(pmetric.MetricTypeHistogram, mg.ls, point.Attributes())
 	mg.setExemplars(point.Exemplars())
 }
 
+// histogramStartTimestamp returns the start timestamp of a histogram point, or
+// 0 if it is unknown. The created timestamp takes precedence. Otherwise a
+// CounterReset hint is mapped to a start timestamp equal to the point
+// timestamp, which is how OTLP signals a reset with an unknown start time:
+// https://opentelemetry.io/docs/specs/otel/metrics/data-model/#cumulative-streams-handling-unknown-start-time
+func (mg *metricGroup) histogramStartTimestamp(tsNanos pcommon.Timestamp) pcommon.Timestamp {
+	if mg.createdSeconds != 0 {
+		return timestampFromFloat64(mg.createdSeconds)
+	}
+	if mg.counterReset {
+		return tsNanos
+	}
+	return 0
+}
+
 func convertDeltaBuckets(spans []histogram.Span, deltas []int64, buckets pcommon.UInt64Slice) {
 	buckets.EnsureCapacity(len(deltas))
 	bucketIdx := 0
@@ -330,14 +350,16 @@ func convertNHCBAbsoluteBuckets(histogram *histogram.FloatHistogram) []uint64 {
 		return bucketCounts
 	}
 	bucketIdx := 0
+	countIdx := 0
 	for _, span := range histogram.PositiveSpans {
 		bucketIdx += int(span.Offset)
 
-		for i := uint32(0); i < span.Length && bucketIdx < len(bucketCounts) && i < uint32(len(histogram.PositiveBuckets)); i++ {
+		for i := uint32(0); i < span.Length && bucketIdx < len(bucketCounts) && countIdx < len(histogram.PositiveBuckets); i++ {
 			if bucketIdx >= 0 && bucketIdx < len(bucketCounts) {
 				// This intentionally truncates the float value to an integer (e.g. 5.7 becomes 5).
-				bucketCounts[bucketIdx] = uint64(histogram.PositiveBuckets[i])
+				bucketCounts[bucketIdx] = uint64(histogram.PositiveBuckets[countIdx])
 			}
+			countIdx++
 			bucketIdx++
 		}
 	}
@@ -526,6 +548,7 @@ func (mf *metricFamily) addExponentialHistogramSeries(seriesRef uint64, metricNa
 		mg.hasCount = true
 		mg.hasSum = true
 		mg.fhValue = fh
+		mg.counterReset = fh.CounterResetHint == histogram.CounterReset
 	case h != nil:
 		if mg.fhValue != nil {
 			return fmt.Errorf("exponential histogram %v already has integer counts", metricName)
@@ -535,6 +558,7 @@ func (mf *metricFamily) addExponentialHistogramSeries(seriesRef uint64, metricNa
 		mg.hasCount = true
 		mg.hasSum = true
 		mg.hValue = h
+		mg.counterReset = h.CounterResetHint == histogram.CounterReset
 	}
 	return nil
 }
@@ -548,6 +572,13 @@ func (mf *metricFamily) addNHCBSeries(seriesRef uint64, metricName string, ls la
 	if mg.mtype != pmetric.MetricTypeHistogram {
 		return fmt.Errorf("metric type mismatch for NHCB metric %v type %s", metricName, mg.mtype.String())
 	}
+	if len(mg.complexValue) > 0 {
+		// The classic buckets of the series were scraped as well, e.g. when both
+		// always_scrape_classic_histograms and convert_classic_histograms_to_nhcb
+		// are enabled. The NHCB is converted from them, so it would be a duplicate.
+		mg.skipNHCB = true
+		return nil
+	}
 	mg.isNHCB = true
 
 	switch {
@@ -557,12 +588,14 @@ func (mf *metricFamily) addNHCBSeries(seriesRef uint64, metricName string, ls la
 		mg.hasCount = true
 		mg.sum = h.Sum
 		mg.hasSum = true
+		mg.counterReset = h.CounterResetHint == histogram.CounterReset
 	case fh != nil:
 		mg.fhValue = fh
 		mg.count = fh.Count
 		mg.hasCount = true
 		mg.sum = fh.Sum
 		mg.hasSum = true
+		mg.counterReset = fh.CounterResetHint == histogram.CounterReset
 	default:
 		return fmt.Errorf("NHCB metric %v has no histogram data", metricName)
 	}
@@ -639,7 +672,7 @@ func (mf *metricFamily) appendMetric(metrics pmetric.MetricSlice, trimSuffixes b
 
 func (mf *metricFamily) addExemplar(seriesRef uint64, e exemplar.Exemplar) {
 	mg := mf.groups[seriesRef]
-	if mg == nil {
+	if mg == nil || mg.skipNHCB {
 		return
 	}
 	es := mg.exemplars
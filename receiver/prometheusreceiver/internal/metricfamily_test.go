@@ -376,6 +376,56 @@ func TestMetricGroupData_toNHCBDistributionUnitTest(t *testing.T) {
 				return point
 			},
 		},
+		{
+			name:                "float NHCB with multiple spans",
+			metricName:          "histogram",
+			intervalStartTimeMs: 12,
+			labels:              labels.FromMap(map[string]string{"a": "A"}),
+			floatHistogram: &histogram.FloatHistogram{
+				Schema:          -53,
+				Count:           15.0,
+				Sum:             42.0,
+				CustomValues:    []float64{1.0, 2.0, 5.0, 10.0},
+				PositiveBuckets: []float64{3.0, 4.0, 6.0, 2.0},
+				PositiveSpans:   []histogram.Span{{Offset: 0, Length: 2}, {Offset: 1, Length: 2}},
+			},
+			want: func() pmetric.HistogramDataPoint {
+				point := pmetric.NewHistogramDataPoint()
+				point.SetCount(15)
+				point.SetSum(42.0)
+				point.SetTimestamp(pcommon.Timestamp(12 * time.Millisecond))
+				point.ExplicitBounds().FromRaw([]float64{1.0, 2.0, 5.0, 10.0})
+				point.BucketCounts().FromRaw([]uint64{3, 4, 0, 6, 2})
+				point.Attributes().PutStr("a", "A")
+				return point
+			},
+		},
+		{
+			name:                "integer NHCB with counter reset hint",
+			metricName:          "histogram",
+			intervalStartTimeMs: 11,
+			labels:              labels.FromMap(map[string]string{"a": "A"}),
+			integerHistogram: &histogram.Histogram{
+				CounterResetHint: histogram.CounterReset,
+				Schema:           -53,
+				Count:            3,
+				Sum:              2.5,
+				CustomValues:     []float64{1.0},
+				PositiveSpans:    []histogram.Span{{Offset: 0, Length: 2}},
+				PositiveBuckets:  []int64{2, -1},
+			},
+			want: func() pmetric.HistogramDataPoint {
+				point := pmetric.NewHistogramDataPoint()
+				point.SetCount(3)
+				point.SetSum(2.5)
+				point.SetTimestamp(pcommon.Timestamp(11 * time.Millisecond))
+				point.SetStartTimestamp(pcommon.Timestamp(11 * time.Millisecond)) // the reset hint maps to an unknown start time.
+				point.ExplicitBounds().FromRaw([]float64{1.0})
+				point.BucketCounts().FromRaw([]uint64{2, 1})
+				point.Attributes().PutStr("a", "A")
+				return point
+			},
+		},
 		{
 			name:                "integer NHCB with negative boundaries",
 			metricName:          "histogram",
@@ -436,7 +486,7 @@ func TestMetricGroupData_toExponentialDistributionUnitTest(t *testing.T) {
 		// Only one kind of value should be set.
 		value            float64
 		integerHistogram *histogram.Histogram
-		floatHistogram   *histogram.FloatHistogram // TODO: add tests for float histograms.
+		floatHistogram   *histogram.FloatHistogram
 	}
 	tests := []struct {
 		name                string
@@ -534,6 +584,167 @@ func TestMetricGroupData_toExponentialDistributionUnitTest(t *testing.T) {
 				return point
 			},
 		},
+		{
+			name:                "integer histogram with counter reset hint",
+			metricName:          "request_duration_seconds",
+			intervalStartTimeMs: 11,
+			labels:              labels.FromMap(map[string]string{"a": "A"}),
+			scrapes: []*scrape{
+				{
+					at:     11,
+					metric: "request_duration_seconds",
+					integerHistogram: &histogram.Histogram{
+						CounterResetHint: histogram.CounterReset,
+						Schema:           1,
+						Count:            3,
+						Sum:              4.5,
+						PositiveSpans:    []histogram.Span{{Offset: 1, Length: 2}},
+						PositiveBuckets:  []int64{1, 1},
+					},
+				},
+			},
+			want: func() pmetric.ExponentialHistogramDataPoint {
+				point := pmetric.NewExponentialHistogramDataPoint()
+				point.SetCount(3)
+				point.SetSum(4.5)
+				point.SetTimestamp(pcommon.Timestamp(11 * time.Millisecond))
+				point.SetStartTimestamp(pcommon.Timestamp(11 * time.Millisecond)) // the reset hint maps to an unknown start time.
+				point.SetScale(1)
+				point.Positive().SetOffset(0)
+				point.Positive().BucketCounts().FromRaw([]uint64{1, 2})
+				point.Attributes().PutStr("a", "A")
+				return point
+			},
+		},
+		{
+			name:                "integer histogram with counter reset hint and startTimestamp from _created",
+			metricName:          "request_duration_seconds",
+			intervalStartTimeMs: 11,
+			labels:              labels.FromMap(map[string]string{"a": "A"}),
+			scrapes: []*scrape{
+				{
+					at:     11,
+					metric: "request_duration_seconds",
+					integerHistogram: &histogram.Histogram{
+						CounterResetHint: histogram.CounterReset,
+						Schema:           1,
+						Count:            3,
+						Sum:              4.5,
+						PositiveSpans:    []histogram.Span{{Offset: 1, Length: 2}},
+						PositiveBuckets:  []int64{1, 1},
+					},
+				},
+				{
+					at:     11,
+					metric: "request_duration_seconds_created",
+					value:  600.78,
+				},
+			},
+			want: func() pmetric.ExponentialHistogramDataPoint {
+				point := pmetric.NewExponentialHistogramDataPoint()
+				point.SetCount(3)
+				point.SetSum(4.5)
+				point.SetTimestamp(pcommon.Timestamp(11 * time.Millisecond))
+				point.SetStartTimestamp(timestampFromFloat64(600.78)) // _created takes precedence over the reset hint.
+				point.SetScale(1)
+				point.Positive().SetOffset(0)
+				point.Positive().BucketCounts().FromRaw([]uint64{1, 2})
+				point.Attributes().PutStr("a", "A")
+				return point
+			},
+		},
+		{
+			name:                "integer histogram with only a zero bucket",
+			metricName:          "request_duration_seconds",
+			intervalStartTimeMs: 11,
+			labels:              labels.FromMap(map[string]string{"a": "A"}),
+			scrapes: []*scrape{
+				{
+					at:     11,
+					metric: "request_duration_seconds",
+					integerHistogram: &histogram.Histogram{
+						Schema:        0,
+						ZeroThreshold: 0.5,
+						ZeroCount:     5,
+						Count:         5,
+						Sum:           0.25,
+					},
+				},
+			},
+			want: func() pmetric.ExponentialHistogramDataPoint {
+				point := pmetric.NewExponentialHistogramDataPoint()
+				point.SetCount(5)
+				point.SetSum(0.25)
+				point.SetTimestamp(pcommon.Timestamp(11 * time.Millisecond))
+				point.SetZeroThreshold(0.5)
+				point.SetZeroCount(5)
+				point.Attributes().PutStr("a", "A")
+				return point
+			},
+		},
+		{
+			name:                "integer histogram with negative schema",
+			metricName:          "request_duration_seconds",
+			intervalStartTimeMs: 11,
+			labels:              labels.FromMap(map[string]string{"a": "A"}),
+			scrapes: []*scrape{
+				{
+					at:     11,
+					metric: "request_duration_seconds",
+					integerHistogram: &histogram.Histogram{
+						Schema:          -4,
+						Count:           5,
+						Sum:             1000,
+						PositiveSpans:   []histogram.Span{{Offset: -1, Length: 2}},
+						PositiveBuckets: []int64{2, 1}, // Delta encoded counts: 2, 3
+					},
+				},
+			},
+			want: func() pmetric.ExponentialHistogramDataPoint {
+				point := pmetric.NewExponentialHistogramDataPoint()
+				point.SetCount(5)
+				point.SetSum(1000)
+				point.SetTimestamp(pcommon.Timestamp(11 * time.Millisecond))
+				point.SetScale(-4)
+				point.Positive().SetOffset(-2)
+				point.Positive().BucketCounts().FromRaw([]uint64{2, 3})
+				point.Attributes().PutStr("a", "A")
+				return point
+			},
+		},
+		{
+			name:                "float histogram",
+			metricName:          "request_duration_seconds",
+			intervalStartTimeMs: 11,
+			labels:              labels.FromMap(map[string]string{"a": "A"}),
+			scrapes: []*scrape{
+				{
+					at:     11,
+					metric: "request_duration_seconds",
+					floatHistogram: &histogram.FloatHistogram{
+						Schema:          0,
+						ZeroThreshold:   0.001,
+						ZeroCount:       2,
+						Count:           10,
+						Sum:             12.5,
+						PositiveSpans:   []histogram.Span{{Offset: 0, Length: 2}, {Offset: 1, Length: 1}},
+						PositiveBuckets: []float64{3, 4, 1}, // Absolute counts.
+					},
+				},
+			},
+			want: func() pmetric.ExponentialHistogramDataPoint {
+				point := pmetric.NewExponentialHistogramDataPoint()
+				point.SetCount(10)
+				point.SetSum(12.5)
+				point.SetTimestamp(pcommon.Timestamp(11 * time.Millisecond))
+				point.SetZeroThreshold(0.001)
+				point.SetZeroCount(2)
+				point.Positive().SetOffset(-1)
+				point.Positive().BucketCounts().FromRaw([]uint64{3, 4, 0, 1})
+				point.Attributes().PutStr("a", "A")
+				return point
+			},
+		},
 		{
 			name:                "integer histogram that is stale",
 			metricName:          "request_duration_seconds",
@@ -229,7 +229,8 @@ func (t *transaction) getOrCreateMetricFamily(key resourceKey, scope scopeID, mn
 		t.families[key][scope] = make(map[metricFamilyKey]*metricFamily)
 	}
 
-	mfKey := metricFamilyKey{isExponentialHistogram: t.addingNativeHistogram, name: mn}
+	// NHCBs are converted to classic OTLP histograms, so they share the family of the classic histogram.
+	mfKey := metricFamilyKey{isExponentialHistogram: t.addingNativeHistogram && !t.addingNHCB, name: mn}
 
 	curMf, ok := t.families[key][scope][mfKey]
 
@@ -329,14 +330,15 @@ func (t *transaction) AppendHistogram(_ storage.SeriesRef, ls labels.Labels, atM
 	// The `up`, `target_info`, `otel_scope_info` metrics should never generate native histograms,
 	// thus we don't check for them here as opposed to the Append function.
 
-	curMF := t.getOrCreateMetricFamily(*rKey, getScopeID(ls), metricName)
-	seriesRef := t.getSeriesRef(ls, curMF.mtype)
-	cacheRef := ls.Hash()
-
 	if h != nil && h.CounterResetHint == histogram.GaugeType || fh != nil && fh.CounterResetHint == histogram.GaugeType {
 		t.logger.Warn("dropping unsupported gauge histogram datapoint", zap.String("metric_name", metricName), zap.Any("labels", ls))
+		return 0, nil
 	}
 
+	curMF := t.getOrCreateMetricFamily(*rKey, getScopeID(ls), metricName)
+	seriesRef := t.getSeriesRef(ls, curMF.mtype)
+	cacheRef := ls.Hash()
+
 	if schema == -53 {
 		err = curMF.addNHCBSeries(seriesRef, metricName, ls, atMs, h, fh)
 	} else {
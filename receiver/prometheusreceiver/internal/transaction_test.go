@@ -1808,6 +1808,19 @@ func TestMetricBuilderNativeHistogram(t *testing.T) {
 		ZeroCount:     0,
 	}
 	h0 := tsdbutil.GenerateTestHistogram(0)
+	nhcb := &histogram.Histogram{
+		Schema:          -53,
+		Count:           10,
+		Sum:             99,
+		CustomValues:    []float64{10, 20},
+		PositiveSpans:   []histogram.Span{{Offset: 0, Length: 3}},
+		PositiveBuckets: []int64{1, 0, 7}, // Delta encoded counts: 1, 1, 8
+	}
+	ex := exemplar.Exemplar{
+		Value:  1,
+		Ts:     1663113420863,
+		Labels: labels.FromStrings("foo", "bar"),
+	}
 
 	tests := []buildTestData{
 		{
@@ -1876,6 +1889,94 @@ func TestMetricBuilderNativeHistogram(t *testing.T) {
 				pt0.Negative().BucketCounts().Append(1)
 				pt0.Negative().BucketCounts().Append(1)
 
+				return []pmetric.Metrics{md0}
+			},
+		},
+		{
+			name: "gauge histogram is dropped",
+			inputs: []*testScrapedPage{
+				{
+					pts: []*testDataPoint{
+						createHistogramDataPoint("hist_test", &histogram.Histogram{
+							CounterResetHint: histogram.GaugeType,
+							Schema:           1,
+							Count:            1,
+							Sum:              1,
+							ZeroCount:        1,
+						}, nil, nil, "foo", "bar"),
+					},
+				},
+			},
+			wants: func() []pmetric.Metrics {
+				return []pmetric.Metrics{pmetric.NewMetrics()}
+			},
+		},
+		{
+			name: "NHCB",
+			inputs: []*testScrapedPage{
+				{
+					pts: []*testDataPoint{
+						createHistogramDataPoint("hist_test", nhcb, nil, nil, "foo", "bar"),
+					},
+				},
+			},
+			wants: func() []pmetric.Metrics {
+				md0 := pmetric.NewMetrics()
+				mL0 := md0.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics()
+				m0 := mL0.AppendEmpty()
+				m0.SetName("hist_test")
+				m0.Metadata().PutStr("prometheus.type", "histogram")
+				hist0 := m0.SetEmptyHistogram()
+				hist0.SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+				pt0 := hist0.DataPoints().AppendEmpty()
+				pt0.SetCount(10)
+				pt0.SetSum(99)
+				pt0.ExplicitBounds().FromRaw([]float64{10, 20})
+				pt0.BucketCounts().FromRaw([]uint64{1, 1, 8})
+				pt0.SetTimestamp(tsNanos)
+				pt0.Attributes().PutStr("foo", "bar")
+
+				return []pmetric.Metrics{md0}
+			},
+		},
+		{
+			// This is what the scrape loop appends when both always_scrape_classic_histograms
+			// and convert_classic_histograms_to_nhcb are enabled.
+			name: "classic histogram and NHCB converted from it",
+			inputs: []*testScrapedPage{
+				{
+					pts: []*testDataPoint{
+						createDataPoint("hist_test_bucket", 1, []exemplar.Exemplar{ex}, "foo", "bar", "le", "10"),
+						createDataPoint("hist_test_bucket", 2, nil, "foo", "bar", "le", "20"),
+						createDataPoint("hist_test_bucket", 10, nil, "foo", "bar", "le", "+inf"),
+						createDataPoint("hist_test_sum", 99, nil, "foo", "bar"),
+						createDataPoint("hist_test_count", 10, nil, "foo", "bar"),
+						createHistogramDataPoint("hist_test", nhcb, nil, []exemplar.Exemplar{ex}, "foo", "bar"),
+					},
+				},
+			},
+			wants: func() []pmetric.Metrics {
+				md0 := pmetric.NewMetrics()
+				mL0 := md0.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics()
+				m0 := mL0.AppendEmpty()
+				m0.SetName("hist_test")
+				m0.Metadata().PutStr("prometheus.type", "histogram")
+				hist0 := m0.SetEmptyHistogram()
+				hist0.SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+				pt0 := hist0.DataPoints().AppendEmpty()
+				pt0.SetCount(10)
+				pt0.SetSum(99)
+				pt0.ExplicitBounds().FromRaw([]float64{10, 20})
+				pt0.BucketCounts().FromRaw([]uint64{1, 1, 8})
+				pt0.SetTimestamp(tsNanos)
+				pt0.Attributes().PutStr("foo", "bar")
+
+				// The exemplar is only kept once.
+				e0 := pt0.Exemplars().AppendEmpty()
+				e0.SetTimestamp(timestampFromMs(1663113420863))
+				e0.SetDoubleValue(1)
+				e0.FilteredAttributes().PutStr("foo", "bar")
+
 				return []pmetric.Metrics{md0}
 			},
 		},
@@ -430,6 +430,90 @@ func TestNativeVsClassicHistogramScrapeViaProtobuf(t *testing.T) {
 				},
 			},
 		},
+		// Classic only histograms are converted to NHCB and emitted as explicit bucket histograms.
+		"scrape native on, convert classic to NHCB": {
+			mutCfg: func(cfg *PromConfig) {
+				truePtr := true
+				for _, sc := range cfg.ScrapeConfigs {
+					sc.ScrapeNativeHistograms = &truePtr
+					sc.ConvertClassicHistogramsToNHCB = &truePtr
+				}
+			},
+			expected: []metricExpectation{
+				{
+					"test_classic_histogram",
+					pmetric.MetricTypeHistogram,
+					"",
+					[]dataPointExpectation{{
+						histogramPointComparator: []histogramPointComparator{
+							compareHistogram(1213, 456, []float64{0.5, 10}, []uint64{789, 222, 202}),
+						},
+					}},
+					nil,
+				},
+				{ // Only scrape native buckets from mixed histograms.
+					"test_mixed_histogram",
+					pmetric.MetricTypeExponentialHistogram,
+					"",
+					[]dataPointExpectation{{
+						exponentialHistogramComparator: []exponentialHistogramComparator{
+							compareExponentialHistogram(3, 1213, 456, 2, -1, []uint64{1, 0, 2}, -3, []uint64{1, 0, 1}),
+							checkMixedHistogramNativeExemplars,
+						},
+					}},
+					nil,
+				},
+				{
+					"test_native_histogram",
+					pmetric.MetricTypeExponentialHistogram,
+					"",
+					[]dataPointExpectation{{
+						exponentialHistogramComparator: []exponentialHistogramComparator{
+							compareExponentialHistogram(3, 1214, 3456, 5, -3, []uint64{1, 0, 2}, 2, []uint64{1, 0, 0, 1}),
+							checkNativeHistogramExemplars,
+						},
+					}},
+					nil,
+				},
+			},
+			expectedIgnoreMetadata: []metricExpectation{
+				{ // NHCB are histograms regardless of the metadata.
+					"test_classic_histogram",
+					pmetric.MetricTypeHistogram,
+					"",
+					[]dataPointExpectation{{
+						histogramPointComparator: []histogramPointComparator{
+							compareHistogram(1213, 456, []float64{0.5, 10}, []uint64{789, 222, 202}),
+						},
+					}},
+					nil,
+				},
+				{
+					"test_mixed_histogram",
+					pmetric.MetricTypeExponentialHistogram,
+					"",
+					[]dataPointExpectation{{
+						exponentialHistogramComparator: []exponentialHistogramComparator{
+							compareExponentialHistogram(3, 1213, 456, 2, -1, []uint64{1, 0, 2}, -3, []uint64{1, 0, 1}),
+							checkMixedHistogramNativeExemplars,
+						},
+					}},
+					nil,
+				},
+				{
+					"test_native_histogram",
+					pmetric.MetricTypeExponentialHistogram,
+					"",
+					[]dataPointExpectation{{
+						exponentialHistogramComparator: []exponentialHistogramComparator{
+							compareExponentialHistogram(3, 1214, 3456, 5, -3, []uint64{1, 0, 2}, 2, []uint64{1, 0, 0, 1}),
+							checkNativeHistogramExemplars,
+						},
+					}},
+					nil,
+				},
+			},
+		},
 		// Scrape both classic and native histograms when AlwaysScrapeClassicHistograms is enabled.
 		"scrape native on, scrape classic on": {
 			mutCfg: func(cfg *PromConfig) {
@@ -137,7 +137,7 @@ func TestLoadConfig(t *testing.T) {
 		{
 			fname:        "config-invalid-missing-logs-metrics.yaml",
 			id:           component.NewIDWithName(metadata.Type, ""),
-			errorMessage: "at least one of 'query.logs' and 'query.metrics' must not be empty",
+			errorMessage: "at least one of 'query.logs', 'query.metrics' and 'query.traces' must not be empty",
 		},
 		{
 			fname:        "config-invalid-missing-datasource.yaml",
@@ -278,6 +278,51 @@ func TestLoadConfig(t *testing.T) {
 			id:           component.NewIDWithName(metadata.Type, ""),
 			errorMessage: "'body_column' must not be empty",
 		},
+		{
+			fname: "config-traces.yaml",
+			id:    component.NewIDWithName(metadata.Type, ""),
+			expected: &Config{
+				Config: sqlquery.Config{
+					ControllerConfig: scraperhelper.ControllerConfig{
+						CollectionInterval: 10 * time.Second,
+						InitialDelay:       time.Second,
+					},
+					Driver:     "postgres",
+					DataSource: "host=localhost port=5432 user=me password=s3cr3t sslmode=disable",
+					Queries: []sqlquery.Query{
+						{
+							SQL:                "select * from job_runs where finished_at > ?",
+							TrackingColumn:     "finished_at",
+							TrackingStartValue: "2025-01-01T00:00:00Z",
+							Traces: []sqlquery.TracesCfg{
+								{
+									TraceIDColumn:       "trace_id",
+									SpanIDColumn:        "run_id",
+									ParentSpanIDColumn:  "parent_run_id",
+									SpanNameColumn:      "job_name",
+									SpanKind:            sqlquery.SpanKindServer,
+									StartTsColumn:       "started_at",
+									EndTsColumn:         "finished_at",
+									StatusCodeColumn:    "status",
+									StatusMessageColumn: "status_message",
+									AttributeColumns:    []string{"job_owner"},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			fname:        "config-traces-missing-span-id-column.yaml",
+			id:           component.NewIDWithName(metadata.Type, ""),
+			errorMessage: "'span_id_column' must not be empty",
+		},
+		{
+			fname:        "config-traces-invalid-span-kind.yaml",
+			id:           component.NewIDWithName(metadata.Type, ""),
+			errorMessage: "traces config has unsupported span_kind: 'xyzserver'",
+		},
 		{
 			fname:        "config-unnecessary-aggregation.yaml",
 			id:           component.NewIDWithName(metadata.Type, ""),
@@ -14,4 +14,5 @@ var (
 const (
 	LogsStability    = component.StabilityLevelDevelopment
 	MetricsStability = component.StabilityLevelAlpha
+	TracesStability  = component.StabilityLevelDevelopment
 )
@@ -5,7 +5,6 @@ package sqlqueryreceiver // import "github.com/open-telemetry/opentelemetry-coll
 
 import (
 	"context"
-	"database/sql"
 	"errors"
 	"fmt"
 	"time"
@@ -55,22 +54,15 @@ func newLogsReceiver(
 		return nil, err
 	}
 
-	var dataSource string
-	if config.DataSource != "" {
-		dataSource = config.DataSource
-	} else {
-		dataSource, err = sqlquery.BuildDataSourceString(config.Config)
-		if err != nil {
-			return nil, err
-		}
+	createConnection, err := newDbProvider(config, sqlOpenerFunc)
+	if err != nil {
+		return nil, err
 	}
 
 	receiver := &logsReceiver{
-		config:   config,
-		settings: settings,
-		createConnection: func() (*sql.DB, error) {
-			return sqlOpenerFunc(config.Driver, dataSource)
-		},
+		config:            config,
+		settings:          settings,
+		createConnection:  createConnection,
 		createClient:      createClient,
 		nextConsumer:      nextConsumer,
 		shutdownRequested: make(chan struct{}),
@@ -106,7 +98,7 @@ func (receiver *logsReceiver) Start(ctx context.Context, host component.Host) er
 			return err
 		}
 	}
-	receiver.startCollecting()
+	startCollecting(receiver.config, receiver.shutdownRequested, receiver.collect)
 	receiver.settings.Logger.Debug("started.")
 	return nil
 }
@@ -132,35 +124,6 @@ func (receiver *logsReceiver) createQueryReceivers() error {
 	return nil
 }
 
-func (receiver *logsReceiver) startCollecting() {
-	initialDelay := receiver.config.InitialDelay
-
-	go func() {
-		if initialDelay > 0 {
-			timer := time.NewTimer(initialDelay)
-			select {
-			case <-timer.C:
-				receiver.collect()
-			case <-receiver.shutdownRequested:
-				timer.Stop()
-				return
-			}
-		}
-
-		collectionIntervalTicker := time.NewTicker(receiver.config.CollectionInterval)
-
-		for {
-			select {
-			case <-collectionIntervalTicker.C:
-				receiver.collect()
-			case <-receiver.shutdownRequested:
-				collectionIntervalTicker.Stop()
-				return
-			}
-		}
-	}()
-}
-
 func (receiver *logsReceiver) collect() {
 	logsChannel := make(chan plog.Logs)
 	for _, queryReceiver := range receiver.queryReceivers {
@@ -218,19 +181,7 @@ func (receiver *logsReceiver) stopCollecting() {
 }
 
 type logsQueryReceiver struct {
-	id           string
-	query        sqlquery.Query
-	createDb     sqlquery.DbProviderFunc
-	createClient sqlquery.ClientProviderFunc
-	logger       *zap.Logger
-	telemetry    sqlquery.TelemetryConfig
-
-	db            *sql.DB
-	client        sqlquery.DbClient
-	trackingValue string
-	// TODO: Extract persistence into its own component
-	storageClient           storage.Client
-	trackingValueStorageKey string
+	baseQueryReceiver
 }
 
 func newLogsQueryReceiver(
@@ -242,69 +193,18 @@ func newLogsQueryReceiver(
 	telemetry sqlquery.TelemetryConfig,
 	storageClient storage.Client,
 ) *logsQueryReceiver {
-	queryReceiver := &logsQueryReceiver{
-		id:            id,
-		query:         query,
-		createDb:      dbProviderFunc,
-		createClient:  clientProviderFunc,
-		logger:        logger,
-		telemetry:     telemetry,
-		storageClient: storageClient,
-	}
-	queryReceiver.trackingValue = queryReceiver.query.TrackingStartValue
-	queryReceiver.trackingValueStorageKey = fmt.Sprintf("%s.%s", queryReceiver.id, "trackingValue")
-	return queryReceiver
-}
-
-func (queryReceiver *logsQueryReceiver) ID() string {
-	return queryReceiver.id
-}
-
-func (queryReceiver *logsQueryReceiver) start(ctx context.Context) error {
-	var err error
-	queryReceiver.db, err = queryReceiver.createDb()
-	if err != nil {
-		return fmt.Errorf("failed to open db connection: %w", err)
+	return &logsQueryReceiver{
+		baseQueryReceiver: newBaseQueryReceiver(id, query, dbProviderFunc, clientProviderFunc, logger, telemetry, storageClient),
 	}
-	queryReceiver.client = queryReceiver.createClient(sqlquery.DbWrapper{Db: queryReceiver.db}, queryReceiver.query.SQL, queryReceiver.logger, queryReceiver.telemetry)
-
-	queryReceiver.trackingValue = queryReceiver.retrieveTrackingValue(ctx)
-
-	return nil
-}
-
-// retrieveTrackingValue retrieves the tracking value from storage, if storage is configured.
-// Otherwise, it returns the tracking value configured in `tracking_start_value`.
-func (queryReceiver *logsQueryReceiver) retrieveTrackingValue(ctx context.Context) string {
-	trackingValueFromConfig := queryReceiver.query.TrackingStartValue
-	if queryReceiver.storageClient == nil {
-		return trackingValueFromConfig
-	}
-
-	storedTrackingValueBytes, err := queryReceiver.storageClient.Get(ctx, queryReceiver.trackingValueStorageKey)
-	if err != nil || storedTrackingValueBytes == nil {
-		return trackingValueFromConfig
-	}
-
-	return string(storedTrackingValueBytes)
 }
 
 func (queryReceiver *logsQueryReceiver) collect(ctx context.Context) (plog.Logs, error) {
 	logs := plog.NewLogs()
 
-	var rows []sqlquery.StringMap
-	var err error
 	observedAt := pcommon.NewTimestampFromTime(time.Now())
-	if queryReceiver.query.TrackingColumn != "" {
-		rows, err = queryReceiver.client.QueryRows(ctx, queryReceiver.trackingValue)
-	} else {
-		rows, err = queryReceiver.client.QueryRows(ctx)
-	}
+	rows, err := queryReceiver.queryRows(ctx, "log")
 	if err != nil {
-		if !errors.Is(err, sqlquery.ErrNullValueWarning) {
-			return logs, fmt.Errorf("scraper: %w", err)
-		}
-		queryReceiver.logger.Warn("problems encountered getting log rows", zap.Error(err))
+		return logs, err
 	}
 
 	var errs []error
@@ -324,20 +224,6 @@ func (queryReceiver *logsQueryReceiver) collect(ctx context.Context) (plog.Logs,
 	return logs, errors.Join(errs...)
 }
 
-func (queryReceiver *logsQueryReceiver) storeTrackingValue(ctx context.Context, row sqlquery.StringMap) error {
-	if queryReceiver.query.TrackingColumn == "" {
-		return nil
-	}
-	queryReceiver.trackingValue = row[queryReceiver.query.TrackingColumn]
-	if queryReceiver.storageClient != nil {
-		err := queryReceiver.storageClient.Set(ctx, queryReceiver.trackingValueStorageKey, []byte(queryReceiver.trackingValue))
-		if err != nil {
-			return err
-		}
-	}
-	return nil
-}
-
 func rowToLog(row sqlquery.StringMap, config sqlquery.LogsCfg, logRecord plog.LogRecord) error {
 	var errs []error
 	value, found := row[config.BodyColumn]
@@ -357,11 +243,3 @@ func rowToLog(row sqlquery.StringMap, config sqlquery.LogsCfg, logRecord plog.Lo
 	}
 	return errors.Join(errs...)
 }
-
-func (queryReceiver *logsQueryReceiver) shutdown(context.Context) error {
-	if queryReceiver.db == nil {
-		return nil
-	}
-
-	return queryReceiver.db.Close()
-}
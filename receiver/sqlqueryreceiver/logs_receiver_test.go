@@ -29,7 +29,7 @@ func TestLogsQueryReceiver_Collect(t *testing.T) {
 			{{"col1": "42"}, {"col1": "63"}},
 		},
 	}
-	queryReceiver := logsQueryReceiver{
+	queryReceiver := logsQueryReceiver{baseQueryReceiver: baseQueryReceiver{
 		client: fakeClient,
 		query: sqlquery.Query{
 			Logs: []sqlquery.LogsCfg{
@@ -38,7 +38,7 @@ func TestLogsQueryReceiver_Collect(t *testing.T) {
 				},
 			},
 		},
-	}
+	}}
 	logs, err := queryReceiver.collect(t.Context())
 	assert.NoError(t, err)
 	assert.NotNil(t, logs)
@@ -65,7 +65,7 @@ func TestLogsQueryReceiver_MissingColumnInResultSet(t *testing.T) {
 			{{"col1": "42"}},
 		},
 	}
-	queryReceiver := logsQueryReceiver{
+	queryReceiver := logsQueryReceiver{baseQueryReceiver: baseQueryReceiver{
 		client: fakeClient,
 		query: sqlquery.Query{
 			Logs: []sqlquery.LogsCfg{
@@ -75,7 +75,7 @@ func TestLogsQueryReceiver_MissingColumnInResultSet(t *testing.T) {
 				},
 			},
 		},
-	}
+	}}
 	_, err := queryReceiver.collect(t.Context())
 	assert.ErrorContains(t, err, "rowToLog: attribute_column 'expected_column' not found in result set")
 	assert.ErrorContains(t, err, "rowToLog: attribute_column 'expected_column_2' not found in result set")
@@ -134,7 +134,7 @@ func TestLogsQueryReceiver_NullValue(t *testing.T) {
 	core, recorded := observer.New(zap.InfoLevel)
 	logger := zap.New(core)
 
-	queryReceiver := logsQueryReceiver{
+	queryReceiver := logsQueryReceiver{baseQueryReceiver: baseQueryReceiver{
 		client: fakeClient,
 		query: sqlquery.Query{
 			Logs: []sqlquery.LogsCfg{
@@ -145,7 +145,7 @@ func TestLogsQueryReceiver_NullValue(t *testing.T) {
 			},
 		},
 		logger: logger,
-	}
+	}}
 	// ensure that the logs are collected successfully
 	logs, err := queryReceiver.collect(t.Context())
 	assert.NoError(t, err)
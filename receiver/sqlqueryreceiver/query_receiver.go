@@ -0,0 +1,198 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sqlqueryreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/sqlqueryreceiver"
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/extension/xextension/storage"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/sqlquery"
+)
+
+// newDbProvider returns a function opening connections to the database configured in config.
+func newDbProvider(config *Config, sqlOpenerFunc sqlquery.SQLOpenerFunc) (sqlquery.DbProviderFunc, error) {
+	dataSource := config.DataSource
+	if dataSource == "" {
+		var err error
+		dataSource, err = sqlquery.BuildDataSourceString(config.Config)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return func() (*sql.DB, error) {
+		return sqlOpenerFunc(config.Driver, dataSource)
+	}, nil
+}
+
+// getStorageClient works as adapter.GetStorageClient, but requests a client with the given name.
+// The receivers of signals other than logs use one, so the tracking values of a query emitting
+// several signals don't collide.
+func getStorageClient(ctx context.Context, host component.Host, storageID *component.ID, componentID component.ID, name string) (storage.Client, error) {
+	if storageID == nil {
+		return storage.NewNopClient(), nil
+	}
+
+	extension, ok := host.GetExtensions()[*storageID]
+	if !ok {
+		return nil, fmt.Errorf("storage extension '%s' not found", storageID)
+	}
+
+	storageExtension, ok := extension.(storage.Extension)
+	if !ok {
+		return nil, fmt.Errorf("non-storage extension '%s' found", storageID)
+	}
+
+	return storageExtension.GetClient(ctx, component.KindReceiver, componentID, name)
+}
+
+// startCollecting calls collect after the initial delay, then at every collection interval
+// until shutdownRequested is closed.
+func startCollecting(config *Config, shutdownRequested <-chan struct{}, collect func()) {
+	initialDelay := config.InitialDelay
+
+	go func() {
+		if initialDelay > 0 {
+			timer := time.NewTimer(initialDelay)
+			select {
+			case <-timer.C:
+				collect()
+			case <-shutdownRequested:
+				timer.Stop()
+				return
+			}
+		}
+
+		collectionIntervalTicker := time.NewTicker(config.CollectionInterval)
+
+		for {
+			select {
+			case <-collectionIntervalTicker.C:
+				collect()
+			case <-shutdownRequested:
+				collectionIntervalTicker.Stop()
+				return
+			}
+		}
+	}()
+}
+
+// baseQueryReceiver runs a query and keeps track of the processed rows. It is embedded in the
+// receivers of each signal, which convert the rows.
+type baseQueryReceiver struct {
+	id           string
+	query        sqlquery.Query
+	createDb     sqlquery.DbProviderFunc
+	createClient sqlquery.ClientProviderFunc
+	logger       *zap.Logger
+	telemetry    sqlquery.TelemetryConfig
+
+	db                      *sql.DB
+	client                  sqlquery.DbClient
+	trackingValue           string
+	storageClient           storage.Client
+	trackingValueStorageKey string
+}
+
+func newBaseQueryReceiver(
+	id string,
+	query sqlquery.Query,
+	dbProviderFunc sqlquery.DbProviderFunc,
+	clientProviderFunc sqlquery.ClientProviderFunc,
+	logger *zap.Logger,
+	telemetry sqlquery.TelemetryConfig,
+	storageClient storage.Client,
+) baseQueryReceiver {
+	return baseQueryReceiver{
+		id:                      id,
+		query:                   query,
+		createDb:                dbProviderFunc,
+		createClient:            clientProviderFunc,
+		logger:                  logger,
+		telemetry:               telemetry,
+		trackingValue:           query.TrackingStartValue,
+		storageClient:           storageClient,
+		trackingValueStorageKey: fmt.Sprintf("%s.%s", id, "trackingValue"),
+	}
+}
+
+func (queryReceiver *baseQueryReceiver) ID() string {
+	return queryReceiver.id
+}
+
+func (queryReceiver *baseQueryReceiver) start(ctx context.Context) error {
+	var err error
+	queryReceiver.db, err = queryReceiver.createDb()
+	if err != nil {
+		return fmt.Errorf("failed to open db connection: %w", err)
+	}
+	queryReceiver.client = queryReceiver.createClient(sqlquery.DbWrapper{Db: queryReceiver.db}, queryReceiver.query.SQL, queryReceiver.logger, queryReceiver.telemetry)
+
+	queryReceiver.trackingValue = queryReceiver.retrieveTrackingValue(ctx)
+
+	return nil
+}
+
+// retrieveTrackingValue retrieves the tracking value from storage, if storage is configured.
+// Otherwise, it returns the tracking value configured in `tracking_start_value`.
+func (queryReceiver *baseQueryReceiver) retrieveTrackingValue(ctx context.Context) string {
+	trackingValueFromConfig := queryReceiver.query.TrackingStartValue
+	if queryReceiver.storageClient == nil {
+		return trackingValueFromConfig
+	}
+
+	storedTrackingValueBytes, err := queryReceiver.storageClient.Get(ctx, queryReceiver.trackingValueStorageKey)
+	if err != nil || storedTrackingValueBytes == nil {
+		return trackingValueFromConfig
+	}
+
+	return string(storedTrackingValueBytes)
+}
+
+// queryRows returns the rows following the tracking value, if a tracking column is configured.
+// Rows holding NULL values are returned with a warning naming the kind of rows.
+func (queryReceiver *baseQueryReceiver) queryRows(ctx context.Context, kind string) ([]sqlquery.StringMap, error) {
+	var rows []sqlquery.StringMap
+	var err error
+	if queryReceiver.query.TrackingColumn != "" {
+		rows, err = queryReceiver.client.QueryRows(ctx, queryReceiver.trackingValue)
+	} else {
+		rows, err = queryReceiver.client.QueryRows(ctx)
+	}
+	if err != nil {
+		if !errors.Is(err, sqlquery.ErrNullValueWarning) {
+			return nil, fmt.Errorf("scraper: %w", err)
+		}
+		queryReceiver.logger.Warn(fmt.Sprintf("problems encountered getting %s rows", kind), zap.Error(err))
+	}
+	return rows, nil
+}
+
+func (queryReceiver *baseQueryReceiver) storeTrackingValue(ctx context.Context, row sqlquery.StringMap) error {
+	if queryReceiver.query.TrackingColumn == "" {
+		return nil
+	}
+	queryReceiver.trackingValue = row[queryReceiver.query.TrackingColumn]
+	if queryReceiver.storageClient != nil {
+		err := queryReceiver.storageClient.Set(ctx, queryReceiver.trackingValueStorageKey, []byte(queryReceiver.trackingValue))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (queryReceiver *baseQueryReceiver) shutdown(context.Context) error {
+	if queryReceiver.db == nil {
+		return nil
+	}
+
+	return queryReceiver.db.Close()
+}
@@ -0,0 +1,57 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sqlqueryreceiver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/storage/storagetest"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/sqlqueryreceiver/internal/metadata"
+)
+
+func TestGetStorageClient(t *testing.T) {
+	storageExtension := storagetest.NewFileBackedStorageExtension("test", t.TempDir())
+	host := storagetest.NewStorageHost().WithExtension(storageExtension.ID, storageExtension)
+	id := component.NewID(metadata.Type)
+
+	tracesClient, err := getStorageClient(t.Context(), host, &storageExtension.ID, id, "traces")
+	require.NoError(t, err)
+	require.NoError(t, tracesClient.Set(t.Context(), "query-0: select.trackingValue", []byte("42")))
+	require.NoError(t, tracesClient.Close(t.Context()))
+
+	// The logs receiver uses the client without a name, which doesn't see the traces tracking values.
+	logsClient, err := getStorageClient(t.Context(), host, &storageExtension.ID, id, "")
+	require.NoError(t, err)
+	value, err := logsClient.Get(t.Context(), "query-0: select.trackingValue")
+	require.NoError(t, err)
+	assert.Nil(t, value)
+	require.NoError(t, logsClient.Close(t.Context()))
+
+	tracesClient, err = getStorageClient(t.Context(), host, &storageExtension.ID, id, "traces")
+	require.NoError(t, err)
+	value, err = tracesClient.Get(t.Context(), "query-0: select.trackingValue")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("42"), value)
+	require.NoError(t, tracesClient.Close(t.Context()))
+}
+
+func TestGetStorageClientErrors(t *testing.T) {
+	id := component.NewID(metadata.Type)
+
+	client, err := getStorageClient(t.Context(), storagetest.NewStorageHost(), nil, id, "traces")
+	require.NoError(t, err)
+	assert.NotNil(t, client)
+
+	storageID := storagetest.NewStorageID("missing")
+	_, err = getStorageClient(t.Context(), storagetest.NewStorageHost(), &storageID, id, "traces")
+	assert.ErrorContains(t, err, "storage extension 'test_storage/missing' not found")
+
+	nonStorageID := storagetest.NewNonStorageID("other")
+	_, err = getStorageClient(t.Context(), storagetest.NewStorageHost().WithNonStorageExtension("other"), &nonStorageID, id, "traces")
+	assert.ErrorContains(t, err, "non-storage extension 'non_storage/other' found")
+}
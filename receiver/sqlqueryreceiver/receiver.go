@@ -30,6 +30,18 @@ func createLogsReceiverFunc(sqlOpenerFunc sqlquery.SQLOpenerFunc, clientProvider
 	}
 }
 
+func createTracesReceiverFunc(sqlOpenerFunc sqlquery.SQLOpenerFunc, clientProviderFunc sqlquery.ClientProviderFunc) receiver.CreateTracesFunc {
+	return func(
+		_ context.Context,
+		settings receiver.Settings,
+		cfg component.Config,
+		consumer consumer.Traces,
+	) (receiver.Traces, error) {
+		sqlQueryConfig := cfg.(*Config)
+		return newTracesReceiver(sqlQueryConfig, settings, sqlOpenerFunc, clientProviderFunc, consumer)
+	}
+}
+
 func createMetricsReceiverFunc(sqlOpenerFunc sqlquery.SQLOpenerFunc, clientProviderFunc sqlquery.ClientProviderFunc) receiver.CreateMetricsFunc {
 	return func(
 		_ context.Context,
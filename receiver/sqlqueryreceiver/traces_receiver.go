@@ -0,0 +1,368 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sqlqueryreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/sqlqueryreceiver"
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/extension/xextension/storage"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.opentelemetry.io/collector/receiver"
+	"go.opentelemetry.io/collector/receiver/receiverhelper"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/sqlquery"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/sqlqueryreceiver/internal/metadata"
+)
+
+type tracesReceiver struct {
+	config           *Config
+	settings         receiver.Settings
+	createConnection sqlquery.DbProviderFunc
+	createClient     sqlquery.ClientProviderFunc
+	queryReceivers   []*tracesQueryReceiver
+	nextConsumer     consumer.Traces
+
+	isStarted         bool
+	shutdownRequested chan struct{}
+
+	id            component.ID
+	storageClient storage.Client
+	obsrecv       *receiverhelper.ObsReport
+}
+
+func newTracesReceiver(
+	config *Config,
+	settings receiver.Settings,
+	sqlOpenerFunc sqlquery.SQLOpenerFunc,
+	createClient sqlquery.ClientProviderFunc,
+	nextConsumer consumer.Traces,
+) (*tracesReceiver, error) {
+	obsr, err := receiverhelper.NewObsReport(receiverhelper.ObsReportSettings{
+		ReceiverID:             settings.ID,
+		ReceiverCreateSettings: settings,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	createConnection, err := newDbProvider(config, sqlOpenerFunc)
+	if err != nil {
+		return nil, err
+	}
+
+	receiver := &tracesReceiver{
+		config:            config,
+		settings:          settings,
+		createConnection:  createConnection,
+		createClient:      createClient,
+		nextConsumer:      nextConsumer,
+		shutdownRequested: make(chan struct{}),
+		id:                settings.ID,
+		obsrecv:           obsr,
+	}
+
+	return receiver, nil
+}
+
+func (receiver *tracesReceiver) Start(ctx context.Context, host component.Host) error {
+	if receiver.isStarted {
+		receiver.settings.Logger.Debug("requested start, but already started, ignoring.")
+		return nil
+	}
+	receiver.settings.Logger.Debug("starting...")
+	receiver.isStarted = true
+
+	var err error
+	receiver.storageClient, err = getStorageClient(ctx, host, receiver.config.StorageID, receiver.settings.ID, "traces")
+	if err != nil {
+		return fmt.Errorf("error connecting to storage: %w", err)
+	}
+
+	receiver.createQueryReceivers()
+
+	for _, queryReceiver := range receiver.queryReceivers {
+		err := queryReceiver.start(ctx)
+		if err != nil {
+			return err
+		}
+	}
+	startCollecting(receiver.config, receiver.shutdownRequested, receiver.collect)
+	receiver.settings.Logger.Debug("started.")
+	return nil
+}
+
+func (receiver *tracesReceiver) createQueryReceivers() {
+	receiver.queryReceivers = nil
+	for i, query := range receiver.config.Queries {
+		if len(query.Traces) == 0 {
+			continue
+		}
+		id := fmt.Sprintf("query-%d: %s", i, query.SQL)
+		queryReceiver := newTracesQueryReceiver(
+			id,
+			query,
+			receiver.createConnection,
+			receiver.createClient,
+			receiver.settings.Logger,
+			receiver.config.Telemetry,
+			receiver.storageClient,
+		)
+		receiver.queryReceivers = append(receiver.queryReceivers, queryReceiver)
+	}
+}
+
+func (receiver *tracesReceiver) collect() {
+	tracesChannel := make(chan ptrace.Traces)
+	for _, queryReceiver := range receiver.queryReceivers {
+		go func(queryReceiver *tracesQueryReceiver) {
+			traces, err := queryReceiver.collect(context.Background())
+			if err != nil {
+				receiver.settings.Logger.Error("error collecting traces", zap.Error(err), zap.String("query", queryReceiver.ID()))
+			}
+			tracesChannel <- traces
+		}(queryReceiver)
+	}
+
+	allTraces := ptrace.NewTraces()
+	for range receiver.queryReceivers {
+		traces := <-tracesChannel
+		traces.ResourceSpans().MoveAndAppendTo(allTraces.ResourceSpans())
+	}
+
+	spanCount := allTraces.SpanCount()
+	if spanCount > 0 {
+		ctx := receiver.obsrecv.StartTracesOp(context.Background())
+		err := receiver.nextConsumer.ConsumeTraces(context.Background(), allTraces)
+		receiver.obsrecv.EndTracesOp(ctx, metadata.Type.String(), spanCount, err)
+		if err != nil {
+			receiver.settings.Logger.Error("failed to send traces", zap.Error(err))
+		}
+	}
+}
+
+func (receiver *tracesReceiver) Shutdown(ctx context.Context) error {
+	if !receiver.isStarted {
+		receiver.settings.Logger.Debug("Requested shutdown, but not started, ignoring.")
+		return nil
+	}
+
+	var errs []error
+	receiver.settings.Logger.Debug("stopping...")
+	receiver.stopCollecting()
+	for _, queryReceiver := range receiver.queryReceivers {
+		errs = append(errs, queryReceiver.shutdown(ctx))
+	}
+
+	if receiver.storageClient != nil {
+		errs = append(errs, receiver.storageClient.Close(ctx))
+	}
+
+	receiver.isStarted = false
+	receiver.settings.Logger.Debug("stopped.")
+
+	return errors.Join(errs...)
+}
+
+func (receiver *tracesReceiver) stopCollecting() {
+	close(receiver.shutdownRequested)
+}
+
+type tracesQueryReceiver struct {
+	baseQueryReceiver
+}
+
+func newTracesQueryReceiver(
+	id string,
+	query sqlquery.Query,
+	dbProviderFunc sqlquery.DbProviderFunc,
+	clientProviderFunc sqlquery.ClientProviderFunc,
+	logger *zap.Logger,
+	telemetry sqlquery.TelemetryConfig,
+	storageClient storage.Client,
+) *tracesQueryReceiver {
+	return &tracesQueryReceiver{
+		baseQueryReceiver: newBaseQueryReceiver(id, query, dbProviderFunc, clientProviderFunc, logger, telemetry, storageClient),
+	}
+}
+
+func (queryReceiver *tracesQueryReceiver) collect(ctx context.Context) (ptrace.Traces, error) {
+	traces := ptrace.NewTraces()
+
+	rows, err := queryReceiver.queryRows(ctx, "span")
+	if err != nil {
+		return traces, err
+	}
+
+	var errs []error
+	scope := traces.ResourceSpans().AppendEmpty().ScopeSpans().AppendEmpty()
+	scope.Scope().SetName(metadata.ScopeName)
+	spans := scope.Spans()
+	for tracesConfigIndex, tracesConfig := range queryReceiver.query.Traces {
+		for _, row := range rows {
+			span := ptrace.NewSpan()
+			if err := rowToSpan(row, tracesConfig, span); err != nil {
+				// A span without valid IDs or timestamps would be rejected downstream.
+				errs = append(errs, err)
+			} else {
+				span.MoveTo(spans.AppendEmpty())
+			}
+			if tracesConfigIndex == 0 {
+				errs = append(errs, queryReceiver.storeTrackingValue(ctx, row))
+			}
+		}
+	}
+	return traces, errors.Join(errs...)
+}
+
+func rowToSpan(row sqlquery.StringMap, config sqlquery.TracesCfg, span ptrace.Span) error {
+	var errs []error
+	if value, found := row[config.TraceIDColumn]; !found {
+		errs = append(errs, fmt.Errorf("rowToSpan: trace_id_column '%s' not found in result set", config.TraceIDColumn))
+	} else if traceID, err := parseTraceID(value); err != nil {
+		errs = append(errs, fmt.Errorf("rowToSpan: trace_id_column '%s': %w", config.TraceIDColumn, err))
+	} else {
+		span.SetTraceID(traceID)
+	}
+
+	if value, found := row[config.SpanIDColumn]; !found {
+		errs = append(errs, fmt.Errorf("rowToSpan: span_id_column '%s' not found in result set", config.SpanIDColumn))
+	} else if spanID, err := parseSpanID(value); err != nil {
+		errs = append(errs, fmt.Errorf("rowToSpan: span_id_column '%s': %w", config.SpanIDColumn, err))
+	} else {
+		span.SetSpanID(spanID)
+	}
+
+	// The parent span ID of root spans is usually NULL, which leaves the column out of the row.
+	if value := row[config.ParentSpanIDColumn]; config.ParentSpanIDColumn != "" && value != "" {
+		if parentSpanID, err := parseSpanID(value); err != nil {
+			errs = append(errs, fmt.Errorf("rowToSpan: parent_span_id_column '%s': %w", config.ParentSpanIDColumn, err))
+		} else {
+			span.SetParentSpanID(parentSpanID)
+		}
+	}
+
+	if value, found := row[config.SpanNameColumn]; !found {
+		errs = append(errs, fmt.Errorf("rowToSpan: span_name_column '%s' not found in result set", config.SpanNameColumn))
+	} else {
+		span.SetName(value)
+	}
+	span.SetKind(spanKind(config.SpanKind))
+
+	if value, found := row[config.StartTsColumn]; !found {
+		errs = append(errs, fmt.Errorf("rowToSpan: start_ts_column '%s' not found in result set", config.StartTsColumn))
+	} else if ts, err := parseTimestamp(value); err != nil {
+		errs = append(errs, fmt.Errorf("rowToSpan: start_ts_column '%s': %w", config.StartTsColumn, err))
+	} else {
+		span.SetStartTimestamp(ts)
+	}
+
+	if value, found := row[config.EndTsColumn]; !found {
+		errs = append(errs, fmt.Errorf("rowToSpan: end_ts_column '%s' not found in result set", config.EndTsColumn))
+	} else if ts, err := parseTimestamp(value); err != nil {
+		errs = append(errs, fmt.Errorf("rowToSpan: end_ts_column '%s': %w", config.EndTsColumn, err))
+	} else {
+		span.SetEndTimestamp(ts)
+	}
+
+	if config.StatusCodeColumn != "" {
+		if value, found := row[config.StatusCodeColumn]; !found {
+			errs = append(errs, fmt.Errorf("rowToSpan: status_code_column '%s' not found in result set", config.StatusCodeColumn))
+		} else if code, err := parseStatusCode(value); err != nil {
+			errs = append(errs, fmt.Errorf("rowToSpan: status_code_column '%s': %w", config.StatusCodeColumn, err))
+		} else {
+			span.Status().SetCode(code)
+		}
+	}
+	if config.StatusMessageColumn != "" {
+		span.Status().SetMessage(row[config.StatusMessageColumn])
+	}
+
+	attrs := span.Attributes()
+	for _, columnName := range config.AttributeColumns {
+		if attrVal, found := row[columnName]; found {
+			attrs.PutStr(columnName, attrVal)
+		} else {
+			errs = append(errs, fmt.Errorf("rowToSpan: attribute_column '%s' not found in result set", columnName))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// parseTraceID parses a hex encoded trace ID. Dashes are ignored, so UUIDs can be used as trace IDs.
+func parseTraceID(value string) (pcommon.TraceID, error) {
+	var traceID pcommon.TraceID
+	b, err := hex.DecodeString(strings.ReplaceAll(value, "-", ""))
+	if err != nil {
+		return traceID, err
+	}
+	if len(b) != len(traceID) {
+		return traceID, fmt.Errorf("trace ID %q must be %d bytes long", value, len(traceID))
+	}
+	copy(traceID[:], b)
+	return traceID, nil
+}
+
+// parseSpanID parses a hex encoded span ID.
+func parseSpanID(value string) (pcommon.SpanID, error) {
+	var spanID pcommon.SpanID
+	b, err := hex.DecodeString(value)
+	if err != nil {
+		return spanID, err
+	}
+	if len(b) != len(spanID) {
+		return spanID, fmt.Errorf("span ID %q must be %d bytes long", value, len(spanID))
+	}
+	copy(spanID[:], b)
+	return spanID, nil
+}
+
+// parseTimestamp parses nanoseconds since the Unix epoch, or an RFC 3339 timestamp as
+// returned for the timestamp columns of most databases.
+func parseTimestamp(value string) (pcommon.Timestamp, error) {
+	if nanos, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return pcommon.Timestamp(nanos), nil
+	}
+	t, err := time.Parse(time.RFC3339Nano, value)
+	if err != nil {
+		return 0, fmt.Errorf("timestamp %q must be nanoseconds since the Unix epoch or in RFC 3339 format", value)
+	}
+	return pcommon.NewTimestampFromTime(t), nil
+}
+
+func parseStatusCode(value string) (ptrace.StatusCode, error) {
+	switch strings.ToLower(value) {
+	case "", "unset", "0":
+		return ptrace.StatusCodeUnset, nil
+	case "ok", "1":
+		return ptrace.StatusCodeOk, nil
+	case "error", "2":
+		return ptrace.StatusCodeError, nil
+	}
+	return ptrace.StatusCodeUnset, fmt.Errorf("unsupported status code %q, must be one of 'unset', 'ok' or 'error'", value)
+}
+
+func spanKind(kind sqlquery.SpanKind) ptrace.SpanKind {
+	switch kind {
+	case sqlquery.SpanKindServer:
+		return ptrace.SpanKindServer
+	case sqlquery.SpanKindClient:
+		return ptrace.SpanKindClient
+	case sqlquery.SpanKindProducer:
+		return ptrace.SpanKindProducer
+	case sqlquery.SpanKindConsumer:
+		return ptrace.SpanKindConsumer
+	default:
+		return ptrace.SpanKindInternal
+	}
+}
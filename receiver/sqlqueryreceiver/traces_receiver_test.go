@@ -0,0 +1,184 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sqlqueryreceiver
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+	"go.opentelemetry.io/collector/scraper/scraperhelper"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/sqlquery"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/sqlqueryreceiver/internal/metadata"
+)
+
+func testTracesCfg() sqlquery.TracesCfg {
+	return sqlquery.TracesCfg{
+		TraceIDColumn:       "trace_id",
+		SpanIDColumn:        "span_id",
+		ParentSpanIDColumn:  "parent_span_id",
+		SpanNameColumn:      "name",
+		SpanKind:            sqlquery.SpanKindServer,
+		StartTsColumn:       "start_ts",
+		EndTsColumn:         "end_ts",
+		StatusCodeColumn:    "status",
+		StatusMessageColumn: "status_message",
+		AttributeColumns:    []string{"owner"},
+	}
+}
+
+func TestTracesQueryReceiver_Collect(t *testing.T) {
+	fakeClient := &sqlquery.FakeDBClient{
+		StringMaps: [][]sqlquery.StringMap{
+			{
+				{
+					"trace_id":       "5b8efff798038103d269b633813fc60c",
+					"span_id":        "eee19b7ec3c1b174",
+					"name":           "root",
+					"start_ts":       "1700000000000000000",
+					"end_ts":         "1700000001000000000",
+					"status":         "OK",
+					"status_message": "",
+					"owner":          "alice",
+				},
+				{
+					"trace_id":       "5b8efff7-9803-8103-d269-b633813fc60c",
+					"span_id":        "eee19b7ec3c1b173",
+					"parent_span_id": "eee19b7ec3c1b174",
+					"name":           "child",
+					"start_ts":       "2023-11-14T22:13:20.5Z",
+					"end_ts":         "2023-11-14T22:13:21Z",
+					"status":         "2",
+					"status_message": "failed",
+					"owner":          "bob",
+				},
+			},
+		},
+	}
+	queryReceiver := tracesQueryReceiver{baseQueryReceiver: baseQueryReceiver{
+		client: fakeClient,
+		query: sqlquery.Query{
+			Traces: []sqlquery.TracesCfg{testTracesCfg()},
+		},
+	}}
+	traces, err := queryReceiver.collect(t.Context())
+	require.NoError(t, err)
+	require.Equal(t, 2, traces.SpanCount())
+
+	scopeSpans := traces.ResourceSpans().At(0).ScopeSpans().At(0)
+	assert.Equal(t, metadata.ScopeName, scopeSpans.Scope().Name())
+
+	traceID := pcommon.TraceID([16]byte{0x5b, 0x8e, 0xff, 0xf7, 0x98, 0x03, 0x81, 0x03, 0xd2, 0x69, 0xb6, 0x33, 0x81, 0x3f, 0xc6, 0x0c})
+	root := scopeSpans.Spans().At(0)
+	assert.Equal(t, traceID, root.TraceID())
+	assert.Equal(t, pcommon.SpanID([8]byte{0xee, 0xe1, 0x9b, 0x7e, 0xc3, 0xc1, 0xb1, 0x74}), root.SpanID())
+	assert.True(t, root.ParentSpanID().IsEmpty())
+	assert.Equal(t, "root", root.Name())
+	assert.Equal(t, ptrace.SpanKindServer, root.Kind())
+	assert.Equal(t, pcommon.Timestamp(1700000000000000000), root.StartTimestamp())
+	assert.Equal(t, pcommon.Timestamp(1700000001000000000), root.EndTimestamp())
+	assert.Equal(t, ptrace.StatusCodeOk, root.Status().Code())
+	owner, _ := root.Attributes().Get("owner")
+	assert.Equal(t, "alice", owner.Str())
+
+	child := scopeSpans.Spans().At(1)
+	assert.Equal(t, traceID, child.TraceID())
+	assert.Equal(t, root.SpanID(), child.ParentSpanID())
+	assert.Equal(t, pcommon.Timestamp(1700000000500000000), child.StartTimestamp())
+	assert.Equal(t, pcommon.Timestamp(1700000001000000000), child.EndTimestamp())
+	assert.Equal(t, ptrace.StatusCodeError, child.Status().Code())
+	assert.Equal(t, "failed", child.Status().Message())
+}
+
+func TestTracesQueryReceiver_InvalidRows(t *testing.T) {
+	fakeClient := &sqlquery.FakeDBClient{
+		StringMaps: [][]sqlquery.StringMap{
+			{
+				{"trace_id": "xyz", "span_id": "eee19b7ec3c1b174", "name": "a", "start_ts": "1", "end_ts": "2", "status": "unset", "owner": "alice"},
+				{"trace_id": "5b8efff798038103d269b633813fc60c", "span_id": "eee19b7ec3c1b174", "name": "b", "start_ts": "yesterday", "end_ts": "2", "status": "unset", "owner": "alice"},
+				{"trace_id": "5b8efff798038103d269b633813fc60c", "span_id": "eee19b7e", "name": "c", "start_ts": "1", "end_ts": "2", "status": "broken", "owner": "alice"},
+				{"trace_id": "5b8efff798038103d269b633813fc60c", "span_id": "eee19b7ec3c1b174", "name": "d", "start_ts": "1", "end_ts": "2", "status": "ok"},
+			},
+		},
+	}
+	queryReceiver := tracesQueryReceiver{baseQueryReceiver: baseQueryReceiver{
+		client: fakeClient,
+		query: sqlquery.Query{
+			Traces: []sqlquery.TracesCfg{testTracesCfg()},
+		},
+	}}
+	traces, err := queryReceiver.collect(t.Context())
+	assert.ErrorContains(t, err, "rowToSpan: trace_id_column 'trace_id'")
+	assert.ErrorContains(t, err, "rowToSpan: start_ts_column 'start_ts'")
+	assert.ErrorContains(t, err, "rowToSpan: span_id_column 'span_id': span ID \"eee19b7e\" must be 8 bytes long")
+	assert.ErrorContains(t, err, "rowToSpan: status_code_column 'status'")
+	assert.ErrorContains(t, err, "rowToSpan: attribute_column 'owner' not found in result set")
+	assert.Equal(t, 0, traces.SpanCount(), "invalid rows must not produce spans")
+}
+
+func TestTracesQueryReceiver_TrackingValue(t *testing.T) {
+	fakeClient := &sqlquery.FakeDBClient{
+		StringMaps: [][]sqlquery.StringMap{
+			{
+				{"trace_id": "5b8efff798038103d269b633813fc60c", "span_id": "eee19b7ec3c1b174", "name": "a", "start_ts": "1", "end_ts": "2"},
+				{"trace_id": "5b8efff798038103d269b633813fc60c", "span_id": "eee19b7ec3c1b173", "name": "b", "start_ts": "2", "end_ts": "3"},
+			},
+		},
+	}
+	queryReceiver := newTracesQueryReceiver("query-0: select", sqlquery.Query{
+		TrackingColumn:     "end_ts",
+		TrackingStartValue: "0",
+		Traces: []sqlquery.TracesCfg{{
+			TraceIDColumn:  "trace_id",
+			SpanIDColumn:   "span_id",
+			SpanNameColumn: "name",
+			StartTsColumn:  "start_ts",
+			EndTsColumn:    "end_ts",
+		}},
+	}, nil, nil, nil, sqlquery.TelemetryConfig{}, nil)
+	queryReceiver.client = fakeClient
+
+	assert.Equal(t, "0", queryReceiver.trackingValue)
+	assert.Equal(t, "query-0: select.trackingValue", queryReceiver.trackingValueStorageKey)
+
+	traces, err := queryReceiver.collect(t.Context())
+	require.NoError(t, err)
+	assert.Equal(t, 2, traces.SpanCount())
+	assert.Equal(t, "3", queryReceiver.trackingValue)
+	assert.Equal(t, ptrace.SpanKindInternal, traces.ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(0).Kind())
+}
+
+func TestTracesReceiver_StartShutdown(t *testing.T) {
+	createReceiver := createTracesReceiverFunc(fakeDBConnect, mkFakeClient)
+	ctx := t.Context()
+	receiver, err := createReceiver(
+		ctx,
+		receivertest.NewNopSettings(metadata.Type),
+		&Config{
+			Config: sqlquery.Config{
+				ControllerConfig: scraperhelper.ControllerConfig{
+					CollectionInterval: 10 * time.Second,
+					InitialDelay:       time.Second,
+				},
+				Driver:     "postgres",
+				DataSource: "my-datasource",
+				Queries: []sqlquery.Query{{
+					SQL:    "select * from foo",
+					Traces: []sqlquery.TracesCfg{testTracesCfg()},
+				}},
+			},
+		},
+		consumertest.NewNop(),
+	)
+	require.NoError(t, err)
+	require.NoError(t, receiver.Start(ctx, componenttest.NewNopHost()))
+	require.NoError(t, receiver.Shutdown(ctx))
+}